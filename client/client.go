@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,24 +17,75 @@ import (
 
 var (
 	httpClientTimeout = 30 * time.Second
+	retryBaseDelay    = 250 * time.Millisecond
+	retryMaxDelay     = 10 * time.Second
 )
 
 type Client struct {
-	host       string
-	httpClient *http.Client
+	host        string
+	apiKey      string
+	tokenSource TokenSource
+	httpClient  *http.Client
+	maxRetries  int
 }
 
-func New(host string) *Client {
-	return &Client{
+// TokenSource provides the bearer token of each request, such as an OIDC ID token that is
+// refreshed when it expires.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithAPIKey makes the client authenticate its requests with the API key.
+func WithAPIKey(key string) Option {
+	return func(c *Client) {
+		c.apiKey = key
+	}
+}
+
+// WithTokenSource makes the client authenticate its requests with tokens from ts. An API key
+// takes precedence.
+func WithTokenSource(ts TokenSource) Option {
+	return func(c *Client) {
+		c.tokenSource = ts
+	}
+}
+
+// WithRetries makes the client retry a request up to maxRetries times when it does not reach
+// the controller, is throttled or fails with a server error. POST requests are made
+// idempotent, so that retrying a request the controller already handled does not repeat it.
+func WithRetries(maxRetries int) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+	}
+}
+
+func New(host string, opts ...Option) *Client {
+	c := &Client{
 		host: host,
 		httpClient: &http.Client{
 			Timeout: httpClientTimeout,
 		},
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 func (c *Client) CreateZone(ctx context.Context, name string) (*Zone, error) {
-	req := createZoneRequest{Name: name}
+	return c.CreateZoneWithOptions(ctx, name, CreateZoneOptions{})
+}
+
+func (c *Client) CreateZoneWithOptions(ctx context.Context, name string, opts CreateZoneOptions) (*Zone, error) {
+	req := createZoneRequest{
+		Name:               name,
+		DelegateFromParent: opts.DelegateFromParent,
+		Template:           opts.Template,
+		Tags:               opts.Tags,
+	}
 	var resp Zone
 	if err := c.postRequest(ctx, "/v1/zones", req, &resp); err != nil {
 		return nil, err
@@ -39,14 +93,57 @@ func (c *Client) CreateZone(ctx context.Context, name string) (*Zone, error) {
 	return &resp, nil
 }
 
-func (c *Client) ListZones(ctx context.Context) ([]Zone, error) {
+// CreateReverseZones creates the reverse DNS zones that cover the addresses in cidr.
+func (c *Client) CreateReverseZones(ctx context.Context, cidr string, opts CreateZoneOptions) ([]Zone, error) {
+	req := createReverseZonesRequest{
+		CIDR:               cidr,
+		DelegateFromParent: opts.DelegateFromParent,
+		Template:           opts.Template,
+		Tags:               opts.Tags,
+	}
 	var resp listZonesResponse
-	if err := c.getRequest(ctx, "/v1/zones", &resp); err != nil {
+	if err := c.postRequest(ctx, "/v1/reverse-zones", req, &resp); err != nil {
 		return nil, err
 	}
 	return resp.Zones, nil
 }
 
+func (c *Client) ListZones(ctx context.Context) ([]Zone, error) {
+	return c.ListZonesWithOptions(ctx, ListOptions{})
+}
+
+// ListZonesWithOptions returns every zone that matches the options, fetching as many pages
+// as needed. Zones can be sorted by name.
+func (c *Client) ListZonesWithOptions(ctx context.Context, opts ListOptions) ([]Zone, error) {
+	return collect(c.AllZones(ctx, opts))
+}
+
+// AllZones iterates over the zones that match the options, fetching pages as it goes.
+func (c *Client) AllZones(ctx context.Context, opts ListOptions) iter.Seq2[Zone, error] {
+	return allPages(opts.Cursor, func(cursor string) (*Page[Zone], error) {
+		opts.Cursor = cursor
+		return c.ListZonesPage(ctx, opts)
+	})
+}
+
+// ListZonesPage returns a single page of the zones that match the options.
+func (c *Client) ListZonesPage(ctx context.Context, opts ListOptions) (*Page[Zone], error) {
+	var resp listZonesResponse
+	if err := c.getRequest(ctx, "/v1/zones"+opts.query(), &resp); err != nil {
+		return nil, err
+	}
+	return &Page[Zone]{Items: resp.Zones, NextCursor: resp.NextCursor}, nil
+}
+
+// UpdateZoneTags changes the tags of the zone and returns the tags it has afterwards.
+func (c *Client) UpdateZoneTags(ctx context.Context, name string, req UpdateTagsRequest) (map[string]string, error) {
+	var resp tagsResponse
+	if err := c.postRequest(ctx, fmt.Sprintf("/v1/zones/%s/tags", name), req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Tags, nil
+}
+
 func (c *Client) GetZone(ctx context.Context, name string) (*Zone, error) {
 	var resp Zone
 	if err := c.getRequest(ctx, fmt.Sprintf("/v1/zones/%s", name), &resp); err != nil {
@@ -59,12 +156,165 @@ func (c *Client) DeleteZone(ctx context.Context, name string) error {
 	return c.deleteRequest(ctx, fmt.Sprintf("/v1/zones/%s", name))
 }
 
+// ForceDeleteZone deletes the zone along with all of its resource record sets.
+func (c *Client) ForceDeleteZone(ctx context.Context, name string) error {
+	return c.deleteRequest(ctx, fmt.Sprintf("/v1/zones/%s?force=true", name))
+}
+
+func (c *Client) ListDeletedZones(ctx context.Context) ([]DeletedZone, error) {
+	return c.ListDeletedZonesWithOptions(ctx, ListOptions{})
+}
+
+// ListDeletedZonesWithOptions returns every deleted zone that matches the options, fetching
+// as many pages as needed. Deleted zones can be sorted by deletedAt, the default, or name.
+func (c *Client) ListDeletedZonesWithOptions(ctx context.Context, opts ListOptions) ([]DeletedZone, error) {
+	return collect(c.AllDeletedZones(ctx, opts))
+}
+
+// AllDeletedZones iterates over the deleted zones that match the options, fetching pages as
+// it goes.
+func (c *Client) AllDeletedZones(ctx context.Context, opts ListOptions) iter.Seq2[DeletedZone, error] {
+	return allPages(opts.Cursor, func(cursor string) (*Page[DeletedZone], error) {
+		opts.Cursor = cursor
+		return c.ListDeletedZonesPage(ctx, opts)
+	})
+}
+
+// ListDeletedZonesPage returns a single page of the deleted zones that match the options.
+func (c *Client) ListDeletedZonesPage(ctx context.Context, opts ListOptions) (*Page[DeletedZone], error) {
+	var resp listDeletedZonesResponse
+	if err := c.getRequest(ctx, "/v1/deleted-zones"+opts.query(), &resp); err != nil {
+		return nil, err
+	}
+	return &Page[DeletedZone]{Items: resp.DeletedZones, NextCursor: resp.NextCursor}, nil
+}
+
+func (c *Client) RestoreZone(ctx context.Context, name string) (*Zone, error) {
+	var resp Zone
+	if err := c.postRequest(ctx, fmt.Sprintf("/v1/deleted-zones/%s/restore", name), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) ImportZone(ctx context.Context, name string, zoneFile string) (*ImportZoneResult, error) {
+	req := importZoneRequest{ZoneFile: zoneFile}
+	var resp ImportZoneResult
+	if err := c.postRequest(ctx, fmt.Sprintf("/v1/zones/%s/import", name), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) ExportZone(ctx context.Context, name string) ([]byte, error) {
+	var resp []byte
+	if err := c.getRequest(ctx, fmt.Sprintf("/v1/zones/%s/export", name), &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// LintZone analyses the record sets of the zone and returns the problems found in them.
+func (c *Client) LintZone(ctx context.Context, name string, opts LintZoneOptions) ([]LintFinding, error) {
+	var resp lintZoneResponse
+	path := fmt.Sprintf("/v1/zones/%s/lint", name)
+	if opts.Resolve {
+		path += "?resolve=true"
+	}
+	if err := c.getRequest(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Findings, nil
+}
+
+func (c *Client) ListZoneVersions(ctx context.Context, name string) ([]ZoneVersion, error) {
+	return c.ListZoneVersionsWithOptions(ctx, name, ListOptions{})
+}
+
+// ListZoneVersionsWithOptions returns every version of the zone, fetching as many pages as
+// needed. Versions are sorted by version, newest first unless Order is asc.
+func (c *Client) ListZoneVersionsWithOptions(
+	ctx context.Context,
+	name string,
+	opts ListOptions,
+) ([]ZoneVersion, error) {
+	return collect(c.AllZoneVersions(ctx, name, opts))
+}
+
+// AllZoneVersions iterates over the versions of the zone, fetching pages as it goes.
+func (c *Client) AllZoneVersions(ctx context.Context, name string, opts ListOptions) iter.Seq2[ZoneVersion, error] {
+	return allPages(opts.Cursor, func(cursor string) (*Page[ZoneVersion], error) {
+		opts.Cursor = cursor
+		return c.ListZoneVersionsPage(ctx, name, opts)
+	})
+}
+
+// ListZoneVersionsPage returns a single page of the versions of the zone.
+func (c *Client) ListZoneVersionsPage(ctx context.Context, name string, opts ListOptions) (*Page[ZoneVersion], error) {
+	var resp listZoneVersionsResponse
+	if err := c.getRequest(ctx, fmt.Sprintf("/v1/zones/%s/versions", name)+opts.query(), &resp); err != nil {
+		return nil, err
+	}
+	return &Page[ZoneVersion]{Items: resp.Versions, NextCursor: resp.NextCursor}, nil
+}
+
+func (c *Client) DiffZoneVersions(ctx context.Context, name string, from int, to int) (*ZoneDiff, error) {
+	var resp ZoneDiff
+	path := fmt.Sprintf("/v1/zones/%s/versions/diff?from=%d&to=%d", name, from, to)
+	if err := c.getRequest(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) RollbackZone(ctx context.Context, name string, version int) (*ChangeInfo, error) {
+	req := rollbackZoneRequest{Version: version}
+	var resp ChangeInfo
+	if err := c.postRequest(ctx, fmt.Sprintf("/v1/zones/%s/rollback", name), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 func (c *Client) ListResourceRecordSets(ctx context.Context, zoneName string) ([]ResourceRecordSet, error) {
+	return c.ListResourceRecordSetsWithOptions(ctx, zoneName, ListResourceRecordSetsOptions{})
+}
+
+// ListResourceRecordSetsWithOptions returns every record set of the zone that matches the
+// options, fetching as many pages as needed.
+func (c *Client) ListResourceRecordSetsWithOptions(
+	ctx context.Context,
+	zoneName string,
+	opts ListResourceRecordSetsOptions,
+) ([]ResourceRecordSet, error) {
+	return collect(c.AllResourceRecordSets(ctx, zoneName, opts))
+}
+
+// AllResourceRecordSets iterates over the record sets of the zone that match the options,
+// fetching pages as it goes.
+func (c *Client) AllResourceRecordSets(
+	ctx context.Context,
+	zoneName string,
+	opts ListResourceRecordSetsOptions,
+) iter.Seq2[ResourceRecordSet, error] {
+	return allPages(opts.Cursor, func(cursor string) (*Page[ResourceRecordSet], error) {
+		opts.Cursor = cursor
+		return c.ListResourceRecordSetsPage(ctx, zoneName, opts)
+	})
+}
+
+// ListResourceRecordSetsPage returns a single page of the record sets of the zone that match
+// the options.
+func (c *Client) ListResourceRecordSetsPage(
+	ctx context.Context,
+	zoneName string,
+	opts ListResourceRecordSetsOptions,
+) (*Page[ResourceRecordSet], error) {
 	var resp listResourceRecordSetsResponse
-	if err := c.getRequest(ctx, fmt.Sprintf("/v1/zones/%s/rrsets", zoneName), &resp); err != nil {
+	if err := c.getRequest(ctx, fmt.Sprintf("/v1/zones/%s/rrsets", zoneName)+opts.query(), &resp); err != nil {
 		return nil, err
 	}
-	return resp.ResourceRecordSets, nil
+	return &Page[ResourceRecordSet]{Items: resp.ResourceRecordSets, NextCursor: resp.NextCursor}, nil
 }
 
 func (c *Client) UpsertResourceRecordSet(
@@ -72,9 +322,57 @@ func (c *Client) UpsertResourceRecordSet(
 	zoneName string,
 	rrSet ResourceRecordSet,
 ) (*ResourceRecordSet, error) {
+	result, err := c.UpsertResourceRecordSetWithOptions(ctx, zoneName, rrSet, ResourceRecordSetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &result.ResourceRecordSet, nil
+}
+
+func (c *Client) UpsertResourceRecordSetWithOptions(
+	ctx context.Context,
+	zoneName string,
+	rrSet ResourceRecordSet,
+	opts ResourceRecordSetOptions,
+) (*UpsertResourceRecordSetResult, error) {
 	req := upsertResourceRecordSetRequest{ResourceRecordSet: rrSet}
-	var resp ResourceRecordSet
-	if err := c.postRequest(ctx, fmt.Sprintf("/v1/zones/%s/rrsets", zoneName), req, &resp); err != nil {
+	var resp UpsertResourceRecordSetResult
+	path := fmt.Sprintf("/v1/zones/%s/rrsets%s", zoneName, opts.query())
+	if err := c.postRequest(ctx, path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// PlanUpsertResourceRecordSet returns the changes an upsert of rrSet would make to the zone
+// without applying it. Only the zone itself is covered: the PTR records an upsert with
+// SyncPTR would change in reverse zones, and any delegation it would update in the parent
+// zone, are not part of the plan.
+func (c *Client) PlanUpsertResourceRecordSet(
+	ctx context.Context,
+	zoneName string,
+	rrSet ResourceRecordSet,
+) (*ZoneDiff, error) {
+	req := upsertResourceRecordSetRequest{ResourceRecordSet: rrSet}
+	var resp ZoneDiff
+	if err := c.postRequest(ctx, fmt.Sprintf("/v1/zones/%s/rrsets?dryRun=true", zoneName), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// PlanDeleteResourceRecordSet returns the changes deleting the resource record set would
+// make to the zone without applying it. As with PlanUpsertResourceRecordSet, changes to
+// reverse and parent zones are not part of the plan.
+func (c *Client) PlanDeleteResourceRecordSet(
+	ctx context.Context,
+	zoneName string,
+	name string,
+	rrType string,
+) (*ZoneDiff, error) {
+	var resp ZoneDiff
+	path := fmt.Sprintf("/v1/zones/%s/rrsets/%s/%s?dryRun=true", zoneName, name, rrType)
+	if err := c.doRequest(ctx, "DELETE", path, nil, &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
@@ -94,7 +392,97 @@ func (c *Client) GetResourceRecordSet(
 }
 
 func (c *Client) DeleteResourceRecordSet(ctx context.Context, zoneID string, name string, rrType string) error {
-	return c.deleteRequest(ctx, fmt.Sprintf("/v1/zones/%s/rrsets/%s/%s", zoneID, name, rrType))
+	return c.DeleteResourceRecordSetWithOptions(ctx, zoneID, name, rrType, ResourceRecordSetOptions{})
+}
+
+func (c *Client) DeleteResourceRecordSetWithOptions(
+	ctx context.Context,
+	zoneID string,
+	name string,
+	rrType string,
+	opts ResourceRecordSetOptions,
+) error {
+	return c.deleteRequest(ctx, fmt.Sprintf("/v1/zones/%s/rrsets/%s/%s%s", zoneID, name, rrType, opts.query()))
+}
+
+func (c *Client) CreateZoneTemplate(ctx context.Context, req CreateZoneTemplateRequest) (*ZoneTemplate, error) {
+	var resp ZoneTemplate
+	if err := c.postRequest(ctx, "/v1/zone-templates", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) UpdateZoneTemplate(
+	ctx context.Context,
+	name string,
+	req UpdateZoneTemplateRequest,
+) (*ZoneTemplate, error) {
+	var resp ZoneTemplate
+	if err := c.postRequest(ctx, fmt.Sprintf("/v1/zone-templates/%s", name), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) GetZoneTemplate(ctx context.Context, name string) (*ZoneTemplate, error) {
+	var resp ZoneTemplate
+	if err := c.getRequest(ctx, fmt.Sprintf("/v1/zone-templates/%s", name), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) ListZoneTemplates(ctx context.Context) ([]ZoneTemplate, error) {
+	return c.ListZoneTemplatesWithOptions(ctx, ListOptions{})
+}
+
+// ListZoneTemplatesWithOptions returns every zone template that matches the options, fetching
+// as many pages as needed. Templates can be sorted by name.
+func (c *Client) ListZoneTemplatesWithOptions(ctx context.Context, opts ListOptions) ([]ZoneTemplate, error) {
+	return collect(c.AllZoneTemplates(ctx, opts))
+}
+
+// AllZoneTemplates iterates over the zone templates that match the options, fetching pages as
+// it goes.
+func (c *Client) AllZoneTemplates(ctx context.Context, opts ListOptions) iter.Seq2[ZoneTemplate, error] {
+	return allPages(opts.Cursor, func(cursor string) (*Page[ZoneTemplate], error) {
+		opts.Cursor = cursor
+		return c.ListZoneTemplatesPage(ctx, opts)
+	})
+}
+
+// ListZoneTemplatesPage returns a single page of the zone templates that match the options.
+func (c *Client) ListZoneTemplatesPage(ctx context.Context, opts ListOptions) (*Page[ZoneTemplate], error) {
+	var resp listZoneTemplatesResponse
+	if err := c.getRequest(ctx, "/v1/zone-templates"+opts.query(), &resp); err != nil {
+		return nil, err
+	}
+	return &Page[ZoneTemplate]{Items: resp.ZoneTemplates, NextCursor: resp.NextCursor}, nil
+}
+
+func (c *Client) DeleteZoneTemplate(ctx context.Context, name string) error {
+	return c.deleteRequest(ctx, fmt.Sprintf("/v1/zone-templates/%s", name))
+}
+
+// ApplyZoneTemplate upserts the record sets of the template that are missing from or differ
+// in each of the zones, and returns how each zone had drifted from the template.
+func (c *Client) ApplyZoneTemplate(ctx context.Context, name string, zones []string) ([]ZoneTemplateDrift, error) {
+	return c.applyZoneTemplate(ctx, fmt.Sprintf("/v1/zone-templates/%s/apply", name), zones)
+}
+
+// GetZoneTemplateDrift returns how each of the zones has drifted from the template without
+// changing them.
+func (c *Client) GetZoneTemplateDrift(ctx context.Context, name string, zones []string) ([]ZoneTemplateDrift, error) {
+	return c.applyZoneTemplate(ctx, fmt.Sprintf("/v1/zone-templates/%s/apply?dryRun=true", name), zones)
+}
+
+func (c *Client) applyZoneTemplate(ctx context.Context, path string, zones []string) ([]ZoneTemplateDrift, error) {
+	var resp applyZoneTemplateResponse
+	if err := c.postRequest(ctx, path, applyZoneTemplateRequest{Zones: zones}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Zones, nil
 }
 
 func (c *Client) CreateFirewallRule(ctx context.Context, req CreateFirewallRuleRequest) (*FirewallRule, error) {
@@ -106,11 +494,45 @@ func (c *Client) CreateFirewallRule(ctx context.Context, req CreateFirewallRuleR
 }
 
 func (c *Client) GetFirewallRules(ctx context.Context) ([]FirewallRule, error) {
+	return c.GetFirewallRulesWithOptions(ctx, ListOptions{})
+}
+
+// GetFirewallRulesWithOptions returns every firewall rule that matches the options, fetching
+// as many pages as needed. Rules can be sorted by priority, the default, or name.
+func (c *Client) GetFirewallRulesWithOptions(ctx context.Context, opts ListOptions) ([]FirewallRule, error) {
+	return collect(c.AllFirewallRules(ctx, opts))
+}
+
+// AllFirewallRules iterates over the firewall rules that match the options, fetching pages as
+// it goes.
+func (c *Client) AllFirewallRules(ctx context.Context, opts ListOptions) iter.Seq2[FirewallRule, error] {
+	return allPages(opts.Cursor, func(cursor string) (*Page[FirewallRule], error) {
+		opts.Cursor = cursor
+		return c.GetFirewallRulesPage(ctx, opts)
+	})
+}
+
+// GetFirewallRulesPage returns a single page of the firewall rules that match the options.
+func (c *Client) GetFirewallRulesPage(ctx context.Context, opts ListOptions) (*Page[FirewallRule], error) {
 	var resp getFirewallRulesResponse
-	if err := c.getRequest(ctx, "/v1/firewall/rules", &resp); err != nil {
+	if err := c.getRequest(ctx, "/v1/firewall/rules"+opts.query(), &resp); err != nil {
 		return nil, err
 	}
-	return resp.Rules, nil
+	return &Page[FirewallRule]{Items: resp.Rules, NextCursor: resp.NextCursor}, nil
+}
+
+// UpdateFirewallRuleTags changes the tags of the firewall rule and returns the tags it has
+// afterwards.
+func (c *Client) UpdateFirewallRuleTags(
+	ctx context.Context,
+	id uuid.UUID,
+	req UpdateTagsRequest,
+) (map[string]string, error) {
+	var resp tagsResponse
+	if err := c.postRequest(ctx, fmt.Sprintf("/v1/firewall/rules/%s/tags", id), req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Tags, nil
 }
 
 func (c *Client) DeleteFirewallRule(ctx context.Context, id uuid.UUID) error {
@@ -146,11 +568,45 @@ func (c *Client) CreateDomainList(ctx context.Context, req CreateDomainListReque
 }
 
 func (c *Client) GetDomainLists(ctx context.Context) ([]DomainList, error) {
+	return c.GetDomainListsWithOptions(ctx, ListOptions{})
+}
+
+// GetDomainListsWithOptions returns every domain list that matches the options, fetching as
+// many pages as needed. Domain lists can be sorted by name or updatedAt.
+func (c *Client) GetDomainListsWithOptions(ctx context.Context, opts ListOptions) ([]DomainList, error) {
+	return collect(c.AllDomainLists(ctx, opts))
+}
+
+// AllDomainLists iterates over the domain lists that match the options, fetching pages as it
+// goes.
+func (c *Client) AllDomainLists(ctx context.Context, opts ListOptions) iter.Seq2[DomainList, error] {
+	return allPages(opts.Cursor, func(cursor string) (*Page[DomainList], error) {
+		opts.Cursor = cursor
+		return c.GetDomainListsPage(ctx, opts)
+	})
+}
+
+// GetDomainListsPage returns a single page of the domain lists that match the options.
+func (c *Client) GetDomainListsPage(ctx context.Context, opts ListOptions) (*Page[DomainList], error) {
 	var resp listDomainListsResponse
-	if err := c.getRequest(ctx, "/v1/firewall/domain-lists", &resp); err != nil {
+	if err := c.getRequest(ctx, "/v1/firewall/domain-lists"+opts.query(), &resp); err != nil {
 		return nil, err
 	}
-	return resp.DomainLists, nil
+	return &Page[DomainList]{Items: resp.DomainLists, NextCursor: resp.NextCursor}, nil
+}
+
+// UpdateDomainListTags changes the tags of the domain list and returns the tags it has
+// afterwards.
+func (c *Client) UpdateDomainListTags(
+	ctx context.Context,
+	id uuid.UUID,
+	req UpdateTagsRequest,
+) (map[string]string, error) {
+	var resp tagsResponse
+	if err := c.postRequest(ctx, fmt.Sprintf("/v1/firewall/domain-lists/%s/tags", id), req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Tags, nil
 }
 
 func (c *Client) GetDomainList(ctx context.Context, id uuid.UUID) (*DomainList, error) {
@@ -162,11 +618,36 @@ func (c *Client) GetDomainList(ctx context.Context, id uuid.UUID) (*DomainList,
 }
 
 func (c *Client) GetDomainListDomains(ctx context.Context, id uuid.UUID) ([]string, error) {
+	return c.GetDomainListDomainsWithOptions(ctx, id, ListOptions{})
+}
+
+// GetDomainListDomainsWithOptions returns every domain of the domain list that matches the
+// options, fetching as many pages as needed. NamePrefix matches the start of the domain.
+func (c *Client) GetDomainListDomainsWithOptions(
+	ctx context.Context,
+	id uuid.UUID,
+	opts ListOptions,
+) ([]string, error) {
+	return collect(c.AllDomainListDomains(ctx, id, opts))
+}
+
+// AllDomainListDomains iterates over the domains of the domain list, fetching pages as it
+// goes. Large domain lists are best read this way.
+func (c *Client) AllDomainListDomains(ctx context.Context, id uuid.UUID, opts ListOptions) iter.Seq2[string, error] {
+	return allPages(opts.Cursor, func(cursor string) (*Page[string], error) {
+		opts.Cursor = cursor
+		return c.GetDomainListDomainsPage(ctx, id, opts)
+	})
+}
+
+// GetDomainListDomainsPage returns a single page of the domains of the domain list.
+func (c *Client) GetDomainListDomainsPage(ctx context.Context, id uuid.UUID, opts ListOptions) (*Page[string], error) {
 	var resp getDomainListDomainsResponse
-	if err := c.getRequest(ctx, fmt.Sprintf("/v1/firewall/domain-lists/%s/domains", id), &resp); err != nil {
+	path := fmt.Sprintf("/v1/firewall/domain-lists/%s/domains", id) + opts.query()
+	if err := c.getRequest(ctx, path, &resp); err != nil {
 		return nil, err
 	}
-	return resp.Domains, nil
+	return &Page[string]{Items: resp.Domains, NextCursor: resp.NextCursor}, nil
 }
 
 func (c *Client) AddDomainsToDomainList(ctx context.Context, id uuid.UUID, domains []string) error {
@@ -191,6 +672,286 @@ func (c *Client) DeleteDomainList(ctx context.Context, id uuid.UUID) error {
 	return c.deleteRequest(ctx, fmt.Sprintf("/v1/firewall/domain-lists/%s", id))
 }
 
+// CreateAPIKey creates an API key. The returned Key cannot be retrieved again.
+func (c *Client) CreateAPIKey(ctx context.Context, req CreateAPIKeyRequest) (*CreatedAPIKey, error) {
+	var resp CreatedAPIKey
+	if err := c.postRequest(ctx, "/v1/api-keys", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) ListAPIKeys(ctx context.Context) ([]APIKey, error) {
+	return c.ListAPIKeysWithOptions(ctx, ListOptions{})
+}
+
+// ListAPIKeysWithOptions returns every API key, including revoked and expired ones, that
+// matches the options, fetching as many pages as needed. API keys can be sorted by name or
+// createdAt.
+func (c *Client) ListAPIKeysWithOptions(ctx context.Context, opts ListOptions) ([]APIKey, error) {
+	return collect(c.AllAPIKeys(ctx, opts))
+}
+
+// AllAPIKeys iterates over the API keys that match the options, fetching pages as it goes.
+func (c *Client) AllAPIKeys(ctx context.Context, opts ListOptions) iter.Seq2[APIKey, error] {
+	return allPages(opts.Cursor, func(cursor string) (*Page[APIKey], error) {
+		opts.Cursor = cursor
+		return c.ListAPIKeysPage(ctx, opts)
+	})
+}
+
+// ListAPIKeysPage returns a single page of the API keys that match the options.
+func (c *Client) ListAPIKeysPage(ctx context.Context, opts ListOptions) (*Page[APIKey], error) {
+	var resp listAPIKeysResponse
+	if err := c.getRequest(ctx, "/v1/api-keys"+opts.query(), &resp); err != nil {
+		return nil, err
+	}
+	return &Page[APIKey]{Items: resp.APIKeys, NextCursor: resp.NextCursor}, nil
+}
+
+// RevokeAPIKey revokes the API key. Requests made with it are rejected from then on.
+func (c *Client) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	return c.deleteRequest(ctx, fmt.Sprintf("/v1/api-keys/%s", id))
+}
+
+// CreateWebhook creates a webhook. The returned Secret cannot be retrieved again.
+func (c *Client) CreateWebhook(ctx context.Context, req CreateWebhookRequest) (*CreatedWebhook, error) {
+	var resp CreatedWebhook
+	if err := c.postRequest(ctx, "/v1/webhooks", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) GetWebhook(ctx context.Context, id uuid.UUID) (*Webhook, error) {
+	var resp Webhook
+	if err := c.getRequest(ctx, fmt.Sprintf("/v1/webhooks/%s", id), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) ListWebhooks(ctx context.Context) ([]Webhook, error) {
+	return c.ListWebhooksWithOptions(ctx, ListOptions{})
+}
+
+// ListWebhooksWithOptions returns every webhook that matches the options, fetching as many
+// pages as needed. Webhooks can be sorted by name or createdAt.
+func (c *Client) ListWebhooksWithOptions(ctx context.Context, opts ListOptions) ([]Webhook, error) {
+	return collect(c.AllWebhooks(ctx, opts))
+}
+
+// AllWebhooks iterates over the webhooks that match the options, fetching pages as it goes.
+func (c *Client) AllWebhooks(ctx context.Context, opts ListOptions) iter.Seq2[Webhook, error] {
+	return allPages(opts.Cursor, func(cursor string) (*Page[Webhook], error) {
+		opts.Cursor = cursor
+		return c.ListWebhooksPage(ctx, opts)
+	})
+}
+
+// ListWebhooksPage returns a single page of the webhooks that match the options.
+func (c *Client) ListWebhooksPage(ctx context.Context, opts ListOptions) (*Page[Webhook], error) {
+	var resp listWebhooksResponse
+	if err := c.getRequest(ctx, "/v1/webhooks"+opts.query(), &resp); err != nil {
+		return nil, err
+	}
+	return &Page[Webhook]{Items: resp.Webhooks, NextCursor: resp.NextCursor}, nil
+}
+
+// DeleteWebhook deletes the webhook along with its delivery log.
+func (c *Client) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	return c.deleteRequest(ctx, fmt.Sprintf("/v1/webhooks/%s", id))
+}
+
+// ListWebhookDeliveries returns the delivery log of the webhook, fetching as many pages as
+// needed. Deliveries are sorted by createdAt, newest first unless Order is asc.
+func (c *Client) ListWebhookDeliveries(ctx context.Context, id uuid.UUID, opts ListOptions) ([]WebhookDelivery, error) {
+	return collect(c.AllWebhookDeliveries(ctx, id, opts))
+}
+
+// AllWebhookDeliveries iterates over the delivery log of the webhook, fetching pages as it
+// goes.
+func (c *Client) AllWebhookDeliveries(
+	ctx context.Context,
+	id uuid.UUID,
+	opts ListOptions,
+) iter.Seq2[WebhookDelivery, error] {
+	return allPages(opts.Cursor, func(cursor string) (*Page[WebhookDelivery], error) {
+		opts.Cursor = cursor
+		return c.ListWebhookDeliveriesPage(ctx, id, opts)
+	})
+}
+
+// ListWebhookDeliveriesPage returns a single page of the delivery log of the webhook.
+func (c *Client) ListWebhookDeliveriesPage(
+	ctx context.Context,
+	id uuid.UUID,
+	opts ListOptions,
+) (*Page[WebhookDelivery], error) {
+	var resp listWebhookDeliveriesResponse
+	if err := c.getRequest(ctx, fmt.Sprintf("/v1/webhooks/%s/deliveries", id)+opts.query(), &resp); err != nil {
+		return nil, err
+	}
+	return &Page[WebhookDelivery]{Items: resp.Deliveries, NextCursor: resp.NextCursor}, nil
+}
+
+// RedeliverWebhookDelivery sends the event of a delivery to the webhook again and returns
+// the new delivery.
+func (c *Client) RedeliverWebhookDelivery(
+	ctx context.Context,
+	id uuid.UUID,
+	deliveryID uuid.UUID,
+) (*WebhookDelivery, error) {
+	var resp WebhookDelivery
+	path := fmt.Sprintf("/v1/webhooks/%s/deliveries/%s/redeliver", id, deliveryID)
+	if err := c.postRequest(ctx, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// WhoAmI returns the principal the client is authenticated as and what it is allowed to do.
+func (c *Client) WhoAmI(ctx context.Context) (*WhoAmI, error) {
+	var resp WhoAmI
+	if err := c.getRequest(ctx, "/v1/whoami", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetOIDCProvider returns how users sign in to the controller through OIDC. It does not need
+// the client to be authenticated.
+func (c *Client) GetOIDCProvider(ctx context.Context) (*OIDCProvider, error) {
+	var resp OIDCProvider
+	if err := c.getRequest(ctx, "/v1/auth/oidc", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) CreateRole(ctx context.Context, req CreateRoleRequest) (*Role, error) {
+	var resp Role
+	if err := c.postRequest(ctx, "/v1/roles", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateRole replaces the description and permissions of the role. Built-in roles cannot
+// be updated.
+func (c *Client) UpdateRole(ctx context.Context, name string, req UpdateRoleRequest) (*Role, error) {
+	var resp Role
+	if err := c.postRequest(ctx, fmt.Sprintf("/v1/roles/%s", name), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) GetRole(ctx context.Context, name string) (*Role, error) {
+	var resp Role
+	if err := c.getRequest(ctx, fmt.Sprintf("/v1/roles/%s", name), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) ListRoles(ctx context.Context) ([]Role, error) {
+	return c.ListRolesWithOptions(ctx, ListOptions{})
+}
+
+// ListRolesWithOptions returns every role that matches the options, fetching as many pages
+// as needed. Roles are sorted by name.
+func (c *Client) ListRolesWithOptions(ctx context.Context, opts ListOptions) ([]Role, error) {
+	return collect(c.AllRoles(ctx, opts))
+}
+
+// AllRoles iterates over the roles that match the options, fetching pages as it goes.
+func (c *Client) AllRoles(ctx context.Context, opts ListOptions) iter.Seq2[Role, error] {
+	return allPages(opts.Cursor, func(cursor string) (*Page[Role], error) {
+		opts.Cursor = cursor
+		return c.ListRolesPage(ctx, opts)
+	})
+}
+
+// ListRolesPage returns a single page of the roles that match the options.
+func (c *Client) ListRolesPage(ctx context.Context, opts ListOptions) (*Page[Role], error) {
+	var resp listRolesResponse
+	if err := c.getRequest(ctx, "/v1/roles"+opts.query(), &resp); err != nil {
+		return nil, err
+	}
+	return &Page[Role]{Items: resp.Roles, NextCursor: resp.NextCursor}, nil
+}
+
+// DeleteRole deletes the role along with its bindings. Built-in roles cannot be deleted.
+func (c *Client) DeleteRole(ctx context.Context, name string) error {
+	return c.deleteRequest(ctx, fmt.Sprintf("/v1/roles/%s", name))
+}
+
+func (c *Client) CreateRoleBinding(ctx context.Context, req CreateRoleBindingRequest) (*RoleBinding, error) {
+	var resp RoleBinding
+	if err := c.postRequest(ctx, "/v1/role-bindings", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) ListRoleBindings(ctx context.Context) ([]RoleBinding, error) {
+	return c.ListRoleBindingsWithOptions(ctx, ListRoleBindingsOptions{})
+}
+
+// ListRoleBindingsWithOptions returns every role binding that matches the options, fetching
+// as many pages as needed.
+func (c *Client) ListRoleBindingsWithOptions(ctx context.Context, opts ListRoleBindingsOptions) ([]RoleBinding, error) {
+	return collect(c.AllRoleBindings(ctx, opts))
+}
+
+// AllRoleBindings iterates over the role bindings that match the options, fetching pages as
+// it goes.
+func (c *Client) AllRoleBindings(ctx context.Context, opts ListRoleBindingsOptions) iter.Seq2[RoleBinding, error] {
+	return allPages(opts.Cursor, func(cursor string) (*Page[RoleBinding], error) {
+		opts.Cursor = cursor
+		return c.ListRoleBindingsPage(ctx, opts)
+	})
+}
+
+// ListRoleBindingsPage returns a single page of the role bindings that match the options.
+func (c *Client) ListRoleBindingsPage(ctx context.Context, opts ListRoleBindingsOptions) (*Page[RoleBinding], error) {
+	var resp listRoleBindingsResponse
+	if err := c.getRequest(ctx, "/v1/role-bindings"+opts.query(), &resp); err != nil {
+		return nil, err
+	}
+	return &Page[RoleBinding]{Items: resp.RoleBindings, NextCursor: resp.NextCursor}, nil
+}
+
+func (c *Client) DeleteRoleBinding(ctx context.Context, id uuid.UUID) error {
+	return c.deleteRequest(ctx, fmt.Sprintf("/v1/role-bindings/%s", id))
+}
+
+// ListAuditEntries returns every entry of the audit log that matches the options, fetching
+// as many pages as needed.
+func (c *Client) ListAuditEntries(ctx context.Context, opts ListAuditEntriesOptions) ([]AuditEntry, error) {
+	return collect(c.AllAuditEntries(ctx, opts))
+}
+
+// AllAuditEntries iterates over the entries of the audit log that match the options,
+// fetching pages as it goes.
+func (c *Client) AllAuditEntries(ctx context.Context, opts ListAuditEntriesOptions) iter.Seq2[AuditEntry, error] {
+	return allPages(opts.Cursor, func(cursor string) (*Page[AuditEntry], error) {
+		opts.Cursor = cursor
+		return c.ListAuditEntriesPage(ctx, opts)
+	})
+}
+
+// ListAuditEntriesPage returns a single page of the entries of the audit log that match the
+// options.
+func (c *Client) ListAuditEntriesPage(ctx context.Context, opts ListAuditEntriesOptions) (*Page[AuditEntry], error) {
+	var resp listAuditEntriesResponse
+	if err := c.getRequest(ctx, "/v1/audit"+opts.query(), &resp); err != nil {
+		return nil, err
+	}
+	return &Page[AuditEntry]{Items: resp.Entries, NextCursor: resp.NextCursor}, nil
+}
+
 func (c *Client) getRequest(ctx context.Context, path string, result any) error {
 	return c.doRequest(ctx, "GET", path, nil, result)
 }
@@ -200,46 +961,137 @@ func (c *Client) postRequest(ctx context.Context, path string, body any, result
 	if err != nil {
 		return fmt.Errorf("failed to marshal request body: %w", err)
 	}
-	return c.doRequest(ctx, "POST", path, bytes.NewReader(jsonBody), result)
+	return c.doRequest(ctx, "POST", path, jsonBody, result)
 }
 
 func (c *Client) deleteRequest(ctx context.Context, path string) error {
 	return c.doRequest(ctx, "DELETE", path, nil, nil)
 }
 
-func (c *Client) doRequest(ctx context.Context, method, path string, bodyReader io.Reader, result any) error {
+// doRequest sends a request, retrying it as configured with WithRetries. A POST request that
+// may be retried carries an Idempotency-Key header, which stays the same across the retries,
+// so that the controller replays the response to a request it already handled rather than
+// handling it twice.
+func (c *Client) doRequest(ctx context.Context, method, path string, body []byte, result any) error {
+	var idempotencyKey string
+	if method == http.MethodPost && c.maxRetries > 0 {
+		idempotencyKey = uuid.NewString()
+	}
+
+	for attempt := 0; ; attempt++ {
+		retry, err := c.sendRequest(ctx, method, path, body, idempotencyKey, result)
+		if err == nil || !retry || attempt >= c.maxRetries {
+			return err
+		}
+
+		timer := time.NewTimer(retryDelay(attempt, err))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// sendRequest sends a request once. It reports whether a failed request can be retried,
+// which is when it did not reach the controller, was throttled, failed with a server error or
+// found an earlier attempt with the same Idempotency-Key still being handled.
+func (c *Client) sendRequest(
+	ctx context.Context,
+	method, path string,
+	body []byte,
+	idempotencyKey string,
+	result any,
+) (bool, error) {
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
 	req, err := http.NewRequestWithContext(ctx, method, c.host+path, bodyReader)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return false, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+	if err = c.authorize(ctx, req); err != nil {
+		return false, err
+	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+		return ctx.Err() == nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode >= http.StatusBadRequest {
-		return c.handleError(resp)
+		err = c.handleError(resp)
+		var inUseErr *IdempotencyKeyInUseError
+		retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError ||
+			errors.As(err, &inUseErr)
+		return retry, err
+	}
+
+	if raw, ok := result.(*[]byte); ok {
+		if *raw, err = io.ReadAll(resp.Body); err != nil {
+			return false, fmt.Errorf("failed to read response: %w", err)
+		}
+		return false, nil
 	}
 
 	if result != nil {
 		if decodeErr := json.NewDecoder(resp.Body).Decode(result); decodeErr != nil {
-			return fmt.Errorf("failed to decode response: %w", decodeErr)
+			return false, fmt.Errorf("failed to decode response: %w", decodeErr)
 		}
 	}
 
+	return false, nil
+}
+
+// authorize sets the Authorization header of req from the API key, or else the token source.
+func (c *Client) authorize(ctx context.Context, req *http.Request) error {
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	} else if c.tokenSource != nil {
+		token, err := c.tokenSource.Token(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get token: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
 	return nil
 }
 
+// retryDelay returns how long to wait before retrying a request that failed with err for the
+// attempt+1th time. A throttled request is retried when the controller says, and any other
+// request after a delay that doubles with every attempt.
+func retryDelay(attempt int, err error) time.Duration {
+	var throttlingErr *ThrottlingError
+	if errors.As(err, &throttlingErr) && throttlingErr.RetryAfter > 0 {
+		return throttlingErr.RetryAfter
+	}
+
+	return min(retryBaseDelay<<attempt, retryMaxDelay)
+}
+
 func (c *Client) handleError(resp *http.Response) error {
 	body, _ := io.ReadAll(resp.Body)
 	var errResponse errorResponse
 	if err := json.Unmarshal(body, &errResponse); err != nil {
 		return fmt.Errorf("failed to unmarshal error response: %w", err)
 	}
-	return parseError(errResponse)
+
+	err := parseError(errResponse)
+	var throttlingErr *ThrottlingError
+	if errors.As(err, &throttlingErr) {
+		if seconds, convErr := strconv.Atoi(resp.Header.Get("Retry-After")); convErr == nil {
+			throttlingErr.RetryAfter = time.Duration(seconds) * time.Second
+		}
+	}
+	return err
 }
@@ -1,11 +1,17 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -531,3 +537,1210 @@ func TestClient_DeleteResourceRecordSet(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_ImportZone(t *testing.T) {
+	tests := []struct {
+		name           string
+		zoneID         string
+		zoneFile       string
+		serverResponse *ImportZoneResult
+		serverStatus   int
+		serverError    *errorResponse
+		wantErr        bool
+	}{
+		{
+			name:     "success",
+			zoneID:   "example.com",
+			zoneFile: "www 300 IN A 1.2.3.4\n",
+			serverResponse: &ImportZoneResult{
+				ChangeID: "change-id",
+				ResourceRecordSets: []ResourceRecordSet{
+					{
+						Name:            "www.example.com.",
+						Type:            "A",
+						TTL:             300,
+						ResourceRecords: []ResourceRecord{{Value: "1.2.3.4"}},
+					},
+				},
+				SkippedRecords: []SkippedRecord{},
+			},
+			serverStatus: http.StatusOK,
+			wantErr:      false,
+		},
+		{
+			name:     "zone not found",
+			zoneID:   "non-existent",
+			zoneFile: "www 300 IN A 1.2.3.4\n",
+			serverError: &errorResponse{
+				Code:    "NoSuchZone",
+				Message: "zone not found",
+			},
+			serverStatus: http.StatusNotFound,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/v1/zones/"+tt.zoneID+"/import", r.URL.Path)
+
+				var req importZoneRequest
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				assert.Equal(t, tt.zoneFile, req.ZoneFile)
+
+				if tt.serverError != nil {
+					w.WriteHeader(tt.serverStatus)
+					json.NewEncoder(w).Encode(tt.serverError)
+					return
+				}
+
+				w.WriteHeader(tt.serverStatus)
+				json.NewEncoder(w).Encode(tt.serverResponse)
+			}))
+			defer server.Close()
+
+			client := New(server.URL)
+			result, err := client.ImportZone(t.Context(), tt.zoneID, tt.zoneFile)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.serverError != nil {
+					assert.IsType(t, &NoSuchZoneError{}, err)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.serverResponse, result)
+		})
+	}
+}
+
+func TestClient_ExportZone(t *testing.T) {
+	tests := []struct {
+		name         string
+		zoneID       string
+		serverBody   string
+		serverStatus int
+		serverError  *errorResponse
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			zoneID:       "example.com",
+			serverBody:   "$ORIGIN example.com.\nwww.example.com.\t300\tIN\tA\t192.0.2.1\n",
+			serverStatus: http.StatusOK,
+			wantErr:      false,
+		},
+		{
+			name:   "zone not found",
+			zoneID: "non-existent",
+			serverError: &errorResponse{
+				Code:    "NoSuchZone",
+				Message: "zone not found",
+			},
+			serverStatus: http.StatusNotFound,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "GET", r.Method)
+				assert.Equal(t, "/v1/zones/"+tt.zoneID+"/export", r.URL.Path)
+
+				if tt.serverError != nil {
+					w.WriteHeader(tt.serverStatus)
+					json.NewEncoder(w).Encode(tt.serverError)
+					return
+				}
+
+				w.Header().Set("Content-Type", "text/dns")
+				w.WriteHeader(tt.serverStatus)
+				w.Write([]byte(tt.serverBody))
+			}))
+			defer server.Close()
+
+			client := New(server.URL)
+			zoneFile, err := client.ExportZone(t.Context(), tt.zoneID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.serverError != nil {
+					assert.IsType(t, &NoSuchZoneError{}, err)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.serverBody, string(zoneFile))
+		})
+	}
+}
+
+func TestClient_RollbackZone(t *testing.T) {
+	tests := []struct {
+		name           string
+		zoneID         string
+		version        int
+		serverResponse *ChangeInfo
+		serverStatus   int
+		serverError    *errorResponse
+		wantErr        bool
+		wantErrType    error
+	}{
+		{
+			name:    "success",
+			zoneID:  "example.com",
+			version: 3,
+			serverResponse: &ChangeInfo{
+				ID:     "change-id",
+				Status: "PENDING",
+			},
+			serverStatus: http.StatusOK,
+			wantErr:      false,
+		},
+		{
+			name:    "version not found",
+			zoneID:  "example.com",
+			version: 42,
+			serverError: &errorResponse{
+				Code:    "NoSuchZoneVersion",
+				Message: "zone version 42 not found",
+			},
+			serverStatus: http.StatusNotFound,
+			wantErr:      true,
+			wantErrType:  &NoSuchZoneVersionError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/v1/zones/"+tt.zoneID+"/rollback", r.URL.Path)
+
+				var req rollbackZoneRequest
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				assert.Equal(t, tt.version, req.Version)
+
+				if tt.serverError != nil {
+					w.WriteHeader(tt.serverStatus)
+					json.NewEncoder(w).Encode(tt.serverError)
+					return
+				}
+
+				w.WriteHeader(tt.serverStatus)
+				json.NewEncoder(w).Encode(tt.serverResponse)
+			}))
+			defer server.Close()
+
+			client := New(server.URL)
+			change, err := client.RollbackZone(t.Context(), tt.zoneID, tt.version)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, tt.wantErrType, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.serverResponse, change)
+		})
+	}
+}
+
+func TestClient_DiffZoneVersions(t *testing.T) {
+	want := &ZoneDiff{
+		Added: []ResourceRecordSet{
+			{
+				Name:            "www.example.com.",
+				Type:            "A",
+				TTL:             300,
+				ResourceRecords: []ResourceRecord{{Value: "192.0.2.1"}},
+			},
+		},
+		Removed:  []ResourceRecordSet{},
+		Modified: []ResourceRecordSetModification{},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/zones/example.com/versions/diff", r.URL.Path)
+		assert.Equal(t, "1", r.URL.Query().Get("from"))
+		assert.Equal(t, "2", r.URL.Query().Get("to"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(want)
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	diff, err := client.DiffZoneVersions(t.Context(), "example.com", 1, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, want, diff)
+}
+
+func TestClient_PlanUpsertResourceRecordSet(t *testing.T) {
+	rrSet := ResourceRecordSet{
+		Name:            "www.example.com.",
+		Type:            "A",
+		TTL:             300,
+		ResourceRecords: []ResourceRecord{{Value: "192.0.2.1"}},
+	}
+	want := &ZoneDiff{
+		Added:    []ResourceRecordSet{rrSet},
+		Removed:  []ResourceRecordSet{},
+		Modified: []ResourceRecordSetModification{},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/zones/example.com/rrsets", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("dryRun"))
+
+		var req upsertResourceRecordSetRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, rrSet, req.ResourceRecordSet)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(want)
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	diff, err := client.PlanUpsertResourceRecordSet(t.Context(), "example.com", rrSet)
+
+	require.NoError(t, err)
+	assert.Equal(t, want, diff)
+}
+
+func TestClient_ForceDeleteZone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/v1/zones/example.com", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("force"))
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	err := client.ForceDeleteZone(t.Context(), "example.com")
+
+	require.NoError(t, err)
+}
+
+func TestClient_RestoreZone(t *testing.T) {
+	tests := []struct {
+		name           string
+		zoneID         string
+		serverResponse *Zone
+		serverStatus   int
+		serverError    *errorResponse
+		wantErr        bool
+		wantErrType    error
+	}{
+		{
+			name:   "success",
+			zoneID: "example.com",
+			serverResponse: &Zone{
+				ID:                     "zone-id",
+				Name:                   "example.com.",
+				ResourceRecordSetCount: 5,
+			},
+			serverStatus: http.StatusOK,
+			wantErr:      false,
+		},
+		{
+			name:   "zone already exists",
+			zoneID: "example.com",
+			serverError: &errorResponse{
+				Code:    "ZoneAlreadyExists",
+				Message: "a zone with this name already exists",
+			},
+			serverStatus: http.StatusConflict,
+			wantErr:      true,
+			wantErrType:  &ZoneAlreadyExistsError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/v1/deleted-zones/"+tt.zoneID+"/restore", r.URL.Path)
+
+				if tt.serverError != nil {
+					w.WriteHeader(tt.serverStatus)
+					json.NewEncoder(w).Encode(tt.serverError)
+					return
+				}
+
+				w.WriteHeader(tt.serverStatus)
+				json.NewEncoder(w).Encode(tt.serverResponse)
+			}))
+			defer server.Close()
+
+			client := New(server.URL)
+			zone, err := client.RestoreZone(t.Context(), tt.zoneID)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, tt.wantErrType, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.serverResponse, zone)
+		})
+	}
+}
+
+func TestClient_CreateReverseZones(t *testing.T) {
+	tests := []struct {
+		name           string
+		cidr           string
+		opts           CreateZoneOptions
+		serverResponse *listZonesResponse
+		serverStatus   int
+		serverError    *errorResponse
+		wantErr        bool
+		wantErrType    error
+	}{
+		{
+			name: "success",
+			cidr: "10.20.0.0/23",
+			opts: CreateZoneOptions{DelegateFromParent: true},
+			serverResponse: &listZonesResponse{
+				Zones: []Zone{
+					{ID: "zone-1", Name: "0.20.10.in-addr.arpa.", ResourceRecordSetCount: 2},
+					{ID: "zone-2", Name: "1.20.10.in-addr.arpa.", ResourceRecordSetCount: 2},
+				},
+			},
+			serverStatus: http.StatusCreated,
+			wantErr:      false,
+		},
+		{
+			name: "invalid cidr",
+			cidr: "10.20.1.0/16",
+			serverError: &errorResponse{
+				Code:    "InvalidArgument",
+				Message: "prefix has host bits set: 10.20.1.0/16",
+			},
+			serverStatus: http.StatusBadRequest,
+			wantErr:      true,
+			wantErrType:  &InvalidArgumentError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/v1/reverse-zones", r.URL.Path)
+
+				var req createReverseZonesRequest
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				assert.Equal(t, tt.cidr, req.CIDR)
+				assert.Equal(t, tt.opts.DelegateFromParent, req.DelegateFromParent)
+
+				if tt.serverError != nil {
+					w.WriteHeader(tt.serverStatus)
+					json.NewEncoder(w).Encode(tt.serverError)
+					return
+				}
+
+				w.WriteHeader(tt.serverStatus)
+				json.NewEncoder(w).Encode(tt.serverResponse)
+			}))
+			defer server.Close()
+
+			client := New(server.URL)
+			zones, err := client.CreateReverseZones(t.Context(), tt.cidr, tt.opts)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, tt.wantErrType, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.serverResponse.Zones, zones)
+		})
+	}
+}
+
+func TestClient_UpsertResourceRecordSetWithOptions(t *testing.T) {
+	tests := []struct {
+		name         string
+		serverStatus int
+		serverError  *errorResponse
+		wantErr      bool
+		wantErrType  error
+	}{
+		{
+			name:         "success",
+			serverStatus: http.StatusOK,
+			wantErr:      false,
+		},
+		{
+			name: "PTR record conflict",
+			serverError: &errorResponse{
+				Code:    "PTRRecordConflict",
+				Message: "PTR record conflict: 1.2.0.192.in-addr.arpa. already points to mail.example.com.",
+			},
+			serverStatus: http.StatusConflict,
+			wantErr:      true,
+			wantErrType:  &PTRRecordConflictError{},
+		},
+	}
+
+	rrSet := ResourceRecordSet{
+		Name:            "www.example.com.",
+		Type:            "A",
+		TTL:             300,
+		ResourceRecords: []ResourceRecord{{Value: "192.0.2.1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/v1/zones/example.com/rrsets", r.URL.Path)
+				assert.Equal(t, "true", r.URL.Query().Get("syncPtr"))
+
+				if tt.serverError != nil {
+					w.WriteHeader(tt.serverStatus)
+					json.NewEncoder(w).Encode(tt.serverError)
+					return
+				}
+
+				w.WriteHeader(tt.serverStatus)
+				json.NewEncoder(w).Encode(rrSet)
+			}))
+			defer server.Close()
+
+			client := New(server.URL)
+			got, err := client.UpsertResourceRecordSetWithOptions(
+				t.Context(),
+				"example.com",
+				rrSet,
+				ResourceRecordSetOptions{SyncPTR: true},
+			)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.IsType(t, tt.wantErrType, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, rrSet, got.ResourceRecordSet)
+			assert.Empty(t, got.Warnings)
+		})
+	}
+}
+
+func TestClient_UpsertResourceRecordSetWithOptions_Warnings(t *testing.T) {
+	rrSet := ResourceRecordSet{
+		Name:            "_dmarc.example.com.",
+		Type:            "TXT",
+		TTL:             300,
+		ResourceRecords: []ResourceRecord{{Value: "\"v=DMARC1; p=none; rua=https://reports.example.com\""}},
+	}
+	warning := LintFinding{
+		Rule:     "dmarc",
+		Severity: "WARNING",
+		Name:     "_dmarc.example.com.",
+		Type:     "TXT",
+		Message:  "DMARC record: rua URI https://reports.example.com does not use mailto",
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("validatePolicies"))
+		assert.Empty(t, r.URL.Query().Get("syncPtr"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(UpsertResourceRecordSetResult{ResourceRecordSet: rrSet, Warnings: []LintFinding{warning}})
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	got, err := client.UpsertResourceRecordSetWithOptions(
+		t.Context(),
+		"example.com",
+		rrSet,
+		ResourceRecordSetOptions{ValidatePolicies: true},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, rrSet, got.ResourceRecordSet)
+	assert.Equal(t, []LintFinding{warning}, got.Warnings)
+}
+
+func TestClient_DeleteResourceRecordSetWithOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/v1/zones/example.com/rrsets/www.example.com/A", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("syncPtr"))
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	err := client.DeleteResourceRecordSetWithOptions(
+		t.Context(),
+		"example.com",
+		"www.example.com",
+		"A",
+		ResourceRecordSetOptions{SyncPTR: true},
+	)
+
+	require.NoError(t, err)
+}
+
+func TestClient_LintZone(t *testing.T) {
+	findings := []LintFinding{
+		{
+			Rule:     "dangling-cname",
+			Severity: "ERROR",
+			Name:     "www.example.com.",
+			Type:     "CNAME",
+			Message:  "CNAME target missing.example.com. does not exist in the zone",
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/zones/example.com/lint", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("resolve"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(lintZoneResponse{Findings: findings})
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	got, err := client.LintZone(t.Context(), "example.com", LintZoneOptions{Resolve: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, findings, got)
+}
+
+func TestClient_CreateZoneWithOptions_Template(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/zones", r.URL.Path)
+
+		var req createZoneRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, createZoneRequest{Name: "example.com", Template: "product"}, req)
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(Zone{ID: "zone-id", Name: "example.com.", ResourceRecordSetCount: 7})
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	zone, err := client.CreateZoneWithOptions(t.Context(), "example.com", CreateZoneOptions{Template: "product"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 7, zone.ResourceRecordSetCount)
+}
+
+func TestClient_CreateZoneTemplate(t *testing.T) {
+	req := CreateZoneTemplateRequest{
+		Name: "product",
+		ResourceRecordSets: []ResourceRecordSet{
+			{
+				Name:            "www.{{zone}}",
+				Type:            "CNAME",
+				TTL:             300,
+				ResourceRecords: []ResourceRecord{{Value: "{{zone}}"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		serverStatus int
+		serverError  *errorResponse
+		wantErrType  error
+	}{
+		{
+			name:         "success",
+			serverStatus: http.StatusCreated,
+		},
+		{
+			name: "template already exists",
+			serverError: &errorResponse{
+				Code:    "ZoneTemplateAlreadyExists",
+				Message: "zone template already exists",
+			},
+			serverStatus: http.StatusConflict,
+			wantErrType:  &ZoneTemplateAlreadyExistsError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/v1/zone-templates", r.URL.Path)
+
+				var got CreateZoneTemplateRequest
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+				assert.Equal(t, req, got)
+
+				w.WriteHeader(tt.serverStatus)
+				if tt.serverError != nil {
+					json.NewEncoder(w).Encode(tt.serverError)
+					return
+				}
+				json.NewEncoder(w).Encode(ZoneTemplate{
+					ID:                 "template-id",
+					Name:               got.Name,
+					ResourceRecordSets: got.ResourceRecordSets,
+				})
+			}))
+			defer server.Close()
+
+			client := New(server.URL)
+			template, err := client.CreateZoneTemplate(t.Context(), req)
+
+			if tt.wantErrType != nil {
+				assert.IsType(t, tt.wantErrType, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "template-id", template.ID)
+			assert.Equal(t, req.ResourceRecordSets, template.ResourceRecordSets)
+		})
+	}
+}
+
+func TestClient_ApplyZoneTemplate(t *testing.T) {
+	want := []ZoneTemplateDrift{
+		{
+			ZoneName: "example.com.",
+			Drift: ZoneDiff{
+				Added: []ResourceRecordSet{
+					{
+						Name:            "www.example.com.",
+						Type:            "CNAME",
+						TTL:             300,
+						ResourceRecords: []ResourceRecord{{Value: "example.com"}},
+					},
+				},
+				Removed:  []ResourceRecordSet{},
+				Modified: []ResourceRecordSetModification{},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		dryRun     bool
+		wantDryRun string
+	}{
+		{name: "apply", wantDryRun: ""},
+		{name: "drift", dryRun: true, wantDryRun: "true"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/v1/zone-templates/product/apply", r.URL.Path)
+				assert.Equal(t, tt.wantDryRun, r.URL.Query().Get("dryRun"))
+
+				var req applyZoneTemplateRequest
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				assert.Equal(t, []string{"example.com"}, req.Zones)
+
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(applyZoneTemplateResponse{Zones: want})
+			}))
+			defer server.Close()
+
+			client := New(server.URL)
+			var drifts []ZoneTemplateDrift
+			var err error
+			if tt.dryRun {
+				drifts, err = client.GetZoneTemplateDrift(t.Context(), "product", []string{"example.com"})
+			} else {
+				drifts, err = client.ApplyZoneTemplate(t.Context(), "product", []string{"example.com"})
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, want, drifts)
+		})
+	}
+}
+
+func TestClient_ListZonesWithOptions(t *testing.T) {
+	want := []Zone{
+		{ID: "zone-id", Name: "example.com.", ResourceRecordSetCount: 2, Tags: map[string]string{"team": "payments"}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/zones", r.URL.Path)
+		assert.Equal(t, []string{"team:payments", "owner"}, r.URL.Query()["tag"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(listZonesResponse{Zones: want})
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	zones, err := client.ListZonesWithOptions(t.Context(), ListOptions{Tags: []string{"team:payments", "owner"}})
+
+	require.NoError(t, err)
+	assert.Equal(t, want, zones)
+}
+
+func TestClient_UpdateZoneTags(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/zones/example.com/tags", r.URL.Path)
+
+		var req UpdateTagsRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, UpdateTagsRequest{
+			Set:    map[string]string{"team": "payments"},
+			Remove: []string{"owner"},
+		}, req)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(tagsResponse{Tags: map[string]string{"team": "payments", "env": "prod"}})
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	tags, err := client.UpdateZoneTags(t.Context(), "example.com", UpdateTagsRequest{
+		Set:    map[string]string{"team": "payments"},
+		Remove: []string{"owner"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "payments", "env": "prod"}, tags)
+}
+
+func TestClient_GetDomainListDomainsWithOptions_Pages(t *testing.T) {
+	id := uuid.New()
+	var cursors []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/firewall/domain-lists/%s/domains", id), r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("limit"))
+
+		cursor := r.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+
+		resp := getDomainListDomainsResponse{Domains: []string{"a.example.com.", "b.example.com."}, NextCursor: "next"}
+		if cursor == "next" {
+			resp = getDomainListDomainsResponse{Domains: []string{"c.example.com."}}
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	domains, err := client.GetDomainListDomainsWithOptions(t.Context(), id, ListOptions{Limit: 2})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.example.com.", "b.example.com.", "c.example.com."}, domains)
+	assert.Equal(t, []string{"", "next"}, cursors)
+}
+
+func TestClient_ListResourceRecordSetsPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/zones/example.com/rrsets", r.URL.Path)
+		assert.Equal(t, "MX", r.URL.Query().Get("type"))
+		assert.Equal(t, "type", r.URL.Query().Get("sort"))
+		assert.Equal(t, "desc", r.URL.Query().Get("order"))
+		assert.Equal(t, "mail", r.URL.Query().Get("namePrefix"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(listResourceRecordSetsResponse{
+			ResourceRecordSets: []ResourceRecordSet{{Name: "mail.example.com.", Type: "MX", TTL: 300}},
+			NextCursor:         "next",
+		})
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	page, err := client.ListResourceRecordSetsPage(t.Context(), "example.com", ListResourceRecordSetsOptions{
+		ListOptions: ListOptions{NamePrefix: "mail", Sort: "type", Order: "desc"},
+		Type:        "MX",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "next", page.NextCursor)
+	assert.Len(t, page.Items, 1)
+}
+
+func TestClient_WithAPIKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer beacon_secret", r.Header.Get("Authorization"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Zone{ID: "zone-id", Name: "example.com."})
+	}))
+	defer server.Close()
+
+	client := New(server.URL, WithAPIKey("beacon_secret"))
+	_, err := client.GetZone(t.Context(), "example.com")
+
+	require.NoError(t, err)
+}
+
+type staticTokenSource struct {
+	token string
+	err   error
+}
+
+func (s staticTokenSource) Token(context.Context) (string, error) {
+	return s.token, s.err
+}
+
+func TestClient_WithTokenSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer id-token", r.Header.Get("Authorization"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Zone{ID: "zone-id", Name: "example.com."})
+	}))
+	defer server.Close()
+
+	client := New(server.URL, WithTokenSource(staticTokenSource{token: "id-token"}))
+	_, err := client.GetZone(t.Context(), "example.com")
+	require.NoError(t, err)
+
+	client = New(server.URL, WithTokenSource(staticTokenSource{err: errors.New("not logged in")}))
+	_, err = client.GetZone(t.Context(), "example.com")
+	require.ErrorContains(t, err, "not logged in")
+}
+
+func TestClient_GetOIDCProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/auth/oidc", r.URL.Path)
+		assert.Empty(t, r.Header.Get("Authorization"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(OIDCProvider{
+			Enabled:  true,
+			Issuer:   "https://sso.example.com",
+			ClientID: "beaconctl",
+			Scopes:   []string{"openid", "email"},
+		})
+	}))
+	defer server.Close()
+
+	provider, err := New(server.URL).GetOIDCProvider(t.Context())
+
+	require.NoError(t, err)
+	assert.True(t, provider.Enabled)
+	assert.Equal(t, "beaconctl", provider.ClientID)
+}
+
+func TestClient_CreateAPIKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/api-keys", r.URL.Path)
+
+		var req CreateAPIKeyRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "ci", req.Name)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CreatedAPIKey{
+			APIKey: APIKey{Name: "ci", Prefix: "beacon_abcdef"},
+			Key:    "beacon_abcdefsecret",
+		})
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	key, err := client.CreateAPIKey(t.Context(), CreateAPIKeyRequest{Name: "ci"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "beacon_abcdefsecret", key.Key)
+	assert.Equal(t, "beacon_abcdef", key.Prefix)
+}
+
+func TestClient_CreateRole(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/roles", r.URL.Path)
+
+		var req CreateRoleRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "dns-editor", req.Name)
+		assert.Equal(t, []Permission{{Actions: []string{"write"}, Resources: []string{"zone/example.com."}}},
+			req.Permissions)
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(Role{Name: req.Name, Permissions: req.Permissions})
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	role, err := client.CreateRole(t.Context(), CreateRoleRequest{
+		Name:        "dns-editor",
+		Permissions: []Permission{{Actions: []string{"write"}, Resources: []string{"zone/example.com."}}},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "dns-editor", role.Name)
+	assert.Len(t, role.Permissions, 1)
+}
+
+func TestClient_ListRoleBindingsWithOptions_Subject(t *testing.T) {
+	keyID := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/role-bindings", r.URL.Path)
+		assert.Equal(t, SubjectTypeAPIKey, r.URL.Query().Get("subjectType"))
+		assert.Equal(t, keyID.String(), r.URL.Query().Get("subjectId"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(listRoleBindingsResponse{
+			RoleBindings: []RoleBinding{{
+				ID:      uuid.New(),
+				Role:    "viewer",
+				Subject: Subject{Type: SubjectTypeAPIKey, ID: keyID.String()},
+			}},
+		})
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	bindings, err := client.ListRoleBindingsWithOptions(t.Context(), ListRoleBindingsOptions{
+		Subject: &Subject{Type: SubjectTypeAPIKey, ID: keyID.String()},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, bindings, 1)
+	assert.Equal(t, "viewer", bindings[0].Role)
+}
+
+func TestClient_ListAuditEntries(t *testing.T) {
+	since := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/audit", r.URL.Path)
+		assert.Equal(t, SubjectTypeUser, r.URL.Query().Get("actorType"))
+		assert.Equal(t, "jane@example.com", r.URL.Query().Get("actorId"))
+		assert.Equal(t, "zone/example.com.", r.URL.Query().Get("target"))
+		assert.Equal(t, "2025-06-01T00:00:00Z", r.URL.Query().Get("since"))
+		assert.Empty(t, r.URL.Query().Get("until"))
+
+		if r.URL.Query().Get("cursor") == "" {
+			json.NewEncoder(w).Encode(listAuditEntriesResponse{
+				Entries:    []AuditEntry{{ID: 2, Operation: "zone.rrset.upsert", After: json.RawMessage(`[]`)}},
+				NextCursor: "next",
+			})
+			return
+		}
+
+		assert.Equal(t, "next", r.URL.Query().Get("cursor"))
+		json.NewEncoder(w).Encode(listAuditEntriesResponse{
+			Entries: []AuditEntry{{ID: 1, Operation: "zone.create"}},
+		})
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	entries, err := client.ListAuditEntries(t.Context(), ListAuditEntriesOptions{
+		Actor:  &Subject{Type: SubjectTypeUser, ID: "jane@example.com"},
+		Target: "zone/example.com.",
+		Since:  &since,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, int64(2), entries[0].ID)
+	assert.JSONEq(t, `[]`, string(entries[0].After))
+	assert.Equal(t, "zone.create", entries[1].Operation)
+	assert.Nil(t, entries[1].Before)
+}
+
+func TestClient_CreateWebhook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/webhooks", r.URL.Path)
+
+		var req CreateWebhookRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "cmdb", req.Name)
+		assert.Equal(t, []string{"zone.*"}, req.Events)
+
+		json.NewEncoder(w).Encode(CreatedWebhook{
+			Webhook: Webhook{Name: req.Name, URL: req.URL, Events: req.Events},
+			Secret:  "whsec_secret",
+		})
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	webhook, err := client.CreateWebhook(t.Context(), CreateWebhookRequest{
+		Name:   "cmdb",
+		URL:    "https://cmdb.example.com/hooks/beacon",
+		Events: []string{"zone.*"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "whsec_secret", webhook.Secret)
+	assert.Equal(t, "https://cmdb.example.com/hooks/beacon", webhook.URL)
+}
+
+func TestClient_RedeliverWebhookDelivery(t *testing.T) {
+	webhookID := uuid.New()
+	deliveryID := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/webhooks/%s/deliveries/%s/redeliver", webhookID, deliveryID), r.URL.Path)
+
+		json.NewEncoder(w).Encode(WebhookDelivery{
+			ID:        uuid.New(),
+			WebhookID: webhookID,
+			EventType: "zone.create",
+			Status:    WebhookDeliveryStatusPending,
+		})
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	delivery, err := client.RedeliverWebhookDelivery(t.Context(), webhookID, deliveryID)
+
+	require.NoError(t, err)
+	assert.Equal(t, WebhookDeliveryStatusPending, delivery.Status)
+	assert.NotEqual(t, deliveryID, delivery.ID)
+}
+
+func TestClient_Unauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(errorResponse{Code: "Unauthorized", Message: "missing bearer token"})
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	_, err := client.ListZones(t.Context())
+
+	var unauthorized *UnauthorizedError
+	require.ErrorAs(t, err, &unauthorized)
+}
+
+func TestClient_Throttling(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", "3")
+		w.WriteHeader(http.StatusTooManyRequests)
+		json.NewEncoder(w).Encode(errorResponse{Code: "Throttling", Message: "rate limit exceeded"})
+	}))
+	defer server.Close()
+
+	client := New(server.URL)
+	_, err := client.ListZones(t.Context())
+
+	var throttling *ThrottlingError
+	require.ErrorAs(t, err, &throttling)
+	assert.Equal(t, 3*time.Second, throttling.RetryAfter)
+}
+
+func TestClient_RetriesWithIdempotencyKey(t *testing.T) {
+	retryBaseDelay = time.Millisecond
+	t.Cleanup(func() { retryBaseDelay = 250 * time.Millisecond })
+
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"name":"blocklist","isManaged":false,"domains":["example.com."]}`, string(body))
+
+		if len(keys) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			json.NewEncoder(w).Encode(errorResponse{Code: "InternalError", Message: "unavailable"})
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(DomainList{Name: "blocklist"})
+	}))
+	defer server.Close()
+
+	client := New(server.URL, WithRetries(2))
+	list, err := client.CreateDomainList(t.Context(), CreateDomainListRequest{
+		Name:    "blocklist",
+		Domains: []string{"example.com."},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "blocklist", list.Name)
+	require.Len(t, keys, 3)
+	assert.NotEmpty(t, keys[0])
+	assert.Equal(t, keys[0], keys[1])
+	assert.Equal(t, keys[0], keys[2])
+}
+
+func TestClient_RetriesIdempotencyKeyInUse(t *testing.T) {
+	retryBaseDelay = time.Millisecond
+	t.Cleanup(func() { retryBaseDelay = 250 * time.Millisecond })
+
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+
+		// The first attempt timed out on the client but is still being handled.
+		if len(keys) < 3 {
+			w.WriteHeader(http.StatusConflict)
+			json.NewEncoder(w).Encode(errorResponse{
+				Code:    "IdempotencyKeyInUse",
+				Message: "a request with this idempotency key is still being handled",
+			})
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(DomainList{Name: "blocklist"})
+	}))
+	defer server.Close()
+
+	client := New(server.URL, WithRetries(2))
+	list, err := client.CreateDomainList(t.Context(), CreateDomainListRequest{
+		Name:    "blocklist",
+		Domains: []string{"example.com."},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "blocklist", list.Name)
+	require.Len(t, keys, 3)
+	assert.Equal(t, keys[0], keys[2])
+}
+
+func TestClient_DoesNotRetryClientErrors(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(errorResponse{Code: "NoSuchZone", Message: "zone not found"})
+	}))
+	defer server.Close()
+
+	client := New(server.URL, WithRetries(3))
+	_, err := client.GetZone(t.Context(), "example.com")
+
+	require.Error(t, err)
+	assert.Equal(t, 1, requests)
+}
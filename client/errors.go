@@ -2,6 +2,7 @@ package client
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/davidseybold/beacondns/internal/beaconerr"
 )
@@ -55,6 +56,18 @@ type NoSuchResourceRecordSetError struct {
 	beaconError
 }
 
+type NoSuchZoneVersionError struct {
+	beaconError
+}
+
+type NoSuchZoneTemplateError struct {
+	beaconError
+}
+
+type ZoneTemplateAlreadyExistsError struct {
+	beaconError
+}
+
 type HostedZoneNotEmptyError struct {
 	beaconError
 }
@@ -75,6 +88,46 @@ type NoSuchFirewallRuleError struct {
 	beaconError
 }
 
+type NoSuchAPIKeyError struct {
+	beaconError
+}
+
+// UnauthorizedError is returned when the client has no API key or the key is invalid,
+// revoked or expired.
+type UnauthorizedError struct {
+	beaconError
+}
+
+// AccessDeniedError is returned when none of the roles bound to the API key of the client
+// allow the request.
+type AccessDeniedError struct {
+	beaconError
+}
+
+type NoSuchRoleError struct {
+	beaconError
+}
+
+type RoleAlreadyExistsError struct {
+	beaconError
+}
+
+type NoSuchRoleBindingError struct {
+	beaconError
+}
+
+type RoleBindingAlreadyExistsError struct {
+	beaconError
+}
+
+type NoSuchWebhookError struct {
+	beaconError
+}
+
+type NoSuchWebhookDeliveryError struct {
+	beaconError
+}
+
 type DomainExistsInDomainListError struct {
 	beaconError
 }
@@ -83,8 +136,39 @@ type DomainListInvalidStateError struct {
 	beaconError
 }
 
+type PTRRecordConflictError struct {
+	beaconError
+}
+
+// ThrottlingError is returned when the client sends requests faster than the rate limit of
+// the controller allows. RetryAfter is how long to wait before sending the request again.
+type ThrottlingError struct {
+	beaconError
+	RetryAfter time.Duration
+}
+
+// QuotaExceededError is returned when a change would store more than a quota of the
+// controller allows, such as more zones or more domains in a domain list.
+type QuotaExceededError struct {
+	beaconError
+}
+
+// IdempotencyKeyInUseError is returned when a request is sent with the Idempotency-Key of a
+// request the controller is still handling, such as a retry of a request that timed out.
+// Retries with WithRetries wait for the first request to finish.
+type IdempotencyKeyInUseError struct {
+	beaconError
+}
+
+// InvalidChangeBatchError is returned when a change to a zone fails validation. Violations
+// lists the reason each rejected action failed.
+type InvalidChangeBatchError struct {
+	beaconError
+	Violations []ChangeViolation
+}
+
 func parseError(errResponse errorResponse) error {
-	bErr := beaconError(errResponse)
+	bErr := beaconError{Code: errResponse.Code, Message: errResponse.Message}
 	code := beaconerr.ErrorCode(errResponse.Code)
 	switch code {
 	case beaconerr.ErrorCodeZoneAlreadyExists:
@@ -103,6 +187,18 @@ func parseError(errResponse errorResponse) error {
 		return &NoSuchResourceRecordSetError{
 			beaconError: bErr,
 		}
+	case beaconerr.ErrorCodeNoSuchZoneVersion:
+		return &NoSuchZoneVersionError{
+			beaconError: bErr,
+		}
+	case beaconerr.ErrorCodeNoSuchZoneTemplate:
+		return &NoSuchZoneTemplateError{
+			beaconError: bErr,
+		}
+	case beaconerr.ErrorCodeZoneTemplateAlreadyExists:
+		return &ZoneTemplateAlreadyExistsError{
+			beaconError: bErr,
+		}
 	case beaconerr.ErrorCodeInvalidArgument:
 		return &InvalidArgumentError{
 			beaconError: bErr,
@@ -131,6 +227,63 @@ func parseError(errResponse errorResponse) error {
 		return &DomainListInvalidStateError{
 			beaconError: bErr,
 		}
+	case beaconerr.ErrorCodeInvalidChangeBatch:
+		return &InvalidChangeBatchError{
+			beaconError: bErr,
+			Violations:  errResponse.Violations,
+		}
+	case beaconerr.ErrorCodeNoSuchAPIKey:
+		return &NoSuchAPIKeyError{
+			beaconError: bErr,
+		}
+	case beaconerr.ErrorCodeUnauthorized:
+		return &UnauthorizedError{
+			beaconError: bErr,
+		}
+	case beaconerr.ErrorCodeAccessDenied:
+		return &AccessDeniedError{
+			beaconError: bErr,
+		}
+	case beaconerr.ErrorCodeNoSuchRole:
+		return &NoSuchRoleError{
+			beaconError: bErr,
+		}
+	case beaconerr.ErrorCodeRoleAlreadyExists:
+		return &RoleAlreadyExistsError{
+			beaconError: bErr,
+		}
+	case beaconerr.ErrorCodeNoSuchRoleBinding:
+		return &NoSuchRoleBindingError{
+			beaconError: bErr,
+		}
+	case beaconerr.ErrorCodeRoleBindingAlreadyExists:
+		return &RoleBindingAlreadyExistsError{
+			beaconError: bErr,
+		}
+	case beaconerr.ErrorCodeNoSuchWebhook:
+		return &NoSuchWebhookError{
+			beaconError: bErr,
+		}
+	case beaconerr.ErrorCodeNoSuchWebhookDelivery:
+		return &NoSuchWebhookDeliveryError{
+			beaconError: bErr,
+		}
+	case beaconerr.ErrorCodePTRRecordConflict:
+		return &PTRRecordConflictError{
+			beaconError: bErr,
+		}
+	case beaconerr.ErrorCodeThrottling:
+		return &ThrottlingError{
+			beaconError: bErr,
+		}
+	case beaconerr.ErrorCodeQuotaExceeded:
+		return &QuotaExceededError{
+			beaconError: bErr,
+		}
+	case beaconerr.ErrorCodeIdempotencyKeyInUse:
+		return &IdempotencyKeyInUseError{
+			beaconError: bErr,
+		}
 	default:
 		return &bErr
 	}
@@ -51,6 +51,42 @@ func TestParseError(t *testing.T) {
 			wantErr:    &NoSuchResourceRecordSetError{},
 			wantErrMsg: "NoSuchResourceRecordSet: resource record set not found",
 		},
+		{
+			name: "unauthorized",
+			errResp: errorResponse{
+				Code:    string(beaconerr.ErrorCodeUnauthorized),
+				Message: "invalid API key",
+			},
+			wantErr:    &UnauthorizedError{},
+			wantErrMsg: "Unauthorized: invalid API key",
+		},
+		{
+			name: "access denied",
+			errResp: errorResponse{
+				Code:    string(beaconerr.ErrorCodeAccessDenied),
+				Message: "ci is not allowed to write zone/example.com.",
+			},
+			wantErr:    &AccessDeniedError{},
+			wantErrMsg: "AccessDenied: ci is not allowed to write zone/example.com.",
+		},
+		{
+			name: "no such role",
+			errResp: errorResponse{
+				Code:    string(beaconerr.ErrorCodeNoSuchRole),
+				Message: "role dns-editor not found",
+			},
+			wantErr:    &NoSuchRoleError{},
+			wantErrMsg: "NoSuchRole: role dns-editor not found",
+		},
+		{
+			name: "no such zone template",
+			errResp: errorResponse{
+				Code:    string(beaconerr.ErrorCodeNoSuchZoneTemplate),
+				Message: "zone template not found",
+			},
+			wantErr:    &NoSuchZoneTemplateError{},
+			wantErrMsg: "NoSuchZoneTemplate: zone template not found",
+		},
 		{
 			name: "invalid argument",
 			errResp: errorResponse{
@@ -1,38 +1,313 @@
 package client
 
-import "github.com/google/uuid"
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type createZoneRequest struct {
-	Name string `json:"name"`
+	Name               string            `json:"name"`
+	DelegateFromParent bool              `json:"delegateFromParent,omitempty"`
+	Template           string            `json:"template,omitempty"`
+	Tags               map[string]string `json:"tags,omitempty"`
+}
+
+type createReverseZonesRequest struct {
+	CIDR               string            `json:"cidr"`
+	DelegateFromParent bool              `json:"delegateFromParent,omitempty"`
+	Template           string            `json:"template,omitempty"`
+	Tags               map[string]string `json:"tags,omitempty"`
+}
+
+type CreateZoneOptions struct {
+	// DelegateFromParent adds NS records for the new zone to its closest parent zone hosted
+	// in Beacon.
+	DelegateFromParent bool
+	// Template is the name of a zone template whose record sets the new zone is created with.
+	Template string
+	// Tags are added to every zone created.
+	Tags map[string]string
+}
+
+type ListOptions struct {
+	// Limit is the number of items fetched per page. The server default is used when zero.
+	Limit int
+	// Cursor starts the listing at the page that follows the one it was returned with.
+	Cursor string
+	// NamePrefix only returns the objects whose name, or domain, starts with the prefix.
+	NamePrefix string
+	// Sort is the field the list is sorted by, such as name. Each list documents the fields
+	// it can be sorted by.
+	Sort string
+	// Order is asc or desc.
+	Order string
+	// Tags only returns the objects carrying every tag given. Each tag is either key:value,
+	// matching the value exactly, or key, matching any value.
+	Tags []string
+}
+
+func (o ListOptions) values() url.Values {
+	params := url.Values{}
+	if o.Limit > 0 {
+		params.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Cursor != "" {
+		params.Set("cursor", o.Cursor)
+	}
+	if o.NamePrefix != "" {
+		params.Set("namePrefix", o.NamePrefix)
+	}
+	if o.Sort != "" {
+		params.Set("sort", o.Sort)
+	}
+	if o.Order != "" {
+		params.Set("order", o.Order)
+	}
+	for _, tag := range o.Tags {
+		params.Add("tag", tag)
+	}
+	return params
+}
+
+func (o ListOptions) query() string {
+	return encodeQuery(o.values())
+}
+
+// ListResourceRecordSetsOptions are the options of a record set listing, which can be sorted
+// by name or type.
+type ListResourceRecordSetsOptions struct {
+	ListOptions
+	// Type only returns the record sets of the record type.
+	Type string
+}
+
+func (o ListResourceRecordSetsOptions) query() string {
+	params := o.values()
+	if o.Type != "" {
+		params.Set("type", o.Type)
+	}
+	return encodeQuery(params)
+}
+
+func encodeQuery(params url.Values) string {
+	if len(params) == 0 {
+		return ""
+	}
+	return "?" + params.Encode()
+}
+
+// UpdateTagsRequest adds or overwrites the tags in Set and removes the keys in Remove.
+type UpdateTagsRequest struct {
+	Set    map[string]string `json:"set,omitempty"`
+	Remove []string          `json:"remove,omitempty"`
+}
+
+type tagsResponse struct {
+	Tags map[string]string `json:"tags"`
+}
+
+type ResourceRecordSetOptions struct {
+	// SyncPTR maintains the PTR records for the addresses of A and AAAA record sets in the
+	// reverse zones hosted in Beacon that cover them.
+	SyncPTR bool
+	// ValidatePolicies checks the SPF, DMARC, DKIM and MTA-STS policies in TXT record sets
+	// against their specifications. Broken policies are rejected, and lesser problems are
+	// returned as warnings.
+	ValidatePolicies bool
+}
+
+func (o ResourceRecordSetOptions) query() string {
+	params := url.Values{}
+	if o.SyncPTR {
+		params.Set("syncPtr", "true")
+	}
+	if o.ValidatePolicies {
+		params.Set("validatePolicies", "true")
+	}
+
+	if len(params) == 0 {
+		return ""
+	}
+	return "?" + params.Encode()
 }
 
 type Zone struct {
-	ID                     string `json:"id"`
-	Name                   string `json:"name"`
-	ResourceRecordSetCount int    `json:"resourceRecordSetCount"`
+	ID                     string            `json:"id"`
+	Name                   string            `json:"name"`
+	ResourceRecordSetCount int               `json:"resourceRecordSetCount"`
+	Tags                   map[string]string `json:"tags,omitempty"`
 }
 
 type ResourceRecordSet struct {
-	Name            string           `json:"name"`
-	Type            string           `json:"type"`
-	TTL             uint32           `json:"ttl"`
-	ResourceRecords []ResourceRecord `json:"resourceRecords"`
+	Name            string            `json:"name"`
+	Type            string            `json:"type"`
+	TTL             uint32            `json:"ttl"`
+	ResourceRecords []ResourceRecord  `json:"resourceRecords"`
+	Tags            map[string]string `json:"tags,omitempty"`
+	Comment         string            `json:"comment,omitempty"`
 }
 
 type ResourceRecord struct {
 	Value string `json:"value"`
 }
 
+type importZoneRequest struct {
+	ZoneFile string `json:"zoneFile"`
+}
+
+type ImportZoneResult struct {
+	ChangeID           string              `json:"changeId"`
+	ResourceRecordSets []ResourceRecordSet `json:"resourceRecordSets"`
+	SkippedRecords     []SkippedRecord     `json:"skippedRecords"`
+}
+
+type SkippedRecord struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+type ZoneVersion struct {
+	Version   int       `json:"version"`
+	ChangeID  string    `json:"changeId"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type listZoneVersionsResponse struct {
+	Versions   []ZoneVersion `json:"versions"`
+	NextCursor string        `json:"nextCursor"`
+}
+
+type LintZoneOptions struct {
+	// Resolve also checks that the names outside the zone that record sets point at
+	// resolve.
+	Resolve bool
+}
+
+type LintFinding struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Message  string `json:"message"`
+}
+
+// UpsertResourceRecordSetResult is the resource record set as stored by an upsert, along
+// with the warnings of the policy checks requested through ResourceRecordSetOptions.
+type UpsertResourceRecordSetResult struct {
+	ResourceRecordSet
+	Warnings []LintFinding `json:"warnings,omitempty"`
+}
+
+type lintZoneResponse struct {
+	Findings []LintFinding `json:"findings"`
+}
+
+type ZoneDiff struct {
+	Added    []ResourceRecordSet             `json:"added"`
+	Removed  []ResourceRecordSet             `json:"removed"`
+	Modified []ResourceRecordSetModification `json:"modified"`
+}
+
+type ResourceRecordSetModification struct {
+	Before         ResourceRecordSet `json:"before"`
+	After          ResourceRecordSet `json:"after"`
+	AddedRecords   []ResourceRecord  `json:"addedRecords"`
+	RemovedRecords []ResourceRecord  `json:"removedRecords"`
+}
+
+type rollbackZoneRequest struct {
+	Version int `json:"version"`
+}
+
+type ChangeInfo struct {
+	ID          string     `json:"id"`
+	Status      string     `json:"status"`
+	SubmittedAt *time.Time `json:"submittedAt,omitempty"`
+}
+
+// ZoneTemplate is a blueprint of record sets for new zones. The {{zone}} placeholder in the
+// names and values of its record sets stands for the name of the zone it is applied to.
+type ZoneTemplate struct {
+	ID                 string              `json:"id"`
+	Name               string              `json:"name"`
+	Description        string              `json:"description,omitempty"`
+	ResourceRecordSets []ResourceRecordSet `json:"resourceRecordSets"`
+	CreatedAt          time.Time           `json:"createdAt"`
+	UpdatedAt          time.Time           `json:"updatedAt"`
+}
+
+type CreateZoneTemplateRequest struct {
+	Name               string              `json:"name"`
+	Description        string              `json:"description,omitempty"`
+	ResourceRecordSets []ResourceRecordSet `json:"resourceRecordSets"`
+}
+
+type UpdateZoneTemplateRequest struct {
+	Description        string              `json:"description,omitempty"`
+	ResourceRecordSets []ResourceRecordSet `json:"resourceRecordSets"`
+}
+
+type listZoneTemplatesResponse struct {
+	ZoneTemplates []ZoneTemplate `json:"zoneTemplates"`
+	NextCursor    string         `json:"nextCursor"`
+}
+
+type applyZoneTemplateRequest struct {
+	Zones []string `json:"zones"`
+}
+
+// ZoneTemplateDrift is how a zone differs from a template. ChangeID is set when the template
+// was applied to the zone.
+type ZoneTemplateDrift struct {
+	ZoneName string   `json:"zoneName"`
+	Drift    ZoneDiff `json:"drift"`
+	ChangeID string   `json:"changeId,omitempty"`
+}
+
+type applyZoneTemplateResponse struct {
+	Zones []ZoneTemplateDrift `json:"zones"`
+}
+
+type DeletedZone struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	DeletedAt  time.Time `json:"deletedAt"`
+	PurgeAfter time.Time `json:"purgeAfter"`
+}
+
+type listDeletedZonesResponse struct {
+	DeletedZones []DeletedZone `json:"deletedZones"`
+	NextCursor   string        `json:"nextCursor"`
+}
+
 type listZonesResponse struct {
-	Zones []Zone `json:"zones"`
+	Zones      []Zone `json:"zones"`
+	NextCursor string `json:"nextCursor"`
 }
 
 type listResourceRecordSetsResponse struct {
 	ResourceRecordSets []ResourceRecordSet `json:"resourceRecordSets"`
+	NextCursor         string              `json:"nextCursor"`
 }
 
 type errorResponse struct {
-	Code    string `json:"code"`
+	Code       string            `json:"code"`
+	Message    string            `json:"message"`
+	Violations []ChangeViolation `json:"violations,omitempty"`
+}
+
+// ChangeViolation describes why a single action of a change was rejected. Action is the
+// index of the action in the change.
+type ChangeViolation struct {
+	Action  int    `json:"action"`
+	Name    string `json:"name,omitempty"`
+	Type    string `json:"type,omitempty"`
 	Message string `json:"message"`
 }
 
@@ -47,6 +322,7 @@ type CreateFirewallRuleRequest struct {
 	BlockResponseType *string            `json:"blockResponseType,omitempty"`
 	BlockResponse     *ResourceRecordSet `json:"blockResponse,omitempty"`
 	Priority          uint               `json:"priority"`
+	Tags              map[string]string  `json:"tags,omitempty"`
 }
 
 type FirewallRule struct {
@@ -57,10 +333,12 @@ type FirewallRule struct {
 	BlockResponseType *string            `json:"blockResponseType,omitempty"`
 	BlockResponse     *ResourceRecordSet `json:"blockResponse,omitempty"`
 	Priority          uint               `json:"priority"`
+	Tags              map[string]string  `json:"tags,omitempty"`
 }
 
 type getFirewallRulesResponse struct {
-	Rules []FirewallRule `json:"rules"`
+	Rules      []FirewallRule `json:"rules"`
+	NextCursor string         `json:"nextCursor"`
 }
 
 type UpdateFirewallRuleRequest struct {
@@ -73,26 +351,30 @@ type UpdateFirewallRuleRequest struct {
 }
 
 type CreateDomainListRequest struct {
-	Name      string   `json:"name"`
-	IsManaged bool     `json:"isManaged"`
-	SourceURL *string  `json:"sourceUrl,omitempty"`
-	Domains   []string `json:"domains"`
+	Name      string            `json:"name"`
+	IsManaged bool              `json:"isManaged"`
+	SourceURL *string           `json:"sourceUrl,omitempty"`
+	Domains   []string          `json:"domains"`
+	Tags      map[string]string `json:"tags,omitempty"`
 }
 
 type DomainList struct {
-	ID          uuid.UUID `json:"id"`
-	Name        string    `json:"name"`
-	IsManaged   bool      `json:"isManaged"`
-	SourceURL   *string   `json:"sourceUrl,omitempty"`
-	DomainCount int       `json:"domainCount"`
+	ID          uuid.UUID         `json:"id"`
+	Name        string            `json:"name"`
+	IsManaged   bool              `json:"isManaged"`
+	SourceURL   *string           `json:"sourceUrl,omitempty"`
+	DomainCount int               `json:"domainCount"`
+	Tags        map[string]string `json:"tags,omitempty"`
 }
 
 type listDomainListsResponse struct {
 	DomainLists []DomainList `json:"domainLists"`
+	NextCursor  string       `json:"nextCursor"`
 }
 
 type getDomainListDomainsResponse struct {
-	Domains []string `json:"domains"`
+	Domains    []string `json:"domains"`
+	NextCursor string   `json:"nextCursor"`
 }
 
 type addDomainsToDomainListRequest struct {
@@ -102,3 +384,270 @@ type addDomainsToDomainListRequest struct {
 type removeDomainsFromDomainListRequest struct {
 	Domains []string `json:"domains"`
 }
+
+type APIKey struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+}
+
+// CreateAPIKeyRequest creates an API key bound to Roles. A key without roles is not allowed
+// to do anything until roles are bound to it.
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Roles     []string   `json:"roles,omitempty"`
+}
+
+// CreatedAPIKey is a new API key. Key is the secret to authenticate with; it is only
+// returned when the key is created.
+type CreatedAPIKey struct {
+	APIKey
+	Key string `json:"key"`
+}
+
+type listAPIKeysResponse struct {
+	APIKeys    []APIKey `json:"apiKeys"`
+	NextCursor string   `json:"nextCursor"`
+}
+
+type Webhook struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// CreateWebhookRequest subscribes URL to the events whose types match any of the Events
+// patterns, such as zone.* or firewall.rule.create. A secret is generated unless Secret is
+// set.
+type CreateWebhookRequest struct {
+	Name   string   `json:"name"`
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	Secret string   `json:"secret,omitempty"`
+}
+
+// CreatedWebhook is a new webhook. Secret signs its deliveries; it is only returned when the
+// webhook is created.
+type CreatedWebhook struct {
+	Webhook
+	Secret string `json:"secret"`
+}
+
+type listWebhooksResponse struct {
+	Webhooks   []Webhook `json:"webhooks"`
+	NextCursor string    `json:"nextCursor"`
+}
+
+const (
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusSucceeded = "succeeded"
+	WebhookDeliveryStatusFailed    = "failed"
+)
+
+// WebhookDelivery is an entry in the delivery log of a webhook. ResponseStatus and Error
+// describe the last attempt, and NextAttemptAt is set while the delivery is pending.
+type WebhookDelivery struct {
+	ID             uuid.UUID       `json:"id"`
+	WebhookID      uuid.UUID       `json:"webhookId"`
+	EventID        uuid.UUID       `json:"eventId"`
+	EventType      string          `json:"eventType"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"nextAttemptAt,omitempty"`
+	LastAttemptAt  *time.Time      `json:"lastAttemptAt,omitempty"`
+	ResponseStatus *int            `json:"responseStatus,omitempty"`
+	Error          string          `json:"error,omitempty"`
+	CreatedAt      time.Time       `json:"createdAt"`
+}
+
+type listWebhookDeliveriesResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+	NextCursor string            `json:"nextCursor"`
+}
+
+// Types of the changes streamed by WatchChanges. Changes to firewall rules and domain lists
+// have the type of the webhook event they come from, such as firewall.rule.update.
+const (
+	ChangeTypeCreateZone  = "zone.create"
+	ChangeTypeDeleteZone  = "zone.delete"
+	ChangeTypeUpsertRRSet = "zone.rrset.upsert"
+	ChangeTypeDeleteRRSet = "zone.rrset.delete"
+	// ChangeTypeReset means the changes since the one the watch resumed from are no longer
+	// kept, so anything built from earlier changes must be reloaded.
+	ChangeTypeReset = "reset"
+)
+
+// Change is a notification that a resource changed. Resource is in the format of permission
+// resources, such as zone/example.com./rrset/www.example.com./A, and Data describes the
+// change, such as the record set upserted.
+type Change struct {
+	ID        int64           `json:"id"`
+	Type      string          `json:"type"`
+	Resource  string          `json:"resource,omitempty"`
+	Data      json.RawMessage `json:"data,omitempty"`
+	CreatedAt time.Time       `json:"createdAt"`
+}
+
+// Permission allows Actions (read, write, delete or *) on the resources matched by any of
+// the Resources patterns, such as zone/example.com. or firewall-rule/*.
+type Permission struct {
+	Actions   []string `json:"actions"`
+	Resources []string `json:"resources"`
+}
+
+type Role struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	Permissions []Permission `json:"permissions"`
+	BuiltIn     bool         `json:"builtIn"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	UpdatedAt   time.Time    `json:"updatedAt"`
+}
+
+type CreateRoleRequest struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// UpdateRoleRequest replaces the description and permissions of a role.
+type UpdateRoleRequest struct {
+	Description string       `json:"description,omitempty"`
+	Permissions []Permission `json:"permissions"`
+}
+
+type listRolesResponse struct {
+	Roles      []Role `json:"roles"`
+	NextCursor string `json:"nextCursor"`
+}
+
+const (
+	SubjectTypeAPIKey = "apiKey"
+	SubjectTypeUser   = "user"
+	SubjectTypeGroup  = "group"
+)
+
+// Subject is who a role is bound to. For API keys, ID is the ID of the key. For users and
+// groups signed in through OIDC, it is the username or group name from their ID token.
+type Subject struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+type RoleBinding struct {
+	ID        uuid.UUID `json:"id"`
+	Role      string    `json:"role"`
+	Subject   Subject   `json:"subject"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type CreateRoleBindingRequest struct {
+	Role    string  `json:"role"`
+	Subject Subject `json:"subject"`
+}
+
+type listRoleBindingsResponse struct {
+	RoleBindings []RoleBinding `json:"roleBindings"`
+	NextCursor   string        `json:"nextCursor"`
+}
+
+// ListRoleBindingsOptions are the options of a role binding listing, which can be sorted by
+// name, meaning the name of the role, or createdAt.
+type ListRoleBindingsOptions struct {
+	ListOptions
+	// Subject only returns the bindings of the subject.
+	Subject *Subject
+}
+
+func (o ListRoleBindingsOptions) query() string {
+	params := o.values()
+	if o.Subject != nil {
+		params.Set("subjectType", o.Subject.Type)
+		params.Set("subjectId", o.Subject.ID)
+	}
+	return encodeQuery(params)
+}
+
+// WhoAmI is the principal the client is authenticated as, along with the permissions of
+// every role bound to it.
+type WhoAmI struct {
+	Subject     Subject      `json:"subject"`
+	Name        string       `json:"name"`
+	Groups      []string     `json:"groups,omitempty"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// OIDCProvider describes how users sign in to the controller. Only Enabled is set when the
+// controller does not accept OIDC tokens.
+type OIDCProvider struct {
+	Enabled  bool     `json:"enabled"`
+	Issuer   string   `json:"issuer,omitempty"`
+	ClientID string   `json:"clientId,omitempty"`
+	Scopes   []string `json:"scopes,omitempty"`
+}
+
+// AuditEntry records a change made through the controller. Target is the resource changed,
+// in the format of permission resources. Before and After hold the state of the resource
+// on either side of the change, and are empty for the side on which it did not exist.
+type AuditEntry struct {
+	ID        int64           `json:"id"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Actor     Subject         `json:"actor"`
+	ActorName string          `json:"actorName"`
+	SourceIP  string          `json:"sourceIp,omitempty"`
+	Operation string          `json:"operation"`
+	Target    string          `json:"target"`
+	Before    json.RawMessage `json:"before,omitempty"`
+	After     json.RawMessage `json:"after,omitempty"`
+}
+
+type listAuditEntriesResponse struct {
+	Entries    []AuditEntry `json:"entries"`
+	NextCursor string       `json:"nextCursor"`
+}
+
+// ListAuditEntriesOptions are the options of an audit log listing, which is sorted by
+// createdAt, newest first unless Order is asc.
+type ListAuditEntriesOptions struct {
+	ListOptions
+	// Actor only returns the changes made by the subject.
+	Actor *Subject
+	// Operation only returns the changes of the operation, such as zone.rrset.upsert.
+	Operation string
+	// Target only returns the changes to the resource and to the resources below it, so a
+	// zone also matches the changes to its record sets.
+	Target string
+	// Since only returns the changes made at or after the time.
+	Since *time.Time
+	// Until only returns the changes made before the time.
+	Until *time.Time
+}
+
+func (o ListAuditEntriesOptions) query() string {
+	params := o.values()
+	if o.Actor != nil {
+		params.Set("actorType", o.Actor.Type)
+		params.Set("actorId", o.Actor.ID)
+	}
+	if o.Operation != "" {
+		params.Set("operation", o.Operation)
+	}
+	if o.Target != "" {
+		params.Set("target", o.Target)
+	}
+	if o.Since != nil {
+		params.Set("since", o.Since.Format(time.RFC3339Nano))
+	}
+	if o.Until != nil {
+		params.Set("until", o.Until.Format(time.RFC3339Nano))
+	}
+	return encodeQuery(params)
+}
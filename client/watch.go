@@ -0,0 +1,177 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxChangeEventSize is the largest event of the change stream that can be read, which bounds
+// the size of a record set in a change.
+const maxChangeEventSize = 4 << 20
+
+// WatchChanges streams the changes the caller may read as the controller applies them,
+// starting after the change with the ID lastEventID, or with the next change if it is empty.
+// A lastEventID of "0" streams every change the controller still keeps.
+//
+// The stream is reconnected when it drops, resuming after the last change received, so no
+// change is missed unless the controller no longer keeps it, in which case a change of type
+// ChangeTypeReset is streamed. Errors are yielded as they happen. The stream is reconnected
+// after those that may pass, such as the controller being unreachable, and ends after the
+// others, such as access being denied. Stop iterating, or cancel ctx, to stop watching.
+func (c *Client) WatchChanges(ctx context.Context, lastEventID string) iter.Seq2[Change, error] {
+	return func(yield func(Change, error) bool) {
+		// Streams last as long as they are watched, so unlike other requests they have no
+		// timeout.
+		streamClient := &http.Client{Transport: c.httpClient.Transport}
+
+		for attempt := 0; ; attempt++ {
+			received, retry, err := c.streamChanges(ctx, streamClient, &lastEventID, yield)
+			if ctx.Err() != nil {
+				return
+			}
+			if !retry {
+				if err != nil {
+					yield(Change{}, err)
+				}
+				return
+			}
+			if err != nil && !yield(Change{}, err) {
+				return
+			}
+			if received {
+				attempt = 0
+			}
+
+			timer := time.NewTimer(retryDelay(attempt, err))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+// streamChanges opens the change stream and yields its changes until it ends, keeping
+// lastEventID up to date. It reports whether any change was received, and whether the
+// stream should be reconnected, which it should be when it ends without the caller
+// stopping, unless it failed in a way a retry will not fix.
+func (c *Client) streamChanges(
+	ctx context.Context,
+	streamClient *http.Client,
+	lastEventID *string,
+	yield func(Change, error) bool,
+) (bool, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.host+"/v1/events/stream", nil)
+	if err != nil {
+		return false, false, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "text/event-stream")
+	if *lastEventID != "" {
+		req.Header.Set("Last-Event-ID", *lastEventID)
+	}
+	if err = c.authorize(ctx, req); err != nil {
+		return false, false, err
+	}
+
+	resp, err := streamClient.Do(req)
+	if err != nil {
+		return false, true, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+		return false, retry, c.handleError(resp)
+	}
+
+	received := false
+	events := newEventReader(resp.Body)
+	for {
+		event, readErr := events.next()
+		if errors.Is(readErr, io.EOF) {
+			return received, true, nil
+		} else if readErr != nil {
+			return received, true, fmt.Errorf("change stream failed: %w", readErr)
+		}
+
+		if event.hasID {
+			*lastEventID = event.id
+		}
+		// An event without data only moves the ID past changes the caller may not read.
+		if event.data == "" {
+			continue
+		}
+
+		var change Change
+		if err = json.Unmarshal([]byte(event.data), &change); err != nil {
+			return received, false, fmt.Errorf("failed to decode change: %w", err)
+		}
+
+		received = true
+		if !yield(change, nil) {
+			return received, false, nil
+		}
+	}
+}
+
+// serverSentEvent holds the fields of a server-sent event that the change stream uses.
+type serverSentEvent struct {
+	id    string
+	hasID bool
+	data  string
+}
+
+// eventReader reads server-sent events from a stream.
+type eventReader struct {
+	scanner *bufio.Scanner
+}
+
+func newEventReader(r io.Reader) *eventReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxChangeEventSize)
+	return &eventReader{scanner: scanner}
+}
+
+// next returns the next event of the stream, skipping comments and fields other than id and
+// data. It returns io.EOF once the stream ends.
+func (r *eventReader) next() (serverSentEvent, error) {
+	var event serverSentEvent
+	var data []string
+
+	for r.scanner.Scan() {
+		line := r.scanner.Text()
+		if line == "" {
+			if event.hasID || data != nil {
+				event.data = strings.Join(data, "\n")
+				return event, nil
+			}
+			continue
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "id":
+			event.id = value
+			event.hasID = true
+		case "data":
+			data = append(data, value)
+		}
+	}
+
+	if err := r.scanner.Err(); err != nil {
+		return event, err
+	}
+	return event, io.EOF
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_WatchChanges(t *testing.T) {
+	retryBaseDelay = time.Millisecond
+	t.Cleanup(func() { retryBaseDelay = 250 * time.Millisecond })
+
+	var connections int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		connections++
+		assert.Equal(t, "/v1/events/stream", r.URL.Path)
+		assert.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+
+		switch connections {
+		case 1:
+			assert.Equal(t, "7", r.Header.Get("Last-Event-ID"))
+			w.Header().Set("Content-Type", "text/event-stream")
+			io.WriteString(w, "id: 8\n"+
+				`data: {"id":8,"type":"zone.create","resource":"zone/example.com.",`+"\n"+
+				`data: "data":{"zoneName":"example.com."}}`+"\n\n"+
+				": keepalive\n\n"+
+				"id: 10\n\n")
+		case 2:
+			// The stream dropped, so the watch resumes after the last ID it was sent.
+			assert.Equal(t, "10", r.Header.Get("Last-Event-ID"))
+			w.Header().Set("Content-Type", "text/event-stream")
+			io.WriteString(w, "id: 11\r\n"+
+				`data: {"id":11,"type":"zone.rrset.delete","resource":"zone/example.com./rrset/www.example.com./A"}`+
+				"\r\n\r\n")
+		default:
+			assert.Equal(t, "11", r.Header.Get("Last-Event-ID"))
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(errorResponse{Code: "AccessDenied", Message: "access denied"})
+		}
+	}))
+	defer server.Close()
+
+	client := New(server.URL, WithAPIKey("test-key"))
+
+	var changes []Change
+	var errs []error
+	for change, err := range client.WatchChanges(t.Context(), "7") {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	require.Len(t, changes, 2)
+	assert.Equal(t, int64(8), changes[0].ID)
+	assert.Equal(t, ChangeTypeCreateZone, changes[0].Type)
+	assert.JSONEq(t, `{"zoneName":"example.com."}`, string(changes[0].Data))
+	assert.Equal(t, int64(11), changes[1].ID)
+	assert.Equal(t, "zone/example.com./rrset/www.example.com./A", changes[1].Resource)
+
+	// Access being denied cannot pass, so it ends the watch.
+	require.Len(t, errs, 1)
+	var accessDenied *AccessDeniedError
+	require.ErrorAs(t, errs[0], &accessDenied)
+	assert.Equal(t, 3, connections)
+}
@@ -0,0 +1,160 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/davidseybold/beacondns/client"
+)
+
+var apiKeysCmd = &cobra.Command{
+	Use:   "api-keys",
+	Short: "Manage API keys",
+	Long: `Commands for managing the API keys that authenticate requests to Beacon. The secret value of
+a key is only shown when it is created.`,
+}
+
+var createAPIKeyCmd = &cobra.Command{
+	Use:   "create [name]",
+	Short: "Create an API key",
+	Long: `Create an API key and print its secret value, which cannot be shown again. The key is only
+allowed to do what the roles bound to it allow.
+Example: beaconctl api-keys create ci --expires-in 720h --role viewer`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		expiresIn, err := cmd.Flags().GetDuration("expires-in")
+		if err != nil {
+			return err
+		}
+
+		roles, err := cmd.Flags().GetStringArray("role")
+		if err != nil {
+			return err
+		}
+
+		req := client.CreateAPIKeyRequest{Name: args[0], Roles: roles}
+		if expiresIn > 0 {
+			expiresAt := time.Now().Add(expiresIn)
+			req.ExpiresAt = &expiresAt
+		}
+
+		c := config.newClient()
+		key, err := c.CreateAPIKey(context.Background(), req)
+		if err != nil {
+			return err
+		}
+
+		if err = renderAPIKeys(cmd, []client.APIKey{key.APIKey}); err != nil {
+			return err
+		}
+
+		cmd.Printf("\nAPI key: %s\nStore it now; it cannot be shown again.\n", key.Key)
+		return nil
+	},
+}
+
+var listAPIKeysCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List API keys",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		opts, err := getListOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		keys, err := c.ListAPIKeysWithOptions(context.Background(), opts)
+		if err != nil {
+			return err
+		}
+
+		if len(keys) == 0 {
+			cmd.Println("No API keys found")
+			return nil
+		}
+
+		return renderAPIKeys(cmd, keys)
+	},
+}
+
+var revokeAPIKeyCmd = &cobra.Command{
+	Use:   "revoke [api-key-id]",
+	Short: "Revoke an API key",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		id, err := uuid.Parse(args[0])
+		if err != nil {
+			cmd.PrintErrln("invalid API key ID")
+			return err
+		}
+
+		c := config.newClient()
+		if err = c.RevokeAPIKey(context.Background(), id); err != nil {
+			return err
+		}
+
+		cmd.Println("API key revoked")
+		return nil
+	},
+}
+
+func renderAPIKeys(cmd *cobra.Command, keys []client.APIKey) error {
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.Header([]string{"ID", "NAME", "PREFIX", "CREATED", "EXPIRES", "LAST USED", "STATUS"})
+	now := time.Now()
+	for _, key := range keys {
+		status := "active"
+		switch {
+		case key.RevokedAt != nil:
+			status = "revoked"
+		case key.ExpiresAt != nil && !now.Before(*key.ExpiresAt):
+			status = "expired"
+		}
+
+		_ = table.Append([]string{
+			key.ID.String(),
+			key.Name,
+			key.Prefix,
+			key.CreatedAt.Format(time.RFC3339),
+			formatOptionalTime(key.ExpiresAt),
+			formatOptionalTime(key.LastUsedAt),
+			status,
+		})
+	}
+	return table.Render()
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
+}
+
+func init() {
+	createAPIKeyCmd.Flags().Duration("expires-in", 0, "Time until the key expires, e.g. 720h (never expires if unset)")
+	createAPIKeyCmd.Flags().StringArray("role", []string{}, "Role to bind to the key (can be repeated)")
+	addFlags([]flagFunc{pageFlags("name", "createdAt"), namePrefixFlag()}, listAPIKeysCmd)
+
+	apiKeysCmd.AddCommand(createAPIKeyCmd, listAPIKeysCmd, revokeAPIKeyCmd)
+	rootCmd.AddCommand(apiKeysCmd)
+}
@@ -0,0 +1,153 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/davidseybold/beacondns/client"
+)
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "List the changes recorded in the audit log",
+	Long: `List the changes made through Beacon, newest first, along with who made them and from where.
+Only the changes to resources you are allowed to read are listed. Use --json to print every
+entry as a line of JSON, including the state of the resource before and after the change.
+Example: beaconctl audit --target zone/example.com. --since 24h`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		opts, err := getAuditOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		asJSON, err := cmd.Flags().GetBool("json")
+		if err != nil {
+			return err
+		}
+
+		limit, err := cmd.Flags().GetInt("limit")
+		if err != nil {
+			return err
+		}
+
+		// The audit log only grows, so unlike other lists it is not fetched in full by default.
+		c := config.newClient()
+		var entries []client.AuditEntry
+		for entry, iterErr := range c.AllAuditEntries(context.Background(), opts) {
+			if iterErr != nil {
+				return iterErr
+			}
+			entries = append(entries, entry)
+			if limit > 0 && len(entries) >= limit {
+				break
+			}
+		}
+
+		if asJSON {
+			encoder := json.NewEncoder(cmd.OutOrStdout())
+			for i := range entries {
+				if err = encoder.Encode(&entries[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		if len(entries) == 0 {
+			cmd.Println("No audit entries found")
+			return nil
+		}
+
+		return renderAuditEntries(cmd, entries)
+	},
+}
+
+func getAuditOptions(cmd *cobra.Command) (client.ListAuditEntriesOptions, error) {
+	listOpts, err := getListOptions(cmd)
+	if err != nil {
+		return client.ListAuditEntriesOptions{}, err
+	}
+
+	opts := client.ListAuditEntriesOptions{ListOptions: listOpts}
+
+	if opts.Actor, err = getSubject(cmd); err != nil {
+		return client.ListAuditEntriesOptions{}, err
+	}
+	if opts.Operation, err = cmd.Flags().GetString("operation"); err != nil {
+		return client.ListAuditEntriesOptions{}, err
+	}
+	if opts.Target, err = cmd.Flags().GetString("target"); err != nil {
+		return client.ListAuditEntriesOptions{}, err
+	}
+	if opts.Since, err = getTimeFlag(cmd, "since"); err != nil {
+		return client.ListAuditEntriesOptions{}, err
+	}
+	if opts.Until, err = getTimeFlag(cmd, "until"); err != nil {
+		return client.ListAuditEntriesOptions{}, err
+	}
+
+	return opts, nil
+}
+
+// getTimeFlag reads a flag holding either an RFC 3339 time or a duration, which stands for
+// that long ago. It returns nil if the flag is not set.
+func getTimeFlag(cmd *cobra.Command, name string) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil || value == "" {
+		return nil, err
+	}
+
+	if ago, parseErr := time.ParseDuration(value); parseErr == nil {
+		t := time.Now().Add(-ago)
+		return &t, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s %q: must be an RFC 3339 time or a duration such as 24h", name, value)
+	}
+	return &t, nil
+}
+
+func renderAuditEntries(cmd *cobra.Command, entries []client.AuditEntry) error {
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.Header([]string{"ID", "TIME", "ACTOR", "SOURCE IP", "OPERATION", "TARGET"})
+	for _, entry := range entries {
+		sourceIP := entry.SourceIP
+		if sourceIP == "" {
+			sourceIP = "-"
+		}
+
+		_ = table.Append([]string{
+			fmt.Sprintf("%d", entry.ID),
+			entry.CreatedAt.Format(time.RFC3339),
+			fmt.Sprintf("%s %s", entry.Actor.Type, entry.ActorName),
+			sourceIP,
+			entry.Operation,
+			entry.Target,
+		})
+	}
+	return table.Render()
+}
+
+func init() {
+	auditCmd.Flags().String("operation", "", "Only list changes of this operation, e.g. zone.rrset.upsert")
+	auditCmd.Flags().String("target", "", "Only list changes to this resource and the resources below it")
+	auditCmd.Flags().String("since", "", "Only list changes made since this RFC 3339 time or duration ago, e.g. 24h")
+	auditCmd.Flags().String("until", "", "Only list changes made before this RFC 3339 time or duration ago")
+	auditCmd.Flags().Int("limit", 100, "Maximum number of entries to list (0 lists every entry)")
+	auditCmd.Flags().Bool("json", false, "Print every entry as a line of JSON, including its before and after state")
+	addFlags([]flagFunc{pageFlags("createdAt"), subjectFlags("Only list changes made by")}, auditCmd)
+
+	rootCmd.AddCommand(auditCmd)
+}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
@@ -51,10 +52,16 @@ var createDomainListCmd = &cobra.Command{
 			domains = append(domains, fileDomains...)
 		}
 
-		c := client.New(config.Host)
+		tags, err := getTags(cmd, "tag")
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
 		domainList, err := c.CreateDomainList(context.Background(), client.CreateDomainListRequest{
 			Name:    name,
 			Domains: domains,
+			Tags:    tags,
 		})
 		if err != nil {
 			return err
@@ -91,7 +98,7 @@ var deleteDomainListCmd = &cobra.Command{
 			return err
 		}
 
-		c := client.New(config.Host)
+		c := config.newClient()
 		err = c.DeleteDomainList(context.Background(), domainListID)
 		if err != nil {
 			cmd.PrintErrf("failed to delete domain list: %v", err)
@@ -112,16 +119,26 @@ var listDomainListsCmd = &cobra.Command{
 			return err
 		}
 
-		c := client.New(config.Host)
-		domainLists, err := c.GetDomainLists(context.Background())
+		opts, err := getListOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		domainLists, err := c.GetDomainListsWithOptions(context.Background(), opts)
 		if err != nil {
 			return err
 		}
 
 		table := tablewriter.NewWriter(cmd.OutOrStdout())
-		table.Header([]string{"ID", "NAME", "DOMAIN COUNT"})
+		table.Header([]string{"ID", "NAME", "DOMAIN COUNT", "TAGS"})
 		for _, domainList := range domainLists {
-			_ = table.Append([]string{domainList.ID.String(), domainList.Name, strconv.Itoa(domainList.DomainCount)})
+			_ = table.Append([]string{
+				domainList.ID.String(),
+				domainList.Name,
+				strconv.Itoa(domainList.DomainCount),
+				formatTags(domainList.Tags),
+			})
 		}
 		return table.Render()
 	},
@@ -143,7 +160,7 @@ var getDomainListCmd = &cobra.Command{
 			return err
 		}
 
-		c := client.New(config.Host)
+		c := config.newClient()
 		domainList, err := c.GetDomainList(context.Background(), domainListID)
 		if err != nil {
 			cmd.PrintErrf("failed to get domain list: %v", err)
@@ -157,12 +174,93 @@ var getDomainListCmd = &cobra.Command{
 	},
 }
 
+var listDomainListDomainsCmd = &cobra.Command{
+	Use:   "domains [domain-list-id]",
+	Short: "List the domains of a domain list",
+	Long: `Print the domains of a domain list, one per line. Domains are fetched a page at a time, so
+even very large lists can be streamed to a file.
+Example: beaconctl domain-lists domains 3f2a9c1e-7b4d-4e8a-9c2f-1d5e6a7b8c9d --name-prefix ads. > ads.txt`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		domainListID, err := uuid.Parse(args[0])
+		if err != nil {
+			cmd.PrintErrln("invalid domain list ID")
+			return err
+		}
+
+		opts, err := getListOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		for domain, iterErr := range c.AllDomainListDomains(context.Background(), domainListID, opts) {
+			if iterErr != nil {
+				return iterErr
+			}
+			if _, err = fmt.Fprintln(cmd.OutOrStdout(), domain); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+var domainListTagsCmd = &cobra.Command{
+	Use:   "tags [domain-list-id]",
+	Short: "Add or remove tags on a domain list",
+	Long: `Add, overwrite or remove tags on a domain list and show the resulting tags.
+Example: beaconctl domain-lists tags 3f2a9c1e-7b4d-4e8a-9c2f-1d5e6a7b8c9d --set team:security --remove owner`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		domainListID, err := uuid.Parse(args[0])
+		if err != nil {
+			cmd.PrintErrln("invalid domain list ID")
+			return err
+		}
+
+		req, err := getTagsUpdate(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		tags, err := c.UpdateDomainListTags(context.Background(), domainListID, req)
+		if err != nil {
+			return err
+		}
+
+		return renderTags(cmd, tags)
+	},
+}
+
 func init() {
 	createDomainListCmd.Flags().StringP("name", "n", "", "Name of the domain list")
 	createDomainListCmd.Flags().StringSliceP("domains", "d", []string{}, "Domains to add to the domain list")
 	createDomainListCmd.Flags().StringP("file", "f", "", "File to read domains from")
 	_ = createDomainListCmd.MarkFlagRequired("name")
+	addFlags([]flagFunc{tagFlag()}, createDomainListCmd)
+	addFlags([]flagFunc{pageFlags("name", "updatedAt"), namePrefixFlag(), tagFilterFlag()}, listDomainListsCmd)
+	addFlags([]flagFunc{pageFlags("domain"), namePrefixFlag()}, listDomainListDomainsCmd)
+	addFlags([]flagFunc{tagsUpdateFlags()}, domainListTagsCmd)
 
-	domainListsCmd.AddCommand(createDomainListCmd, deleteDomainListCmd, getDomainListCmd, listDomainListsCmd)
+	domainListsCmd.AddCommand(
+		createDomainListCmd,
+		deleteDomainListCmd,
+		getDomainListCmd,
+		listDomainListsCmd,
+		domainListTagsCmd,
+		listDomainListDomainsCmd,
+	)
 	rootCmd.AddCommand(domainListsCmd)
 }
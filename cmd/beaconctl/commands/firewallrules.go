@@ -91,7 +91,12 @@ var createFirewallRuleCmd = &cobra.Command{
 			}
 		}
 
-		c := client.New(config.Host)
+		tags, err := getTags(cmd, "tag")
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
 		rule, err := c.CreateFirewallRule(context.Background(), client.CreateFirewallRuleRequest{
 			Name:              name,
 			DomainListID:      domainListID,
@@ -99,6 +104,7 @@ var createFirewallRuleCmd = &cobra.Command{
 			BlockResponseType: &blockResponseType,
 			BlockResponse:     blockResponse,
 			Priority:          priority,
+			Tags:              tags,
 		})
 		if err != nil {
 			return err
@@ -134,7 +140,7 @@ var deleteFirewallRuleCmd = &cobra.Command{
 			return err
 		}
 
-		c := client.New(config.Host)
+		c := config.newClient()
 		err = c.DeleteFirewallRule(context.Background(), ruleID)
 		if err != nil {
 			return err
@@ -160,7 +166,7 @@ var getFirewallRuleCmd = &cobra.Command{
 			return err
 		}
 
-		c := client.New(config.Host)
+		c := config.newClient()
 		rule, err := c.GetFirewallRule(context.Background(), ruleID)
 		if err != nil {
 			return err
@@ -190,14 +196,19 @@ var listFirewallRulesCmd = &cobra.Command{
 			return err
 		}
 
-		c := client.New(config.Host)
-		rules, err := c.GetFirewallRules(context.Background())
+		opts, err := getListOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		rules, err := c.GetFirewallRulesWithOptions(context.Background(), opts)
 		if err != nil {
 			return err
 		}
 
 		table := tablewriter.NewWriter(cmd.OutOrStdout())
-		table.Header([]string{"ID", "NAME", "DOMAIN LIST ID", "ACTION", "PRIORITY"})
+		table.Header([]string{"ID", "NAME", "DOMAIN LIST ID", "ACTION", "PRIORITY", "TAGS"})
 		for _, rule := range rules {
 			_ = table.Append(
 				[]string{
@@ -206,6 +217,7 @@ var listFirewallRulesCmd = &cobra.Command{
 					rule.DomainListID.String(),
 					rule.Action,
 					strconv.Itoa(int(rule.Priority)),
+					formatTags(rule.Tags),
 				},
 			)
 		}
@@ -283,7 +295,7 @@ var updateFirewallRuleCmd = &cobra.Command{
 			}
 		}
 
-		c := client.New(config.Host)
+		c := config.newClient()
 		rule, err := c.UpdateFirewallRule(context.Background(), ruleID, client.UpdateFirewallRuleRequest{
 			Name:              name,
 			Action:            action,
@@ -310,6 +322,39 @@ var updateFirewallRuleCmd = &cobra.Command{
 	},
 }
 
+var firewallRuleTagsCmd = &cobra.Command{
+	Use:   "tags [firewall-rule-id]",
+	Short: "Add or remove tags on a firewall rule",
+	Long: `Add, overwrite or remove tags on a firewall rule and show the resulting tags.
+Example: beaconctl firewall-rules tags 8c4e2b7a-1f3d-4a6b-8e9c-2d7f5a1b3c6e --set team:security --remove owner`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		ruleID, err := uuid.Parse(args[0])
+		if err != nil {
+			cmd.PrintErrln("invalid firewall rule ID")
+			return err
+		}
+
+		req, err := getTagsUpdate(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		tags, err := c.UpdateFirewallRuleTags(context.Background(), ruleID, req)
+		if err != nil {
+			return err
+		}
+
+		return renderTags(cmd, tags)
+	},
+}
+
 func init() {
 	createFirewallRuleCmd.Flags().String("name", "", "Name of the firewall rule")
 	createFirewallRuleCmd.Flags().String("domain-list-id", "", "ID of the domain list to apply the firewall rule to")
@@ -329,6 +374,7 @@ func init() {
 	_ = createFirewallRuleCmd.MarkFlagRequired("action")
 	_ = createFirewallRuleCmd.MarkFlagRequired("priority")
 	_ = createFirewallRuleCmd.MarkFlagRequired("block-response-type")
+	addFlags([]flagFunc{tagFlag()}, createFirewallRuleCmd)
 
 	updateFirewallRuleCmd.Flags().String("name", "", "Name of the firewall rule")
 	updateFirewallRuleCmd.Flags().
@@ -348,12 +394,16 @@ func init() {
 	_ = updateFirewallRuleCmd.MarkFlagRequired("priority")
 	_ = updateFirewallRuleCmd.MarkFlagRequired("block-response-type")
 
+	addFlags([]flagFunc{pageFlags("priority", "name"), namePrefixFlag(), tagFilterFlag()}, listFirewallRulesCmd)
+	addFlags([]flagFunc{tagsUpdateFlags()}, firewallRuleTagsCmd)
+
 	firewallRulesCmd.AddCommand(
 		createFirewallRuleCmd,
 		deleteFirewallRuleCmd,
 		getFirewallRuleCmd,
 		listFirewallRulesCmd,
 		updateFirewallRuleCmd,
+		firewallRuleTagsCmd,
 	)
 	rootCmd.AddCommand(firewallRulesCmd)
 }
@@ -8,16 +8,38 @@ import (
 	"path/filepath"
 
 	"github.com/spf13/cobra"
+
+	"github.com/davidseybold/beacondns/client"
+)
+
+const (
+	// apiKeyEnv overrides the API key of the configuration file.
+	apiKeyEnv = "BEACON_API_KEY"
+	// maxRetries is how many times a request that failed on the way or on the server is retried.
+	maxRetries = 3
 )
 
 type Config struct {
-	Host string `json:"host"`
+	Host   string `json:"host"`
+	APIKey string `json:"apiKey,omitempty"`
+}
+
+// newClient returns a client that authenticates with the API key if one is configured, and
+// with the tokens stored by beaconctl login otherwise.
+func (c *Config) newClient() *client.Client {
+	if c.APIKey != "" {
+		return client.New(c.Host, client.WithAPIKey(c.APIKey), client.WithRetries(maxRetries))
+	}
+	return client.New(c.Host, client.WithTokenSource(newOIDCTokenSource(nil)), client.WithRetries(maxRetries))
 }
 
 var initCmd = &cobra.Command{
 	Use:   "init",
 	Short: "Initialize beaconctl configuration",
-	Long:  `Initialize beaconctl by setting the host URL for the Beacon DNS API.`,
+	Long: `Initialize beaconctl by setting the host URL for the Beacon DNS API and the API key to
+authenticate with. The API key can also be set with the BEACON_API_KEY environment variable,
+which takes precedence over the configuration file. Leave it out to sign in with
+beaconctl login instead.`,
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		host, err := cmd.Flags().GetString("host")
 		if err != nil {
@@ -28,13 +50,19 @@ var initCmd = &cobra.Command{
 			return errors.New("host URL is required")
 		}
 
+		apiKey, err := cmd.Flags().GetString("api-key")
+		if err != nil {
+			return err
+		}
+
 		configDir, err := getConfigDir()
 		if err != nil {
 			return fmt.Errorf("failed to get config directory: %w", err)
 		}
 
 		config := Config{
-			Host: host,
+			Host:   host,
+			APIKey: apiKey,
 		}
 
 		configFile := filepath.Join(configDir, "config")
@@ -54,6 +82,7 @@ var initCmd = &cobra.Command{
 
 func init() {
 	initCmd.Flags().String("host", "", "Host URL for the Beacon DNS API (e.g., http://localhost:8080)")
+	initCmd.Flags().String("api-key", "", "API key to authenticate with")
 	_ = initCmd.MarkFlagRequired("host")
 	rootCmd.AddCommand(initCmd)
 }
@@ -75,5 +104,9 @@ func loadConfig() (*Config, error) {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
+	if apiKey := os.Getenv(apiKeyEnv); apiKey != "" {
+		config.APIKey = apiKey
+	}
+
 	return &config, nil
 }
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davidseybold/beacondns/client"
+)
+
+// pageFlags registers the flags that sort a list command. List commands fetch every page of
+// the list, so the page size only controls how many items each request returns.
+func pageFlags(sortFields ...string) flagFunc {
+	return func(cmd *cobra.Command) {
+		cmd.Flags().Int("page-size", 0, "Number of items to fetch per request (defaults to the server default)")
+		cmd.Flags().String("sort", "", fmt.Sprintf("Field to sort by (%s)", strings.Join(sortFields, ", ")))
+		cmd.Flags().String("order", "", "Sort order (asc or desc)")
+	}
+}
+
+func namePrefixFlag() flagFunc {
+	return func(cmd *cobra.Command) {
+		cmd.Flags().String("name-prefix", "", "Only list items whose name starts with the prefix")
+	}
+}
+
+func tagFilterFlag() flagFunc {
+	return func(cmd *cobra.Command) {
+		cmd.Flags().StringArray(
+			"tag",
+			[]string{},
+			"Only list objects with this tag, as key:value or key for any value (can be repeated)",
+		)
+	}
+}
+
+// getListOptions reads the flags registered by pageFlags, namePrefixFlag and tagFilterFlag.
+func getListOptions(cmd *cobra.Command) (client.ListOptions, error) {
+	var opts client.ListOptions
+	var err error
+
+	if opts.Limit, err = cmd.Flags().GetInt("page-size"); err != nil {
+		return client.ListOptions{}, err
+	}
+
+	if opts.Sort, err = cmd.Flags().GetString("sort"); err != nil {
+		return client.ListOptions{}, err
+	}
+
+	if opts.Order, err = cmd.Flags().GetString("order"); err != nil {
+		return client.ListOptions{}, err
+	}
+
+	if cmd.Flags().Lookup("name-prefix") != nil {
+		if opts.NamePrefix, err = cmd.Flags().GetString("name-prefix"); err != nil {
+			return client.ListOptions{}, err
+		}
+	}
+
+	if cmd.Flags().Lookup("tag") != nil {
+		if opts.Tags, err = cmd.Flags().GetStringArray("tag"); err != nil {
+			return client.ListOptions{}, err
+		}
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,443 @@
+package commands
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davidseybold/beacondns/client"
+)
+
+const (
+	tokensFile = "tokens.json"
+
+	deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+	// defaultPollInterval is how often the token endpoint is polled when the issuer does not
+	// say, as recommended by RFC 8628.
+	defaultPollInterval = 5 * time.Second
+	// tokenExpiryMargin refreshes tokens a little before they expire, so that they do not
+	// expire on their way to the controller.
+	tokenExpiryMargin = 30 * time.Second
+	oidcHTTPTimeout   = 30 * time.Second
+)
+
+var errNotLoggedIn = errors.New("not logged in: run beaconctl login or configure an API key")
+
+// Tokens are the tokens of a user signed in through OIDC, stored in the config directory.
+type Tokens struct {
+	Issuer        string    `json:"issuer"`
+	ClientID      string    `json:"clientId"`
+	TokenEndpoint string    `json:"tokenEndpoint"`
+	IDToken       string    `json:"idToken"`
+	RefreshToken  string    `json:"refreshToken,omitempty"`
+	ExpiresAt     time.Time `json:"expiresAt"`
+}
+
+var loginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Sign in through the OIDC issuer of the controller",
+	Long: `Sign in through the OIDC issuer the controller is configured with, using the device
+authorization flow: beaconctl shows a code to enter in a browser, on this or any other device,
+and waits until sign-in is complete. The tokens are stored in the ~/.beacon directory and
+refreshed as needed. An API key in the configuration or the BEACON_API_KEY environment
+variable takes precedence over them.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		provider, err := client.New(config.Host).GetOIDCProvider(ctx)
+		if err != nil {
+			return err
+		}
+		if !provider.Enabled {
+			return errors.New("the controller does not accept OIDC sign-in; use an API key instead")
+		}
+
+		httpClient := &http.Client{Timeout: oidcHTTPTimeout}
+		discovery, err := discoverOIDC(ctx, httpClient, provider.Issuer)
+		if err != nil {
+			return err
+		}
+		if discovery.DeviceAuthorizationEndpoint == "" {
+			return fmt.Errorf("OIDC issuer %s does not support the device authorization flow", provider.Issuer)
+		}
+
+		var device deviceAuthorization
+		err = postForm(ctx, httpClient, discovery.DeviceAuthorizationEndpoint, url.Values{
+			"client_id": {provider.ClientID},
+			"scope":     {strings.Join(provider.Scopes, " ")},
+		}, &device)
+		if err != nil {
+			return fmt.Errorf("failed to start sign-in: %w", err)
+		}
+
+		if device.VerificationURIComplete != "" {
+			cmd.Printf("Open %s in a browser and check that it shows the code %s.\n",
+				device.VerificationURIComplete, device.UserCode)
+		} else {
+			cmd.Printf("Open %s in a browser and enter the code %s.\n", device.VerificationURI, device.UserCode)
+		}
+		cmd.Println("Waiting for sign-in to complete...")
+
+		resp, err := pollDeviceToken(ctx, httpClient, discovery.TokenEndpoint, provider.ClientID, &device)
+		if err != nil {
+			return err
+		}
+
+		tokens := &Tokens{
+			Issuer:        provider.Issuer,
+			ClientID:      provider.ClientID,
+			TokenEndpoint: discovery.TokenEndpoint,
+		}
+		if err = tokens.update(resp); err != nil {
+			return err
+		}
+		if err = saveTokens(tokens); err != nil {
+			return err
+		}
+
+		whoAmI, err := client.New(config.Host, client.WithTokenSource(newOIDCTokenSource(tokens))).WhoAmI(ctx)
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("Logged in as %s\n", whoAmI.Name)
+		return nil
+	},
+}
+
+var logoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Remove the tokens stored by beaconctl login",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		path, err := tokensPath()
+		if err != nil {
+			return err
+		}
+
+		if err = os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove tokens: %w", err)
+		}
+
+		cmd.Println("Logged out")
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(loginCmd, logoutCmd)
+}
+
+type oidcDiscovery struct {
+	Issuer                      string `json:"issuer"`
+	TokenEndpoint               string `json:"token_endpoint"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+}
+
+type deviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+type tokenResponse struct {
+	IDToken      string `json:"id_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+// oauthError is the error response of an OAuth endpoint, as defined in RFC 6749.
+type oauthError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (e *oauthError) Error() string {
+	if e.Description != "" {
+		return fmt.Sprintf("%s: %s", e.Code, e.Description)
+	}
+	return e.Code
+}
+
+func discoverOIDC(ctx context.Context, httpClient *http.Client, issuer string) (*oidcDiscovery, error) {
+	discoveryURL := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var discovery oidcDiscovery
+	if err = doOIDCRequest(httpClient, req, &discovery); err != nil {
+		return nil, fmt.Errorf("failed to discover OIDC configuration: %w", err)
+	}
+	if discovery.Issuer != issuer {
+		return nil, fmt.Errorf("OIDC configuration is for issuer %q, not %q", discovery.Issuer, issuer)
+	}
+
+	return &discovery, nil
+}
+
+// pollDeviceToken polls the token endpoint until the user completes or denies sign-in, or
+// the device code expires.
+func pollDeviceToken(
+	ctx context.Context,
+	httpClient *http.Client,
+	tokenEndpoint string,
+	clientID string,
+	device *deviceAuthorization,
+) (*tokenResponse, error) {
+	interval := defaultPollInterval
+	if device.Interval > 0 {
+		interval = time.Duration(device.Interval) * time.Second
+	}
+
+	var deadline time.Time
+	if device.ExpiresIn > 0 {
+		deadline = time.Now().Add(time.Duration(device.ExpiresIn) * time.Second)
+	}
+
+	for {
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			return nil, errors.New("sign-in timed out; run beaconctl login again")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		var resp tokenResponse
+		err := postForm(ctx, httpClient, tokenEndpoint, url.Values{
+			"grant_type":  {deviceCodeGrantType},
+			"device_code": {device.DeviceCode},
+			"client_id":   {clientID},
+		}, &resp)
+
+		var oauthErr *oauthError
+		switch {
+		case err == nil:
+			return &resp, nil
+		case errors.As(err, &oauthErr) && oauthErr.Code == "authorization_pending":
+		case errors.As(err, &oauthErr) && oauthErr.Code == "slow_down":
+			interval += defaultPollInterval
+		case errors.As(err, &oauthErr) && oauthErr.Code == "access_denied":
+			return nil, errors.New("sign-in was denied")
+		case errors.As(err, &oauthErr) && oauthErr.Code == "expired_token":
+			return nil, errors.New("sign-in timed out; run beaconctl login again")
+		default:
+			return nil, fmt.Errorf("failed to sign in: %w", err)
+		}
+	}
+}
+
+func postForm(ctx context.Context, httpClient *http.Client, endpoint string, form url.Values, result any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return doOIDCRequest(httpClient, req, result)
+}
+
+func doOIDCRequest(httpClient *http.Client, req *http.Request, result any) error {
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var oauthErr oauthError
+		if json.Unmarshal(body, &oauthErr) == nil && oauthErr.Code != "" {
+			return &oauthErr
+		}
+		return fmt.Errorf("unexpected status %s from %s", resp.Status, req.URL)
+	}
+
+	if err = json.Unmarshal(body, result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// update takes the tokens of a token response. Issuers may leave out the refresh token on a
+// refresh, in which case the current one stays valid.
+func (t *Tokens) update(resp *tokenResponse) error {
+	if resp.IDToken == "" {
+		return errors.New("OIDC issuer did not return an ID token; check that the openid scope is requested")
+	}
+
+	expiresAt, err := idTokenExpiry(resp.IDToken)
+	if err != nil {
+		return err
+	}
+
+	t.IDToken = resp.IDToken
+	t.ExpiresAt = expiresAt
+	if resp.RefreshToken != "" {
+		t.RefreshToken = resp.RefreshToken
+	}
+	return nil
+}
+
+// idTokenExpiry reads the exp claim of an ID token. The token is verified by the controller,
+// beaconctl only needs to know when to refresh it.
+func idTokenExpiry(idToken string) (time.Time, error) {
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return time.Time{}, errors.New("malformed ID token")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("malformed ID token: %w", err)
+	}
+
+	var claims struct {
+		Expiry int64 `json:"exp"`
+	}
+	if err = json.Unmarshal(payload, &claims); err != nil || claims.Expiry == 0 {
+		return time.Time{}, errors.New("ID token has no expiry")
+	}
+
+	return time.Unix(claims.Expiry, 0), nil
+}
+
+// oidcTokenSource provides the stored ID token, refreshing and storing it again when it is
+// about to expire. The tokens are only loaded when the first request is made, so that
+// commands that fail before making one do not need a login.
+type oidcTokenSource struct {
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu     sync.Mutex
+	tokens *Tokens
+}
+
+var _ client.TokenSource = (*oidcTokenSource)(nil)
+
+func newOIDCTokenSource(tokens *Tokens) *oidcTokenSource {
+	return &oidcTokenSource{
+		httpClient: &http.Client{Timeout: oidcHTTPTimeout},
+		now:        time.Now,
+		tokens:     tokens,
+	}
+}
+
+func (s *oidcTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.tokens == nil {
+		tokens, err := loadTokens()
+		if err != nil {
+			return "", err
+		}
+		s.tokens = tokens
+	}
+
+	if s.now().Add(tokenExpiryMargin).Before(s.tokens.ExpiresAt) {
+		return s.tokens.IDToken, nil
+	}
+
+	if s.tokens.RefreshToken == "" {
+		return "", errors.New("session expired: run beaconctl login again")
+	}
+
+	var resp tokenResponse
+	err := postForm(ctx, s.httpClient, s.tokens.TokenEndpoint, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {s.tokens.RefreshToken},
+		"client_id":     {s.tokens.ClientID},
+	}, &resp)
+	var oauthErr *oauthError
+	if errors.As(err, &oauthErr) && oauthErr.Code == "invalid_grant" {
+		return "", errors.New("session expired: run beaconctl login again")
+	} else if err != nil {
+		return "", fmt.Errorf("failed to refresh token: %w", err)
+	}
+
+	if err = s.tokens.update(&resp); err != nil {
+		return "", err
+	}
+	if err = saveTokens(s.tokens); err != nil {
+		return "", err
+	}
+
+	return s.tokens.IDToken, nil
+}
+
+func tokensPath() (string, error) {
+	configDir, err := getConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get config directory: %w", err)
+	}
+	return filepath.Join(configDir, tokensFile), nil
+}
+
+func loadTokens() (*Tokens, error) {
+	path, err := tokensPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errNotLoggedIn
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read tokens: %w", err)
+	}
+
+	var tokens Tokens
+	if err = json.Unmarshal(data, &tokens); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tokens: %w", err)
+	}
+
+	return &tokens, nil
+}
+
+// saveTokens stores the tokens readable only by the user, like the config file.
+func saveTokens(tokens *Tokens) error {
+	path, err := tokensPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(tokens, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal tokens: %w", err)
+	}
+
+	if err = os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write tokens: %w", err)
+	}
+
+	return nil
+}
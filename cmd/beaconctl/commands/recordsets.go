@@ -2,6 +2,7 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"strings"
 
@@ -20,6 +21,8 @@ var recordsCmd = &cobra.Command{
 var listRecordsCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all resource record sets in a zone",
+	Long: `List the resource record sets in a zone, optionally filtered by type, name prefix or tags.
+Example: beaconctl record-sets list --zone-id example.com --type TXT --name-prefix _dmarc --sort type`,
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		config, err := loadConfig()
 		if err != nil {
@@ -31,14 +34,28 @@ var listRecordsCmd = &cobra.Command{
 			return err
 		}
 
-		c := client.New(config.Host)
-		rrSets, err := c.ListResourceRecordSets(context.Background(), zoneID)
+		recordType, err := cmd.Flags().GetString("type")
+		if err != nil {
+			return err
+		}
+
+		opts, err := getListOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		rrSets, err := c.ListResourceRecordSetsWithOptions(
+			context.Background(),
+			zoneID,
+			client.ListResourceRecordSetsOptions{ListOptions: opts, Type: recordType},
+		)
 		if err != nil {
 			return err
 		}
 
 		table := tablewriter.NewWriter(cmd.OutOrStdout())
-		table.Header([]string{"NAME", "TYPE", "TTL", "VALUES"})
+		table.Header([]string{"NAME", "TYPE", "TTL", "VALUES", "TAGS"})
 		for _, rrset := range rrSets {
 			values := ""
 			for i, record := range rrset.ResourceRecords {
@@ -47,7 +64,9 @@ var listRecordsCmd = &cobra.Command{
 				}
 				values += record.Value
 			}
-			_ = table.Append([]string{rrset.Name, rrset.Type, strconv.Itoa(int(rrset.TTL)), values})
+			_ = table.Append(
+				[]string{rrset.Name, rrset.Type, strconv.Itoa(int(rrset.TTL)), values, formatTags(rrset.Tags)},
+			)
 		}
 		return table.Render()
 	},
@@ -57,6 +76,7 @@ var createRecordCmd = &cobra.Command{
 	Use:   "create [name]",
 	Short: "Create a new resource record set",
 	Long: `Create a new resource record set in a zone.
+Tags and the comment replace those already on the record set.
 Example: beaconctl records create www.example.com --zone-id 123 --type A --ttl 300 --values 192.0.2.1`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -85,6 +105,26 @@ Example: beaconctl records create www.example.com --zone-id 123 --type A --ttl 3
 			return err
 		}
 
+		syncPTR, err := cmd.Flags().GetBool("sync-ptr")
+		if err != nil {
+			return err
+		}
+
+		validatePolicies, err := cmd.Flags().GetBool("validate-policies")
+		if err != nil {
+			return err
+		}
+
+		tags, err := getTags(cmd, "tag")
+		if err != nil {
+			return err
+		}
+
+		comment, err := cmd.Flags().GetString("comment")
+		if err != nil {
+			return err
+		}
+
 		name := args[0]
 
 		resourceRecords := make([]client.ResourceRecord, len(values))
@@ -92,13 +132,15 @@ Example: beaconctl records create www.example.com --zone-id 123 --type A --ttl 3
 			resourceRecords[i] = client.ResourceRecord{Value: value}
 		}
 
-		c := client.New(config.Host)
-		rrSet, err := c.UpsertResourceRecordSet(context.Background(), zoneID, client.ResourceRecordSet{
+		c := config.newClient()
+		rrSet, err := c.UpsertResourceRecordSetWithOptions(context.Background(), zoneID, client.ResourceRecordSet{
 			Name:            name,
 			Type:            recordType,
 			TTL:             ttl,
 			ResourceRecords: resourceRecords,
-		})
+			Tags:            tags,
+			Comment:         comment,
+		}, client.ResourceRecordSetOptions{SyncPTR: syncPTR, ValidatePolicies: validatePolicies})
 		if err != nil {
 			return err
 		}
@@ -108,7 +150,14 @@ Example: beaconctl records create www.example.com --zone-id 123 --type A --ttl 3
 		_ = table.Append(
 			[]string{zoneID, rrSet.Name, rrSet.Type, strconv.Itoa(int(rrSet.TTL)), strings.Join(values, ", ")},
 		)
-		return table.Render()
+		if err = table.Render(); err != nil {
+			return err
+		}
+
+		for _, warning := range rrSet.Warnings {
+			cmd.PrintErrf("Warning: %s (%s)\n", warning.Message, warning.Rule)
+		}
+		return nil
 	},
 }
 
@@ -134,10 +183,21 @@ Example: beaconctl records delete www.example.com --zone-id 123 --type A`,
 			return err
 		}
 
+		syncPTR, err := cmd.Flags().GetBool("sync-ptr")
+		if err != nil {
+			return err
+		}
+
 		name := args[0]
 
-		c := client.New(config.Host)
-		err = c.DeleteResourceRecordSet(context.Background(), zoneID, name, recordType)
+		c := config.newClient()
+		err = c.DeleteResourceRecordSetWithOptions(
+			context.Background(),
+			zoneID,
+			name,
+			recordType,
+			client.ResourceRecordSetOptions{SyncPTR: syncPTR},
+		)
 		if err != nil {
 			return err
 		}
@@ -171,7 +231,7 @@ Example: beaconctl records get www.example.com --zone-id 123 --type A`,
 
 		name := args[0]
 
-		c := client.New(config.Host)
+		c := config.newClient()
 		rrSet, err := c.GetResourceRecordSet(context.Background(), zoneID, name, recordType)
 		if err != nil {
 			return err
@@ -184,9 +244,85 @@ Example: beaconctl records get www.example.com --zone-id 123 --type A`,
 	},
 }
 
+var planRecordCmd = &cobra.Command{
+	Use:   "plan [name]",
+	Short: "Preview the changes to a resource record set",
+	Long: `Validate an upsert or delete of a resource record set and show the changes it would make
+to the zone, without applying them. Changes to the PTR records in reverse zones and to the
+delegation in the parent zone are not shown.
+Example: beaconctl record-sets plan www.example.com --zone-id example.com --type A --values 192.0.2.1
+Example: beaconctl record-sets plan www.example.com --zone-id example.com --type A --delete`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		zoneID, err := cmd.Flags().GetString("zone-id")
+		if err != nil {
+			return err
+		}
+
+		recordType, err := cmd.Flags().GetString("type")
+		if err != nil {
+			return err
+		}
+
+		ttl, err := cmd.Flags().GetUint32("ttl")
+		if err != nil {
+			return err
+		}
+
+		values, err := cmd.Flags().GetStringSlice("values")
+		if err != nil {
+			return err
+		}
+
+		del, err := cmd.Flags().GetBool("delete")
+		if err != nil {
+			return err
+		}
+
+		name := args[0]
+
+		c := config.newClient()
+
+		var diff *client.ZoneDiff
+		if del {
+			diff, err = c.PlanDeleteResourceRecordSet(context.Background(), zoneID, name, recordType)
+		} else {
+			if len(values) == 0 {
+				return errors.New("--values is required unless --delete is set")
+			}
+
+			resourceRecords := make([]client.ResourceRecord, len(values))
+			for i, value := range values {
+				resourceRecords[i] = client.ResourceRecord{Value: value}
+			}
+
+			diff, err = c.PlanUpsertResourceRecordSet(context.Background(), zoneID, client.ResourceRecordSet{
+				Name:            name,
+				Type:            recordType,
+				TTL:             ttl,
+				ResourceRecords: resourceRecords,
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		return renderZoneDiff(cmd, diff)
+	},
+}
+
 func init() {
 	listRecordsFlags := []flagFunc{
 		zoneIDFlag(),
+		recordTypeFlag(false),
+		pageFlags("name", "type"),
+		namePrefixFlag(),
+		tagFilterFlag(),
 	}
 
 	createRecordFlags := []flagFunc{
@@ -194,11 +330,16 @@ func init() {
 		recordTypeFlag(true),
 		ttlFlag(false),
 		valuesFlag(true),
+		syncPTRFlag(),
+		validatePoliciesFlag(),
+		tagFlag(),
+		commentFlag(),
 	}
 
 	deleteRecordFlags := []flagFunc{
 		zoneIDFlag(),
 		recordTypeFlag(true),
+		syncPTRFlag(),
 	}
 
 	getRecordFlags := []flagFunc{
@@ -209,9 +350,18 @@ func init() {
 	addFlags(listRecordsFlags, listRecordsCmd)
 	addFlags(createRecordFlags, createRecordCmd)
 	addFlags(deleteRecordFlags, deleteRecordCmd)
+	planRecordFlags := []flagFunc{
+		zoneIDFlag(),
+		recordTypeFlag(true),
+		ttlFlag(false),
+		valuesFlag(false),
+	}
+
 	addFlags(getRecordFlags, getRecordCmd)
+	addFlags(planRecordFlags, planRecordCmd)
+	planRecordCmd.Flags().Bool("delete", false, "Plan a delete of the record set instead of an upsert")
 
-	recordsCmd.AddCommand(listRecordsCmd, createRecordCmd, deleteRecordCmd, getRecordCmd)
+	recordsCmd.AddCommand(listRecordsCmd, createRecordCmd, deleteRecordCmd, getRecordCmd, planRecordCmd)
 	rootCmd.AddCommand(recordsCmd)
 }
 
@@ -248,3 +398,25 @@ func valuesFlag(required bool) flagFunc {
 		}
 	}
 }
+
+func syncPTRFlag() flagFunc {
+	return func(cmd *cobra.Command) {
+		cmd.Flags().Bool("sync-ptr", false, "Maintain PTR records for A and AAAA records in hosted reverse zones")
+	}
+}
+
+func validatePoliciesFlag() flagFunc {
+	return func(cmd *cobra.Command) {
+		cmd.Flags().Bool(
+			"validate-policies",
+			false,
+			"Check SPF, DMARC, DKIM and MTA-STS records against their specifications",
+		)
+	}
+}
+
+func commentFlag() flagFunc {
+	return func(cmd *cobra.Command) {
+		cmd.Flags().String("comment", "", "Free-form note kept with the record set")
+	}
+}
@@ -0,0 +1,434 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/davidseybold/beacondns/client"
+)
+
+var rolesCmd = &cobra.Command{
+	Use:   "roles",
+	Short: "Manage roles",
+	Long: `Commands for managing roles, the named sets of permissions that are granted to API keys
+through role bindings. A permission allows actions (read, write, delete or *) on the resources
+that match a pattern, such as zone/example.com., zone/*.example.com./rrset/www.example.com.
+or firewall-rule/*. The admin and viewer roles are built in and cannot be changed.`,
+}
+
+var createRoleCmd = &cobra.Command{
+	Use:   "create [name]",
+	Short: "Create a role",
+	Long: `Create a role with the given permissions.
+Example: beaconctl roles create dns-editor --permission read,write:zone/example.com.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		description, err := cmd.Flags().GetString("description")
+		if err != nil {
+			return err
+		}
+
+		permissions, err := getPermissions(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		role, err := c.CreateRole(context.Background(), client.CreateRoleRequest{
+			Name:        args[0],
+			Description: description,
+			Permissions: permissions,
+		})
+		if err != nil {
+			return err
+		}
+
+		return renderRoles(cmd, []client.Role{*role})
+	},
+}
+
+var updateRoleCmd = &cobra.Command{
+	Use:   "update [name]",
+	Short: "Replace the description and permissions of a role",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		description, err := cmd.Flags().GetString("description")
+		if err != nil {
+			return err
+		}
+
+		permissions, err := getPermissions(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		role, err := c.UpdateRole(context.Background(), args[0], client.UpdateRoleRequest{
+			Description: description,
+			Permissions: permissions,
+		})
+		if err != nil {
+			return err
+		}
+
+		return renderRoles(cmd, []client.Role{*role})
+	},
+}
+
+var listRolesCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List roles",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		opts, err := getListOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		roles, err := c.ListRolesWithOptions(context.Background(), opts)
+		if err != nil {
+			return err
+		}
+
+		if len(roles) == 0 {
+			cmd.Println("No roles found")
+			return nil
+		}
+
+		return renderRoles(cmd, roles)
+	},
+}
+
+var describeRoleCmd = &cobra.Command{
+	Use:   "describe [name]",
+	Short: "Describe a role and its permissions",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		role, err := c.GetRole(context.Background(), args[0])
+		if err != nil {
+			return err
+		}
+
+		if err = renderRoles(cmd, []client.Role{*role}); err != nil {
+			return err
+		}
+
+		cmd.Println()
+		return renderPermissions(cmd, role.Permissions)
+	},
+}
+
+var deleteRoleCmd = &cobra.Command{
+	Use:   "delete [name]",
+	Short: "Delete a role along with its bindings",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		if err = c.DeleteRole(context.Background(), args[0]); err != nil {
+			return err
+		}
+
+		cmd.Println("Role deleted")
+		return nil
+	},
+}
+
+var roleBindingsCmd = &cobra.Command{
+	Use:   "role-bindings",
+	Short: "Manage role bindings",
+	Long:  `Commands for granting roles to API keys, users and groups and taking them away.`,
+}
+
+var createRoleBindingCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Bind a role to an API key, user or group",
+	Long: `Bind a role to an API key, a user or a group of users signing in through OIDC. The subject
+is allowed to do what the role allows from its next request. Users are named by the username
+claim of their ID token, which is their email address by default.
+Example: beaconctl role-bindings create --role viewer --api-key 3f1c2d4e-...
+Example: beaconctl role-bindings create --role admin --group dns-admins`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		role, err := cmd.Flags().GetString("role")
+		if err != nil {
+			return err
+		}
+
+		subject, err := getSubject(cmd)
+		if err != nil {
+			return err
+		}
+		if subject == nil {
+			return errors.New("one of --api-key, --user and --group is required")
+		}
+
+		c := config.newClient()
+		binding, err := c.CreateRoleBinding(context.Background(), client.CreateRoleBindingRequest{
+			Role:    role,
+			Subject: *subject,
+		})
+		if err != nil {
+			return err
+		}
+
+		return renderRoleBindings(cmd, []client.RoleBinding{*binding})
+	},
+}
+
+var listRoleBindingsCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List role bindings",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		opts, err := getListOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		subject, err := getSubject(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		bindings, err := c.ListRoleBindingsWithOptions(context.Background(), client.ListRoleBindingsOptions{
+			ListOptions: opts,
+			Subject:     subject,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(bindings) == 0 {
+			cmd.Println("No role bindings found")
+			return nil
+		}
+
+		return renderRoleBindings(cmd, bindings)
+	},
+}
+
+var deleteRoleBindingCmd = &cobra.Command{
+	Use:   "delete [role-binding-id]",
+	Short: "Delete a role binding",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		id, err := uuid.Parse(args[0])
+		if err != nil {
+			cmd.PrintErrln("invalid role binding ID")
+			return err
+		}
+
+		c := config.newClient()
+		if err = c.DeleteRoleBinding(context.Background(), id); err != nil {
+			return err
+		}
+
+		cmd.Println("Role binding deleted")
+		return nil
+	},
+}
+
+var whoAmICmd = &cobra.Command{
+	Use:   "whoami",
+	Short: "Show who you are authenticated as and what you are allowed to do",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		whoAmI, err := c.WhoAmI(context.Background())
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("Name: %s\nSubject: %s %s\n", whoAmI.Name, whoAmI.Subject.Type, whoAmI.Subject.ID)
+		if len(whoAmI.Groups) > 0 {
+			cmd.Printf("Groups: %s\n", strings.Join(whoAmI.Groups, ", "))
+		}
+		cmd.Println()
+		if len(whoAmI.Permissions) == 0 {
+			cmd.Println("No permissions")
+			return nil
+		}
+		return renderPermissions(cmd, whoAmI.Permissions)
+	},
+}
+
+func permissionFlag() flagFunc {
+	return func(cmd *cobra.Command) {
+		cmd.Flags().StringArray("permission", []string{},
+			"Permission as actions:resource, e.g. read,write:zone/example.com. (can be repeated)")
+	}
+}
+
+// getPermissions parses the --permission flags. Each one holds a comma separated list of
+// actions and a single resource pattern, separated by the first colon.
+func getPermissions(cmd *cobra.Command) ([]client.Permission, error) {
+	values, err := cmd.Flags().GetStringArray("permission")
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := make([]client.Permission, 0, len(values))
+	for _, value := range values {
+		actions, resource, ok := strings.Cut(value, ":")
+		if !ok || actions == "" || resource == "" {
+			return nil, fmt.Errorf("invalid --permission %q: must be actions:resource", value)
+		}
+		permissions = append(permissions, client.Permission{
+			Actions:   strings.Split(actions, ","),
+			Resources: []string{resource},
+		})
+	}
+	return permissions, nil
+}
+
+func subjectFlags(verb string) flagFunc {
+	return func(cmd *cobra.Command) {
+		cmd.Flags().String("api-key", "", verb+" the API key with this ID")
+		cmd.Flags().String("user", "", verb+" the user with this username")
+		cmd.Flags().String("group", "", verb+" the group with this name")
+		cmd.MarkFlagsMutuallyExclusive("api-key", "user", "group")
+	}
+}
+
+// getSubject returns the subject of the --api-key, --user or --group flag, or nil if none
+// of them is set.
+func getSubject(cmd *cobra.Command) (*client.Subject, error) {
+	apiKey, err := cmd.Flags().GetString("api-key")
+	if err != nil {
+		return nil, err
+	}
+	user, err := cmd.Flags().GetString("user")
+	if err != nil {
+		return nil, err
+	}
+	group, err := cmd.Flags().GetString("group")
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case apiKey != "":
+		id, parseErr := uuid.Parse(apiKey)
+		if parseErr != nil {
+			return nil, fmt.Errorf("invalid --api-key %q: must be the ID of an API key", apiKey)
+		}
+		return &client.Subject{Type: client.SubjectTypeAPIKey, ID: id.String()}, nil
+	case user != "":
+		return &client.Subject{Type: client.SubjectTypeUser, ID: user}, nil
+	case group != "":
+		return &client.Subject{Type: client.SubjectTypeGroup, ID: group}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func renderRoles(cmd *cobra.Command, roles []client.Role) error {
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.Header([]string{"NAME", "DESCRIPTION", "PERMISSIONS", "BUILT IN", "UPDATED"})
+	for _, role := range roles {
+		_ = table.Append([]string{
+			role.Name,
+			role.Description,
+			fmt.Sprintf("%d", len(role.Permissions)),
+			fmt.Sprintf("%t", role.BuiltIn),
+			role.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+	return table.Render()
+}
+
+func renderPermissions(cmd *cobra.Command, permissions []client.Permission) error {
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.Header([]string{"ACTIONS", "RESOURCES"})
+	for _, permission := range permissions {
+		_ = table.Append([]string{
+			strings.Join(permission.Actions, ", "),
+			strings.Join(permission.Resources, ", "),
+		})
+	}
+	return table.Render()
+}
+
+func renderRoleBindings(cmd *cobra.Command, bindings []client.RoleBinding) error {
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.Header([]string{"ID", "ROLE", "SUBJECT TYPE", "SUBJECT ID", "CREATED"})
+	for _, binding := range bindings {
+		_ = table.Append([]string{
+			binding.ID.String(),
+			binding.Role,
+			binding.Subject.Type,
+			binding.Subject.ID,
+			binding.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return table.Render()
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{createRoleCmd, updateRoleCmd} {
+		addFlags([]flagFunc{permissionFlag()}, cmd)
+		cmd.Flags().String("description", "", "Description of the role")
+	}
+	addFlags([]flagFunc{pageFlags("name"), namePrefixFlag()}, listRolesCmd)
+
+	createRoleBindingCmd.Flags().String("role", "", "Name of the role to bind")
+	_ = createRoleBindingCmd.MarkFlagRequired("role")
+	addFlags([]flagFunc{subjectFlags("Bind the role to")}, createRoleBindingCmd)
+	addFlags([]flagFunc{pageFlags("name", "createdAt"), namePrefixFlag(), subjectFlags("Only list the bindings of")},
+		listRoleBindingsCmd)
+
+	rolesCmd.AddCommand(createRoleCmd, updateRoleCmd, listRolesCmd, describeRoleCmd, deleteRoleCmd)
+	roleBindingsCmd.AddCommand(createRoleBindingCmd, listRoleBindingsCmd, deleteRoleBindingCmd)
+	rootCmd.AddCommand(rolesCmd, roleBindingsCmd, whoAmICmd)
+}
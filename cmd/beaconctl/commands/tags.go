@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/davidseybold/beacondns/client"
+)
+
+func tagFlag() flagFunc {
+	return func(cmd *cobra.Command) {
+		cmd.Flags().StringArray("tag", []string{}, "Tag to add as key:value (can be repeated)")
+	}
+}
+
+func tagsUpdateFlags() flagFunc {
+	return func(cmd *cobra.Command) {
+		cmd.Flags().StringArray("set", []string{}, "Tag to add or overwrite as key:value (can be repeated)")
+		cmd.Flags().StringArray("remove", []string{}, "Key of a tag to remove (can be repeated)")
+	}
+}
+
+func getTags(cmd *cobra.Command, flag string) (map[string]string, error) {
+	values, err := cmd.Flags().GetStringArray(flag)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	tags := make(map[string]string, len(values))
+	for _, value := range values {
+		key, val, ok := strings.Cut(value, ":")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --%s %q: must be key:value", flag, value)
+		}
+		tags[key] = val
+	}
+	return tags, nil
+}
+
+func getTagsUpdate(cmd *cobra.Command) (client.UpdateTagsRequest, error) {
+	set, err := getTags(cmd, "set")
+	if err != nil {
+		return client.UpdateTagsRequest{}, err
+	}
+
+	remove, err := cmd.Flags().GetStringArray("remove")
+	if err != nil {
+		return client.UpdateTagsRequest{}, err
+	}
+
+	if len(set) == 0 && len(remove) == 0 {
+		return client.UpdateTagsRequest{}, errors.New("at least one of --set or --remove is required")
+	}
+
+	return client.UpdateTagsRequest{Set: set, Remove: remove}, nil
+}
+
+func renderTags(cmd *cobra.Command, tags map[string]string) error {
+	if len(tags) == 0 {
+		cmd.Println("No tags")
+		return nil
+	}
+
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.Header([]string{"KEY", "VALUE"})
+	for _, key := range slices.Sorted(maps.Keys(tags)) {
+		_ = table.Append([]string{key, tags[key]})
+	}
+	return table.Render()
+}
+
+// formatTags renders tags as a sorted, comma separated list of key:value pairs for table
+// columns.
+func formatTags(tags map[string]string) string {
+	pairs := make([]string, 0, len(tags))
+	for _, key := range slices.Sorted(maps.Keys(tags)) {
+		pairs = append(pairs, key+":"+tags[key])
+	}
+	return strings.Join(pairs, ", ")
+}
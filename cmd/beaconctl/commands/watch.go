@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/davidseybold/beacondns/client"
+)
+
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Tail changes to zones, record sets and the firewall as they are applied",
+	Long: `Print the changes to zones, record sets, firewall rules and domain lists as Beacon applies
+them, until interrupted. Only the changes to resources you are allowed to read are printed.
+The watch reconnects when it drops and resumes where it left off. Use --after with the ID of
+a change to resume after it, and --json to print every change as a line of JSON along with
+its data.
+Example: beaconctl watch --after 1042`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		after, err := cmd.Flags().GetString("after")
+		if err != nil {
+			return err
+		}
+		if after != "" {
+			if _, err = strconv.ParseInt(after, 10, 64); err != nil {
+				cmd.PrintErrln("invalid change ID")
+				return err
+			}
+		}
+
+		asJSON, err := cmd.Flags().GetBool("json")
+		if err != nil {
+			return err
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		c := config.newClient()
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		// The watch ends after an error it cannot recover from, and reconnects after the
+		// others, so an error is only known to have been recovered from once the watch goes
+		// on.
+		var watchErr error
+		for change, iterErr := range c.WatchChanges(ctx, after) {
+			if watchErr != nil {
+				cmd.PrintErrf("Reconnecting after error: %s\n", watchErr)
+			}
+			watchErr = iterErr
+			if iterErr != nil {
+				continue
+			}
+
+			if asJSON {
+				if err = encoder.Encode(&change); err != nil {
+					return err
+				}
+				continue
+			}
+			printChange(cmd, &change)
+		}
+
+		return watchErr
+	},
+}
+
+func printChange(cmd *cobra.Command, change *client.Change) {
+	if change.Type == client.ChangeTypeReset {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s\t%d\t%s\tchanges since the last one received were lost\n",
+			change.CreatedAt.Format(time.RFC3339), change.ID, change.Type)
+		return
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "%s\t%d\t%s\t%s\n",
+		change.CreatedAt.Format(time.RFC3339), change.ID, change.Type, change.Resource)
+}
+
+func init() {
+	watchCmd.Flags().String("after", "", "Resume after the change with this ID (0 prints every change still kept)")
+	watchCmd.Flags().Bool("json", false, "Print every change as a line of JSON, including its data")
+
+	rootCmd.AddCommand(watchCmd)
+}
@@ -0,0 +1,266 @@
+package commands
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/davidseybold/beacondns/client"
+)
+
+var webhooksCmd = &cobra.Command{
+	Use:   "webhooks",
+	Short: "Manage webhooks",
+	Long: `Commands for managing the webhooks that zone and firewall events are delivered to. The secret
+that signs the deliveries of a webhook is only shown when it is created.`,
+}
+
+var createWebhookCmd = &cobra.Command{
+	Use:   "create [name] [url]",
+	Short: "Create a webhook",
+	Long: `Create a webhook that is sent the events whose types match any of the --event patterns, and
+print the secret that signs its deliveries, which cannot be shown again.
+Example: beaconctl webhooks create cmdb https://cmdb.example.com/hooks/beacon --event 'zone.*'`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		events, err := cmd.Flags().GetStringArray("event")
+		if err != nil {
+			return err
+		}
+
+		secret, err := cmd.Flags().GetString("secret")
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		webhook, err := c.CreateWebhook(context.Background(), client.CreateWebhookRequest{
+			Name:   args[0],
+			URL:    args[1],
+			Events: events,
+			Secret: secret,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err = renderWebhooks(cmd, []client.Webhook{webhook.Webhook}); err != nil {
+			return err
+		}
+
+		cmd.Printf("\nSecret: %s\nStore it now; it cannot be shown again.\n", webhook.Secret)
+		return nil
+	},
+}
+
+var listWebhooksCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List webhooks",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		opts, err := getListOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		webhooks, err := c.ListWebhooksWithOptions(context.Background(), opts)
+		if err != nil {
+			return err
+		}
+
+		if len(webhooks) == 0 {
+			cmd.Println("No webhooks found")
+			return nil
+		}
+
+		return renderWebhooks(cmd, webhooks)
+	},
+}
+
+var deleteWebhookCmd = &cobra.Command{
+	Use:   "delete [webhook-id]",
+	Short: "Delete a webhook",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		id, err := uuid.Parse(args[0])
+		if err != nil {
+			cmd.PrintErrln("invalid webhook ID")
+			return err
+		}
+
+		c := config.newClient()
+		if err = c.DeleteWebhook(context.Background(), id); err != nil {
+			return err
+		}
+
+		cmd.Println("Webhook deleted")
+		return nil
+	},
+}
+
+var listWebhookDeliveriesCmd = &cobra.Command{
+	Use:   "deliveries [webhook-id]",
+	Short: "List the deliveries of a webhook",
+	Long: `List the deliveries of a webhook, newest first, along with the outcome of their last attempt.
+Example: beaconctl webhooks deliveries 4f8a0f36-5c1e-4c1a-9a55-0d5b3c5f6a21 --limit 20`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		id, err := uuid.Parse(args[0])
+		if err != nil {
+			cmd.PrintErrln("invalid webhook ID")
+			return err
+		}
+
+		opts, err := getListOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		limit, err := cmd.Flags().GetInt("limit")
+		if err != nil {
+			return err
+		}
+
+		// Like the audit log, the delivery log only grows, so it is not fetched in full by
+		// default.
+		c := config.newClient()
+		var deliveries []client.WebhookDelivery
+		for delivery, iterErr := range c.AllWebhookDeliveries(context.Background(), id, opts) {
+			if iterErr != nil {
+				return iterErr
+			}
+			deliveries = append(deliveries, delivery)
+			if limit > 0 && len(deliveries) >= limit {
+				break
+			}
+		}
+
+		if len(deliveries) == 0 {
+			cmd.Println("No deliveries found")
+			return nil
+		}
+
+		return renderWebhookDeliveries(cmd, deliveries)
+	},
+}
+
+var redeliverWebhookCmd = &cobra.Command{
+	Use:   "redeliver [webhook-id] [delivery-id]",
+	Short: "Send the event of a delivery to its webhook again",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		id, err := uuid.Parse(args[0])
+		if err != nil {
+			cmd.PrintErrln("invalid webhook ID")
+			return err
+		}
+
+		deliveryID, err := uuid.Parse(args[1])
+		if err != nil {
+			cmd.PrintErrln("invalid delivery ID")
+			return err
+		}
+
+		c := config.newClient()
+		delivery, err := c.RedeliverWebhookDelivery(context.Background(), id, deliveryID)
+		if err != nil {
+			return err
+		}
+
+		return renderWebhookDeliveries(cmd, []client.WebhookDelivery{*delivery})
+	},
+}
+
+func renderWebhooks(cmd *cobra.Command, webhooks []client.Webhook) error {
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.Header([]string{"ID", "NAME", "URL", "EVENTS", "CREATED"})
+	for _, webhook := range webhooks {
+		_ = table.Append([]string{
+			webhook.ID.String(),
+			webhook.Name,
+			webhook.URL,
+			strings.Join(webhook.Events, ", "),
+			webhook.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return table.Render()
+}
+
+func renderWebhookDeliveries(cmd *cobra.Command, deliveries []client.WebhookDelivery) error {
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.Header([]string{"ID", "EVENT", "CREATED", "STATUS", "ATTEMPTS", "RESPONSE", "NEXT ATTEMPT", "ERROR"})
+	for _, delivery := range deliveries {
+		response := "-"
+		if delivery.ResponseStatus != nil {
+			response = strconv.Itoa(*delivery.ResponseStatus)
+		}
+
+		lastError := delivery.Error
+		if lastError == "" {
+			lastError = "-"
+		}
+
+		_ = table.Append([]string{
+			delivery.ID.String(),
+			delivery.EventType,
+			delivery.CreatedAt.Format(time.RFC3339),
+			delivery.Status,
+			strconv.Itoa(delivery.Attempts),
+			response,
+			formatOptionalTime(delivery.NextAttemptAt),
+			lastError,
+		})
+	}
+	return table.Render()
+}
+
+func init() {
+	createWebhookCmd.Flags().StringArray(
+		"event",
+		[]string{},
+		"Pattern of the event types to deliver, e.g. zone.* (can be repeated)",
+	)
+	createWebhookCmd.Flags().String("secret", "", "Secret to sign deliveries with (generated if unset)")
+	addFlags([]flagFunc{pageFlags("name", "createdAt"), namePrefixFlag()}, listWebhooksCmd)
+	listWebhookDeliveriesCmd.Flags().Int("limit", 100, "Maximum number of deliveries to list (0 lists every delivery)")
+	addFlags([]flagFunc{pageFlags("createdAt")}, listWebhookDeliveriesCmd)
+
+	webhooksCmd.AddCommand(
+		createWebhookCmd,
+		listWebhooksCmd,
+		deleteWebhookCmd,
+		listWebhookDeliveriesCmd,
+		redeliverWebhookCmd,
+	)
+	rootCmd.AddCommand(webhooksCmd)
+}
@@ -2,7 +2,10 @@ package commands
 
 import (
 	"context"
+	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
@@ -19,17 +22,39 @@ var zonesCmd = &cobra.Command{
 var createZoneCmd = &cobra.Command{
 	Use:   "create [name]",
 	Short: "Create a new DNS zone",
-	Args:  cobra.ExactArgs(1),
+	Long: `Create a new DNS zone. With --delegate, NS records for the new zone are added to its closest
+parent zone hosted in Beacon. With --template, the zone is created with the record sets of a zone template.
+Example: beaconctl zones create dev.example.com --delegate --template product`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		config, err := loadConfig()
 		if err != nil {
 			return err
 		}
 
+		delegate, err := cmd.Flags().GetBool("delegate")
+		if err != nil {
+			return err
+		}
+
+		template, err := cmd.Flags().GetString("template")
+		if err != nil {
+			return err
+		}
+
+		tags, err := getTags(cmd, "tag")
+		if err != nil {
+			return err
+		}
+
 		name := args[0]
 
-		c := client.New(config.Host)
-		zone, err := c.CreateZone(context.Background(), name)
+		c := config.newClient()
+		zone, err := c.CreateZoneWithOptions(context.Background(), name, client.CreateZoneOptions{
+			DelegateFromParent: delegate,
+			Template:           template,
+			Tags:               tags,
+		})
 		if err != nil {
 			return err
 		}
@@ -41,17 +66,74 @@ var createZoneCmd = &cobra.Command{
 	},
 }
 
+var createReverseZonesCmd = &cobra.Command{
+	Use:   "create-reverse [cidr]",
+	Short: "Create the reverse DNS zones for a CIDR block",
+	Long: `Create the in-addr.arpa or ip6.arpa zones that serve PTR records for a CIDR block.
+Prefixes that do not end on an octet (IPv4) or nibble (IPv6) boundary are split into
+several zones. IPv4 prefixes longer than /24 get an RFC 2317 classless zone; with --delegate
+the enclosing /24 zone also gets a CNAME for each address in the block.
+Example: beaconctl zones create-reverse 10.20.0.0/16`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		delegate, err := cmd.Flags().GetBool("delegate")
+		if err != nil {
+			return err
+		}
+
+		template, err := cmd.Flags().GetString("template")
+		if err != nil {
+			return err
+		}
+
+		tags, err := getTags(cmd, "tag")
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		zones, err := c.CreateReverseZones(context.Background(), args[0], client.CreateZoneOptions{
+			DelegateFromParent: delegate,
+			Template:           template,
+			Tags:               tags,
+		})
+		if err != nil {
+			return err
+		}
+
+		table := tablewriter.NewWriter(cmd.OutOrStdout())
+		table.Header([]string{"ID", "NAME", "RECORD COUNT"})
+		for _, zone := range zones {
+			_ = table.Append([]string{zone.ID, zone.Name, strconv.Itoa(zone.ResourceRecordSetCount)})
+		}
+		return table.Render()
+	},
+}
+
 var listZonesCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all DNS zones",
+	Long: `List all DNS zones. With --tag, only zones carrying every given tag are listed.
+Example: beaconctl zones list --tag team:payments --tag env
+Example: beaconctl zones list --name-prefix dev --order desc`,
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		config, err := loadConfig()
 		if err != nil {
 			return err
 		}
 
-		c := client.New(config.Host)
-		zones, err := c.ListZones(context.Background())
+		opts, err := getListOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		zones, err := c.ListZonesWithOptions(context.Background(), opts)
 		if err != nil {
 			return err
 		}
@@ -62,9 +144,11 @@ var listZonesCmd = &cobra.Command{
 		}
 
 		table := tablewriter.NewWriter(cmd.OutOrStdout())
-		table.Header([]string{"ID", "NAME", "RECORD COUNT"})
+		table.Header([]string{"ID", "NAME", "RECORD COUNT", "TAGS"})
 		for _, zone := range zones {
-			_ = table.Append([]string{zone.ID, zone.Name, strconv.Itoa(zone.ResourceRecordSetCount)})
+			_ = table.Append(
+				[]string{zone.ID, zone.Name, strconv.Itoa(zone.ResourceRecordSetCount), formatTags(zone.Tags)},
+			)
 		}
 		return table.Render()
 	},
@@ -84,7 +168,7 @@ var describeZoneCmd = &cobra.Command{
 			return err
 		}
 
-		c := client.New(config.Host)
+		c := config.newClient()
 		zone, err := c.GetZone(context.Background(), zoneID)
 		if err != nil {
 			return err
@@ -100,19 +184,29 @@ var describeZoneCmd = &cobra.Command{
 var deleteZoneCmd = &cobra.Command{
 	Use:   "delete [zone-id]",
 	Short: "Delete a DNS zone",
-	RunE: func(cmd *cobra.Command, _ []string) error {
+	Long: `Delete a DNS zone. The zone must be empty unless --force is given, in which case all of its
+resource record sets and change history are deleted with it.
+Example: beaconctl zones delete example.com --force`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
 		config, err := loadConfig()
 		if err != nil {
 			return err
 		}
 
-		zoneID, err := cmd.Flags().GetString("id")
+		force, err := cmd.Flags().GetBool("force")
 		if err != nil {
 			return err
 		}
 
-		c := client.New(config.Host)
-		err = c.DeleteZone(context.Background(), zoneID)
+		zoneID := args[0]
+
+		c := config.newClient()
+		if force {
+			err = c.ForceDeleteZone(context.Background(), zoneID)
+		} else {
+			err = c.DeleteZone(context.Background(), zoneID)
+		}
 		if err != nil {
 			return err
 		}
@@ -122,7 +216,414 @@ var deleteZoneCmd = &cobra.Command{
 	},
 }
 
+var listDeletedZonesCmd = &cobra.Command{
+	Use:   "trash",
+	Short: "List force-deleted zones that can still be restored",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		opts, err := getListOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		zones, err := c.ListDeletedZonesWithOptions(context.Background(), opts)
+		if err != nil {
+			return err
+		}
+
+		if len(zones) == 0 {
+			cmd.Println("No deleted zones found")
+			return nil
+		}
+
+		table := tablewriter.NewWriter(cmd.OutOrStdout())
+		table.Header([]string{"ID", "NAME", "DELETED AT", "PURGE AFTER"})
+		for _, zone := range zones {
+			_ = table.Append([]string{
+				zone.ID,
+				zone.Name,
+				zone.DeletedAt.Format(time.RFC3339),
+				zone.PurgeAfter.Format(time.RFC3339),
+			})
+		}
+		return table.Render()
+	},
+}
+
+var restoreZoneCmd = &cobra.Command{
+	Use:   "restore [name]",
+	Short: "Restore a force-deleted zone from the trash",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		zone, err := c.RestoreZone(context.Background(), args[0])
+		if err != nil {
+			return err
+		}
+
+		table := tablewriter.NewWriter(cmd.OutOrStdout())
+		table.Header([]string{"ID", "NAME", "RECORD COUNT"})
+		_ = table.Append([]string{zone.ID, zone.Name, strconv.Itoa(zone.ResourceRecordSetCount)})
+		return table.Render()
+	},
+}
+
+var importZoneCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Import resource record sets from a zone file",
+	Long: `Import resource record sets from an RFC 1035 master file into an existing zone.
+Records of unsupported types are skipped and reported.
+Example: beaconctl zones import example.com.zone --zone-id example.com`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		zoneID, err := cmd.Flags().GetString("zone-id")
+		if err != nil {
+			return err
+		}
+
+		zoneFile, err := os.ReadFile(args[0])
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		result, err := c.ImportZone(context.Background(), zoneID, string(zoneFile))
+		if err != nil {
+			return err
+		}
+
+		table := tablewriter.NewWriter(cmd.OutOrStdout())
+		table.Header([]string{"NAME", "TYPE", "TTL", "VALUES"})
+		for _, rrset := range result.ResourceRecordSets {
+			values := make([]string, len(rrset.ResourceRecords))
+			for i, record := range rrset.ResourceRecords {
+				values[i] = record.Value
+			}
+			_ = table.Append([]string{rrset.Name, rrset.Type, strconv.Itoa(int(rrset.TTL)), strings.Join(values, ", ")})
+		}
+		if err = table.Render(); err != nil {
+			return err
+		}
+
+		if len(result.SkippedRecords) == 0 {
+			return nil
+		}
+
+		cmd.Println("Skipped records:")
+		table = tablewriter.NewWriter(cmd.OutOrStdout())
+		table.Header([]string{"NAME", "TYPE", "REASON"})
+		for _, skipped := range result.SkippedRecords {
+			_ = table.Append([]string{skipped.Name, skipped.Type, skipped.Reason})
+		}
+		return table.Render()
+	},
+}
+
+var exportZoneCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export a zone as a zone file",
+	Long: `Export all resource record sets of a zone as an RFC 1035 master file.
+Example: beaconctl zones export --zone-id example.com --output example.com.zone`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		zoneID, err := cmd.Flags().GetString("zone-id")
+		if err != nil {
+			return err
+		}
+
+		output, err := cmd.Flags().GetString("output")
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		zoneFile, err := c.ExportZone(context.Background(), zoneID)
+		if err != nil {
+			return err
+		}
+
+		if output == "" {
+			_, err = cmd.OutOrStdout().Write(zoneFile)
+			return err
+		}
+
+		return os.WriteFile(output, zoneFile, 0600)
+	},
+}
+
+var lintZoneCmd = &cobra.Command{
+	Use:   "lint",
+	Short: "Check a zone for common problems",
+	Long: `Check the resource record sets of a zone for problems such as dangling CNAMEs, name servers without glue
+and MX or SRV records that point at a CNAME. With --resolve, names outside the zone are also looked up.
+Example: beaconctl zones lint --zone-id example.com --resolve`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		zoneID, err := cmd.Flags().GetString("zone-id")
+		if err != nil {
+			return err
+		}
+
+		resolve, err := cmd.Flags().GetBool("resolve")
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		findings, err := c.LintZone(context.Background(), zoneID, client.LintZoneOptions{Resolve: resolve})
+		if err != nil {
+			return err
+		}
+
+		if len(findings) == 0 {
+			cmd.Println("No problems found")
+			return nil
+		}
+
+		table := tablewriter.NewWriter(cmd.OutOrStdout())
+		table.Header([]string{"SEVERITY", "RULE", "NAME", "TYPE", "MESSAGE"})
+		for _, finding := range findings {
+			_ = table.Append([]string{finding.Severity, finding.Rule, finding.Name, finding.Type, finding.Message})
+		}
+		return table.Render()
+	},
+}
+
+var listZoneVersionsCmd = &cobra.Command{
+	Use:   "versions",
+	Short: "List the version history of a zone",
+	Long: `List every version of a zone, newest first. A new version is recorded each time a change is applied.
+Example: beaconctl zones versions --zone-id example.com`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		zoneID, err := cmd.Flags().GetString("zone-id")
+		if err != nil {
+			return err
+		}
+
+		opts, err := getListOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		versions, err := c.ListZoneVersionsWithOptions(context.Background(), zoneID, opts)
+		if err != nil {
+			return err
+		}
+
+		table := tablewriter.NewWriter(cmd.OutOrStdout())
+		table.Header([]string{"VERSION", "CHANGE ID", "STATUS", "CREATED AT"})
+		for _, version := range versions {
+			_ = table.Append([]string{
+				strconv.Itoa(version.Version),
+				version.ChangeID,
+				version.Status,
+				version.CreatedAt.Format(time.RFC3339),
+			})
+		}
+		return table.Render()
+	},
+}
+
+var diffZoneVersionsCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Show the differences between two versions of a zone",
+	Long: `Show the resource record sets that were added, removed or modified between two versions of a zone.
+Example: beaconctl zones diff --zone-id example.com --from 1 --to 3`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		zoneID, err := cmd.Flags().GetString("zone-id")
+		if err != nil {
+			return err
+		}
+
+		from, err := cmd.Flags().GetInt("from")
+		if err != nil {
+			return err
+		}
+
+		to, err := cmd.Flags().GetInt("to")
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		diff, err := c.DiffZoneVersions(context.Background(), zoneID, from, to)
+		if err != nil {
+			return err
+		}
+
+		return renderZoneDiff(cmd, diff)
+	},
+}
+
+var rollbackZoneCmd = &cobra.Command{
+	Use:   "rollback",
+	Short: "Roll a zone back to a previous version",
+	Long: `Restore the resource record sets of a zone to a previous version. The rollback is applied
+as a new change and recorded as a new version.
+Example: beaconctl zones rollback --zone-id example.com --version 2`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		zoneID, err := cmd.Flags().GetString("zone-id")
+		if err != nil {
+			return err
+		}
+
+		version, err := cmd.Flags().GetInt("version")
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		change, err := c.RollbackZone(context.Background(), zoneID, version)
+		if err != nil {
+			return err
+		}
+
+		table := tablewriter.NewWriter(cmd.OutOrStdout())
+		table.Header([]string{"CHANGE ID", "STATUS"})
+		_ = table.Append([]string{change.ID, change.Status})
+		return table.Render()
+	},
+}
+
+func renderZoneDiff(cmd *cobra.Command, diff *client.ZoneDiff) error {
+	if len(diff.Added) == 0 && len(diff.Removed) == 0 && len(diff.Modified) == 0 {
+		cmd.Println("No differences")
+		return nil
+	}
+
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.Header([]string{"CHANGE", "NAME", "TYPE", "TTL", "VALUES"})
+	for _, rrSet := range diff.Added {
+		_ = table.Append(diffRow("+", rrSet.Name, rrSet.Type, rrSet.TTL, rrSet.ResourceRecords))
+	}
+	for _, rrSet := range diff.Removed {
+		_ = table.Append(diffRow("-", rrSet.Name, rrSet.Type, rrSet.TTL, rrSet.ResourceRecords))
+	}
+	for _, mod := range diff.Modified {
+		_ = table.Append(diffRow("~", mod.After.Name, mod.After.Type, mod.After.TTL, mod.After.ResourceRecords))
+	}
+	return table.Render()
+}
+
+var zoneTagsCmd = &cobra.Command{
+	Use:   "tags",
+	Short: "Add or remove tags on a zone",
+	Long: `Add, overwrite or remove tags on a zone and show the resulting tags.
+Example: beaconctl zones tags --zone-id example.com --set team:payments --remove owner`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		zoneID, err := cmd.Flags().GetString("zone-id")
+		if err != nil {
+			return err
+		}
+
+		req, err := getTagsUpdate(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		tags, err := c.UpdateZoneTags(context.Background(), zoneID, req)
+		if err != nil {
+			return err
+		}
+
+		return renderTags(cmd, tags)
+	},
+}
+
+func diffRow(change, name, rrType string, ttl uint32, records []client.ResourceRecord) []string {
+	values := make([]string, len(records))
+	for i, record := range records {
+		values[i] = record.Value
+	}
+	return []string{change, name, rrType, strconv.Itoa(int(ttl)), strings.Join(values, ", ")}
+}
+
 func init() {
-	zonesCmd.AddCommand(createZoneCmd, listZonesCmd, describeZoneCmd, deleteZoneCmd)
+	createZoneCmd.Flags().Bool("delegate", false, "Add NS records for the zone to its hosted parent zone")
+	createZoneCmd.Flags().String("template", "", "Name of a zone template to create the zone with")
+	createReverseZonesCmd.Flags().Bool("delegate", false, "Add NS records for the zones to their hosted parent zone")
+	createReverseZonesCmd.Flags().String("template", "", "Name of a zone template to create the zones with")
+	addFlags([]flagFunc{tagFlag()}, createZoneCmd)
+	addFlags([]flagFunc{tagFlag()}, createReverseZonesCmd)
+	addFlags([]flagFunc{pageFlags("name"), namePrefixFlag(), tagFilterFlag()}, listZonesCmd)
+	addFlags([]flagFunc{pageFlags("deletedAt", "name"), namePrefixFlag(), tagFilterFlag()}, listDeletedZonesCmd)
+	addFlags([]flagFunc{zoneIDFlag(), tagsUpdateFlags()}, zoneTagsCmd)
+	deleteZoneCmd.Flags().Bool("force", false, "Delete the zone even if it still contains resource record sets")
+	addFlags([]flagFunc{zoneIDFlag()}, importZoneCmd)
+	addFlags([]flagFunc{zoneIDFlag()}, exportZoneCmd)
+	exportZoneCmd.Flags().StringP("output", "o", "", "File to write the zone file to (defaults to stdout)")
+	addFlags([]flagFunc{zoneIDFlag()}, lintZoneCmd)
+	lintZoneCmd.Flags().Bool("resolve", false, "Also check that names outside the zone resolve")
+	addFlags([]flagFunc{zoneIDFlag(), pageFlags("version")}, listZoneVersionsCmd)
+	addFlags([]flagFunc{zoneIDFlag()}, diffZoneVersionsCmd)
+	diffZoneVersionsCmd.Flags().Int("from", 0, "Version to compare from")
+	diffZoneVersionsCmd.Flags().Int("to", 0, "Version to compare to")
+	_ = diffZoneVersionsCmd.MarkFlagRequired("from")
+	_ = diffZoneVersionsCmd.MarkFlagRequired("to")
+	addFlags([]flagFunc{zoneIDFlag()}, rollbackZoneCmd)
+	rollbackZoneCmd.Flags().Int("version", 0, "Version to roll the zone back to")
+	_ = rollbackZoneCmd.MarkFlagRequired("version")
+
+	zonesCmd.AddCommand(
+		createZoneCmd,
+		createReverseZonesCmd,
+		listZonesCmd,
+		describeZoneCmd,
+		deleteZoneCmd,
+		listDeletedZonesCmd,
+		restoreZoneCmd,
+		importZoneCmd,
+		exportZoneCmd,
+		lintZoneCmd,
+		listZoneVersionsCmd,
+		diffZoneVersionsCmd,
+		rollbackZoneCmd,
+		zoneTagsCmd,
+	)
 	rootCmd.AddCommand(zonesCmd)
 }
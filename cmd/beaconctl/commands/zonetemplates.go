@@ -0,0 +1,276 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/davidseybold/beacondns/client"
+)
+
+var zoneTemplatesCmd = &cobra.Command{
+	Use:   "zone-templates",
+	Short: "Manage zone templates",
+	Long: `Commands for managing zone templates in Beacon. A zone template is a set of resource record sets
+that new zones can be created with. The {{zone}} placeholder in the names and values of its record sets
+is replaced with the name of the zone, without the trailing dot.`,
+}
+
+var createZoneTemplateCmd = &cobra.Command{
+	Use:   "create [name]",
+	Short: "Create a zone template",
+	Long: `Create a zone template from a JSON file holding an array of resource record sets.
+Example: beaconctl zone-templates create product --file product.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		description, rrSets, err := zoneTemplateFlags(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		template, err := c.CreateZoneTemplate(context.Background(), client.CreateZoneTemplateRequest{
+			Name:               args[0],
+			Description:        description,
+			ResourceRecordSets: rrSets,
+		})
+		if err != nil {
+			return err
+		}
+
+		return renderZoneTemplates(cmd, []client.ZoneTemplate{*template})
+	},
+}
+
+var updateZoneTemplateCmd = &cobra.Command{
+	Use:   "update [name]",
+	Short: "Replace the record sets of a zone template",
+	Long: `Replace the description and resource record sets of a zone template. Zones created from the
+template are not changed; use apply to bring them in line with it.
+Example: beaconctl zone-templates update product --file product.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		description, rrSets, err := zoneTemplateFlags(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		template, err := c.UpdateZoneTemplate(context.Background(), args[0], client.UpdateZoneTemplateRequest{
+			Description:        description,
+			ResourceRecordSets: rrSets,
+		})
+		if err != nil {
+			return err
+		}
+
+		return renderZoneTemplates(cmd, []client.ZoneTemplate{*template})
+	},
+}
+
+var listZoneTemplatesCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all zone templates",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		opts, err := getListOptions(cmd)
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		templates, err := c.ListZoneTemplatesWithOptions(context.Background(), opts)
+		if err != nil {
+			return err
+		}
+
+		if len(templates) == 0 {
+			cmd.Println("No zone templates found")
+			return nil
+		}
+
+		return renderZoneTemplates(cmd, templates)
+	},
+}
+
+var describeZoneTemplateCmd = &cobra.Command{
+	Use:   "describe [name]",
+	Short: "Show the record sets of a zone template",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		template, err := c.GetZoneTemplate(context.Background(), args[0])
+		if err != nil {
+			return err
+		}
+
+		if template.Description != "" {
+			cmd.Println(template.Description)
+		}
+
+		table := tablewriter.NewWriter(cmd.OutOrStdout())
+		table.Header([]string{"NAME", "TYPE", "TTL", "VALUES"})
+		for _, rrSet := range template.ResourceRecordSets {
+			row := diffRow("", rrSet.Name, rrSet.Type, rrSet.TTL, rrSet.ResourceRecords)
+			_ = table.Append(row[1:])
+		}
+		return table.Render()
+	},
+}
+
+var deleteZoneTemplateCmd = &cobra.Command{
+	Use:   "delete [name]",
+	Short: "Delete a zone template",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		if err = c.DeleteZoneTemplate(context.Background(), args[0]); err != nil {
+			return err
+		}
+
+		cmd.Println("Zone template deleted")
+		return nil
+	},
+}
+
+var applyZoneTemplateCmd = &cobra.Command{
+	Use:   "apply [name]",
+	Short: "Apply a zone template to existing zones",
+	Long: `Upsert the record sets of a zone template that are missing from or differ in each of the given
+zones. Record sets the template does not cover are left alone. With --dry-run, only report how
+each zone has drifted from the template.
+Example: beaconctl zone-templates apply product --zone example.com --zone example.net --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := loadConfig()
+		if err != nil {
+			return err
+		}
+
+		zones, err := cmd.Flags().GetStringSlice("zone")
+		if err != nil {
+			return err
+		}
+
+		dryRun, err := cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			return err
+		}
+
+		c := config.newClient()
+		var drifts []client.ZoneTemplateDrift
+		if dryRun {
+			drifts, err = c.GetZoneTemplateDrift(context.Background(), args[0], zones)
+		} else {
+			drifts, err = c.ApplyZoneTemplate(context.Background(), args[0], zones)
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, drift := range drifts {
+			if drift.ChangeID != "" {
+				cmd.Printf("%s (change %s)\n", drift.ZoneName, drift.ChangeID)
+			} else {
+				cmd.Println(drift.ZoneName)
+			}
+
+			if err = renderZoneDiff(cmd, &drift.Drift); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+// zoneTemplateFlags reads the description and the resource record sets of a zone template
+// from the flags of the create and update commands.
+func zoneTemplateFlags(cmd *cobra.Command) (string, []client.ResourceRecordSet, error) {
+	description, err := cmd.Flags().GetString("description")
+	if err != nil {
+		return "", nil, err
+	}
+
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return "", nil, err
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var rrSets []client.ResourceRecordSet
+	if err = json.Unmarshal(data, &rrSets); err != nil {
+		return "", nil, fmt.Errorf("failed to parse %s: %w", file, err)
+	}
+
+	return description, rrSets, nil
+}
+
+func renderZoneTemplates(cmd *cobra.Command, templates []client.ZoneTemplate) error {
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.Header([]string{"NAME", "DESCRIPTION", "RECORD SETS", "UPDATED AT"})
+	for _, template := range templates {
+		_ = table.Append([]string{
+			template.Name,
+			template.Description,
+			strconv.Itoa(len(template.ResourceRecordSets)),
+			template.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+	return table.Render()
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{createZoneTemplateCmd, updateZoneTemplateCmd} {
+		cmd.Flags().StringP("file", "f", "", "JSON file with the resource record sets of the template")
+		cmd.Flags().String("description", "", "Description of the template")
+		_ = cmd.MarkFlagRequired("file")
+	}
+	applyZoneTemplateCmd.Flags().StringSlice("zone", []string{}, "Zone to apply the template to (repeatable)")
+	applyZoneTemplateCmd.Flags().Bool("dry-run", false, "Only report how the zones differ from the template")
+	_ = applyZoneTemplateCmd.MarkFlagRequired("zone")
+	addFlags([]flagFunc{pageFlags("name"), namePrefixFlag()}, listZoneTemplatesCmd)
+
+	zoneTemplatesCmd.AddCommand(
+		createZoneTemplateCmd,
+		updateZoneTemplateCmd,
+		listZoneTemplatesCmd,
+		describeZoneTemplateCmd,
+		deleteZoneTemplateCmd,
+		applyZoneTemplateCmd,
+	)
+	rootCmd.AddCommand(zoneTemplatesCmd)
+}
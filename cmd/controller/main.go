@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"strings"
@@ -16,31 +17,94 @@ import (
 	"github.com/oklog/run"
 
 	"github.com/davidseybold/beacondns/internal/api"
+	"github.com/davidseybold/beacondns/internal/audit"
+	"github.com/davidseybold/beacondns/internal/auth"
+	"github.com/davidseybold/beacondns/internal/changelog"
 	"github.com/davidseybold/beacondns/internal/db/kvstore"
 	"github.com/davidseybold/beacondns/internal/db/postgres"
 	"github.com/davidseybold/beacondns/internal/dnsstore"
 	"github.com/davidseybold/beacondns/internal/firewall"
+	"github.com/davidseybold/beacondns/internal/grpcapi"
+	"github.com/davidseybold/beacondns/internal/idempotency"
 	"github.com/davidseybold/beacondns/internal/log"
+	"github.com/davidseybold/beacondns/internal/recursive"
 	"github.com/davidseybold/beacondns/internal/repository"
+	"github.com/davidseybold/beacondns/internal/route53"
+	"github.com/davidseybold/beacondns/internal/webhook"
 	"github.com/davidseybold/beacondns/internal/worker"
 	"github.com/davidseybold/beacondns/internal/zone"
 )
 
 type serviceConfig struct {
-	Port            int      `env:"BEACON_CONTROLLER_PORT"  envDefault:"8080"`
-	DBHost          string   `env:"BEACON_DB_HOST"`
-	DBName          string   `env:"BEACON_DB_NAME"          envDefault:"beacon_db"`
-	DBUser          string   `env:"BEACON_DB_USER"          envDefault:"beacon_controller"`
-	DBPass          string   `env:"BEACON_DB_PASSWORD"`
-	DBPort          int      `env:"BEACON_DB_PORT"          envDefault:"5432"`
-	ShutdownTimeout int      `env:"BEACON_SHUTDOWN_TIMEOUT" envDefault:"30"`
-	EtcdEndpoints   []string `env:"BEACON_ETCD_ENDPOINTS"`
+	Port                     int           `env:"BEACON_CONTROLLER_PORT"            envDefault:"8080"`
+	GRPCPort                 int           `env:"BEACON_CONTROLLER_GRPC_PORT"       envDefault:"9090"`
+	Route53Port              int           `env:"BEACON_ROUTE53_PORT"               envDefault:"0"`
+	Route53Credentials       string        `env:"BEACON_ROUTE53_CREDENTIALS"        envDefault:""`
+	DBHost                   string        `env:"BEACON_DB_HOST"`
+	DBName                   string        `env:"BEACON_DB_NAME"                    envDefault:"beacon_db"`
+	DBUser                   string        `env:"BEACON_DB_USER"                    envDefault:"beacon_controller"`
+	DBPass                   string        `env:"BEACON_DB_PASSWORD"`
+	DBPort                   int           `env:"BEACON_DB_PORT"                    envDefault:"5432"`
+	ShutdownTimeout          int           `env:"BEACON_SHUTDOWN_TIMEOUT"           envDefault:"30"`
+	EtcdEndpoints            []string      `env:"BEACON_ETCD_ENDPOINTS"`
+	ZoneTrashPeriod          time.Duration `env:"BEACON_ZONE_TRASH_PERIOD"          envDefault:"0s"`
+	BootstrapAPIKey          string        `env:"BEACON_BOOTSTRAP_API_KEY"          envDefault:""`
+	AuditLogFile             string        `env:"BEACON_AUDIT_LOG_FILE"             envDefault:""`
+	RateLimits               string        `env:"BEACON_RATE_LIMITS"                envDefault:""`
+	IdempotencyKeyRetention  time.Duration `env:"BEACON_IDEMPOTENCY_KEY_RETENTION"  envDefault:"24h"`
+	WebhookMaxAttempts       int           `env:"BEACON_WEBHOOK_MAX_ATTEMPTS"       envDefault:"8"`
+	ChangeLogRetention       time.Duration `env:"BEACON_CHANGE_LOG_RETENTION"       envDefault:"168h"`
+	LintResolution           bool          `env:"BEACON_LINT_RESOLUTION"            envDefault:"false"`
+	WebhookDeliveryRetention time.Duration `env:"BEACON_WEBHOOK_DELIVERY_RETENTION" envDefault:"168h"`
+	WebhookAllowedNetworks   []string      `env:"BEACON_WEBHOOK_ALLOWED_NETWORKS"   envDefault:""`
+	OIDC                     oidcConfig
+	Quotas                   quotaConfig
+}
+
+// quotaConfig limits how much can be stored through the API. A limit of zero is unlimited.
+type quotaConfig struct {
+	MaxZones                     int `env:"BEACON_MAX_ZONES"                  envDefault:"0"`
+	MaxResourceRecordSetsPerZone int `env:"BEACON_MAX_RECORD_SETS_PER_ZONE"   envDefault:"0"`
+	MaxDomainsPerList            int `env:"BEACON_MAX_DOMAINS_PER_LIST"       envDefault:"0"`
+}
+
+// oidcConfig configures sign-in through an OIDC issuer, which is disabled when no issuer is
+// set.
+type oidcConfig struct {
+	Issuer        string   `env:"BEACON_OIDC_ISSUER"                envDefault:""`
+	ClientID      string   `env:"BEACON_OIDC_CLIENT_ID"             envDefault:""`
+	JWKSURL       string   `env:"BEACON_OIDC_JWKS_URL"              envDefault:""`
+	JWKSFile      string   `env:"BEACON_OIDC_JWKS_FILE"             envDefault:""`
+	UsernameClaim string   `env:"BEACON_OIDC_USERNAME_CLAIM"        envDefault:"email"`
+	GroupsClaim   string   `env:"BEACON_OIDC_GROUPS_CLAIM"          envDefault:"groups"`
+	Scopes        []string `env:"BEACON_OIDC_SCOPES"                envDefault:"openid,email,profile,offline_access"`
+}
+
+func (c *oidcConfig) authConfig() auth.OIDCConfig {
+	return auth.OIDCConfig{
+		Issuer:        c.Issuer,
+		ClientID:      c.ClientID,
+		JWKSURL:       c.JWKSURL,
+		JWKSFile:      c.JWKSFile,
+		UsernameClaim: c.UsernameClaim,
+		GroupsClaim:   c.GroupsClaim,
+		Scopes:        c.Scopes,
+	}
 }
 
 func (c *serviceConfig) Validate() error {
 	if c.Port <= 0 || c.Port > 65535 {
 		return fmt.Errorf("invalid port number: %d", c.Port)
 	}
+	if c.GRPCPort <= 0 || c.GRPCPort > 65535 {
+		return fmt.Errorf("invalid gRPC port number: %d", c.GRPCPort)
+	}
+	if c.GRPCPort == c.Port {
+		return fmt.Errorf("gRPC port must differ from the HTTP port: %d", c.GRPCPort)
+	}
+	if err := c.validateRoute53(); err != nil {
+		return err
+	}
 	if c.DBPort <= 0 || c.DBPort > 65535 {
 		return fmt.Errorf("invalid database port number: %d", c.DBPort)
 	}
@@ -48,6 +112,79 @@ func (c *serviceConfig) Validate() error {
 	if c.ShutdownTimeout <= 0 {
 		return fmt.Errorf("invalid shutdown timeout: %d", c.ShutdownTimeout)
 	}
+
+	if c.ZoneTrashPeriod < 0 {
+		return fmt.Errorf("invalid zone trash period: %s", c.ZoneTrashPeriod)
+	}
+
+	if c.IdempotencyKeyRetention <= 0 {
+		return fmt.Errorf("invalid idempotency key retention: %s", c.IdempotencyKeyRetention)
+	}
+
+	if c.WebhookMaxAttempts <= 0 {
+		return fmt.Errorf("invalid webhook max attempts: %d", c.WebhookMaxAttempts)
+	}
+
+	if c.ChangeLogRetention <= 0 {
+		return fmt.Errorf("invalid change log retention: %s", c.ChangeLogRetention)
+	}
+
+	if c.WebhookDeliveryRetention <= 0 {
+		return fmt.Errorf("invalid webhook delivery retention: %s", c.WebhookDeliveryRetention)
+	}
+
+	if _, err := webhook.ParseAllowedNetworks(c.WebhookAllowedNetworks); err != nil {
+		return err
+	}
+
+	if _, err := api.ParseRateLimits(c.RateLimits); err != nil {
+		return err
+	}
+
+	if c.Quotas.MaxZones < 0 || c.Quotas.MaxResourceRecordSetsPerZone < 0 || c.Quotas.MaxDomainsPerList < 0 {
+		return errors.New("invalid quota: quotas must not be negative")
+	}
+
+	if c.BootstrapAPIKey != "" {
+		if err := auth.ValidateBootstrapAPIKey(c.BootstrapAPIKey); err != nil {
+			return err
+		}
+	}
+
+	if c.OIDC.Issuer != "" {
+		oidcCfg := c.OIDC.authConfig()
+		if err := oidcCfg.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateRoute53 checks the configuration of the Route 53 API, which is only served when its
+// port is set.
+func (c *serviceConfig) validateRoute53() error {
+	if c.Route53Port == 0 {
+		if c.Route53Credentials != "" {
+			return errors.New("route 53 credentials are set but the Route 53 port is not")
+		}
+		return nil
+	}
+
+	if c.Route53Port < 0 || c.Route53Port > 65535 {
+		return fmt.Errorf("invalid Route 53 port number: %d", c.Route53Port)
+	}
+	if c.Route53Port == c.Port || c.Route53Port == c.GRPCPort {
+		return fmt.Errorf("route 53 port must differ from the HTTP and gRPC ports: %d", c.Route53Port)
+	}
+
+	credentials, err := route53.ParseCredentials(c.Route53Credentials)
+	if err != nil {
+		return err
+	}
+	if len(credentials) == 0 {
+		return errors.New("route 53 credentials are required to serve the Route 53 API")
+	}
+
 	return nil
 }
 
@@ -93,7 +230,24 @@ func start(ctx context.Context, w io.Writer) error {
 
 	dnsStore := dnsstore.New(kvstore)
 
-	zoneService := zone.NewService(repoRegistry)
+	// The resolver is only created when resolution checks are enabled, since it queries name
+	// servers on the internet from the controller.
+	var resolver zone.Resolver
+	if cfg.LintResolution {
+		recursiveResolver, resolverErr := recursive.NewResolver(logger)
+		if resolverErr != nil {
+			return fmt.Errorf("error creating resolver: %w", resolverErr)
+		}
+		defer recursiveResolver.Close()
+		resolver = recursiveResolver
+	}
+
+	zoneService := zone.NewService(repoRegistry, zone.ServiceConfig{
+		TrashPeriod:                  cfg.ZoneTrashPeriod,
+		Resolver:                     resolver,
+		MaxZones:                     cfg.Quotas.MaxZones,
+		MaxResourceRecordSetsPerZone: cfg.Quotas.MaxResourceRecordSetsPerZone,
+	})
 	zoneEventProcessor, err := zone.NewEventProcessor(&zone.EventProcessorDeps{
 		Repository: repoRegistry,
 		DNSStore:   dnsStore,
@@ -103,7 +257,9 @@ func start(ctx context.Context, w io.Writer) error {
 		return fmt.Errorf("error creating zone event processor: %w", err)
 	}
 
-	firewallService := firewall.NewService(repoRegistry)
+	firewallService := firewall.NewService(repoRegistry, firewall.ServiceConfig{
+		MaxDomainsPerList: cfg.Quotas.MaxDomainsPerList,
+	})
 	firewallEventProcessor, err := firewall.NewEventProcessor(&firewall.EventProcessorDeps{
 		Repository: repoRegistry,
 		DNSStore:   dnsStore,
@@ -113,20 +269,81 @@ func start(ctx context.Context, w io.Writer) error {
 		return fmt.Errorf("error creating firewall event processor: %w", err)
 	}
 
+	var authCfg auth.ServiceConfig
+	if cfg.OIDC.Issuer != "" {
+		if authCfg.OIDC, err = auth.NewOIDCVerifier(cfg.OIDC.authConfig()); err != nil {
+			return fmt.Errorf("error creating OIDC verifier: %w", err)
+		}
+	}
+
+	authService := auth.NewService(repoRegistry, authCfg)
+	if cfg.BootstrapAPIKey != "" {
+		if err = authService.EnsureBootstrapAPIKey(ctx, cfg.BootstrapAPIKey); err != nil {
+			return err
+		}
+	}
+
 	workerCtx, workerCancel := context.WithCancel(ctx)
 	defer workerCancel()
 
+	webhookService := webhook.NewService(repoRegistry)
+	webhookAllowedNetworks, err := webhook.ParseAllowedNetworks(cfg.WebhookAllowedNetworks)
+	if err != nil {
+		return err
+	}
+
+	webhookDispatcher := webhook.NewDispatcher(repoRegistry, logger, webhook.DispatcherConfig{
+		MaxAttempts:       cfg.WebhookMaxAttempts,
+		DeliveryRetention: cfg.WebhookDeliveryRetention,
+		AllowedNetworks:   webhookAllowedNetworks,
+	})
+
+	changeLogService := changelog.NewService(repoRegistry)
+	changeLogRecorder := changelog.NewRecorder(cfg.ChangeLogRetention)
+
 	worker := worker.New(
 		repoRegistry,
 		logger,
 		[]worker.EventProcessor{zoneEventProcessor, firewallEventProcessor},
+		[]worker.EventObserver{changeLogRecorder, webhookDispatcher},
 	)
 
-	handler, err := api.NewHTTPHandler(logger, zoneService, firewallService)
+	idempotencyService := idempotency.NewService(repoRegistry, idempotency.ServiceConfig{
+		Retention: cfg.IdempotencyKeyRetention,
+		Logger:    logger,
+	})
+
+	rateLimits, err := api.ParseRateLimits(cfg.RateLimits)
+	if err != nil {
+		return fmt.Errorf("error parsing rate limits: %w", err)
+	}
+	rateLimiter := api.NewRateLimiter(rateLimits)
+
+	// Change streams are ended as the HTTP server shuts down rather than holding it up.
+	streamsDone := make(chan struct{})
+
+	handler, err := api.NewHTTPHandler(
+		logger,
+		zoneService,
+		firewallService,
+		authService,
+		webhookService,
+		changeLogService,
+		idempotencyService,
+		rateLimiter,
+		streamsDone,
+	)
 	if err != nil {
 		return fmt.Errorf("error creating HTTP handler: %w", err)
 	}
 
+	grpcServer := grpcapi.NewServer(logger, zoneService, firewallService, authService, rateLimiter)
+
+	route53Credentials, err := route53.ParseCredentials(cfg.Route53Credentials)
+	if err != nil {
+		return fmt.Errorf("error parsing Route 53 credentials: %w", err)
+	}
+
 	var g run.Group
 	{
 		httpServer := &http.Server{
@@ -135,6 +352,7 @@ func start(ctx context.Context, w io.Writer) error {
 			Addr:              fmt.Sprintf(":%d", cfg.Port),
 			Handler:           handler,
 		}
+		httpServer.RegisterOnShutdown(func() { close(streamsDone) })
 		g.Add(
 			func() error {
 				if err = httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -154,6 +372,55 @@ func start(ctx context.Context, w io.Writer) error {
 			},
 		)
 	}
+	{
+		g.Add(
+			func() error {
+				listener, listenErr := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GRPCPort))
+				if listenErr != nil {
+					return listenErr
+				}
+				return grpcServer.Serve(listener)
+			},
+			func(_ error) {
+				// Streams in progress are waited for until the shutdown timeout, then cut off.
+				stopped := make(chan struct{})
+				go func() {
+					grpcServer.GracefulStop()
+					close(stopped)
+				}()
+				select {
+				case <-stopped:
+				case <-time.After(time.Duration(cfg.ShutdownTimeout) * time.Second):
+					grpcServer.Stop()
+				}
+			},
+		)
+	}
+	if cfg.Route53Port != 0 {
+		route53Server := &http.Server{
+			ReadHeaderTimeout: time.Second * 10, //nolint:mnd,nolintlint
+			Addr:              fmt.Sprintf(":%d", cfg.Route53Port),
+			Handler:           route53.NewHTTPHandler(logger, zoneService, authService, route53Credentials, rateLimiter),
+		}
+		g.Add(
+			func() error {
+				if err = route53Server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					return err
+				}
+				return nil
+			},
+			func(_ error) {
+				shutdownCtx, cancel := context.WithTimeout(
+					context.Background(),
+					time.Duration(cfg.ShutdownTimeout)*time.Second,
+				)
+				defer cancel()
+				if err = route53Server.Shutdown(shutdownCtx); err != nil {
+					fmt.Fprintf(w, "error shutting down Route 53 server: %s\n", err)
+				}
+			},
+		)
+	}
 	{
 		g.Add(
 			func() error {
@@ -164,6 +431,37 @@ func start(ctx context.Context, w io.Writer) error {
 			},
 		)
 	}
+	{
+		g.Add(
+			func() error {
+				return webhookDispatcher.Start(workerCtx)
+			},
+			func(_ error) {
+				workerCancel()
+			},
+		)
+	}
+	{
+		g.Add(
+			func() error {
+				return idempotencyService.Start(workerCtx)
+			},
+			func(_ error) {
+				workerCancel()
+			},
+		)
+	}
+	if cfg.AuditLogFile != "" {
+		exporter := audit.NewFileExporter(repoRegistry, cfg.AuditLogFile, logger)
+		g.Add(
+			func() error {
+				return exporter.Start(workerCtx)
+			},
+			func(_ error) {
+				workerCancel()
+			},
+		)
+	}
 	g.Add(run.SignalHandler(ctx, os.Interrupt))
 
 	return g.Run()
@@ -4,24 +4,48 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 
+	"github.com/davidseybold/beacondns/internal/auth"
 	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/changelog"
 	"github.com/davidseybold/beacondns/internal/firewall"
+	"github.com/davidseybold/beacondns/internal/idempotency"
+	"github.com/davidseybold/beacondns/internal/webhook"
 	"github.com/davidseybold/beacondns/internal/zone"
 )
 
+// NewEngine returns a gin engine with the default logger and recovery middleware that takes
+// the client IP of a request from its connection. Headers such as X-Forwarded-For are
+// ignored, since any client can set them, and the client IP is what failed authentications
+// are throttled by and what the audit log records.
+func NewEngine() *gin.Engine {
+	r := gin.Default()
+	r.ForwardedByClientIP = false
+	return r
+}
+
+// NewHTTPHandler returns the handler of the API. Change streams never end on their own, so
+// they are ended once streamsDone is closed, which the server should do as it shuts down.
 func NewHTTPHandler(
 	logger *slog.Logger,
 	zoneService zone.Service,
 	firewallService firewall.Service,
+	authService auth.Service,
+	webhookService webhook.Service,
+	changeLogService changelog.Service,
+	idempotencyService idempotency.Service,
+	rateLimiter *RateLimiter,
+	streamsDone <-chan struct{},
 ) (http.Handler, error) {
-	r := gin.Default()
+	r := NewEngine()
 
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
 		registerStructValidators(v)
@@ -34,19 +58,39 @@ func NewHTTPHandler(
 	}
 
 	handler := &handler{
-		logger:          logger,
-		zoneService:     zoneService,
-		firewallService: firewallService,
+		logger:             logger,
+		zoneService:        zoneService,
+		firewallService:    firewallService,
+		authService:        authService,
+		webhookService:     webhookService,
+		changeLogService:   changeLogService,
+		idempotencyService: idempotencyService,
+		rateLimiter:        rateLimiter,
+		streamsDone:        streamsDone,
 	}
 
 	r.GET("/health", handler.Health)
+	r.GET("/v1/auth/oidc", handler.rateLimit("auth"), handler.GetOIDCProvider)
+
+	// Everything but the health check and the sign-in configuration requires authentication.
+	// Failed authentications are rate limited per client IP, and each route group is rate
+	// limited after authentication, so that the limits apply per principal. POST requests
+	// carrying an Idempotency-Key header are replayed when they are retried.
+	authenticated := r.Group("", handler.authenticate)
 
 	{
-		g := r.Group("/v1/zones")
+		g := authenticated.Group("/v1/zones", handler.rateLimit("zones"), handler.idempotent)
 		g.POST("", handler.CreateZone)
 		g.DELETE("/:zoneName", handler.DeleteZone)
 		g.GET("", handler.ListZones)
 		g.GET("/:zoneName", handler.GetZone)
+		g.POST("/:zoneName/tags", handler.UpdateZoneTags)
+		g.POST("/:zoneName/import", handler.ImportZone)
+		g.GET("/:zoneName/export", handler.ExportZone)
+		g.GET("/:zoneName/lint", handler.LintZone)
+		g.GET("/:zoneName/versions", handler.ListZoneVersions)
+		g.GET("/:zoneName/versions/diff", handler.DiffZoneVersions)
+		g.POST("/:zoneName/rollback", handler.RollbackZone)
 		g.POST("/:zoneName/rrsets", handler.UpsertResourceRecordSet)
 		g.GET("/:zoneName/rrsets", handler.ListResourceRecordSets)
 		g.DELETE("/:zoneName/rrsets/:name/:type", handler.DeleteResourceRecordSet)
@@ -54,9 +98,31 @@ func NewHTTPHandler(
 	}
 
 	{
-		g := r.Group("/v1/firewall")
+		g := authenticated.Group("/v1/reverse-zones", handler.rateLimit("reverse-zones"), handler.idempotent)
+		g.POST("", handler.CreateReverseZones)
+	}
+
+	{
+		g := authenticated.Group("/v1/deleted-zones", handler.rateLimit("deleted-zones"), handler.idempotent)
+		g.GET("", handler.ListDeletedZones)
+		g.POST("/:zoneName/restore", handler.RestoreZone)
+	}
+
+	{
+		g := authenticated.Group("/v1/zone-templates", handler.rateLimit("zone-templates"), handler.idempotent)
+		g.POST("", handler.CreateZoneTemplate)
+		g.GET("", handler.ListZoneTemplates)
+		g.GET("/:templateName", handler.GetZoneTemplate)
+		g.POST("/:templateName", handler.UpdateZoneTemplate)
+		g.DELETE("/:templateName", handler.DeleteZoneTemplate)
+		g.POST("/:templateName/apply", handler.ApplyZoneTemplate)
+	}
+
+	{
+		g := authenticated.Group("/v1/firewall", handler.rateLimit("firewall"), handler.idempotent)
 		g.POST("/domain-lists", handler.CreateDomainList)
 		g.POST("/domain-lists/:id/refresh", handler.RefreshDomainList)
+		g.POST("/domain-lists/:id/tags", handler.UpdateDomainListTags)
 		g.DELETE("/domain-lists/:id", handler.DeleteDomainList)
 		g.GET("/domain-lists/:id/domains", handler.ListDomainListDomains)
 		g.POST("/domain-lists/:id/domains", handler.AddDomainsToDomainList)
@@ -65,18 +131,64 @@ func NewHTTPHandler(
 		g.GET("/domain-lists", handler.ListDomainLists)
 		g.POST("/rules", handler.CreateFirewallRule)
 		g.POST("/rules/:id", handler.UpdateFirewallRule)
+		g.POST("/rules/:id/tags", handler.UpdateFirewallRuleTags)
 		g.DELETE("/rules/:id", handler.DeleteFirewallRule)
 		g.GET("/rules/:id", handler.GetFirewallRule)
 		g.GET("/rules", handler.ListFirewallRules)
 	}
 
+	{
+		// Creating an API key is not idempotent, so that the secret it returns is never stored.
+		g := authenticated.Group("/v1/api-keys", handler.rateLimit("api-keys"))
+		g.POST("", handler.CreateAPIKey)
+		g.GET("", handler.ListAPIKeys)
+		g.DELETE("/:id", handler.RevokeAPIKey)
+	}
+
+	{
+		g := authenticated.Group("/v1/roles", handler.rateLimit("roles"), handler.idempotent)
+		g.POST("", handler.CreateRole)
+		g.GET("", handler.ListRoles)
+		g.GET("/:roleName", handler.GetRole)
+		g.POST("/:roleName", handler.UpdateRole)
+		g.DELETE("/:roleName", handler.DeleteRole)
+	}
+
+	{
+		g := authenticated.Group("/v1/role-bindings", handler.rateLimit("role-bindings"), handler.idempotent)
+		g.POST("", handler.CreateRoleBinding)
+		g.GET("", handler.ListRoleBindings)
+		g.DELETE("/:id", handler.DeleteRoleBinding)
+	}
+
+	{
+		// Creating a webhook is not idempotent, so that the secret it returns is never stored.
+		g := authenticated.Group("/v1/webhooks", handler.rateLimit("webhooks"))
+		g.POST("", handler.CreateWebhook)
+		g.GET("", handler.ListWebhooks)
+		g.GET("/:id", handler.GetWebhook)
+		g.DELETE("/:id", handler.DeleteWebhook)
+		g.GET("/:id/deliveries", handler.ListWebhookDeliveries)
+		g.POST("/:id/deliveries/:deliveryId/redeliver", handler.idempotent, handler.RedeliverWebhookDelivery)
+	}
+
+	authenticated.GET("/v1/events/stream", handler.rateLimit("events"), handler.StreamChanges)
+	authenticated.GET("/v1/audit", handler.rateLimit("audit"), handler.ListAuditEntries)
+	authenticated.GET("/v1/whoami", handler.rateLimit("whoami"), handler.WhoAmI)
+
 	return r, nil
 }
 
 type handler struct {
-	zoneService     zone.Service
-	firewallService firewall.Service
-	logger          *slog.Logger
+	zoneService        zone.Service
+	firewallService    firewall.Service
+	authService        auth.Service
+	webhookService     webhook.Service
+	changeLogService   changelog.Service
+	idempotencyService idempotency.Service
+	rateLimiter        *RateLimiter
+	streamsDone        <-chan struct{}
+	logger             *slog.Logger
 }
 
 func (h *handler) Health(c *gin.Context) {
@@ -92,6 +204,25 @@ func (h *handler) handleError(c *gin.Context, err error) {
 	h.logger.Error("api error", "err", err)
 
 	switch {
+	case beaconerr.IsUnauthorizedError(err):
+		c.Header("WWW-Authenticate", "Bearer")
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Code:    beaconErr.Code(),
+			Message: beaconErr.Message(),
+		})
+	case beaconerr.IsAccessDeniedError(err):
+		c.JSON(http.StatusForbidden, ErrorResponse{
+			Code:    beaconErr.Code(),
+			Message: beaconErr.Message(),
+		})
+	case beaconerr.IsThrottlingError(err):
+		var throttlingErr *beaconerr.ThrottlingError
+		errors.As(err, &throttlingErr)
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(throttlingErr.RetryAfter.Seconds()))))
+		c.JSON(http.StatusTooManyRequests, ErrorResponse{
+			Code:    beaconErr.Code(),
+			Message: beaconErr.Message(),
+		})
 	case beaconerr.IsNoSuchError(err):
 		c.JSON(http.StatusNotFound, ErrorResponse{
 			Code:    beaconErr.Code(),
@@ -109,8 +240,9 @@ func (h *handler) handleError(c *gin.Context, err error) {
 		})
 	case beaconerr.IsBadRequestError(err):
 		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Code:    beaconErr.Code(),
-			Message: beaconErr.Message(),
+			Code:       beaconErr.Code(),
+			Message:    beaconErr.Message(),
+			Violations: convertChangeViolationsToAPI(err),
 		})
 	default:
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *handler) CreateAPIKey(c *gin.Context) {
+	var req CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	key, secret, err := h.authService.CreateAPIKey(c.Request.Context(), req.Name, req.ExpiresAt, req.Roles)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, CreateAPIKeyResponse{
+		APIKey: *convertModelAPIKeyToAPI(key),
+		Key:    secret,
+	})
+}
+
+func (h *handler) ListAPIKeys(c *gin.Context) {
+	opts, ok := h.bindListOptions(c)
+	if !ok {
+		return
+	}
+
+	keys, err := h.authService.ListAPIKeys(c.Request.Context(), opts)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	responseBody := ListAPIKeysResponse{
+		APIKeys:    make([]APIKey, len(keys.Items)),
+		NextCursor: keys.NextCursor,
+	}
+	for i := range keys.Items {
+		responseBody.APIKeys[i] = *convertModelAPIKeyToAPI(&keys.Items[i])
+	}
+
+	c.JSON(http.StatusOK, responseBody)
+}
+
+func (h *handler) RevokeAPIKey(c *gin.Context) {
+	id, err := getIDParam(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	if err = h.authService.RevokeAPIKey(c.Request.Context(), id); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+func (h *handler) ListAuditEntries(c *gin.Context) {
+	var query ListAuditEntriesQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	filter := model.AuditFilter{
+		Operation: query.Operation,
+		Target:    query.Target,
+		Since:     query.Since,
+		Until:     query.Until,
+	}
+	switch {
+	case query.ActorType != "" && query.ActorID != "":
+		filter.Actor = &model.Subject{Type: model.SubjectType(query.ActorType), ID: query.ActorID}
+	case query.ActorType != "" || query.ActorID != "":
+		h.handleError(c, beaconerr.ErrInvalidArgument("actorType and actorId must be set together", "actor"))
+		return
+	}
+
+	opts, ok := h.bindListOptions(c)
+	if !ok {
+		return
+	}
+
+	entries, err := h.authService.ListAuditEntries(c.Request.Context(), filter, opts)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	responseBody := ListAuditEntriesResponse{
+		Entries:    make([]AuditEntry, len(entries.Items)),
+		NextCursor: entries.NextCursor,
+	}
+	for i := range entries.Items {
+		responseBody.Entries[i] = *convertModelAuditEntryToAPI(&entries.Items[i])
+	}
+
+	c.JSON(http.StatusOK, responseBody)
+}
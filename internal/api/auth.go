@@ -0,0 +1,58 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/davidseybold/beacondns/internal/audit"
+	"github.com/davidseybold/beacondns/internal/auth"
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+)
+
+// authenticate rejects requests that do not carry a valid API key or OIDC ID token as a
+// bearer token, and throttles clients that keep failing to authenticate.
+// The principal of an authenticated request is carried by the request context, which the
+// handlers pass on to the services to authorize against and to record changes in the audit
+// log against.
+func (h *handler) authenticate(c *gin.Context) {
+	principal, err := h.rateLimiter.Authenticate(c.ClientIP(), func() (*auth.Principal, error) {
+		scheme, secret, ok := strings.Cut(c.GetHeader("Authorization"), " ")
+		if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(secret) == "" {
+			return nil, beaconerr.ErrUnauthorized("missing bearer token")
+		}
+		return h.authService.Authenticate(c.Request.Context(), strings.TrimSpace(secret))
+	})
+	if err != nil {
+		h.handleError(c, err)
+		c.Abort()
+		return
+	}
+
+	ctx := auth.WithPrincipal(c.Request.Context(), principal)
+	ctx = audit.WithActor(ctx, audit.Actor{
+		Subject:  principal.Subject,
+		Name:     principal.Name,
+		SourceIP: c.RemoteIP(),
+	})
+	c.Request = c.Request.WithContext(ctx)
+	c.Next()
+}
+
+// GetOIDCProvider returns what clients need to sign users in, so that beaconctl only needs
+// the address of the controller. It is not authenticated, since it is needed to log in.
+func (h *handler) GetOIDCProvider(c *gin.Context) {
+	provider := h.authService.OIDCProvider()
+	if provider == nil {
+		c.JSON(http.StatusOK, OIDCProviderResponse{})
+		return
+	}
+
+	c.JSON(http.StatusOK, OIDCProviderResponse{
+		Enabled:  true,
+		Issuer:   provider.Issuer,
+		ClientID: provider.ClientID,
+		Scopes:   provider.Scopes,
+	})
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+// LastEventIDHeader holds the id of the last event a reconnecting watcher received.
+const LastEventIDHeader = "Last-Event-ID"
+
+const (
+	changeStreamPollInterval = time.Second
+	// changeStreamKeepAlive is how often a comment is sent on a quiet stream, so that proxies
+	// do not close it as idle.
+	changeStreamKeepAlive = 15 * time.Second
+)
+
+// StreamChanges streams the notifications of the changes the principal may read as
+// server-sent events, each with the id of its notification. A watcher resumes after the
+// last event it received with the Last-Event-ID header, or the lastEventId query parameter
+// where it cannot set headers.
+func (h *handler) StreamChanges(c *gin.Context) {
+	lastEventID, err := getLastEventID(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	after, reset, err := h.changeLogService.ResumeFrom(ctx, lastEventID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	if reset {
+		if err = writeChangeEvent(c.Writer, &ChangeNotification{
+			ID:        after,
+			Type:      model.ChangeTypeReset,
+			CreatedAt: time.Now(),
+		}); err != nil {
+			return
+		}
+	}
+	c.Writer.Flush()
+
+	poll := time.NewTicker(changeStreamPollInterval)
+	defer poll.Stop()
+	keepAlive := time.NewTicker(changeStreamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		notifications, next, listErr := h.changeLogService.ListChangesAfter(ctx, after)
+		if listErr != nil {
+			if ctx.Err() == nil {
+				h.logger.Error("failed to list changes", "err", listErr)
+			}
+			// The watcher reconnects and resumes after the last event it received.
+			return
+		}
+
+		for i := range notifications {
+			if err = writeChangeEvent(c.Writer, convertModelChangeNotificationToAPI(&notifications[i])); err != nil {
+				return
+			}
+		}
+
+		// Notifications the principal may not read were left out. Moving the id of the
+		// watcher past them spares it reading them again when it resumes.
+		if next != after && (len(notifications) == 0 || notifications[len(notifications)-1].ID != next) {
+			if _, err = fmt.Fprintf(c.Writer, "id: %d\n\n", next); err != nil {
+				return
+			}
+		}
+
+		if next != after {
+			c.Writer.Flush()
+			after = next
+			// There may be more to read.
+			continue
+		}
+
+		select {
+		case <-poll.C:
+		case <-keepAlive.C:
+			if _, err = io.WriteString(c.Writer, ": keepalive\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		case <-ctx.Done():
+			return
+		case <-h.streamsDone:
+			return
+		}
+	}
+}
+
+func getLastEventID(c *gin.Context) (*int64, error) {
+	value := c.GetHeader(LastEventIDHeader)
+	if value == "" {
+		value = c.Query("lastEventId")
+	}
+	if value == "" {
+		return nil, nil
+	}
+
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || id < 0 {
+		return nil, beaconerr.ErrInvalidArgument("last event id must be a non-negative integer", LastEventIDHeader)
+	}
+
+	return &id, nil
+}
+
+func writeChangeEvent(w io.Writer, notification *ChangeNotification) error {
+	data, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\ndata: %s\n\n", notification.ID, data)
+	return err
+}
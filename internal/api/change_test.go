@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davidseybold/beacondns/internal/log"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+// fakeChangeLogService streams a fixed list of notifications, leaving out those of hidden
+// resources, and ends every stream once they are all listed.
+type fakeChangeLogService struct {
+	notifications []model.ChangeNotification
+	hidden        string
+	latest        int64
+	done          chan struct{}
+	lastEventID   *int64
+}
+
+func (f *fakeChangeLogService) ResumeFrom(_ context.Context, lastEventID *int64) (int64, bool, error) {
+	f.lastEventID = lastEventID
+	if lastEventID == nil {
+		return f.latest, false, nil
+	}
+	if *lastEventID > f.latest {
+		return f.latest, true, nil
+	}
+	return *lastEventID, false, nil
+}
+
+func (f *fakeChangeLogService) ListChangesAfter(
+	_ context.Context,
+	id int64,
+) ([]model.ChangeNotification, int64, error) {
+	next := id
+	var readable []model.ChangeNotification
+	for _, notification := range f.notifications {
+		if notification.ID <= id {
+			continue
+		}
+		next = notification.ID
+		if notification.Resource != f.hidden {
+			readable = append(readable, notification)
+		}
+	}
+
+	if next == id {
+		close(f.done)
+	}
+	return readable, next, nil
+}
+
+func TestStreamChanges(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	createdAt := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	stream := func(header string, query string) (*httptest.ResponseRecorder, *fakeChangeLogService) {
+		service := &fakeChangeLogService{
+			notifications: []model.ChangeNotification{
+				{ID: 1, Type: model.ChangeTypeCreateZone, Resource: "zone/example.com.", CreatedAt: createdAt},
+				{ID: 2, Type: model.ChangeTypeUpsertRRSet, Resource: "zone/example.com./rrset/www.example.com./A",
+					Data: []byte(`{"zoneName":"example.com."}`), CreatedAt: createdAt},
+				{ID: 3, Type: "firewall.rule.create", Resource: "firewall-rule/hidden", CreatedAt: createdAt},
+			},
+			hidden: "firewall-rule/hidden",
+			latest: 3,
+			done:   make(chan struct{}),
+		}
+		h := &handler{logger: log.NewDiscardLogger(), changeLogService: service, streamsDone: service.done}
+
+		r := gin.New()
+		r.GET("/v1/events/stream", h.StreamChanges)
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/events/stream"+query, nil)
+		if header != "" {
+			req.Header.Set(LastEventIDHeader, header)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w, service
+	}
+
+	t.Run("resume", func(t *testing.T) {
+		w, service := stream("0", "")
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+		assert.Equal(t, int64(0), *service.lastEventID)
+		assert.Equal(t, "id: 1\n"+
+			`data: {"id":1,"type":"zone.create","resource":"zone/example.com.",`+
+			`"createdAt":"2025-06-01T00:00:00Z"}`+"\n\n"+
+			"id: 2\n"+
+			`data: {"id":2,"type":"zone.rrset.upsert","resource":"zone/example.com./rrset/www.example.com./A",`+
+			`"data":{"zoneName":"example.com."},"createdAt":"2025-06-01T00:00:00Z"}`+"\n\n"+
+			"id: 3\n\n",
+			w.Body.String())
+	})
+
+	t.Run("query parameter", func(t *testing.T) {
+		w, service := stream("", "?lastEventId=2")
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, int64(2), *service.lastEventID)
+		assert.Equal(t, "id: 3\n\n", w.Body.String())
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		w, _ := stream("42", "")
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "id: 3\ndata: {\"id\":3,\"type\":\"reset\"")
+	})
+
+	t.Run("invalid last event id", func(t *testing.T) {
+		w, _ := stream("abc", "")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
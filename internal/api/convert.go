@@ -1,8 +1,10 @@
 package api
 
 import (
+	"errors"
 	"strings"
 
+	"github.com/davidseybold/beacondns/internal/beaconerr"
 	"github.com/davidseybold/beacondns/internal/model"
 )
 
@@ -16,6 +18,8 @@ func convertAPIResourceRecordSetToModel(recordSet *ResourceRecordSet) *model.Res
 		Type:            model.RRType(strings.ToUpper(recordSet.Type)),
 		TTL:             recordSet.TTL,
 		ResourceRecords: convertAPIResourceRecordsToModel(recordSet.ResourceRecords),
+		Tags:            recordSet.Tags,
+		Comment:         recordSet.Comment,
 	}
 }
 
@@ -39,6 +43,8 @@ func convertModelResourceRecordSetToAPI(rrSet *model.ResourceRecordSet) *Resourc
 		Type:            strings.ToUpper(string(rrSet.Type)),
 		TTL:             rrSet.TTL,
 		ResourceRecords: convertModelResourceRecordsToAPI(rrSet.ResourceRecords),
+		Tags:            rrSet.Tags,
+		Comment:         rrSet.Comment,
 	}
 }
 
@@ -52,6 +58,65 @@ func convertModelResourceRecordsToAPI(records []model.ResourceRecord) []Resource
 	return apiRecords
 }
 
+func convertModelResourceRecordSetsToAPI(rrSets []model.ResourceRecordSet) []ResourceRecordSet {
+	apiRRSets := make([]ResourceRecordSet, len(rrSets))
+	for i := range rrSets {
+		apiRRSets[i] = *convertModelResourceRecordSetToAPI(&rrSets[i])
+	}
+	return apiRRSets
+}
+
+func convertModelLintFindingsToAPI(findings []model.LintFinding) []LintFinding {
+	apiFindings := make([]LintFinding, len(findings))
+	for i, finding := range findings {
+		apiFindings[i] = LintFinding{
+			Rule:     finding.Rule,
+			Severity: string(finding.Severity),
+			Name:     finding.Name,
+			Type:     string(finding.Type),
+			Message:  finding.Message,
+		}
+	}
+	return apiFindings
+}
+
+func convertModelZoneDiffToAPI(diff *model.ZoneDiff) *ZoneDiff {
+	modified := make([]ResourceRecordSetModification, len(diff.Modified))
+	for i := range diff.Modified {
+		modified[i] = ResourceRecordSetModification{
+			Before:         *convertModelResourceRecordSetToAPI(&diff.Modified[i].Before),
+			After:          *convertModelResourceRecordSetToAPI(&diff.Modified[i].After),
+			AddedRecords:   convertModelResourceRecordsToAPI(diff.Modified[i].AddedRecords),
+			RemovedRecords: convertModelResourceRecordsToAPI(diff.Modified[i].RemovedRecords),
+		}
+	}
+
+	return &ZoneDiff{
+		Added:    convertModelResourceRecordSetsToAPI(diff.Added),
+		Removed:  convertModelResourceRecordSetsToAPI(diff.Removed),
+		Modified: modified,
+	}
+}
+
+func convertAPIResourceRecordSetsToModel(rrSets []ResourceRecordSet) []model.ResourceRecordSet {
+	modelRRSets := make([]model.ResourceRecordSet, len(rrSets))
+	for i := range rrSets {
+		modelRRSets[i] = *convertAPIResourceRecordSetToModel(&rrSets[i])
+	}
+	return modelRRSets
+}
+
+func convertModelZoneTemplateToAPI(template *model.ZoneTemplate) *ZoneTemplate {
+	return &ZoneTemplate{
+		ID:                 template.ID.String(),
+		Name:               template.Name,
+		Description:        template.Description,
+		ResourceRecordSets: convertModelResourceRecordSetsToAPI(template.ResourceRecordSets),
+		CreatedAt:          template.CreatedAt,
+		UpdatedAt:          template.UpdatedAt,
+	}
+}
+
 func convertModelFirewallRuleToAPI(rule *model.FirewallRule) *FirewallRule {
 	var blockResponseType *string
 	if rule.BlockResponseType != nil {
@@ -66,6 +131,25 @@ func convertModelFirewallRuleToAPI(rule *model.FirewallRule) *FirewallRule {
 		BlockResponseType: blockResponseType,
 		BlockResponse:     convertModelResourceRecordSetToAPI(rule.BlockResponse),
 		Priority:          rule.Priority,
+		Tags:              rule.Tags,
+	}
+}
+
+func convertModelDomainListInfoToAPI(info *model.DomainListInfo) DomainList {
+	return DomainList{
+		ID:          info.ID,
+		Name:        info.Name,
+		DomainCount: info.DomainCount,
+		Tags:        info.Tags,
+	}
+}
+
+func convertModelZoneInfoToAPI(info *model.ZoneInfo) Zone {
+	return Zone{
+		ID:                     info.ID.String(),
+		Name:                   info.Name,
+		ResourceRecordSetCount: info.ResourceRecordSetCount,
+		Tags:                   info.Tags,
 	}
 }
 
@@ -83,3 +167,132 @@ func getFirewallRuleBlockResponseType(blockResponseType *string) *model.Firewall
 	t := model.FirewallRuleBlockResponseType(upper)
 	return &t
 }
+
+func convertChangeViolationsToAPI(err error) []ChangeViolation {
+	var batchErr *beaconerr.InvalidChangeBatchError
+	if !errors.As(err, &batchErr) {
+		return nil
+	}
+
+	violations := make([]ChangeViolation, len(batchErr.Violations))
+	for i, violation := range batchErr.Violations {
+		violations[i] = ChangeViolation{
+			Action:  violation.Action,
+			Name:    violation.Name,
+			Type:    violation.Type,
+			Message: violation.Message,
+		}
+	}
+	return violations
+}
+
+func convertModelAPIKeyToAPI(key *model.APIKey) *APIKey {
+	return &APIKey{
+		ID:         key.ID.String(),
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		CreatedAt:  key.CreatedAt,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+	}
+}
+
+func convertModelWebhookToAPI(webhook *model.Webhook) *Webhook {
+	return &Webhook{
+		ID:        webhook.ID,
+		Name:      webhook.Name,
+		URL:       webhook.URL,
+		Events:    webhook.Events,
+		CreatedAt: webhook.CreatedAt,
+	}
+}
+
+func convertModelWebhookDeliveryToAPI(delivery *model.WebhookDelivery) *WebhookDelivery {
+	return &WebhookDelivery{
+		ID:             delivery.ID,
+		WebhookID:      delivery.WebhookID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastAttemptAt:  delivery.LastAttemptAt,
+		ResponseStatus: delivery.ResponseStatus,
+		Error:          delivery.Error,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
+
+func convertAPIPermissionsToModel(permissions []Permission) []model.Permission {
+	converted := make([]model.Permission, len(permissions))
+	for i, permission := range permissions {
+		actions := make([]model.Action, len(permission.Actions))
+		for j, action := range permission.Actions {
+			actions[j] = model.Action(action)
+		}
+		converted[i] = model.Permission{Actions: actions, Resources: permission.Resources}
+	}
+	return converted
+}
+
+func convertModelPermissionsToAPI(permissions []model.Permission) []Permission {
+	converted := make([]Permission, len(permissions))
+	for i, permission := range permissions {
+		actions := make([]string, len(permission.Actions))
+		for j, action := range permission.Actions {
+			actions[j] = string(action)
+		}
+		converted[i] = Permission{Actions: actions, Resources: permission.Resources}
+	}
+	return converted
+}
+
+func convertModelRoleToAPI(role *model.Role) *Role {
+	return &Role{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: convertModelPermissionsToAPI(role.Permissions),
+		BuiltIn:     role.BuiltIn,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
+
+func convertModelSubjectToAPI(subject model.Subject) Subject {
+	return Subject{Type: string(subject.Type), ID: subject.ID}
+}
+
+func convertModelRoleBindingToAPI(binding *model.RoleBinding) *RoleBinding {
+	return &RoleBinding{
+		ID:        binding.ID,
+		Role:      binding.Role,
+		Subject:   convertModelSubjectToAPI(binding.Subject),
+		CreatedAt: binding.CreatedAt,
+	}
+}
+
+func convertModelAuditEntryToAPI(entry *model.AuditEntry) *AuditEntry {
+	return &AuditEntry{
+		ID:        entry.ID,
+		CreatedAt: entry.CreatedAt,
+		Actor:     convertModelSubjectToAPI(entry.Actor),
+		ActorName: entry.ActorName,
+		SourceIP:  entry.SourceIP,
+		Operation: entry.Operation,
+		Target:    entry.Target,
+		Before:    entry.Before,
+		After:     entry.After,
+	}
+}
+
+func convertModelChangeNotificationToAPI(notification *model.ChangeNotification) *ChangeNotification {
+	return &ChangeNotification{
+		ID:        notification.ID,
+		Type:      notification.Type,
+		Resource:  notification.Resource,
+		Data:      notification.Data,
+		CreatedAt: notification.CreatedAt,
+	}
+}
@@ -19,20 +19,16 @@ func (h *handler) CreateDomainList(c *gin.Context) {
 	var err error
 
 	if req.IsManaged {
-		info, err = h.firewallService.CreateManagedDomainList(c, req.Name, *req.SourceURL)
+		info, err = h.firewallService.CreateManagedDomainList(c.Request.Context(), req.Name, *req.SourceURL, req.Tags)
 	} else {
-		info, err = h.firewallService.CreateUnmanagedDomainList(c, req.Name, req.Domains)
+		info, err = h.firewallService.CreateUnmanagedDomainList(c.Request.Context(), req.Name, req.Domains, req.Tags)
 	}
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, DomainList{
-		ID:          info.ID,
-		Name:        info.Name,
-		DomainCount: info.DomainCount,
-	})
+	c.JSON(http.StatusOK, convertModelDomainListInfoToAPI(info))
 }
 
 func (h *handler) DeleteDomainList(c *gin.Context) {
@@ -42,7 +38,7 @@ func (h *handler) DeleteDomainList(c *gin.Context) {
 		return
 	}
 
-	err = h.firewallService.DeleteDomainList(c, id)
+	err = h.firewallService.DeleteDomainList(c.Request.Context(), id)
 	if err != nil {
 		h.handleError(c, err)
 		return
@@ -64,7 +60,7 @@ func (h *handler) AddDomainsToDomainList(c *gin.Context) {
 		return
 	}
 
-	err = h.firewallService.AddDomainsToDomainList(c, id, req.Domains)
+	err = h.firewallService.AddDomainsToDomainList(c.Request.Context(), id, req.Domains)
 	if err != nil {
 		h.handleError(c, err)
 		return
@@ -86,7 +82,7 @@ func (h *handler) RemoveDomainsFromDomainList(c *gin.Context) {
 		return
 	}
 
-	err = h.firewallService.RemoveDomainsFromDomainList(c, id, req.Domains)
+	err = h.firewallService.RemoveDomainsFromDomainList(c.Request.Context(), id, req.Domains)
 	if err != nil {
 		h.handleError(c, err)
 		return
@@ -102,17 +98,13 @@ func (h *handler) GetDomainList(c *gin.Context) {
 		return
 	}
 
-	dl, err := h.firewallService.GetDomainList(c, id)
+	dl, err := h.firewallService.GetDomainList(c.Request.Context(), id)
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, DomainList{
-		ID:          dl.ID,
-		Name:        dl.Name,
-		DomainCount: dl.DomainCount,
-	})
+	c.JSON(http.StatusOK, convertModelDomainListInfoToAPI(dl))
 }
 
 func (h *handler) ListDomainListDomains(c *gin.Context) {
@@ -122,35 +114,43 @@ func (h *handler) ListDomainListDomains(c *gin.Context) {
 		return
 	}
 
-	domains, err := h.firewallService.GetDomainListDomains(c, id)
+	opts, ok := h.bindListOptions(c)
+	if !ok {
+		return
+	}
+
+	domains, err := h.firewallService.GetDomainListDomains(c.Request.Context(), id, opts)
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, ListDomainListDomainsResponse{
-		Domains: domains,
+		Domains:    domains.Items,
+		NextCursor: domains.NextCursor,
 	})
 }
 
 func (h *handler) ListDomainLists(c *gin.Context) {
-	lists, err := h.firewallService.GetDomainLists(c)
+	opts, ok := h.bindListOptions(c)
+	if !ok {
+		return
+	}
+
+	lists, err := h.firewallService.GetDomainLists(c.Request.Context(), opts)
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
-	domainLists := make([]DomainList, 0, len(lists))
-	for _, list := range lists {
-		domainLists = append(domainLists, DomainList{
-			ID:          list.ID,
-			Name:        list.Name,
-			DomainCount: list.DomainCount,
-		})
+	domainLists := make([]DomainList, 0, len(lists.Items))
+	for i := range lists.Items {
+		domainLists = append(domainLists, convertModelDomainListInfoToAPI(&lists.Items[i]))
 	}
 
 	c.JSON(http.StatusOK, ListDomainListsResponse{
 		DomainLists: domainLists,
+		NextCursor:  lists.NextCursor,
 	})
 }
 
@@ -161,13 +161,14 @@ func (h *handler) CreateFirewallRule(c *gin.Context) {
 		return
 	}
 
-	rule, err := h.firewallService.CreateFirewallRule(c, &model.FirewallRule{
+	rule, err := h.firewallService.CreateFirewallRule(c.Request.Context(), &model.FirewallRule{
 		Name:              req.Name,
 		DomainListID:      req.DomainListID,
 		Action:            getFirewallRuleAction(req.Action),
 		BlockResponseType: getFirewallRuleBlockResponseType(req.BlockResponseType),
 		BlockResponse:     convertAPIResourceRecordSetToModel(req.BlockResponse),
 		Priority:          req.Priority,
+		Tags:              req.Tags,
 	})
 	if err != nil {
 		h.handleError(c, err)
@@ -184,7 +185,7 @@ func (h *handler) DeleteFirewallRule(c *gin.Context) {
 		return
 	}
 
-	err = h.firewallService.DeleteFirewallRule(c, id)
+	err = h.firewallService.DeleteFirewallRule(c.Request.Context(), id)
 	if err != nil {
 		h.handleError(c, err)
 		return
@@ -200,7 +201,7 @@ func (h *handler) GetFirewallRule(c *gin.Context) {
 		return
 	}
 
-	rule, err := h.firewallService.GetFirewallRule(c, id)
+	rule, err := h.firewallService.GetFirewallRule(c.Request.Context(), id)
 	if err != nil {
 		h.handleError(c, err)
 		return
@@ -210,23 +211,30 @@ func (h *handler) GetFirewallRule(c *gin.Context) {
 }
 
 type ListFirewallRulesResponse struct {
-	Rules []FirewallRule `json:"rules"`
+	Rules      []FirewallRule `json:"rules"`
+	NextCursor string         `json:"nextCursor,omitempty"`
 }
 
 func (h *handler) ListFirewallRules(c *gin.Context) {
-	rules, err := h.firewallService.GetFirewallRules(c)
+	opts, ok := h.bindListOptions(c)
+	if !ok {
+		return
+	}
+
+	rules, err := h.firewallService.GetFirewallRules(c.Request.Context(), opts)
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
-	apiRules := make([]FirewallRule, 0, len(rules))
-	for _, rule := range rules {
+	apiRules := make([]FirewallRule, 0, len(rules.Items))
+	for _, rule := range rules.Items {
 		apiRules = append(apiRules, *convertModelFirewallRuleToAPI(&rule))
 	}
 
 	c.JSON(http.StatusOK, ListFirewallRulesResponse{
-		Rules: apiRules,
+		Rules:      apiRules,
+		NextCursor: rules.NextCursor,
 	})
 }
 
@@ -243,7 +251,7 @@ func (h *handler) UpdateFirewallRule(c *gin.Context) {
 		return
 	}
 
-	rule, err := h.firewallService.UpdateFirewallRule(c, &model.FirewallRule{
+	rule, err := h.firewallService.UpdateFirewallRule(c.Request.Context(), &model.FirewallRule{
 		ID:                id,
 		Name:              req.Name,
 		DomainListID:      req.DomainListID,
@@ -267,15 +275,11 @@ func (h *handler) RefreshDomainList(c *gin.Context) {
 		return
 	}
 
-	info, err := h.firewallService.RefreshManagedDomainList(c, id)
+	info, err := h.firewallService.RefreshManagedDomainList(c.Request.Context(), id)
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, DomainList{
-		ID:          info.ID,
-		Name:        info.Name,
-		DomainCount: info.DomainCount,
-	})
+	c.JSON(http.StatusOK, convertModelDomainListInfoToAPI(info))
 }
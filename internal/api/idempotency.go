@@ -0,0 +1,103 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+const (
+	idempotencyKeyHeader     = "Idempotency-Key"
+	idempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// idempotent replays the response to a POST request that is retried with the same
+// Idempotency-Key header, rather than handling the request again. Only successful responses
+// are stored, so a request that failed is handled again when it is retried. Reusing a key
+// for a different request, or while the first request is still being handled, is rejected.
+func (h *handler) idempotent(c *gin.Context) {
+	key := c.GetHeader(idempotencyKeyHeader)
+	if c.Request.Method != http.MethodPost || key == "" {
+		c.Next()
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		h.handleError(c, beaconerr.ErrInvalidArgument("failed to read request body", "body"))
+		c.Abort()
+		return
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	ctx := c.Request.Context()
+	requestHash := hashRequest(c.Request.Method, c.Request.URL.RequestURI(), body)
+	stored, err := h.idempotencyService.Begin(ctx, key, requestHash)
+	if err != nil {
+		h.handleError(c, err)
+		c.Abort()
+		return
+	}
+
+	if stored != nil {
+		c.Header(idempotentReplayedHeader, "true")
+		c.Data(stored.StatusCode, stored.ContentType, stored.Body)
+		c.Abort()
+		return
+	}
+
+	recorder := &responseRecorder{ResponseWriter: c.Writer}
+	c.Writer = recorder
+	c.Next()
+
+	// The outcome is stored even if the client went away, since that is when it retries.
+	ctx = context.WithoutCancel(ctx)
+	status := recorder.Status()
+	if status < http.StatusOK || status >= http.StatusMultipleChoices {
+		err = h.idempotencyService.Release(ctx, key)
+	} else {
+		err = h.idempotencyService.Complete(ctx, key, model.IdempotentResponse{
+			StatusCode:  status,
+			ContentType: recorder.Header().Get("Content-Type"),
+			Body:        recorder.body.Bytes(),
+		})
+	}
+	if err != nil {
+		h.logger.Error("failed to finish idempotent request", "err", err)
+	}
+}
+
+// hashRequest identifies a request by its method, path, query and body, to tell a retry of a
+// request from a different request made with the same key.
+func hashRequest(method string, uri string, body []byte) []byte {
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write([]byte(uri))
+	hash.Write([]byte{0})
+	hash.Write(body)
+	return hash.Sum(nil)
+}
+
+// responseRecorder keeps a copy of the body written to the response.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/log"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+// memoryIdempotencyService keeps idempotency keys in memory, without scoping them to a
+// principal.
+type memoryIdempotencyService struct {
+	hashes    map[string][]byte
+	responses map[string]*model.IdempotentResponse
+}
+
+func (m *memoryIdempotencyService) Begin(
+	_ context.Context,
+	key string,
+	requestHash []byte,
+) (*model.IdempotentResponse, error) {
+	hash, ok := m.hashes[key]
+	if !ok {
+		m.hashes[key] = requestHash
+		return nil, nil
+	}
+	if !bytes.Equal(hash, requestHash) {
+		return nil, beaconerr.ErrIdempotencyKeyMismatch("idempotency key was used for a different request")
+	}
+	if m.responses[key] == nil {
+		return nil, beaconerr.ErrIdempotencyKeyInUse("a request with this idempotency key is still in progress")
+	}
+	return m.responses[key], nil
+}
+
+func (m *memoryIdempotencyService) Complete(_ context.Context, key string, response model.IdempotentResponse) error {
+	m.responses[key] = &response
+	return nil
+}
+
+func (m *memoryIdempotencyService) Release(_ context.Context, key string) error {
+	delete(m.hashes, key)
+	return nil
+}
+
+func TestIdempotent(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	h := &handler{
+		logger: log.NewDiscardLogger(),
+		idempotencyService: &memoryIdempotencyService{
+			hashes:    map[string][]byte{},
+			responses: map[string]*model.IdempotentResponse{},
+		},
+	}
+
+	var created int
+	r := gin.New()
+	r.POST("/v1/firewall/rules", h.idempotent, func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		assert.NoError(t, err)
+		if string(body) == `{"fail":true}` {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Code: "InvalidArgument"})
+			return
+		}
+		created++
+		c.JSON(http.StatusCreated, gin.H{"id": created})
+	})
+
+	post := func(key string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/v1/firewall/rules", bytes.NewBufferString(body))
+		if key != "" {
+			req.Header.Set(idempotencyKeyHeader, key)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	first := post("key-1", `{"name":"block"}`)
+	require.Equal(t, http.StatusCreated, first.Code)
+	assert.JSONEq(t, `{"id":1}`, first.Body.String())
+
+	replayed := post("key-1", `{"name":"block"}`)
+	assert.Equal(t, http.StatusCreated, replayed.Code)
+	assert.JSONEq(t, `{"id":1}`, replayed.Body.String())
+	assert.Equal(t, "true", replayed.Header().Get(idempotentReplayedHeader))
+	assert.Equal(t, 1, created)
+
+	mismatch := post("key-1", `{"name":"allow"}`)
+	assert.Equal(t, http.StatusBadRequest, mismatch.Code)
+	assert.Contains(t, mismatch.Body.String(), "IdempotencyKeyMismatch")
+
+	// Failed requests are not stored, so the key can be used again.
+	assert.Equal(t, http.StatusBadRequest, post("key-2", `{"fail":true}`).Code)
+	assert.Equal(t, http.StatusCreated, post("key-2", `{"name":"allow"}`).Code)
+
+	// Requests without a key are never replayed.
+	post("", `{"name":"block"}`)
+	post("", `{"name":"block"}`)
+	assert.Equal(t, 4, created)
+}
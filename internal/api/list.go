@@ -0,0 +1,33 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+// bindListOptions parses the paging, filtering and sorting query parameters of a list
+// request. It writes the error response and returns false if they are invalid.
+func (h *handler) bindListOptions(c *gin.Context) (model.ListOptions, bool) {
+	var query ListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.handleGinBindingError(c, err)
+		return model.ListOptions{}, false
+	}
+
+	filter, err := model.ParseTagFilter(query.Tags)
+	if err != nil {
+		h.handleError(c, beaconerr.ErrInvalidArgument(err.Error(), "tag"))
+		return model.ListOptions{}, false
+	}
+
+	return model.ListOptions{
+		Limit:      query.Limit,
+		Cursor:     query.Cursor,
+		NamePrefix: query.NamePrefix,
+		SortBy:     query.Sort,
+		Order:      model.SortOrder(query.Order),
+		Tags:       filter,
+	}, true
+}
@@ -1,45 +1,246 @@
 package api
 
-import "github.com/google/uuid"
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type ErrorResponse struct {
-	Code    string `json:"code"`
+	Code       string            `json:"code"`
+	Message    string            `json:"message"`
+	Violations []ChangeViolation `json:"violations,omitempty"`
+}
+
+type ChangeViolation struct {
+	Action  int    `json:"action"`
+	Name    string `json:"name,omitempty"`
+	Type    string `json:"type,omitempty"`
 	Message string `json:"message"`
 }
 
 type CreateZoneRequest struct {
-	Name string `json:"name" binding:"required"`
+	Name               string            `json:"name"               binding:"required"`
+	DelegateFromParent bool              `json:"delegateFromParent"`
+	Template           string            `json:"template"`
+	Tags               map[string]string `json:"tags"`
 }
 
 type Zone struct {
-	ID                     string `json:"id"`
-	Name                   string `json:"name"`
-	ResourceRecordSetCount int    `json:"resourceRecordSetCount"`
+	ID                     string            `json:"id"`
+	Name                   string            `json:"name"`
+	ResourceRecordSetCount int               `json:"resourceRecordSetCount"`
+	Tags                   map[string]string `json:"tags,omitempty"`
+}
+
+type CreateReverseZonesRequest struct {
+	CIDR               string            `json:"cidr"               binding:"required"`
+	DelegateFromParent bool              `json:"delegateFromParent"`
+	Template           string            `json:"template"`
+	Tags               map[string]string `json:"tags"`
+}
+
+// ListQuery holds the paging, filtering and sorting parameters of a list request. Each tag
+// filter is either key:value, matching a tag exactly, or key, matching any value of the key.
+type ListQuery struct {
+	Limit      int      `form:"limit"`
+	Cursor     string   `form:"cursor"`
+	NamePrefix string   `form:"namePrefix"`
+	Sort       string   `form:"sort"`
+	Order      string   `form:"order"`
+	Tags       []string `form:"tag"`
+}
+
+type ListResourceRecordSetsQuery struct {
+	Type string `form:"type"`
+}
+
+type UpdateTagsRequest struct {
+	Set    map[string]string `json:"set"`
+	Remove []string          `json:"remove"`
+}
+
+type TagsResponse struct {
+	Tags map[string]string `json:"tags"`
+}
+
+type DeleteZoneQuery struct {
+	Force bool `form:"force"`
+}
+
+type DeletedZone struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	DeletedAt  time.Time `json:"deletedAt"`
+	PurgeAfter time.Time `json:"purgeAfter"`
+}
+
+type ListDeletedZonesResponse struct {
+	DeletedZones []DeletedZone `json:"deletedZones"`
+	NextCursor   string        `json:"nextCursor,omitempty"`
 }
 
 type ListZonesResponse struct {
-	Zones []Zone `json:"zones"`
+	Zones      []Zone `json:"zones"`
+	NextCursor string `json:"nextCursor,omitempty"`
 }
 
 type ListResourceRecordSetsResponse struct {
 	ResourceRecordSets []ResourceRecordSet `json:"resourceRecordSets"`
+	NextCursor         string              `json:"nextCursor,omitempty"`
 }
 
 type UpsertResourceRecordSetRequest struct {
 	ResourceRecordSet
 }
 
+type ResourceRecordSetMutationQuery struct {
+	DryRun           bool `form:"dryRun"`
+	SyncPTR          bool `form:"syncPtr"`
+	ValidatePolicies bool `form:"validatePolicies"`
+}
+
+// PolicyValidationQuery holds the query parameters of the zone-wide changes that can check the
+// policies in the TXT record sets they upsert.
+type PolicyValidationQuery struct {
+	ValidatePolicies bool `form:"validatePolicies"`
+}
+
+type UpsertResourceRecordSetResponse struct {
+	ResourceRecordSet
+	Warnings []LintFinding `json:"warnings,omitempty"`
+}
+
 type ResourceRecordSet struct {
-	Name            string           `json:"name"            binding:"required"`
-	Type            string           `json:"type"            binding:"required"`
-	TTL             uint32           `json:"ttl"             binding:"required"`
-	ResourceRecords []ResourceRecord `json:"resourceRecords" binding:"required,min=1"`
+	Name            string            `json:"name"              binding:"required"`
+	Type            string            `json:"type"              binding:"required"`
+	TTL             uint32            `json:"ttl"               binding:"required"`
+	ResourceRecords []ResourceRecord  `json:"resourceRecords"   binding:"required,min=1"`
+	Tags            map[string]string `json:"tags,omitempty"`
+	Comment         string            `json:"comment,omitempty"`
 }
 
 type ResourceRecord struct {
 	Value string `json:"value" binding:"required"`
 }
 
+type ImportZoneRequest struct {
+	ZoneFile string `json:"zoneFile" binding:"required"`
+}
+
+type ImportZoneResponse struct {
+	ChangeID           string              `json:"changeId"`
+	ResourceRecordSets []ResourceRecordSet `json:"resourceRecordSets"`
+	SkippedRecords     []SkippedRecord     `json:"skippedRecords"`
+	Warnings           []LintFinding       `json:"warnings,omitempty"`
+}
+
+type SkippedRecord struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+type ZoneVersion struct {
+	Version   int       `json:"version"`
+	ChangeID  string    `json:"changeId"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type ListZoneVersionsResponse struct {
+	Versions   []ZoneVersion `json:"versions"`
+	NextCursor string        `json:"nextCursor,omitempty"`
+}
+
+type DiffZoneVersionsRequest struct {
+	From int `form:"from" binding:"required,min=1"`
+	To   int `form:"to"   binding:"required,min=1"`
+}
+
+type ZoneDiff struct {
+	Added    []ResourceRecordSet             `json:"added"`
+	Removed  []ResourceRecordSet             `json:"removed"`
+	Modified []ResourceRecordSetModification `json:"modified"`
+}
+
+type ResourceRecordSetModification struct {
+	Before         ResourceRecordSet `json:"before"`
+	After          ResourceRecordSet `json:"after"`
+	AddedRecords   []ResourceRecord  `json:"addedRecords"`
+	RemovedRecords []ResourceRecord  `json:"removedRecords"`
+}
+
+type LintZoneQuery struct {
+	Resolve bool `form:"resolve"`
+}
+
+type LintFinding struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Message  string `json:"message"`
+}
+
+type LintZoneResponse struct {
+	Findings []LintFinding `json:"findings"`
+}
+
+type RollbackZoneRequest struct {
+	Version int `json:"version" binding:"required,min=1"`
+}
+
+type ChangeInfo struct {
+	ID          string     `json:"id"`
+	Status      string     `json:"status"`
+	SubmittedAt *time.Time `json:"submittedAt,omitempty"`
+}
+
+type ZoneTemplate struct {
+	ID                 string              `json:"id"`
+	Name               string              `json:"name"`
+	Description        string              `json:"description,omitempty"`
+	ResourceRecordSets []ResourceRecordSet `json:"resourceRecordSets"`
+	CreatedAt          time.Time           `json:"createdAt"`
+	UpdatedAt          time.Time           `json:"updatedAt"`
+}
+
+type CreateZoneTemplateRequest struct {
+	Name               string              `json:"name"               binding:"required"`
+	Description        string              `json:"description"`
+	ResourceRecordSets []ResourceRecordSet `json:"resourceRecordSets" binding:"required,min=1,dive"`
+}
+
+type UpdateZoneTemplateRequest struct {
+	Description        string              `json:"description"`
+	ResourceRecordSets []ResourceRecordSet `json:"resourceRecordSets" binding:"required,min=1,dive"`
+}
+
+type ListZoneTemplatesResponse struct {
+	ZoneTemplates []ZoneTemplate `json:"zoneTemplates"`
+	NextCursor    string         `json:"nextCursor,omitempty"`
+}
+
+type ApplyZoneTemplateRequest struct {
+	Zones []string `json:"zones" binding:"required,min=1"`
+}
+
+type ApplyZoneTemplateQuery struct {
+	DryRun bool `form:"dryRun"`
+}
+
+type ZoneTemplateDrift struct {
+	ZoneName string   `json:"zoneName"`
+	Drift    ZoneDiff `json:"drift"`
+	ChangeID string   `json:"changeId,omitempty"`
+}
+
+type ApplyZoneTemplateResponse struct {
+	Zones []ZoneTemplateDrift `json:"zones"`
+}
+
 type FirewallRule struct {
 	ID                string             `json:"id"`
 	DomainListID      string             `json:"domainListId"`
@@ -47,6 +248,7 @@ type FirewallRule struct {
 	BlockResponseType *string            `json:"blockResponseType,omitempty" binding:"firewallRuleBlockResponseType"`
 	BlockResponse     *ResourceRecordSet `json:"blockResponse,omitempty"`
 	Priority          uint               `json:"priority"`
+	Tags              map[string]string  `json:"tags,omitempty"`
 }
 
 type AddDomainsToDomainListRequest struct {
@@ -64,13 +266,15 @@ type ListDomainListDomainsResponse struct {
 
 type ListDomainListsResponse struct {
 	DomainLists []DomainList `json:"domainLists"`
+	NextCursor  string       `json:"nextCursor,omitempty"`
 }
 
 type CreateDomainListRequest struct {
-	Name      string   `json:"name"                binding:"required"`
-	IsManaged bool     `json:"isManaged"`
-	Domains   []string `json:"domains"`
-	SourceURL *string  `json:"sourceUrl,omitempty"`
+	Name      string            `json:"name"                binding:"required"`
+	IsManaged bool              `json:"isManaged"`
+	Domains   []string          `json:"domains"`
+	SourceURL *string           `json:"sourceUrl,omitempty"`
+	Tags      map[string]string `json:"tags"`
 }
 
 type FirewallRuleRequest struct {
@@ -80,10 +284,209 @@ type FirewallRuleRequest struct {
 	BlockResponseType *string            `json:"blockResponseType,omitempty" binding:"firewallRuleBlockResponseType"`
 	BlockResponse     *ResourceRecordSet `json:"blockResponse,omitempty"`
 	Priority          uint               `json:"priority"                    binding:"required"`
+	// Tags are only applied when the rule is created. Use the tags endpoint to change them.
+	Tags map[string]string `json:"tags"`
 }
 
 type DomainList struct {
-	ID          uuid.UUID `json:"id"`
-	Name        string    `json:"name"`
-	DomainCount int       `json:"domainCount"`
+	ID          uuid.UUID         `json:"id"`
+	Name        string            `json:"name"`
+	DomainCount int               `json:"domainCount"`
+	Tags        map[string]string `json:"tags,omitempty"`
+}
+
+type APIKey struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+}
+
+// CreateAPIKeyRequest creates a key bound to Roles. A key without roles is not allowed to
+// do anything until roles are bound to it.
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name"      binding:"required"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+	Roles     []string   `json:"roles"`
+}
+
+// CreateAPIKeyResponse holds the new key. Key is the secret to authenticate with and is not
+// returned again.
+type CreateAPIKeyResponse struct {
+	APIKey
+	Key string `json:"key"`
+}
+
+type ListAPIKeysResponse struct {
+	APIKeys    []APIKey `json:"apiKeys"`
+	NextCursor string   `json:"nextCursor,omitempty"`
+}
+
+type Webhook struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// CreateWebhookRequest subscribes URL to the events whose types match any of the Events
+// patterns, such as zone.* or firewall.rule.create. A secret is generated unless one is
+// given.
+type CreateWebhookRequest struct {
+	Name   string   `json:"name"   binding:"required"`
+	URL    string   `json:"url"    binding:"required"`
+	Events []string `json:"events" binding:"required"`
+	Secret string   `json:"secret"`
+}
+
+// CreateWebhookResponse holds the new webhook. Secret signs its deliveries and is not
+// returned again.
+type CreateWebhookResponse struct {
+	Webhook
+	Secret string `json:"secret"`
+}
+
+type ListWebhooksResponse struct {
+	Webhooks   []Webhook `json:"webhooks"`
+	NextCursor string    `json:"nextCursor,omitempty"`
+}
+
+type WebhookDelivery struct {
+	ID             uuid.UUID       `json:"id"`
+	WebhookID      uuid.UUID       `json:"webhookId"`
+	EventID        uuid.UUID       `json:"eventId"`
+	EventType      string          `json:"eventType"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"nextAttemptAt,omitempty"`
+	LastAttemptAt  *time.Time      `json:"lastAttemptAt,omitempty"`
+	ResponseStatus *int            `json:"responseStatus,omitempty"`
+	Error          string          `json:"error,omitempty"`
+	CreatedAt      time.Time       `json:"createdAt"`
+}
+
+type ListWebhookDeliveriesResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+	NextCursor string            `json:"nextCursor,omitempty"`
+}
+
+type Permission struct {
+	Actions   []string `json:"actions"   binding:"required"`
+	Resources []string `json:"resources" binding:"required"`
+}
+
+type Role struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	Permissions []Permission `json:"permissions"`
+	BuiltIn     bool         `json:"builtIn"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	UpdatedAt   time.Time    `json:"updatedAt"`
+}
+
+type CreateRoleRequest struct {
+	Name        string       `json:"name"        binding:"required"`
+	Description string       `json:"description"`
+	Permissions []Permission `json:"permissions" binding:"dive"`
+}
+
+// UpdateRoleRequest replaces the description and permissions of a role.
+type UpdateRoleRequest struct {
+	Description string       `json:"description"`
+	Permissions []Permission `json:"permissions" binding:"dive"`
+}
+
+type ListRolesResponse struct {
+	Roles      []Role `json:"roles"`
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+type Subject struct {
+	Type string `json:"type" binding:"required"`
+	ID   string `json:"id"   binding:"required"`
+}
+
+type RoleBinding struct {
+	ID        uuid.UUID `json:"id"`
+	Role      string    `json:"role"`
+	Subject   Subject   `json:"subject"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type CreateRoleBindingRequest struct {
+	Role    string  `json:"role"    binding:"required"`
+	Subject Subject `json:"subject" binding:"required"`
+}
+
+type ListRoleBindingsResponse struct {
+	RoleBindings []RoleBinding `json:"roleBindings"`
+	NextCursor   string        `json:"nextCursor,omitempty"`
+}
+
+// ListRoleBindingsQuery restricts the bindings listed to those of one subject. Both fields
+// must be set together.
+type ListRoleBindingsQuery struct {
+	SubjectType string `form:"subjectType"`
+	SubjectID   string `form:"subjectId"`
+}
+
+// WhoAmIResponse describes the principal a request is authenticated as.
+type WhoAmIResponse struct {
+	Subject     Subject      `json:"subject"`
+	Name        string       `json:"name"`
+	Groups      []string     `json:"groups,omitempty"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// OIDCProviderResponse describes how users sign in through OIDC. Only Enabled is set when
+// OIDC is not configured.
+type OIDCProviderResponse struct {
+	Enabled  bool     `json:"enabled"`
+	Issuer   string   `json:"issuer,omitempty"`
+	ClientID string   `json:"clientId,omitempty"`
+	Scopes   []string `json:"scopes,omitempty"`
+}
+
+type AuditEntry struct {
+	ID        int64           `json:"id"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Actor     Subject         `json:"actor"`
+	ActorName string          `json:"actorName"`
+	SourceIP  string          `json:"sourceIp,omitempty"`
+	Operation string          `json:"operation"`
+	Target    string          `json:"target"`
+	Before    json.RawMessage `json:"before,omitempty"`
+	After     json.RawMessage `json:"after,omitempty"`
+}
+
+type ListAuditEntriesResponse struct {
+	Entries    []AuditEntry `json:"entries"`
+	NextCursor string       `json:"nextCursor,omitempty"`
+}
+
+// ListAuditEntriesQuery filters the audit log. ActorType and ActorID must be set together.
+// Target also matches the resources below it, so a zone matches the changes to its record
+// sets. Since is inclusive and Until exclusive.
+type ListAuditEntriesQuery struct {
+	ActorType string     `form:"actorType"`
+	ActorID   string     `form:"actorId"`
+	Operation string     `form:"operation"`
+	Target    string     `form:"target"`
+	Since     *time.Time `form:"since"`
+	Until     *time.Time `form:"until"`
+}
+
+// ChangeNotification is the data of each event of the change stream. A notification of type
+// "reset" tells the watcher that the changes after the event it resumed from were lost.
+type ChangeNotification struct {
+	ID        int64           `json:"id"`
+	Type      string          `json:"type"`
+	Resource  string          `json:"resource,omitempty"`
+	Data      json.RawMessage `json:"data,omitempty"`
+	CreatedAt time.Time       `json:"createdAt"`
 }
@@ -0,0 +1,259 @@
+package api
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/davidseybold/beacondns/internal/auth"
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+)
+
+// DefaultRateLimitGroup is the name under which RateLimits holds the limit of the route groups
+// that have no limit of their own.
+const DefaultRateLimitGroup = "default"
+
+// AuthRateLimitGroup is the name of the route group of the sign-in configuration, whose limit
+// also applies to failed authentications from a client IP.
+const AuthRateLimitGroup = "auth"
+
+// rateLimitSweepInterval is how often the buckets of clients that have gone quiet are dropped.
+const rateLimitSweepInterval = time.Minute
+
+// rateLimitGroups are the names of the route groups that can be given a rate limit. They
+// are named after the first segment of their path.
+var rateLimitGroups = []string{
+	DefaultRateLimitGroup,
+	AuthRateLimitGroup,
+	"zones",
+	"reverse-zones",
+	"deleted-zones",
+	"zone-templates",
+	"firewall",
+	"api-keys",
+	"roles",
+	"role-bindings",
+	"webhooks",
+	"events",
+	"audit",
+	"whoami",
+}
+
+// RateLimit allows Rate requests per second on average, in bursts of up to Burst requests.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimits holds the rate limit of each route group, keyed by the name of the group.
+// Requests are not rate limited when it is empty.
+type RateLimits map[string]RateLimit
+
+// ParseRateLimits parses rate limits of the form "group=rate:burst,...", such as
+// "default=20:40,firewall=5:10". Rate is in requests per second and can be fractional.
+func ParseRateLimits(s string) (RateLimits, error) {
+	limits := RateLimits{}
+	if strings.TrimSpace(s) == "" {
+		return limits, nil
+	}
+
+	for part := range strings.SplitSeq(s, ",") {
+		group, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate limit %q: must be of the form group=rate:burst", part)
+		}
+		if !slices.Contains(rateLimitGroups, group) {
+			return nil, fmt.Errorf("invalid rate limit %q: unknown route group %q", part, group)
+		}
+
+		rateValue, burstValue, ok := strings.Cut(value, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate limit %q: must be of the form group=rate:burst", part)
+		}
+
+		rate, err := strconv.ParseFloat(rateValue, 64)
+		if err != nil || rate <= 0 || math.IsInf(rate, 0) {
+			return nil, fmt.Errorf("invalid rate limit %q: rate must be a positive number", part)
+		}
+
+		burst, err := strconv.Atoi(burstValue)
+		if err != nil || burst < 1 {
+			return nil, fmt.Errorf("invalid rate limit %q: burst must be a positive integer", part)
+		}
+
+		limits[group] = RateLimit{Rate: rate, Burst: burst}
+	}
+
+	return limits, nil
+}
+
+// RateLimiter keeps a token bucket for every client of every rate limited route group. A
+// single RateLimiter is shared by every listener of the controller, so that a client has the
+// same budget whichever API it calls.
+type RateLimiter struct {
+	limits RateLimits
+	now    func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[bucketKey]*tokenBucket
+	lastSweep time.Time
+}
+
+type bucketKey struct {
+	group  string
+	client string
+}
+
+type tokenBucket struct {
+	limit   RateLimit
+	tokens  float64
+	updated time.Time
+}
+
+func NewRateLimiter(limits RateLimits) *RateLimiter {
+	return &RateLimiter{
+		limits:  limits,
+		now:     time.Now,
+		buckets: map[bucketKey]*tokenBucket{},
+	}
+}
+
+// limit returns the rate limit of group, and false if the group is not rate limited.
+func (l *RateLimiter) limit(group string) (RateLimit, bool) {
+	if limit, ok := l.limits[group]; ok {
+		return limit, true
+	}
+	limit, ok := l.limits[DefaultRateLimitGroup]
+	return limit, ok
+}
+
+// Allow takes a token from the bucket of client in group, and returns a throttling error if
+// the bucket is empty.
+func (l *RateLimiter) Allow(group string, client string) error {
+	if ok, retryAfter := l.allow(group, client); !ok {
+		return beaconerr.ErrThrottling("rate limit exceeded", retryAfter)
+	}
+	return nil
+}
+
+// Authenticate calls authenticate unless the client at ip has failed to authenticate so often
+// that its bucket in the auth group is empty. A token is reserved before authenticate is
+// called and given back if it succeeds, so that only failed authentications cost a token
+// while concurrent attempts cannot all get past an almost empty bucket. Requests with missing
+// or invalid credentials are thereby limited per IP before they cost a lookup, while
+// authenticated requests are limited per principal by the route groups.
+func (l *RateLimiter) Authenticate(
+	ip string,
+	authenticate func() (*auth.Principal, error),
+) (*auth.Principal, error) {
+	client := IPRateLimitClient(ip)
+	if ok, retryAfter := l.allow(AuthRateLimitGroup, client); !ok {
+		return nil, beaconerr.ErrThrottling("too many failed authentication attempts", retryAfter)
+	}
+
+	principal, err := authenticate()
+	if err != nil {
+		return nil, err
+	}
+
+	l.refund(AuthRateLimitGroup, client)
+	return principal, nil
+}
+
+// PrincipalRateLimitClient returns the client a principal is rate limited as.
+func PrincipalRateLimitClient(principal *auth.Principal) string {
+	return string(principal.Subject.Type) + ":" + principal.Subject.ID
+}
+
+// IPRateLimitClient returns the client an unauthenticated request from ip is rate limited as.
+func IPRateLimitClient(ip string) string {
+	return "ip:" + ip
+}
+
+// allow takes a token from the bucket of client in group. If the bucket is empty, it returns
+// false along with how long it takes for the next token to be added.
+func (l *RateLimiter) allow(group string, client string) (bool, time.Duration) {
+	limit, ok := l.limit(group)
+	if !ok {
+		return true, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Sub(l.lastSweep) >= rateLimitSweepInterval {
+		l.sweep(now)
+	}
+
+	key := bucketKey{group: group, client: client}
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{limit: limit, tokens: float64(limit.Burst), updated: now}
+		l.buckets[key] = bucket
+	}
+
+	bucket.refill(now)
+	if bucket.tokens < 1 {
+		return false, time.Duration((1 - bucket.tokens) / limit.Rate * float64(time.Second))
+	}
+
+	bucket.tokens--
+	return true, 0
+}
+
+// refund gives back a token taken from the bucket of client in group.
+func (l *RateLimiter) refund(group string, client string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if bucket, ok := l.buckets[bucketKey{group: group, client: client}]; ok {
+		bucket.refill(l.now())
+		bucket.tokens = min(float64(bucket.limit.Burst), bucket.tokens+1)
+	}
+}
+
+// sweep drops the buckets that have filled up again, since a new bucket starts out full.
+func (l *RateLimiter) sweep(now time.Time) {
+	for key, bucket := range l.buckets {
+		if bucket.refill(now); bucket.tokens >= float64(bucket.limit.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(b.updated).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	b.tokens = min(float64(b.limit.Burst), b.tokens+elapsed*b.limit.Rate)
+	b.updated = now
+}
+
+// rateLimit returns middleware that limits the rate of requests to the route group named
+// group. Authenticated requests are limited per principal, so that every API key and user
+// has a bucket of its own, and other requests per client IP.
+func (h *handler) rateLimit(group string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		client := IPRateLimitClient(c.ClientIP())
+		if principal, ok := auth.PrincipalFromContext(c.Request.Context()); ok {
+			client = PrincipalRateLimitClient(principal)
+		}
+
+		if err := h.rateLimiter.Allow(group, client); err != nil {
+			h.handleError(c, err)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
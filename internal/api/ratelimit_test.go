@@ -0,0 +1,244 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davidseybold/beacondns/internal/audit"
+	"github.com/davidseybold/beacondns/internal/auth"
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/log"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+func TestParseRateLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    RateLimits
+		wantErr bool
+	}{
+		{name: "empty", value: "", want: RateLimits{}},
+		{
+			name:  "several groups",
+			value: "default=20:40, firewall=0.5:5",
+			want: RateLimits{
+				DefaultRateLimitGroup: {Rate: 20, Burst: 40},
+				"firewall":            {Rate: 0.5, Burst: 5},
+			},
+		},
+		{name: "unknown group", value: "dns=1:1", wantErr: true},
+		{name: "missing burst", value: "zones=10", wantErr: true},
+		{name: "missing group", value: "10:20", wantErr: true},
+		{name: "zero rate", value: "zones=0:10", wantErr: true},
+		{name: "zero burst", value: "zones=10:0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRateLimits(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := NewRateLimiter(RateLimits{
+		DefaultRateLimitGroup: {Rate: 10, Burst: 10},
+		"firewall":            {Rate: 1, Burst: 2},
+	})
+	l.now = func() time.Time { return now }
+
+	// The bucket starts out full, so a burst is allowed.
+	for range 2 {
+		ok, _ := l.allow("firewall", "apiKey:a")
+		require.True(t, ok)
+	}
+
+	ok, retryAfter := l.allow("firewall", "apiKey:a")
+	assert.False(t, ok)
+	assert.Equal(t, time.Second, retryAfter)
+
+	// Other clients and other groups have buckets of their own.
+	ok, _ = l.allow("firewall", "apiKey:b")
+	assert.True(t, ok)
+	ok, _ = l.allow("zones", "apiKey:a")
+	assert.True(t, ok)
+
+	now = now.Add(500 * time.Millisecond)
+	ok, retryAfter = l.allow("firewall", "apiKey:a")
+	assert.False(t, ok)
+	assert.Equal(t, 500*time.Millisecond, retryAfter)
+
+	now = now.Add(500 * time.Millisecond)
+	ok, _ = l.allow("firewall", "apiKey:a")
+	assert.True(t, ok)
+
+	// Buckets that have filled up again are dropped.
+	now = now.Add(time.Hour)
+	ok, _ = l.allow("zones", "apiKey:c")
+	assert.True(t, ok)
+	assert.Len(t, l.buckets, 1)
+}
+
+func TestRateLimiterWithoutLimits(t *testing.T) {
+	l := NewRateLimiter(RateLimits{"firewall": {Rate: 1, Burst: 1}})
+
+	for range 100 {
+		ok, _ := l.allow("zones", "apiKey:a")
+		require.True(t, ok)
+	}
+	assert.Empty(t, l.buckets)
+}
+
+// countingAuthService authenticates the API key "beacon_valid" only, and counts the lookups
+// made.
+type countingAuthService struct {
+	auth.Service
+
+	lookups int
+}
+
+func (f *countingAuthService) Authenticate(_ context.Context, token string) (*auth.Principal, error) {
+	f.lookups++
+	if token != "beacon_valid" {
+		return nil, beaconerr.ErrUnauthorized("invalid API key")
+	}
+	return &auth.Principal{Subject: model.Subject{Type: model.SubjectTypeAPIKey, ID: "valid"}}, nil
+}
+
+func TestAuthenticateRateLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	authService := &countingAuthService{}
+	h := &handler{
+		logger:      log.NewDiscardLogger(),
+		authService: authService,
+		rateLimiter: NewRateLimiter(RateLimits{AuthRateLimitGroup: {Rate: 1, Burst: 3}}),
+	}
+
+	r := NewEngine()
+	r.GET("/v1/whoami", h.authenticate, func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	get := func(ip string, token string, forwardedFor ...string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/v1/whoami", nil)
+		req.RemoteAddr = ip + ":1234"
+		req.Header.Set("Authorization", "Bearer "+token)
+		for _, addr := range forwardedFor {
+			req.Header.Add("X-Forwarded-For", addr)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	// Valid keys do not take from the bucket of their IP.
+	for range 5 {
+		require.Equal(t, http.StatusOK, get("192.0.2.1", "beacon_valid").Code)
+	}
+
+	for range 3 {
+		require.Equal(t, http.StatusUnauthorized, get("192.0.2.1", "beacon_invalid").Code)
+	}
+
+	// Once the burst of failures is used up, requests are throttled without a lookup.
+	lookups := authService.lookups
+	for range 10 {
+		w := get("192.0.2.1", "beacon_invalid")
+		require.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, "1", w.Header().Get("Retry-After"))
+	}
+	assert.Equal(t, lookups, authService.lookups)
+
+	// Forwarding headers are set by the client, so they do not give it a bucket of its own.
+	for i := range 10 {
+		w := get("192.0.2.1", "beacon_invalid", fmt.Sprintf("198.51.100.%d", i))
+		require.Equal(t, http.StatusTooManyRequests, w.Code)
+	}
+	assert.Equal(t, lookups, authService.lookups)
+
+	// Other IPs have buckets of their own.
+	assert.Equal(t, http.StatusUnauthorized, get("192.0.2.2", "beacon_invalid").Code)
+}
+
+func TestRateLimiterAuthenticateConcurrent(t *testing.T) {
+	l := NewRateLimiter(RateLimits{AuthRateLimitGroup: {Rate: 1, Burst: 3}})
+	l.now = func() time.Time { return time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	// The lookups block until every attempt has been made, so that none fails before the
+	// others have got past the throttle.
+	var lookups atomic.Int32
+	release := make(chan struct{})
+	errs := make(chan error)
+	for range 10 {
+		go func() {
+			_, err := l.Authenticate("192.0.2.1", func() (*auth.Principal, error) {
+				lookups.Add(1)
+				<-release
+				return nil, beaconerr.ErrUnauthorized("invalid API key")
+			})
+			errs <- err
+		}()
+	}
+
+	for range 7 {
+		assert.True(t, beaconerr.IsThrottlingError(<-errs))
+	}
+	close(release)
+	for range 3 {
+		assert.True(t, beaconerr.IsUnauthorizedError(<-errs))
+	}
+	assert.Equal(t, int32(3), lookups.Load())
+
+	// A successful authentication gives its token back.
+	principal := &auth.Principal{Subject: model.Subject{Type: model.SubjectTypeAPIKey, ID: "valid"}}
+	limiter := NewRateLimiter(RateLimits{AuthRateLimitGroup: {Rate: 1, Burst: 1}})
+	for range 3 {
+		_, err := limiter.Authenticate("192.0.2.1", func() (*auth.Principal, error) { return principal, nil })
+		require.NoError(t, err)
+	}
+}
+
+func TestAuthenticateSourceIP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	h := &handler{
+		logger:      log.NewDiscardLogger(),
+		authService: &countingAuthService{},
+		rateLimiter: NewRateLimiter(RateLimits{}),
+	}
+
+	var actor audit.Actor
+	r := NewEngine()
+	r.GET("/v1/whoami", h.authenticate, func(c *gin.Context) {
+		actor = audit.ActorFromContext(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/whoami", nil)
+	req.RemoteAddr = "192.0.2.1:1234"
+	req.Header.Set("Authorization", "Bearer beacon_valid")
+	req.Header.Set("X-Forwarded-For", "198.51.100.1")
+	req.Header.Set("X-Real-IP", "198.51.100.2")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "192.0.2.1", actor.SourceIP)
+}
@@ -0,0 +1,182 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/davidseybold/beacondns/internal/auth"
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+func (h *handler) CreateRole(c *gin.Context) {
+	var body CreateRoleRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	role, err := h.authService.CreateRole(c.Request.Context(), &model.Role{
+		Name:        body.Name,
+		Description: body.Description,
+		Permissions: convertAPIPermissionsToModel(body.Permissions),
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, convertModelRoleToAPI(role))
+}
+
+func (h *handler) UpdateRole(c *gin.Context) {
+	var body UpdateRoleRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	role, err := h.authService.UpdateRole(c.Request.Context(), &model.Role{
+		Name:        c.Param("roleName"),
+		Description: body.Description,
+		Permissions: convertAPIPermissionsToModel(body.Permissions),
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, convertModelRoleToAPI(role))
+}
+
+func (h *handler) GetRole(c *gin.Context) {
+	role, err := h.authService.GetRole(c.Request.Context(), c.Param("roleName"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, convertModelRoleToAPI(role))
+}
+
+func (h *handler) ListRoles(c *gin.Context) {
+	opts, ok := h.bindListOptions(c)
+	if !ok {
+		return
+	}
+
+	roles, err := h.authService.ListRoles(c.Request.Context(), opts)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	responseBody := ListRolesResponse{
+		Roles:      make([]Role, len(roles.Items)),
+		NextCursor: roles.NextCursor,
+	}
+	for i := range roles.Items {
+		responseBody.Roles[i] = *convertModelRoleToAPI(&roles.Items[i])
+	}
+
+	c.JSON(http.StatusOK, responseBody)
+}
+
+func (h *handler) DeleteRole(c *gin.Context) {
+	if err := h.authService.DeleteRole(c.Request.Context(), c.Param("roleName")); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *handler) CreateRoleBinding(c *gin.Context) {
+	var body CreateRoleBindingRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	binding, err := h.authService.CreateRoleBinding(c.Request.Context(), body.Role, model.Subject{
+		Type: model.SubjectType(body.Subject.Type),
+		ID:   body.Subject.ID,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, convertModelRoleBindingToAPI(binding))
+}
+
+func (h *handler) ListRoleBindings(c *gin.Context) {
+	var query ListRoleBindingsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	var subject *model.Subject
+	switch {
+	case query.SubjectType != "" && query.SubjectID != "":
+		subject = &model.Subject{Type: model.SubjectType(query.SubjectType), ID: query.SubjectID}
+	case query.SubjectType != "" || query.SubjectID != "":
+		h.handleError(c, beaconerr.ErrInvalidArgument("subjectType and subjectId must be set together", "subject"))
+		return
+	}
+
+	opts, ok := h.bindListOptions(c)
+	if !ok {
+		return
+	}
+
+	bindings, err := h.authService.ListRoleBindings(c.Request.Context(), subject, opts)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	responseBody := ListRoleBindingsResponse{
+		RoleBindings: make([]RoleBinding, len(bindings.Items)),
+		NextCursor:   bindings.NextCursor,
+	}
+	for i := range bindings.Items {
+		responseBody.RoleBindings[i] = *convertModelRoleBindingToAPI(&bindings.Items[i])
+	}
+
+	c.JSON(http.StatusOK, responseBody)
+}
+
+func (h *handler) DeleteRoleBinding(c *gin.Context) {
+	id, err := getIDParam(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	if err = h.authService.DeleteRoleBinding(c.Request.Context(), id); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+// WhoAmI returns the principal the request is authenticated as, so that clients can check
+// what they are allowed to do.
+func (h *handler) WhoAmI(c *gin.Context) {
+	principal, ok := auth.PrincipalFromContext(c.Request.Context())
+	if !ok {
+		h.handleError(c, beaconerr.ErrUnauthorized("request is not authenticated"))
+		return
+	}
+
+	c.JSON(http.StatusOK, WhoAmIResponse{
+		Subject:     convertModelSubjectToAPI(principal.Subject),
+		Name:        principal.Name,
+		Groups:      principal.Groups,
+		Permissions: convertModelPermissionsToAPI(principal.Permissions),
+	})
+}
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *handler) UpdateZoneTags(c *gin.Context) {
+	zoneName := c.Param("zoneName")
+
+	var req UpdateTagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	tags, err := h.zoneService.UpdateZoneTags(c.Request.Context(), zoneName, req.Set, req.Remove)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, TagsResponse{Tags: tags})
+}
+
+func (h *handler) UpdateDomainListTags(c *gin.Context) {
+	id, err := getIDParam(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	var req UpdateTagsRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	tags, err := h.firewallService.UpdateDomainListTags(c.Request.Context(), id, req.Set, req.Remove)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, TagsResponse{Tags: tags})
+}
+
+func (h *handler) UpdateFirewallRuleTags(c *gin.Context) {
+	id, err := getIDParam(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	var req UpdateTagsRequest
+	if err = c.ShouldBindJSON(&req); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	tags, err := h.firewallService.UpdateFirewallRuleTags(c.Request.Context(), id, req.Set, req.Remove)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, TagsResponse{Tags: tags})
+}
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+func (h *handler) CreateZoneTemplate(c *gin.Context) {
+	var body CreateZoneTemplateRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	template, err := h.zoneService.CreateZoneTemplate(c.Request.Context(), &model.ZoneTemplate{
+		Name:               body.Name,
+		Description:        body.Description,
+		ResourceRecordSets: convertAPIResourceRecordSetsToModel(body.ResourceRecordSets),
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, convertModelZoneTemplateToAPI(template))
+}
+
+func (h *handler) UpdateZoneTemplate(c *gin.Context) {
+	var body UpdateZoneTemplateRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	template, err := h.zoneService.UpdateZoneTemplate(c.Request.Context(), &model.ZoneTemplate{
+		Name:               c.Param("templateName"),
+		Description:        body.Description,
+		ResourceRecordSets: convertAPIResourceRecordSetsToModel(body.ResourceRecordSets),
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, convertModelZoneTemplateToAPI(template))
+}
+
+func (h *handler) GetZoneTemplate(c *gin.Context) {
+	template, err := h.zoneService.GetZoneTemplate(c.Request.Context(), c.Param("templateName"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, convertModelZoneTemplateToAPI(template))
+}
+
+func (h *handler) ListZoneTemplates(c *gin.Context) {
+	opts, ok := h.bindListOptions(c)
+	if !ok {
+		return
+	}
+
+	templates, err := h.zoneService.ListZoneTemplates(c.Request.Context(), opts)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	responseBody := ListZoneTemplatesResponse{
+		ZoneTemplates: make([]ZoneTemplate, len(templates.Items)),
+		NextCursor:    templates.NextCursor,
+	}
+
+	for i := range templates.Items {
+		responseBody.ZoneTemplates[i] = *convertModelZoneTemplateToAPI(&templates.Items[i])
+	}
+
+	c.JSON(http.StatusOK, responseBody)
+}
+
+func (h *handler) DeleteZoneTemplate(c *gin.Context) {
+	err := h.zoneService.DeleteZoneTemplate(c.Request.Context(), c.Param("templateName"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *handler) ApplyZoneTemplate(c *gin.Context) {
+	var body ApplyZoneTemplateRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	var query ApplyZoneTemplateQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	drifts, err := h.zoneService.ApplyZoneTemplate(
+		c.Request.Context(),
+		c.Param("templateName"),
+		body.Zones,
+		query.DryRun,
+	)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	responseBody := ApplyZoneTemplateResponse{
+		Zones: make([]ZoneTemplateDrift, len(drifts)),
+	}
+
+	for i := range drifts {
+		responseBody.Zones[i] = ZoneTemplateDrift{
+			ZoneName: drifts[i].ZoneName,
+			Drift:    *convertModelZoneDiffToAPI(&drifts[i].Drift),
+		}
+		if drifts[i].ChangeID != nil {
+			responseBody.Zones[i].ChangeID = drifts[i].ChangeID.String()
+		}
+	}
+
+	c.JSON(http.StatusOK, responseBody)
+}
@@ -0,0 +1,140 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+func (h *handler) CreateWebhook(c *gin.Context) {
+	var req CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	webhook, err := h.webhookService.CreateWebhook(c.Request.Context(), &model.Webhook{
+		Name:   req.Name,
+		URL:    req.URL,
+		Events: req.Events,
+		Secret: req.Secret,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, CreateWebhookResponse{
+		Webhook: *convertModelWebhookToAPI(webhook),
+		Secret:  webhook.Secret,
+	})
+}
+
+func (h *handler) ListWebhooks(c *gin.Context) {
+	opts, ok := h.bindListOptions(c)
+	if !ok {
+		return
+	}
+
+	webhooks, err := h.webhookService.ListWebhooks(c.Request.Context(), opts)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	responseBody := ListWebhooksResponse{
+		Webhooks:   make([]Webhook, len(webhooks.Items)),
+		NextCursor: webhooks.NextCursor,
+	}
+	for i := range webhooks.Items {
+		responseBody.Webhooks[i] = *convertModelWebhookToAPI(&webhooks.Items[i])
+	}
+
+	c.JSON(http.StatusOK, responseBody)
+}
+
+func (h *handler) GetWebhook(c *gin.Context) {
+	id, err := getIDParam(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	webhook, err := h.webhookService.GetWebhook(c.Request.Context(), id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, convertModelWebhookToAPI(webhook))
+}
+
+func (h *handler) DeleteWebhook(c *gin.Context) {
+	id, err := getIDParam(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	if err = h.webhookService.DeleteWebhook(c.Request.Context(), id); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *handler) ListWebhookDeliveries(c *gin.Context) {
+	id, err := getIDParam(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	opts, ok := h.bindListOptions(c)
+	if !ok {
+		return
+	}
+
+	deliveries, err := h.webhookService.ListWebhookDeliveries(c.Request.Context(), id, opts)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	responseBody := ListWebhookDeliveriesResponse{
+		Deliveries: make([]WebhookDelivery, len(deliveries.Items)),
+		NextCursor: deliveries.NextCursor,
+	}
+	for i := range deliveries.Items {
+		responseBody.Deliveries[i] = *convertModelWebhookDeliveryToAPI(&deliveries.Items[i])
+	}
+
+	c.JSON(http.StatusOK, responseBody)
+}
+
+func (h *handler) RedeliverWebhookDelivery(c *gin.Context) {
+	id, err := getIDParam(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	deliveryID, err := uuid.Parse(c.Param("deliveryId"))
+	if err != nil {
+		h.handleError(c, beaconerr.ErrInvalidArgument("invalid parameter", "deliveryId"))
+		return
+	}
+
+	delivery, err := h.webhookService.RedeliverWebhookDelivery(c.Request.Context(), id, deliveryID)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, convertModelWebhookDeliveryToAPI(delivery))
+}
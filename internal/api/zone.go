@@ -8,25 +8,31 @@ import (
 
 	"github.com/davidseybold/beacondns/internal/beaconerr"
 	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/zone"
 )
 
+// zoneFileContentType is the media type registered for master files by RFC 4027.
+const zoneFileContentType = "text/dns"
+
 func (h *handler) ListZones(c *gin.Context) {
-	zones, err := h.zoneService.ListZones(c.Request.Context())
+	opts, ok := h.bindListOptions(c)
+	if !ok {
+		return
+	}
+
+	zones, err := h.zoneService.ListZones(c.Request.Context(), opts)
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
 	responseBody := ListZonesResponse{
-		Zones: make([]Zone, len(zones)),
+		Zones:      make([]Zone, len(zones.Items)),
+		NextCursor: zones.NextCursor,
 	}
 
-	for i, zone := range zones {
-		responseBody.Zones[i] = Zone{
-			ID:                     zone.ID.String(),
-			Name:                   zone.Name,
-			ResourceRecordSetCount: zone.ResourceRecordSetCount,
-		}
+	for i := range zones.Items {
+		responseBody.Zones[i] = convertModelZoneInfoToAPI(&zones.Items[i])
 	}
 
 	c.JSON(http.StatusOK, responseBody)
@@ -39,17 +45,45 @@ func (h *handler) CreateZone(c *gin.Context) {
 		return
 	}
 
-	res, err := h.zoneService.CreateZone(c.Request.Context(), body.Name)
+	res, err := h.zoneService.CreateZone(c.Request.Context(), body.Name, zone.CreateZoneOptions{
+		DelegateFromParent: body.DelegateFromParent,
+		Template:           body.Template,
+		Tags:               body.Tags,
+	})
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, Zone{
-		ID:                     res.ID.String(),
-		Name:                   res.Name,
-		ResourceRecordSetCount: res.ResourceRecordSetCount,
+	c.JSON(http.StatusCreated, convertModelZoneInfoToAPI(res))
+}
+
+func (h *handler) CreateReverseZones(c *gin.Context) {
+	var body CreateReverseZonesRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	zones, err := h.zoneService.CreateReverseZones(c.Request.Context(), body.CIDR, zone.CreateZoneOptions{
+		DelegateFromParent: body.DelegateFromParent,
+		Template:           body.Template,
+		Tags:               body.Tags,
 	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	responseBody := ListZonesResponse{
+		Zones: make([]Zone, len(zones)),
+	}
+
+	for i := range zones {
+		responseBody.Zones[i] = convertModelZoneInfoToAPI(&zones[i])
+	}
+
+	c.JSON(http.StatusCreated, responseBody)
 }
 
 func (h *handler) GetZone(c *gin.Context) {
@@ -61,11 +95,7 @@ func (h *handler) GetZone(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, Zone{
-		ID:                     zone.ID.String(),
-		Name:                   zone.Name,
-		ResourceRecordSetCount: zone.ResourceRecordSetCount,
-	})
+	c.JSON(http.StatusOK, convertModelZoneInfoToAPI(zone))
 }
 
 func (h *handler) UpsertResourceRecordSet(c *gin.Context) {
@@ -82,15 +112,49 @@ func (h *handler) UpsertResourceRecordSet(c *gin.Context) {
 		return
 	}
 
+	var query ResourceRecordSetMutationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
 	rrSet := convertAPIResourceRecordSetToModel(&body.ResourceRecordSet)
 
-	newRRSet, err := h.zoneService.UpsertResourceRecordSet(c.Request.Context(), zoneName, rrSet)
+	if query.DryRun {
+		diff, err := h.zoneService.PlanUpsertResourceRecordSet(
+			c.Request.Context(),
+			zoneName,
+			rrSet,
+			zone.ResourceRecordSetOptions{ValidatePolicies: query.ValidatePolicies},
+		)
+		if err != nil {
+			h.handleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, convertModelZoneDiffToAPI(diff))
+		return
+	}
+
+	newRRSet, warnings, err := h.zoneService.UpsertResourceRecordSet(
+		c.Request.Context(),
+		zoneName,
+		rrSet,
+		zone.ResourceRecordSetOptions{SyncPTR: query.SyncPTR, ValidatePolicies: query.ValidatePolicies},
+	)
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, convertModelResourceRecordSetToAPI(newRRSet))
+	responseBody := UpsertResourceRecordSetResponse{
+		ResourceRecordSet: *convertModelResourceRecordSetToAPI(newRRSet),
+	}
+	if len(warnings) > 0 {
+		responseBody.Warnings = convertModelLintFindingsToAPI(warnings)
+	}
+
+	c.JSON(http.StatusOK, responseBody)
 }
 
 func (h *handler) ListResourceRecordSets(c *gin.Context) {
@@ -101,35 +165,87 @@ func (h *handler) ListResourceRecordSets(c *gin.Context) {
 		return
 	}
 
-	rrsets, err := h.zoneService.ListResourceRecordSets(c.Request.Context(), zoneName)
+	opts, ok := h.bindListOptions(c)
+	if !ok {
+		return
+	}
+
+	var query ListResourceRecordSetsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	rrsets, err := h.zoneService.ListResourceRecordSets(
+		c.Request.Context(),
+		zoneName,
+		model.RRType(strings.ToUpper(query.Type)),
+		opts,
+	)
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
-	responseBody := ListResourceRecordSetsResponse{
-		ResourceRecordSets: make([]ResourceRecordSet, len(rrsets)),
+	c.JSON(http.StatusOK, ListResourceRecordSetsResponse{
+		ResourceRecordSets: convertModelResourceRecordSetsToAPI(rrsets.Items),
+		NextCursor:         rrsets.NextCursor,
+	})
+}
+
+func (h *handler) DeleteZone(c *gin.Context) {
+	zoneName := c.Param("zoneName")
+
+	if zoneName == "" {
+		h.handleError(c, beaconerr.ErrInvalidArgument("zone name is required", "zoneName"))
+		return
 	}
 
-	for i, rrset := range rrsets {
-		responseBody.ResourceRecordSets[i] = ResourceRecordSet{
-			Name:            rrset.Name,
-			Type:            string(rrset.Type),
-			TTL:             rrset.TTL,
-			ResourceRecords: make([]ResourceRecord, len(rrset.ResourceRecords)),
-		}
+	var query DeleteZoneQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	err := h.zoneService.DeleteZone(c.Request.Context(), zoneName, query.Force)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *handler) ListDeletedZones(c *gin.Context) {
+	opts, ok := h.bindListOptions(c)
+	if !ok {
+		return
+	}
+
+	zones, err := h.zoneService.ListDeletedZones(c.Request.Context(), opts)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
 
-		for j, record := range rrset.ResourceRecords {
-			responseBody.ResourceRecordSets[i].ResourceRecords[j] = ResourceRecord{
-				Value: record.Value,
-			}
+	responseBody := ListDeletedZonesResponse{
+		DeletedZones: make([]DeletedZone, len(zones.Items)),
+		NextCursor:   zones.NextCursor,
+	}
+
+	for i, zone := range zones.Items {
+		responseBody.DeletedZones[i] = DeletedZone{
+			ID:         zone.ID.String(),
+			Name:       zone.Name,
+			DeletedAt:  zone.DeletedAt,
+			PurgeAfter: zone.PurgeAfter,
 		}
 	}
 
 	c.JSON(http.StatusOK, responseBody)
 }
 
-func (h *handler) DeleteZone(c *gin.Context) {
+func (h *handler) RestoreZone(c *gin.Context) {
 	zoneName := c.Param("zoneName")
 
 	if zoneName == "" {
@@ -137,13 +253,13 @@ func (h *handler) DeleteZone(c *gin.Context) {
 		return
 	}
 
-	err := h.zoneService.DeleteZone(c.Request.Context(), zoneName)
+	zone, err := h.zoneService.RestoreZone(c.Request.Context(), zoneName)
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusNoContent, nil)
+	c.JSON(http.StatusOK, convertModelZoneInfoToAPI(zone))
 }
 
 func (h *handler) DeleteResourceRecordSet(c *gin.Context) {
@@ -168,7 +284,30 @@ func (h *handler) DeleteResourceRecordSet(c *gin.Context) {
 		return
 	}
 
-	err := h.zoneService.DeleteResourceRecordSet(c.Request.Context(), zoneName, name, rrType)
+	var query ResourceRecordSetMutationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	if query.DryRun {
+		diff, err := h.zoneService.PlanDeleteResourceRecordSet(c.Request.Context(), zoneName, name, rrType)
+		if err != nil {
+			h.handleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, convertModelZoneDiffToAPI(diff))
+		return
+	}
+
+	err := h.zoneService.DeleteResourceRecordSet(
+		c.Request.Context(),
+		zoneName,
+		name,
+		rrType,
+		zone.ResourceRecordSetOptions{SyncPTR: query.SyncPTR},
+	)
 	if err != nil {
 		h.handleError(c, err)
 		return
@@ -202,3 +341,204 @@ func (h *handler) GetResourceRecordSet(c *gin.Context) {
 
 	c.JSON(http.StatusOK, convertModelResourceRecordSetToAPI(rrSet))
 }
+
+func (h *handler) ImportZone(c *gin.Context) {
+	zoneName := c.Param("zoneName")
+
+	if zoneName == "" {
+		h.handleError(c, beaconerr.ErrInvalidArgument("zone name is required", "zoneName"))
+		return
+	}
+
+	var body ImportZoneRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	var query PolicyValidationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	res, err := h.zoneService.ImportZone(
+		c.Request.Context(),
+		zoneName,
+		strings.NewReader(body.ZoneFile),
+		zone.ResourceRecordSetOptions{ValidatePolicies: query.ValidatePolicies},
+	)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	responseBody := ImportZoneResponse{
+		ChangeID:           res.ChangeID.String(),
+		ResourceRecordSets: make([]ResourceRecordSet, len(res.ResourceRecordSets)),
+		SkippedRecords:     make([]SkippedRecord, len(res.SkippedRecords)),
+	}
+
+	for i := range res.ResourceRecordSets {
+		responseBody.ResourceRecordSets[i] = *convertModelResourceRecordSetToAPI(&res.ResourceRecordSets[i])
+	}
+
+	for i, skipped := range res.SkippedRecords {
+		responseBody.SkippedRecords[i] = SkippedRecord{
+			Name:   skipped.Name,
+			Type:   skipped.Type,
+			Reason: skipped.Reason,
+		}
+	}
+
+	if len(res.Warnings) > 0 {
+		responseBody.Warnings = convertModelLintFindingsToAPI(res.Warnings)
+	}
+
+	c.JSON(http.StatusOK, responseBody)
+}
+
+func (h *handler) ExportZone(c *gin.Context) {
+	zoneName := c.Param("zoneName")
+
+	if zoneName == "" {
+		h.handleError(c, beaconerr.ErrInvalidArgument("zone name is required", "zoneName"))
+		return
+	}
+
+	zoneFile, err := h.zoneService.ExportZone(c.Request.Context(), zoneName)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Data(http.StatusOK, zoneFileContentType, zoneFile)
+}
+
+func (h *handler) LintZone(c *gin.Context) {
+	zoneName := c.Param("zoneName")
+
+	if zoneName == "" {
+		h.handleError(c, beaconerr.ErrInvalidArgument("zone name is required", "zoneName"))
+		return
+	}
+
+	var query LintZoneQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	findings, err := h.zoneService.LintZone(
+		c.Request.Context(),
+		zoneName,
+		zone.LintOptions{Resolve: query.Resolve},
+	)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	responseBody := LintZoneResponse{
+		Findings: convertModelLintFindingsToAPI(findings),
+	}
+
+	c.JSON(http.StatusOK, responseBody)
+}
+
+func (h *handler) ListZoneVersions(c *gin.Context) {
+	zoneName := c.Param("zoneName")
+
+	if zoneName == "" {
+		h.handleError(c, beaconerr.ErrInvalidArgument("zone name is required", "zoneName"))
+		return
+	}
+
+	opts, ok := h.bindListOptions(c)
+	if !ok {
+		return
+	}
+
+	versions, err := h.zoneService.ListZoneVersions(c.Request.Context(), zoneName, opts)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	responseBody := ListZoneVersionsResponse{
+		Versions:   make([]ZoneVersion, len(versions.Items)),
+		NextCursor: versions.NextCursor,
+	}
+
+	for i, version := range versions.Items {
+		responseBody.Versions[i] = ZoneVersion{
+			Version:   version.Version,
+			ChangeID:  version.ChangeID.String(),
+			Status:    string(version.Status),
+			CreatedAt: version.CreatedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, responseBody)
+}
+
+func (h *handler) DiffZoneVersions(c *gin.Context) {
+	zoneName := c.Param("zoneName")
+
+	if zoneName == "" {
+		h.handleError(c, beaconerr.ErrInvalidArgument("zone name is required", "zoneName"))
+		return
+	}
+
+	var query DiffZoneVersionsRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	diff, err := h.zoneService.DiffZoneVersions(c.Request.Context(), zoneName, query.From, query.To)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, convertModelZoneDiffToAPI(diff))
+}
+
+func (h *handler) RollbackZone(c *gin.Context) {
+	zoneName := c.Param("zoneName")
+
+	if zoneName == "" {
+		h.handleError(c, beaconerr.ErrInvalidArgument("zone name is required", "zoneName"))
+		return
+	}
+
+	var body RollbackZoneRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	var query PolicyValidationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		h.handleGinBindingError(c, err)
+		return
+	}
+
+	change, err := h.zoneService.RollbackZone(
+		c.Request.Context(),
+		zoneName,
+		body.Version,
+		zone.ResourceRecordSetOptions{ValidatePolicies: query.ValidatePolicies},
+	)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ChangeInfo{
+		ID:          change.ID.String(),
+		Status:      string(change.Status),
+		SubmittedAt: change.SubmittedAt,
+	})
+}
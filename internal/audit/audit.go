@@ -0,0 +1,95 @@
+// Package audit records the changes made through the services in the audit log, and
+// exports the log to a file.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/repository"
+)
+
+// Actor is who made a change, and where the request came from.
+type Actor struct {
+	Subject  model.Subject
+	Name     string
+	SourceIP string
+}
+
+// SystemActor is the actor of changes made without a request, such as the bootstrap API key
+// being created when the controller starts.
+var SystemActor = Actor{
+	Subject: model.Subject{Type: model.SubjectTypeSystem, ID: "controller"},
+	Name:    "controller",
+}
+
+type actorKey struct{}
+
+// WithActor returns a context carrying the actor that changes made with it are recorded
+// against.
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor carried by ctx, or SystemActor if there is none.
+func ActorFromContext(ctx context.Context) Actor {
+	if actor, ok := ctx.Value(actorKey{}).(Actor); ok {
+		return actor
+	}
+	return SystemActor
+}
+
+// Record appends an entry for a change to the audit log, with the actor carried by ctx.
+// before and after are the state of target on either side of the change, and nil on the
+// side where it did not exist. It must be called with the registry of the transaction that
+// makes the change, so that the entry is only kept if the change is.
+func Record(
+	ctx context.Context,
+	r repository.Registry,
+	operation string,
+	target string,
+	before any,
+	after any,
+) error {
+	beforeJSON, err := payload(before)
+	if err != nil {
+		return err
+	}
+
+	afterJSON, err := payload(after)
+	if err != nil {
+		return err
+	}
+
+	actor := ActorFromContext(ctx)
+	_, err = r.GetAuditRepository().CreateAuditEntry(ctx, &model.AuditEntry{
+		Actor:     actor.Subject,
+		ActorName: actor.Name,
+		SourceIP:  actor.SourceIP,
+		Operation: operation,
+		Target:    target,
+		Before:    beforeJSON,
+		After:     afterJSON,
+	})
+	return err
+}
+
+func payload(value any) (json.RawMessage, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode audit payload: %w", err)
+	}
+
+	// A nil pointer, slice or map is as absent as a nil interface.
+	if string(data) == "null" {
+		return nil, nil
+	}
+
+	return data, nil
+}
@@ -0,0 +1,51 @@
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+func TestActorFromContext(t *testing.T) {
+	assert.Equal(t, SystemActor, ActorFromContext(t.Context()))
+
+	actor := Actor{
+		Subject:  model.Subject{Type: model.SubjectTypeUser, ID: "jane@example.com"},
+		Name:     "jane@example.com",
+		SourceIP: "192.0.2.1",
+	}
+	assert.Equal(t, actor, ActorFromContext(WithActor(t.Context(), actor)))
+}
+
+func TestPayload(t *testing.T) {
+	var nilZone *model.Zone
+	var nilTags model.Tags
+
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{name: "nil", value: nil},
+		{name: "nil pointer", value: nilZone},
+		{name: "nil map", value: nilTags},
+		{name: "empty map", value: model.Tags{}, want: `{}`},
+		{name: "value", value: []string{"example.com."}, want: `["example.com."]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := payload(tt.value)
+
+			require.NoError(t, err)
+			if tt.want == "" {
+				assert.Nil(t, data)
+			} else {
+				assert.JSONEq(t, tt.want, string(data))
+			}
+		})
+	}
+}
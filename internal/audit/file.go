@@ -0,0 +1,158 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/davidseybold/beacondns/internal/log"
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/repository"
+)
+
+const (
+	fileExportInterval  = time.Second
+	fileExportBatchSize = 500
+	// tailChunkSize is how much of the file is read at a time when looking for its last line.
+	tailChunkSize = 64 * 1024
+)
+
+// FileExporter appends the entries of the audit log to a file as JSON lines, for shipping to
+// a log pipeline or SIEM. It picks up after the last entry in the file, so an existing file
+// is continued rather than rewritten when the controller restarts, and a new file starts
+// with the whole log.
+type FileExporter struct {
+	registry repository.Registry
+	path     string
+	logger   *slog.Logger
+}
+
+func NewFileExporter(registry repository.Registry, path string, l *slog.Logger) *FileExporter {
+	if l == nil {
+		l = log.NewDiscardLogger()
+	}
+
+	return &FileExporter{
+		registry: registry,
+		path:     path,
+		logger:   l,
+	}
+}
+
+// Start exports new entries every second until ctx is canceled.
+func (e *FileExporter) Start(ctx context.Context) error {
+	f, err := os.OpenFile(e.path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log file: %w", err)
+	}
+	defer f.Close()
+
+	lastID, err := lastExportedID(f)
+	if err != nil {
+		return fmt.Errorf("failed to resume audit log file %s: %w", e.path, err)
+	}
+
+	ticker := time.NewTicker(fileExportInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			lastID, err = e.export(ctx, f, lastID)
+			if err != nil {
+				e.logger.ErrorContext(ctx, "failed to export audit log", "error", err)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// export appends the entries after lastID to f and returns the id of the last entry written.
+func (e *FileExporter) export(ctx context.Context, f *os.File, lastID int64) (int64, error) {
+	for {
+		entries, err := e.registry.GetAuditRepository().ListAuditEntriesAfter(ctx, lastID, fileExportBatchSize)
+		if err != nil {
+			return lastID, err
+		}
+		if len(entries) == 0 {
+			return lastID, nil
+		}
+
+		var buf bytes.Buffer
+		encoder := json.NewEncoder(&buf)
+		for i := range entries {
+			if err = encoder.Encode(&entries[i]); err != nil {
+				return lastID, fmt.Errorf("failed to encode audit entry %d: %w", entries[i].ID, err)
+			}
+		}
+
+		if _, err = f.Write(buf.Bytes()); err != nil {
+			return lastID, fmt.Errorf("failed to write audit log file: %w", err)
+		}
+		if err = f.Sync(); err != nil {
+			return lastID, fmt.Errorf("failed to sync audit log file: %w", err)
+		}
+
+		lastID = entries[len(entries)-1].ID
+		if len(entries) < fileExportBatchSize {
+			return lastID, nil
+		}
+	}
+}
+
+// lastExportedID returns the id of the entry on the last line of f, or 0 if f is empty, and
+// leaves f positioned at its end. A partial last line, left by a write that was cut short,
+// is truncated so that the entry is written again in full.
+func lastExportedID(f *os.File) (int64, error) {
+	end, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+
+	var line []byte
+	for offset := end; offset > 0; {
+		size := min(offset, tailChunkSize)
+		offset -= size
+
+		chunk := make([]byte, size)
+		if _, err = f.ReadAt(chunk, offset); err != nil {
+			return 0, err
+		}
+		line = append(chunk, line...)
+
+		// Look for the start of the last line, skipping the newline that ends it.
+		if i := bytes.LastIndexByte(bytes.TrimSuffix(line, []byte("\n")), '\n'); i >= 0 {
+			line = line[i+1:]
+			break
+		}
+	}
+
+	if len(line) == 0 {
+		return 0, nil
+	}
+
+	if !bytes.HasSuffix(line, []byte("\n")) {
+		end -= int64(len(line))
+		if err = f.Truncate(end); err != nil {
+			return 0, err
+		}
+		if _, err = f.Seek(end, io.SeekStart); err != nil {
+			return 0, err
+		}
+		return lastExportedID(f)
+	}
+
+	var entry model.AuditEntry
+	if err = json.Unmarshal(line, &entry); err != nil || entry.ID == 0 {
+		return 0, errors.New("last line is not an audit entry")
+	}
+
+	return entry.ID, nil
+}
@@ -0,0 +1,69 @@
+package audit
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLastExportedID(t *testing.T) {
+	entry := func(id string) string {
+		return `{"id":` + id + `,"operation":"zone.create","target":"zone/example.com."}` + "\n"
+	}
+	long := `{"id":7,"after":"` + strings.Repeat("x", 3*tailChunkSize) + `"}` + "\n"
+
+	tests := []struct {
+		name     string
+		contents string
+		wantID   int64
+		wantRest string
+		wantErr  bool
+	}{
+		{name: "empty", contents: "", wantID: 0},
+		{name: "one entry", contents: entry("1"), wantID: 1, wantRest: entry("1")},
+		{name: "several entries", contents: entry("1") + entry("2"), wantID: 2, wantRest: entry("1") + entry("2")},
+		{name: "line longer than a chunk", contents: entry("6") + long, wantID: 7, wantRest: entry("6") + long},
+		{
+			name:     "partial last line",
+			contents: entry("1") + entry("2") + `{"id":3,"oper`,
+			wantID:   2,
+			wantRest: entry("1") + entry("2"),
+		},
+		{name: "only a partial line", contents: `{"id":1`, wantID: 0, wantRest: ""},
+		{name: "not an audit entry", contents: "hello\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "audit.jsonl")
+			require.NoError(t, os.WriteFile(path, []byte(tt.contents), 0600))
+
+			f, err := os.OpenFile(path, os.O_RDWR, 0600)
+			require.NoError(t, err)
+			defer f.Close()
+
+			id, err := lastExportedID(f)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, id)
+
+			// The file is left positioned at its end, after any partial line is dropped.
+			_, err = f.WriteString(entry("9"))
+			require.NoError(t, err)
+			_, err = f.Seek(0, io.SeekStart)
+			require.NoError(t, err)
+			contents, err := io.ReadAll(f)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRest+entry("9"), string(contents))
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+func (d *DefaultService) ListAuditEntries(
+	ctx context.Context,
+	filter model.AuditFilter,
+	opts model.ListOptions,
+) (model.Page[model.AuditEntry], error) {
+	if err := opts.Normalize(model.SortDescending, model.SortByCreatedAt); err != nil {
+		return model.Page[model.AuditEntry]{}, beaconerr.ErrInvalidArgument(err.Error(), "options")
+	}
+	if opts.NamePrefix != "" || !opts.Tags.IsZero() {
+		return model.Page[model.AuditEntry]{}, beaconerr.ErrInvalidArgument(
+			"audit entries cannot be filtered by name prefix or tags", "options")
+	}
+	if filter.Since != nil && filter.Until != nil && !filter.Since.Before(*filter.Until) {
+		return model.Page[model.AuditEntry]{}, beaconerr.ErrInvalidArgument("since must be before until", "since")
+	}
+
+	entries, err := d.repReg.GetAuditRepository().ListAuditEntries(ctx, filter, opts)
+	if err != nil {
+		return model.Page[model.AuditEntry]{}, listError("failed to list audit entries", err)
+	}
+
+	return FilterPage(ctx, entries, func(entry *model.AuditEntry) string {
+		return entry.Target
+	}), nil
+}
@@ -0,0 +1,306 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// jwksRefreshInterval limits how often the keys of an issuer are fetched again when a
+	// token is signed with a key that is not known yet, such as after a key rotation.
+	jwksRefreshInterval = time.Minute
+	// maxJWKSSize caps the size of discovery documents and key sets fetched from an issuer.
+	maxJWKSSize = 1 << 20
+)
+
+// jsonWebKey is a key of a JSON Web Key Set, as defined in RFC 7517.
+type jsonWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n"`
+	E         string `json:"e"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	Y         string `json:"y"`
+}
+
+type publicJWK struct {
+	keyID     string
+	algorithm string
+	key       crypto.PublicKey
+}
+
+// parseJWKS returns the signing keys of a JSON Web Key Set. Keys of unsupported types and
+// encryption keys are skipped.
+func parseJWKS(data []byte) ([]publicJWK, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("invalid JWKS: %w", err)
+	}
+
+	keys := make([]publicJWK, 0, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWKS key %q: %w", jwk.KeyID, err)
+		}
+		if key == nil {
+			continue
+		}
+
+		keys = append(keys, publicJWK{keyID: jwk.KeyID, algorithm: jwk.Algorithm, key: key})
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("invalid JWKS: no signing keys")
+	}
+
+	return keys, nil
+}
+
+// publicKey returns the public key of the JWK, or nil if the key type is not supported.
+func (k *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("RSA exponent out of range")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, nil
+		}
+
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+		if _, err = key.ECDH(); err != nil {
+			return nil, errors.New("EC point is not on the curve")
+		}
+		return key, nil
+	case "OKP":
+		if k.Curve != "Ed25519" {
+			return nil, nil
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, nil
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil || len(data) == 0 {
+		return nil, errors.New("invalid key parameter")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+// keySet holds the signing keys of an issuer. Keys from a file are loaded once. Keys from a
+// URL are fetched on first use and again when a token names a key that is not known, at
+// most once per jwksRefreshInterval, so that tokens naming made up keys cannot make every
+// request fetch them. Without either, the URL is discovered from the OpenID configuration of
+// the issuer.
+type keySet struct {
+	issuer     string
+	url        string
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu        sync.Mutex
+	keys      []publicJWK
+	fetchedAt time.Time
+	// fetching is closed once the fetch in progress, if any, is done.
+	fetching chan struct{}
+	fetchErr error
+}
+
+func newFileKeySet(path string) (*keySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWKS file: %w", err)
+	}
+
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &keySet{keys: keys, now: time.Now}, nil
+}
+
+func newRemoteKeySet(issuer string, url string, httpClient *http.Client) *keySet {
+	return &keySet{
+		issuer:     issuer,
+		url:        url,
+		httpClient: httpClient,
+		now:        time.Now,
+	}
+}
+
+// candidates returns the keys a token with the key ID and algorithm may be signed with.
+// Keys are fetched apart from the request, which only waits for them until ctx is done, so
+// that a request that is cancelled does not cancel the fetch for the others, and a slow
+// issuer does not hold up requests whose keys are known.
+func (s *keySet) candidates(ctx context.Context, keyID string, alg string) ([]publicJWK, error) {
+	s.mu.Lock()
+	keys := matchingKeys(s.keys, keyID, alg)
+	if len(keys) > 0 || s.httpClient == nil {
+		s.mu.Unlock()
+		return keys, nil
+	}
+
+	fetching := s.fetching
+	if fetching == nil {
+		if !s.fetchedAt.IsZero() && s.now().Sub(s.fetchedAt) < jwksRefreshInterval {
+			s.mu.Unlock()
+			return nil, nil
+		}
+
+		fetching = make(chan struct{})
+		s.fetching = fetching
+		s.fetchedAt = s.now()
+		go s.refresh(fetching)
+	}
+	s.mu.Unlock()
+
+	select {
+	case <-fetching:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fetchErr != nil {
+		return nil, s.fetchErr
+	}
+
+	return matchingKeys(s.keys, keyID, alg), nil
+}
+
+// refresh fetches the keys and closes done. The keys known so far are kept if the fetch
+// fails.
+func (s *keySet) refresh(done chan struct{}) {
+	fetched, err := s.fetch(context.Background())
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err == nil {
+		s.keys = fetched
+	}
+	s.fetchErr = err
+	s.fetching = nil
+	close(done)
+}
+
+func matchingKeys(keys []publicJWK, keyID string, alg string) []publicJWK {
+	var matching []publicJWK
+	for _, key := range keys {
+		if keyID != "" && key.keyID != keyID {
+			continue
+		}
+		if key.algorithm != "" && key.algorithm != alg {
+			continue
+		}
+		matching = append(matching, key)
+	}
+	return matching
+}
+
+func (s *keySet) fetch(ctx context.Context) ([]publicJWK, error) {
+	if s.url == "" {
+		var discovery struct {
+			Issuer  string `json:"issuer"`
+			JWKSURI string `json:"jwks_uri"`
+		}
+		discoveryURL := strings.TrimSuffix(s.issuer, "/") + "/.well-known/openid-configuration"
+		if err := s.getJSON(ctx, discoveryURL, &discovery); err != nil {
+			return nil, fmt.Errorf("failed to discover OIDC configuration: %w", err)
+		}
+		if discovery.Issuer != s.issuer {
+			return nil, fmt.Errorf("OIDC configuration is for issuer %q, not %q", discovery.Issuer, s.issuer)
+		}
+		if discovery.JWKSURI == "" {
+			return nil, errors.New("OIDC configuration has no jwks_uri")
+		}
+		s.url = discovery.JWKSURI
+	}
+
+	var raw json.RawMessage
+	if err := s.getJSON(ctx, s.url, &raw); err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+
+	return parseJWKS(raw)
+}
+
+func (s *keySet) getJSON(ctx context.Context, url string, result any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s from %s", resp.Status, url)
+	}
+
+	return json.NewDecoder(io.LimitReader(resp.Body, maxJWKSSize)).Decode(result)
+}
@@ -0,0 +1,226 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+)
+
+const (
+	DefaultOIDCUsernameClaim = "email"
+	DefaultOIDCGroupsClaim   = "groups"
+
+	// oidcClockSkew is how far the clocks of Beacon and the issuer may drift apart before
+	// a token is rejected as expired or not valid yet.
+	oidcClockSkew   = time.Minute
+	oidcHTTPTimeout = 10 * time.Second
+)
+
+var errInvalidToken = errors.New("invalid token")
+
+// OIDCConfig configures the validation of ID tokens issued to users by an OpenID Connect
+// provider.
+type OIDCConfig struct {
+	// Issuer is the issuer URL, which must match the iss claim of tokens exactly.
+	Issuer string
+	// ClientID is the client beaconctl signs in as, which must be an audience of tokens.
+	ClientID string
+	// JWKSURL is where the signing keys of the issuer are fetched from. It is discovered
+	// from the OpenID configuration of the issuer when neither it nor JWKSFile is set.
+	JWKSURL string
+	// JWKSFile holds the signing keys for setups that cannot reach the issuer.
+	JWKSFile string
+	// UsernameClaim names the user in role bindings. Tokens without an email_verified claim
+	// of true are rejected when it is email.
+	UsernameClaim string
+	// GroupsClaim lists the groups of the user, which can be named in role bindings too.
+	GroupsClaim string
+	// Scopes are the scopes clients should request when signing in.
+	Scopes []string
+}
+
+func (c *OIDCConfig) Validate() error {
+	if c.Issuer == "" {
+		return errors.New("OIDC issuer is required")
+	}
+	if c.ClientID == "" {
+		return errors.New("OIDC client ID is required")
+	}
+	if c.JWKSURL != "" && c.JWKSFile != "" {
+		return errors.New("only one of the OIDC JWKS URL and JWKS file can be set")
+	}
+	return nil
+}
+
+// OIDCProvider is what clients need to sign users in with the issuer.
+type OIDCProvider struct {
+	Issuer   string
+	ClientID string
+	Scopes   []string
+}
+
+// Identity is the user a verified ID token was issued to.
+type Identity struct {
+	Username string
+	Groups   []string
+}
+
+// OIDCVerifier verifies ID tokens against the configured issuer and its signing keys.
+type OIDCVerifier struct {
+	cfg  OIDCConfig
+	keys *keySet
+	now  func() time.Time
+}
+
+// NewOIDCVerifier returns a verifier for cfg. Keys from a JWKS file are loaded right away,
+// while remote keys are only fetched once the first token is verified, so that the
+// controller can start while the issuer is unreachable.
+func NewOIDCVerifier(cfg OIDCConfig) (*OIDCVerifier, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if cfg.UsernameClaim == "" {
+		cfg.UsernameClaim = DefaultOIDCUsernameClaim
+	}
+	if cfg.GroupsClaim == "" {
+		cfg.GroupsClaim = DefaultOIDCGroupsClaim
+	}
+
+	var keys *keySet
+	if cfg.JWKSFile != "" {
+		var err error
+		if keys, err = newFileKeySet(cfg.JWKSFile); err != nil {
+			return nil, err
+		}
+	} else {
+		keys = newRemoteKeySet(cfg.Issuer, cfg.JWKSURL, &http.Client{Timeout: oidcHTTPTimeout})
+	}
+
+	return &OIDCVerifier{cfg: cfg, keys: keys, now: time.Now}, nil
+}
+
+func (v *OIDCVerifier) Provider() *OIDCProvider {
+	return &OIDCProvider{
+		Issuer:   v.cfg.Issuer,
+		ClientID: v.cfg.ClientID,
+		Scopes:   v.cfg.Scopes,
+	}
+}
+
+// Verify checks the signature, issuer, audience and validity period of an ID token and
+// returns the identity it was issued to.
+func (v *OIDCVerifier) Verify(ctx context.Context, rawToken string) (*Identity, error) {
+	token, err := parseJWT(rawToken)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := v.keys.candidates(ctx, token.header.KeyID, token.header.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	verified := false
+	for _, key := range keys {
+		err = verifyJWTSignature(token.header.Algorithm, key.key, token.signingInput, token.signature)
+		if errors.Is(err, errUnsupportedAlgorithm) {
+			return nil, err
+		}
+		if err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, errInvalidSignature
+	}
+
+	if err = v.verifyClaims(token.claims); err != nil {
+		return nil, err
+	}
+
+	return v.identity(token.claims)
+}
+
+func (v *OIDCVerifier) verifyClaims(claims map[string]any) error {
+	if issuer, _ := claims["iss"].(string); issuer != v.cfg.Issuer {
+		return fmt.Errorf("%w: issued by %q", errInvalidToken, issuer)
+	}
+
+	if !slices.Contains(stringsClaim(claims["aud"]), v.cfg.ClientID) {
+		return fmt.Errorf("%w: not issued for client %q", errInvalidToken, v.cfg.ClientID)
+	}
+
+	now := v.now()
+
+	expiry, ok := timeClaim(claims["exp"])
+	if !ok {
+		return fmt.Errorf("%w: no expiry", errInvalidToken)
+	}
+	if now.After(expiry.Add(oidcClockSkew)) {
+		return fmt.Errorf("%w: expired", errInvalidToken)
+	}
+
+	if notBefore, hasNotBefore := timeClaim(claims["nbf"]); hasNotBefore && now.Add(oidcClockSkew).Before(notBefore) {
+		return fmt.Errorf("%w: not valid yet", errInvalidToken)
+	}
+
+	return nil
+}
+
+func (v *OIDCVerifier) identity(claims map[string]any) (*Identity, error) {
+	username, _ := claims[v.cfg.UsernameClaim].(string)
+	if username == "" {
+		return nil, fmt.Errorf("%w: no %s claim", errInvalidToken, v.cfg.UsernameClaim)
+	}
+
+	// An issuer that does not say the email is verified may let users claim any address.
+	if v.cfg.UsernameClaim == "email" {
+		if verified, _ := claims["email_verified"].(bool); !verified {
+			return nil, fmt.Errorf("%w: email %s is not verified", errInvalidToken, username)
+		}
+	}
+
+	return &Identity{
+		Username: username,
+		Groups:   stringsClaim(claims[v.cfg.GroupsClaim]),
+	}, nil
+}
+
+// stringsClaim returns the value of a claim that holds either a string or a list of them,
+// like aud.
+func stringsClaim(value any) []string {
+	switch value := value.(type) {
+	case string:
+		return []string{value}
+	case []any:
+		values := make([]string, 0, len(value))
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+func timeClaim(value any) (time.Time, bool) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	seconds, err := number.Float64()
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(int64(seconds), 0), true
+}
@@ -0,0 +1,349 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIssuer   = "https://sso.example.com"
+	testClientID = "beaconctl"
+)
+
+type testSigner struct {
+	keyID string
+	alg   string
+	key   crypto.Signer
+}
+
+func newTestRSASigner(t *testing.T, keyID string) *testSigner {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return &testSigner{keyID: keyID, alg: "RS256", key: key}
+}
+
+func (s *testSigner) jwk() map[string]string {
+	encode := base64.RawURLEncoding.EncodeToString
+	switch key := s.key.Public().(type) {
+	case *rsa.PublicKey:
+		return map[string]string{
+			"kty": "RSA", "kid": s.keyID, "use": "sig", "alg": s.alg,
+			"n": encode(key.N.Bytes()), "e": encode(big.NewInt(int64(key.E)).Bytes()),
+		}
+	case *ecdsa.PublicKey:
+		ecdhKey, _ := key.ECDH()
+		point := ecdhKey.Bytes()[1:]
+		return map[string]string{
+			"kty": "EC", "kid": s.keyID, "crv": "P-256",
+			"x": encode(point[:len(point)/2]), "y": encode(point[len(point)/2:]),
+		}
+	case ed25519.PublicKey:
+		return map[string]string{"kty": "OKP", "kid": s.keyID, "crv": "Ed25519", "x": encode(key)}
+	default:
+		panic("unsupported key")
+	}
+}
+
+func (s *testSigner) sign(t *testing.T, claims map[string]any) string {
+	header, err := json.Marshal(map[string]string{"alg": s.alg, "kid": s.keyID, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	var signature []byte
+	switch key := s.key.(type) {
+	case *rsa.PrivateKey:
+		digest := sha256.Sum256([]byte(signingInput))
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	case *ecdsa.PrivateKey:
+		digest := sha256.Sum256([]byte(signingInput))
+		var r, sig *big.Int
+		r, sig, err = ecdsa.Sign(rand.Reader, key, digest[:])
+		signature = append(r.FillBytes(make([]byte, 32)), sig.FillBytes(make([]byte, 32))...)
+	case ed25519.PrivateKey:
+		signature = ed25519.Sign(key, []byte(signingInput))
+	}
+	require.NoError(t, err)
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func writeJWKS(t *testing.T, signers ...*testSigner) string {
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, jwksJSON(t, signers...), 0600))
+	return path
+}
+
+func jwksJSON(t *testing.T, signers ...*testSigner) []byte {
+	keys := make([]map[string]string, len(signers))
+	for i, signer := range signers {
+		keys[i] = signer.jwk()
+	}
+	data, err := json.Marshal(map[string]any{"keys": keys})
+	require.NoError(t, err)
+	return data
+}
+
+func validClaims(now time.Time) map[string]any {
+	return map[string]any{
+		"iss":            testIssuer,
+		"aud":            testClientID,
+		"exp":            now.Add(time.Hour).Unix(),
+		"iat":            now.Unix(),
+		"email":          "jane@example.com",
+		"email_verified": true,
+		"groups":         []string{"dns-admins", "engineering"},
+	}
+}
+
+func TestOIDCVerifier_Verify(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	signer := newTestRSASigner(t, "rsa-1")
+	other := newTestRSASigner(t, "rsa-1")
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecSigner := &testSigner{keyID: "ec-1", alg: "ES256", key: ecKey}
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edSigner := &testSigner{keyID: "ed-1", alg: "EdDSA", key: edKey}
+
+	verifier, err := NewOIDCVerifier(OIDCConfig{
+		Issuer:   testIssuer,
+		ClientID: testClientID,
+		JWKSFile: writeJWKS(t, signer, ecSigner, edSigner),
+	})
+	require.NoError(t, err)
+	verifier.now = func() time.Time { return now }
+
+	alg := func(alg string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"` + alg + `","kid":"rsa-1"}`))
+	}
+
+	// claimChanges are applied to the valid claims, where nil removes a claim.
+	type claimChanges = map[string]any
+
+	tests := []struct {
+		name    string
+		signer  *testSigner
+		changes claimChanges
+		token   string
+		wantErr bool
+	}{
+		{name: "RS256", signer: signer},
+		{name: "ES256", signer: ecSigner},
+		{name: "EdDSA", signer: edSigner},
+		{name: "audience list", signer: signer, changes: claimChanges{"aud": []string{"other", testClientID}}},
+		{name: "expired within leeway", signer: signer, changes: claimChanges{"exp": now.Add(-time.Second).Unix()}},
+		{name: "expired", signer: signer, changes: claimChanges{"exp": now.Add(-time.Hour).Unix()}, wantErr: true},
+		{name: "no expiry", signer: signer, changes: claimChanges{"exp": nil}, wantErr: true},
+		{name: "not valid yet", signer: signer, changes: claimChanges{"nbf": now.Add(time.Hour).Unix()}, wantErr: true},
+		{name: "wrong issuer", signer: signer, changes: claimChanges{"iss": "https://evil.example.com"}, wantErr: true},
+		{name: "wrong audience", signer: signer, changes: claimChanges{"aud": "other"}, wantErr: true},
+		{name: "unverified email", signer: signer, changes: claimChanges{"email_verified": false}, wantErr: true},
+		{name: "email not said verified", signer: signer, changes: claimChanges{"email_verified": nil}, wantErr: true},
+		{name: "no username", signer: signer, changes: claimChanges{"email": nil}, wantErr: true},
+		{name: "signed with unknown key", signer: other, wantErr: true},
+		{name: "malformed", token: "not-a-token", wantErr: true},
+		{name: "alg none", token: alg("none") + ".e30.", wantErr: true},
+		{name: "alg HS256", token: alg("HS256") + ".e30.c2lnbmF0dXJl", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := tt.token
+			if tt.signer != nil {
+				claims := validClaims(now)
+				for name, value := range tt.changes {
+					if value == nil {
+						delete(claims, name)
+					} else {
+						claims[name] = value
+					}
+				}
+				token = tt.signer.sign(t, claims)
+			}
+
+			identity, verifyErr := verifier.Verify(t.Context(), token)
+			if tt.wantErr {
+				assert.Error(t, verifyErr)
+				return
+			}
+
+			require.NoError(t, verifyErr)
+			assert.Equal(t, "jane@example.com", identity.Username)
+			assert.Equal(t, []string{"dns-admins", "engineering"}, identity.Groups)
+		})
+	}
+}
+
+func TestOIDCVerifier_VerifyTamperedToken(t *testing.T) {
+	signer := newTestRSASigner(t, "rsa-1")
+	verifier, err := NewOIDCVerifier(OIDCConfig{
+		Issuer:   testIssuer,
+		ClientID: testClientID,
+		JWKSFile: writeJWKS(t, signer),
+	})
+	require.NoError(t, err)
+
+	token := signer.sign(t, validClaims(time.Now()))
+	forged := signer.sign(t, validClaims(time.Now().Add(24*time.Hour)))
+
+	// Take the claims of one token and the signature of another.
+	parts := strings.Split(token, ".")
+	forgedParts := strings.Split(forged, ".")
+	_, err = verifier.Verify(t.Context(), parts[0]+"."+forgedParts[1]+"."+parts[2])
+
+	assert.ErrorIs(t, err, errInvalidSignature)
+}
+
+func TestOIDCVerifier_Claims(t *testing.T) {
+	signer := newTestRSASigner(t, "rsa-1")
+	verifier, err := NewOIDCVerifier(OIDCConfig{
+		Issuer:        testIssuer,
+		ClientID:      testClientID,
+		JWKSFile:      writeJWKS(t, signer),
+		UsernameClaim: "preferred_username",
+		GroupsClaim:   "roles",
+	})
+	require.NoError(t, err)
+
+	claims := validClaims(time.Now())
+	claims["preferred_username"] = "jane"
+	claims["roles"] = "dns-admins"
+	claims["email_verified"] = false
+
+	identity, err := verifier.Verify(t.Context(), signer.sign(t, claims))
+
+	require.NoError(t, err)
+	assert.Equal(t, "jane", identity.Username)
+	assert.Equal(t, []string{"dns-admins"}, identity.Groups)
+}
+
+func TestOIDCVerifier_RemoteKeys(t *testing.T) {
+	first := newTestRSASigner(t, "first")
+	second := newTestRSASigner(t, "second")
+
+	var keys atomic.Pointer[[]byte]
+	initial := jwksJSON(t, first)
+	keys.Store(&initial)
+	var fetches atomic.Int32
+
+	var issuer string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			json.NewEncoder(w).Encode(map[string]string{"issuer": issuer, "jwks_uri": issuer + "/keys"})
+		case "/keys":
+			fetches.Add(1)
+			w.Write(*keys.Load())
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	issuer = server.URL
+
+	now := time.Now()
+	verifier, err := NewOIDCVerifier(OIDCConfig{Issuer: issuer, ClientID: testClientID})
+	require.NoError(t, err)
+	verifier.now = func() time.Time { return now }
+	verifier.keys.now = func() time.Time { return now }
+
+	claims := validClaims(now)
+	claims["iss"] = issuer
+
+	_, err = verifier.Verify(t.Context(), first.sign(t, claims))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), fetches.Load())
+
+	// Keys are fetched again for an unknown key, but at most once per refresh interval.
+	rotated := jwksJSON(t, first, second)
+	keys.Store(&rotated)
+
+	_, err = verifier.Verify(t.Context(), second.sign(t, claims))
+	require.Error(t, err)
+	assert.Equal(t, int32(1), fetches.Load())
+
+	now = now.Add(jwksRefreshInterval)
+	_, err = verifier.Verify(t.Context(), second.sign(t, claims))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), fetches.Load())
+
+	_, err = verifier.Verify(t.Context(), first.sign(t, claims))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), fetches.Load())
+}
+
+func TestOIDCVerifier_RemoteKeysCancelled(t *testing.T) {
+	signer := newTestRSASigner(t, "first")
+
+	release := make(chan struct{})
+	var fetches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fetches.Add(1)
+		<-release
+		w.Write(jwksJSON(t, signer))
+	}))
+	defer server.Close()
+
+	verifier, err := NewOIDCVerifier(OIDCConfig{Issuer: testIssuer, ClientID: testClientID, JWKSURL: server.URL})
+	require.NoError(t, err)
+	token := signer.sign(t, validClaims(time.Now()))
+
+	// A request that gives up waiting for the issuer does not cancel the fetch for the others.
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	_, err = verifier.Verify(ctx, token)
+	require.ErrorIs(t, err, context.Canceled)
+
+	close(release)
+	_, err = verifier.Verify(t.Context(), token)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), fetches.Load())
+}
+
+func TestNewOIDCVerifier_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  OIDCConfig
+	}{
+		{name: "no issuer", cfg: OIDCConfig{ClientID: testClientID}},
+		{name: "no client ID", cfg: OIDCConfig{Issuer: testIssuer}},
+		{
+			name: "JWKS URL and file",
+			cfg:  OIDCConfig{Issuer: testIssuer, ClientID: testClientID, JWKSURL: "https://x", JWKSFile: "/x"},
+		},
+		{name: "missing JWKS file", cfg: OIDCConfig{Issuer: testIssuer, ClientID: testClientID, JWKSFile: "/missing"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewOIDCVerifier(tt.cfg)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+// Principal is who a request is made by, along with the permissions of the roles bound to
+// them.
+type Principal struct {
+	Subject model.Subject
+	Name    string
+	// Groups are the groups of a user signed in through OIDC.
+	Groups      []string
+	Permissions []model.Permission
+}
+
+// Can reports whether the principal is allowed to perform action on resource.
+func (p *Principal) Can(action model.Action, resource string) bool {
+	return slices.ContainsFunc(p.Permissions, func(permission model.Permission) bool {
+		return permission.Allows(action, resource)
+	})
+}
+
+// CanGrant reports whether the principal is allowed to perform action on every resource that
+// pattern matches.
+func (p *Principal) CanGrant(action model.Action, pattern string) bool {
+	return slices.ContainsFunc(p.Permissions, func(permission model.Permission) bool {
+		return permission.Covers(action, pattern)
+	})
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a context carrying the principal that the services authorize
+// actions against.
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal carried by ctx.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
+
+// Authorize returns an access denied error unless the principal carried by ctx is allowed to
+// perform action on resource. A context without a principal is never authorized.
+func Authorize(ctx context.Context, action model.Action, resource string) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return beaconerr.ErrAccessDenied("request is not authenticated")
+	}
+
+	if !principal.Can(action, resource) {
+		return beaconerr.ErrAccessDenied(fmt.Sprintf("%s is not allowed to %s %s", principal.Name, action, resource))
+	}
+
+	return nil
+}
+
+// Can reports whether the principal carried by ctx is allowed to perform action on resource.
+// It is used to leave out the items of a list the principal may not read.
+func Can(ctx context.Context, action model.Action, resource string) bool {
+	principal, ok := PrincipalFromContext(ctx)
+	return ok && principal.Can(action, resource)
+}
+
+// FilterPage leaves out the items of page that the principal carried by ctx may not read.
+// The cursor of the page is kept, so a filtered page can have fewer items than requested,
+// or none, and still be followed by more.
+func FilterPage[T any](ctx context.Context, page model.Page[T], resource func(*T) string) model.Page[T] {
+	items := make([]T, 0, len(page.Items))
+	for i := range page.Items {
+		if Can(ctx, model.ActionRead, resource(&page.Items[i])) {
+			items = append(items, page.Items[i])
+		}
+	}
+	return model.Page[T]{Items: items, NextCursor: page.NextCursor}
+}
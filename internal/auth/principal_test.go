@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+func TestAuthorize(t *testing.T) {
+	ctx := WithPrincipal(context.Background(), &Principal{
+		Name: "ci",
+		Permissions: []model.Permission{
+			{Actions: []model.Action{model.ActionRead}, Resources: []string{model.ResourceAll}},
+			{Actions: []model.Action{model.ActionWrite}, Resources: []string{"zone/example.com."}},
+		},
+	})
+
+	assert.NoError(t, Authorize(ctx, model.ActionRead, model.ZoneResource("example.org.")))
+	assert.NoError(t, Authorize(ctx, model.ActionWrite,
+		model.ResourceRecordSetResource("example.com.", "www.example.com.", model.RRTypeA)))
+
+	err := Authorize(ctx, model.ActionWrite, model.ZoneResource("example.org."))
+	assert.True(t, beaconerr.IsAccessDeniedError(err))
+	assert.EqualError(t, err, "AccessDenied: ci is not allowed to write zone/example.org.")
+
+	err = Authorize(context.Background(), model.ActionRead, model.ZoneResource("example.com."))
+	assert.True(t, beaconerr.IsAccessDeniedError(err))
+}
+
+func TestFilterPage(t *testing.T) {
+	ctx := WithPrincipal(context.Background(), &Principal{
+		Name: "ci",
+		Permissions: []model.Permission{
+			{Actions: []model.Action{model.ActionRead}, Resources: []string{"zone/*.example.com."}},
+		},
+	})
+
+	page := model.Page[model.ZoneInfo]{
+		Items: []model.ZoneInfo{
+			{Name: "a.example.com."},
+			{Name: "example.org."},
+			{Name: "b.example.com."},
+		},
+		NextCursor: "next",
+	}
+
+	filtered := FilterPage(ctx, page, func(zone *model.ZoneInfo) string {
+		return model.ZoneResource(zone.Name)
+	})
+
+	assert.Equal(t, "next", filtered.NextCursor)
+	assert.Equal(t, []model.ZoneInfo{{Name: "a.example.com."}, {Name: "b.example.com."}}, filtered.Items)
+}
+
+func TestAuthorizeGrant(t *testing.T) {
+	ctx := WithPrincipal(context.Background(), &Principal{
+		Name: "dns-admin",
+		Permissions: []model.Permission{
+			{Actions: []model.Action{model.ActionAll}, Resources: []string{"zone/*.example.com."}},
+			{Actions: []model.Action{model.ActionWrite}, Resources: []string{"role/dns-*", "role-binding/dns-*"}},
+		},
+	})
+
+	assert.NoError(t, authorizeGrant(ctx, []model.Permission{
+		{Actions: []model.Action{model.ActionRead, model.ActionWrite}, Resources: []string{"zone/dev.example.com."}},
+		{Actions: []model.Action{model.ActionAll}, Resources: []string{"zone/*.example.com."}},
+	}))
+
+	for _, permission := range []model.Permission{
+		{Actions: []model.Action{model.ActionAll}, Resources: []string{model.ResourceAll}},
+		{Actions: []model.Action{model.ActionRead}, Resources: []string{"zone/*"}},
+		{Actions: []model.Action{model.ActionDelete}, Resources: []string{"role/dns-*"}},
+	} {
+		err := authorizeGrant(ctx, []model.Permission{permission})
+		assert.True(t, beaconerr.IsAccessDeniedError(err), permission)
+	}
+}
@@ -0,0 +1,369 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/davidseybold/beacondns/internal/audit"
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/repository"
+)
+
+// CreateRole creates a role. Only permissions the principal holds can be given to it, so that
+// managing roles cannot be used to gain permissions.
+func (d *DefaultService) CreateRole(ctx context.Context, role *model.Role) (*model.Role, error) {
+	if err := Authorize(ctx, model.ActionWrite, model.RoleResource(role.Name)); err != nil {
+		return nil, err
+	}
+
+	if err := role.Validate(); err != nil {
+		return nil, beaconerr.ErrInvalidArgument(err.Error(), "role")
+	}
+
+	if err := authorizeGrant(ctx, role.Permissions); err != nil {
+		return nil, err
+	}
+
+	var created *model.Role
+	err := d.repReg.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		var txErr error
+		if created, txErr = r.GetRoleRepository().CreateRole(ctx, role); txErr != nil {
+			return txErr
+		}
+
+		return audit.Record(ctx, r, model.AuditOperationCreateRole, model.RoleResource(role.Name), nil, created)
+	})
+	if err != nil && errors.Is(err, repository.ErrEntityAlreadyExists) {
+		return nil, beaconerr.ErrRoleAlreadyExists(fmt.Sprintf("role %s already exists", role.Name))
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to create role", err)
+	}
+
+	return created, nil
+}
+
+// UpdateRole replaces the description and permissions of a role. The change applies to the
+// next request of every subject the role is bound to. As with CreateRole, only permissions the
+// principal holds can be given to the role.
+func (d *DefaultService) UpdateRole(ctx context.Context, role *model.Role) (*model.Role, error) {
+	if err := Authorize(ctx, model.ActionWrite, model.RoleResource(role.Name)); err != nil {
+		return nil, err
+	}
+
+	if err := role.Validate(); err != nil {
+		return nil, beaconerr.ErrInvalidArgument(err.Error(), "role")
+	}
+
+	if err := authorizeGrant(ctx, role.Permissions); err != nil {
+		return nil, err
+	}
+
+	var updated *model.Role
+	err := d.repReg.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		existing, txErr := checkRoleChangeable(ctx, r, role.Name)
+		if txErr != nil {
+			return txErr
+		}
+
+		if updated, txErr = r.GetRoleRepository().UpdateRole(ctx, role); txErr != nil {
+			return txErr
+		}
+
+		return audit.Record(ctx, r, model.AuditOperationUpdateRole, model.RoleResource(role.Name), existing, updated)
+	})
+	if err != nil {
+		return nil, roleError(role.Name, "failed to update role", err)
+	}
+
+	return updated, nil
+}
+
+func (d *DefaultService) GetRole(ctx context.Context, name string) (*model.Role, error) {
+	if err := Authorize(ctx, model.ActionRead, model.RoleResource(name)); err != nil {
+		return nil, err
+	}
+
+	role, err := d.repReg.GetRoleRepository().GetRole(ctx, name)
+	if err != nil {
+		return nil, roleError(name, "failed to get role", err)
+	}
+
+	return role, nil
+}
+
+func (d *DefaultService) ListRoles(ctx context.Context, opts model.ListOptions) (model.Page[model.Role], error) {
+	if err := normalizeListOptions(&opts, "roles", model.SortByName); err != nil {
+		return model.Page[model.Role]{}, err
+	}
+
+	roles, err := d.repReg.GetRoleRepository().ListRoles(ctx, opts)
+	if err != nil {
+		return model.Page[model.Role]{}, listError("failed to list roles", err)
+	}
+
+	return FilterPage(ctx, roles, func(role *model.Role) string {
+		return model.RoleResource(role.Name)
+	}), nil
+}
+
+// DeleteRole deletes a role along with its bindings.
+func (d *DefaultService) DeleteRole(ctx context.Context, name string) error {
+	if err := Authorize(ctx, model.ActionDelete, model.RoleResource(name)); err != nil {
+		return err
+	}
+
+	err := d.repReg.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		existing, txErr := checkRoleChangeable(ctx, r, name)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = r.GetRoleRepository().DeleteRole(ctx, name); txErr != nil {
+			return txErr
+		}
+
+		return audit.Record(ctx, r, model.AuditOperationDeleteRole, model.RoleResource(name), existing, nil)
+	})
+	if err != nil {
+		return roleError(name, "failed to delete role", err)
+	}
+
+	return nil
+}
+
+// CreateRoleBinding grants a role to a subject. It needs write access to the bindings of the
+// role rather than to the role itself, so that granting a role can be delegated without
+// allowing its permissions to be changed, and the principal must hold every permission of the
+// role.
+func (d *DefaultService) CreateRoleBinding(
+	ctx context.Context,
+	role string,
+	subject model.Subject,
+) (*model.RoleBinding, error) {
+	if err := Authorize(ctx, model.ActionWrite, model.RoleBindingResource(role)); err != nil {
+		return nil, err
+	}
+
+	var binding *model.RoleBinding
+	err := d.repReg.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		if txErr := checkSubject(ctx, r, subject); txErr != nil {
+			return txErr
+		}
+
+		var txErr error
+		binding, txErr = grantRole(ctx, r, role, subject)
+		return txErr
+	})
+	if err != nil && (beaconerr.IsBadRequestError(err) || beaconerr.IsNoSuchError(err) ||
+		beaconerr.IsConflictError(err) || beaconerr.IsAccessDeniedError(err)) {
+		return nil, err
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to create role binding", err)
+	}
+
+	return binding, nil
+}
+
+func (d *DefaultService) ListRoleBindings(
+	ctx context.Context,
+	subject *model.Subject,
+	opts model.ListOptions,
+) (model.Page[model.RoleBinding], error) {
+	if err := normalizeListOptions(&opts, "role bindings", model.SortByName, model.SortByCreatedAt); err != nil {
+		return model.Page[model.RoleBinding]{}, err
+	}
+
+	bindings, err := d.repReg.GetRoleRepository().ListRoleBindings(ctx, subject, opts)
+	if err != nil {
+		return model.Page[model.RoleBinding]{}, listError("failed to list role bindings", err)
+	}
+
+	return FilterPage(ctx, bindings, func(binding *model.RoleBinding) string {
+		return model.RoleBindingResource(binding.Role)
+	}), nil
+}
+
+// DeleteRoleBinding deletes a role binding. Whether a binding exists is only told to principals
+// allowed to delete it, or, for bindings that do not exist, to delete the bindings of every
+// role, so that binding IDs cannot be probed.
+func (d *DefaultService) DeleteRoleBinding(ctx context.Context, id uuid.UUID) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return beaconerr.ErrAccessDenied("request is not authenticated")
+	}
+	denied := beaconerr.ErrAccessDenied(fmt.Sprintf("%s is not allowed to delete role binding %s", principal.Name, id))
+
+	err := d.repReg.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		repo := r.GetRoleRepository()
+
+		binding, txErr := repo.GetRoleBinding(ctx, id)
+		if txErr != nil && errors.Is(txErr, repository.ErrEntityNotFound) &&
+			!principal.Can(model.ActionDelete, model.AllResources(model.ResourceKindRoleBinding)) {
+			return denied
+		} else if txErr != nil {
+			return txErr
+		}
+
+		if !principal.Can(model.ActionDelete, model.RoleBindingResource(binding.Role)) {
+			return denied
+		}
+
+		if txErr = repo.DeleteRoleBinding(ctx, id); txErr != nil {
+			return txErr
+		}
+
+		return audit.Record(ctx, r, model.AuditOperationDeleteRoleBinding, model.RoleBindingResource(binding.Role),
+			binding, nil)
+	})
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return beaconerr.ErrNoSuchRoleBinding(fmt.Sprintf("role binding %s not found", id))
+	} else if err != nil && beaconerr.IsAccessDeniedError(err) {
+		return err
+	} else if err != nil {
+		return beaconerr.ErrInternalError("failed to delete role binding", err)
+	}
+
+	return nil
+}
+
+// grantRole grants an existing role to a subject on behalf of the principal carried by ctx,
+// which must hold every permission of the role.
+func grantRole(
+	ctx context.Context,
+	r repository.Registry,
+	role string,
+	subject model.Subject,
+) (*model.RoleBinding, error) {
+	existing, err := getRole(ctx, r, role)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = authorizeGrant(ctx, existing.Permissions); err != nil {
+		return nil, err
+	}
+
+	return insertRoleBinding(ctx, r, role, subject)
+}
+
+// bindRole grants an existing role to a subject, failing with a no such role error if the
+// role does not exist. Unlike grantRole, it does not check the permissions of the role
+// against a principal, so that the bootstrap API key can be made an admin.
+func bindRole(ctx context.Context, r repository.Registry, role string, subject model.Subject) error {
+	if _, err := getRole(ctx, r, role); err != nil {
+		return err
+	}
+
+	_, err := insertRoleBinding(ctx, r, role, subject)
+	return err
+}
+
+// getRole returns a role, failing with a no such role error if it does not exist.
+func getRole(ctx context.Context, r repository.Registry, name string) (*model.Role, error) {
+	role, err := r.GetRoleRepository().GetRole(ctx, name)
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchRole(fmt.Sprintf("role %s not found", name))
+	} else if err != nil {
+		return nil, err
+	}
+
+	return role, nil
+}
+
+// authorizeGrant returns an access denied error unless the principal carried by ctx holds
+// every action of permissions on every resource their patterns match.
+func authorizeGrant(ctx context.Context, permissions []model.Permission) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return beaconerr.ErrAccessDenied("request is not authenticated")
+	}
+
+	for _, permission := range permissions {
+		for _, action := range permission.Actions {
+			for _, resource := range permission.Resources {
+				if !principal.CanGrant(action, resource) {
+					return beaconerr.ErrAccessDenied(fmt.Sprintf("%s cannot grant %s on %s, which they are not allowed",
+						principal.Name, action, resource))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func insertRoleBinding(
+	ctx context.Context,
+	r repository.Registry,
+	role string,
+	subject model.Subject,
+) (*model.RoleBinding, error) {
+	binding, err := r.GetRoleRepository().CreateRoleBinding(ctx, &model.RoleBinding{Role: role, Subject: subject})
+	if err != nil && errors.Is(err, repository.ErrEntityAlreadyExists) {
+		return nil, beaconerr.ErrRoleBindingAlreadyExists(
+			fmt.Sprintf("role %s is already bound to %s %s", role, subject.Type, subject.ID))
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = audit.Record(ctx, r, model.AuditOperationCreateRoleBinding, model.RoleBindingResource(role), nil, binding)
+	if err != nil {
+		return nil, err
+	}
+
+	return binding, nil
+}
+
+// checkSubject checks that the subject of a binding exists. Users and groups are only known
+// to the OIDC issuer, so only their names are checked.
+func checkSubject(ctx context.Context, r repository.Registry, subject model.Subject) error {
+	switch subject.Type {
+	case model.SubjectTypeUser, model.SubjectTypeGroup:
+		if strings.TrimSpace(subject.ID) == "" {
+			return beaconerr.ErrInvalidArgument(fmt.Sprintf("%s name is required", subject.Type), "subject.id")
+		}
+		return nil
+	case model.SubjectTypeAPIKey:
+		id, err := uuid.Parse(subject.ID)
+		if err != nil {
+			return beaconerr.ErrInvalidArgument("subject id must be the ID of an API key", "subject.id")
+		}
+
+		_, err = r.GetAPIKeyRepository().GetAPIKey(ctx, id)
+		if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+			return beaconerr.ErrNoSuchAPIKey(fmt.Sprintf("API key %s not found", id))
+		}
+		return err
+	default:
+		return beaconerr.ErrInvalidArgument(fmt.Sprintf("unknown subject type %q", subject.Type), "subject.type")
+	}
+}
+
+// checkRoleChangeable returns the role, failing if it does not exist or is built in.
+func checkRoleChangeable(ctx context.Context, r repository.Registry, name string) (*model.Role, error) {
+	role, err := r.GetRoleRepository().GetRole(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if role.BuiltIn {
+		return nil, beaconerr.ErrInvalidArgument(fmt.Sprintf("role %s is built in and cannot be changed", name), "name")
+	}
+
+	return role, nil
+}
+
+func roleError(name string, message string, err error) error {
+	if errors.Is(err, repository.ErrEntityNotFound) {
+		return beaconerr.ErrNoSuchRole(fmt.Sprintf("role %s not found", name))
+	}
+	if beaconerr.IsBadRequestError(err) {
+		return err
+	}
+	return beaconerr.ErrInternalError(message, err)
+}
@@ -0,0 +1,380 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/davidseybold/beacondns/internal/audit"
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/repository"
+)
+
+const (
+	// APIKeyPrefix starts every API key, which makes keys easy to recognise, for example by
+	// secret scanners.
+	APIKeyPrefix = "beacon_"
+
+	// BootstrapAPIKeyName is the name of the key created from the bootstrap key configured
+	// on the controller.
+	BootstrapAPIKeyName = "bootstrap"
+
+	apiKeySecretBytes = 32
+	// minBootstrapSecretLength is the minimum length of the bootstrap key after the prefix.
+	minBootstrapSecretLength = 32
+	// apiKeyDisplayLength is how much of a key is kept as its prefix.
+	apiKeyDisplayLength = len(APIKeyPrefix) + 6
+	// lastUsedResolution limits how often the last use of a key is written, so that a busy
+	// client does not cause a write on every request.
+	lastUsedResolution = time.Minute
+)
+
+type Service interface {
+	// CreateAPIKey creates a key bound to the given roles and returns it along with its secret
+	// value, which cannot be retrieved again.
+	CreateAPIKey(
+		ctx context.Context,
+		name string,
+		expiresAt *time.Time,
+		roles []string,
+	) (*model.APIKey, string, error)
+	ListAPIKeys(ctx context.Context, opts model.ListOptions) (model.Page[model.APIKey], error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) error
+	// AuthenticateAPIKey returns the principal of the key with the given secret value. It
+	// fails with an unauthorized error if there is no such key or the key is revoked or
+	// expired.
+	AuthenticateAPIKey(ctx context.Context, secret string) (*Principal, error)
+	// Authenticate returns the principal of a bearer token, which is either an API key or,
+	// if OIDC is configured, an ID token issued to a user.
+	Authenticate(ctx context.Context, token string) (*Principal, error)
+	// OIDCProvider returns the OIDC provider users sign in with, or nil if OIDC is not
+	// configured.
+	OIDCProvider() *OIDCProvider
+
+	CreateRole(ctx context.Context, role *model.Role) (*model.Role, error)
+	UpdateRole(ctx context.Context, role *model.Role) (*model.Role, error)
+	GetRole(ctx context.Context, name string) (*model.Role, error)
+	ListRoles(ctx context.Context, opts model.ListOptions) (model.Page[model.Role], error)
+	DeleteRole(ctx context.Context, name string) error
+
+	CreateRoleBinding(ctx context.Context, role string, subject model.Subject) (*model.RoleBinding, error)
+	ListRoleBindings(
+		ctx context.Context,
+		subject *model.Subject,
+		opts model.ListOptions,
+	) (model.Page[model.RoleBinding], error)
+	DeleteRoleBinding(ctx context.Context, id uuid.UUID) error
+
+	// ListAuditEntries returns a page of the audit log, newest first unless sorted otherwise.
+	// Entries for targets the principal may not read are left out.
+	ListAuditEntries(
+		ctx context.Context,
+		filter model.AuditFilter,
+		opts model.ListOptions,
+	) (model.Page[model.AuditEntry], error)
+}
+
+type ServiceConfig struct {
+	// OIDC verifies the ID tokens of users. Only API keys are accepted when it is nil.
+	OIDC *OIDCVerifier
+}
+
+type DefaultService struct {
+	repReg repository.TransactorRegistry
+	oidc   *OIDCVerifier
+	now    func() time.Time
+}
+
+var _ Service = (*DefaultService)(nil)
+
+func NewService(repReg repository.TransactorRegistry, cfg ServiceConfig) *DefaultService {
+	return &DefaultService{
+		repReg: repReg,
+		oidc:   cfg.OIDC,
+		now:    time.Now,
+	}
+}
+
+// CreateAPIKey needs write access to every API key, since the ID of the key is only known
+// once it is created, and write access to the bindings of each of the roles.
+func (d *DefaultService) CreateAPIKey(
+	ctx context.Context,
+	name string,
+	expiresAt *time.Time,
+	roles []string,
+) (*model.APIKey, string, error) {
+	if err := Authorize(ctx, model.ActionWrite, model.AllResources(model.ResourceKindAPIKey)); err != nil {
+		return nil, "", err
+	}
+	for _, role := range roles {
+		if err := Authorize(ctx, model.ActionWrite, model.RoleBindingResource(role)); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if strings.TrimSpace(name) == "" {
+		return nil, "", beaconerr.ErrInvalidArgument("API key name is required", "name")
+	}
+	if expiresAt != nil && !expiresAt.After(d.now()) {
+		return nil, "", beaconerr.ErrInvalidArgument("API key expiry must be in the future", "expiresAt")
+	}
+
+	secret, err := generateAPIKey()
+	if err != nil {
+		return nil, "", beaconerr.ErrInternalError("failed to generate API key", err)
+	}
+
+	var key *model.APIKey
+	err = d.repReg.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		var txErr error
+		key, txErr = r.GetAPIKeyRepository().CreateAPIKey(ctx, &model.APIKey{
+			Name:      name,
+			Prefix:    secret[:apiKeyDisplayLength],
+			ExpiresAt: expiresAt,
+		}, hashAPIKey(secret))
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = audit.Record(ctx, r, model.AuditOperationCreateAPIKey, model.APIKeyResource(key.ID), nil, key)
+		if txErr != nil {
+			return txErr
+		}
+
+		for _, role := range roles {
+			if _, txErr = grantRole(ctx, r, role, apiKeySubject(key.ID)); txErr != nil {
+				return txErr
+			}
+		}
+
+		return nil
+	})
+	if err != nil && (beaconerr.IsNoSuchError(err) || beaconerr.IsConflictError(err) ||
+		beaconerr.IsAccessDeniedError(err)) {
+		return nil, "", err
+	} else if err != nil {
+		return nil, "", beaconerr.ErrInternalError("failed to create API key", err)
+	}
+
+	return key, secret, nil
+}
+
+func (d *DefaultService) ListAPIKeys(
+	ctx context.Context,
+	opts model.ListOptions,
+) (model.Page[model.APIKey], error) {
+	if err := normalizeListOptions(&opts, "API keys", model.SortByName, model.SortByCreatedAt); err != nil {
+		return model.Page[model.APIKey]{}, err
+	}
+
+	keys, err := d.repReg.GetAPIKeyRepository().ListAPIKeys(ctx, opts)
+	if err != nil {
+		return model.Page[model.APIKey]{}, listError("failed to list API keys", err)
+	}
+
+	return FilterPage(ctx, keys, func(key *model.APIKey) string {
+		return model.APIKeyResource(key.ID)
+	}), nil
+}
+
+// normalizeListOptions normalizes the options of a list that cannot be filtered by tags.
+func normalizeListOptions(opts *model.ListOptions, items string, sortFields ...string) error {
+	if err := opts.Normalize(model.SortAscending, sortFields...); err != nil {
+		return beaconerr.ErrInvalidArgument(err.Error(), "options")
+	}
+	if !opts.Tags.IsZero() {
+		return beaconerr.ErrInvalidArgument(items+" cannot be filtered by tags", "options")
+	}
+	return nil
+}
+
+// listError maps the error of a list query. Whether a cursor fits the sort key is only known
+// once the query is built.
+func listError(message string, err error) error {
+	if errors.Is(err, model.ErrInvalidCursor) {
+		return beaconerr.ErrInvalidArgument(err.Error(), "cursor")
+	}
+	return beaconerr.ErrInternalError(message, err)
+}
+
+func (d *DefaultService) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	if err := Authorize(ctx, model.ActionDelete, model.APIKeyResource(id)); err != nil {
+		return err
+	}
+
+	err := d.repReg.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		repo := r.GetAPIKeyRepository()
+
+		existing, txErr := repo.GetAPIKey(ctx, id)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = repo.RevokeAPIKey(ctx, id); txErr != nil {
+			return txErr
+		}
+
+		revoked, txErr := repo.GetAPIKey(ctx, id)
+		if txErr != nil {
+			return txErr
+		}
+
+		return audit.Record(ctx, r, model.AuditOperationRevokeAPIKey, model.APIKeyResource(id), existing, revoked)
+	})
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return beaconerr.ErrNoSuchAPIKey("API key not found")
+	} else if err != nil {
+		return beaconerr.ErrInternalError("failed to revoke API key", err)
+	}
+
+	return nil
+}
+
+func (d *DefaultService) AuthenticateAPIKey(ctx context.Context, secret string) (*Principal, error) {
+	if !strings.HasPrefix(secret, APIKeyPrefix) {
+		return nil, beaconerr.ErrUnauthorized("invalid API key")
+	}
+
+	repo := d.repReg.GetAPIKeyRepository()
+
+	key, err := repo.GetAPIKeyByHash(ctx, hashAPIKey(secret))
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrUnauthorized("invalid API key")
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to authenticate API key", err)
+	}
+
+	now := d.now()
+	if !key.IsActive(now) {
+		return nil, beaconerr.ErrUnauthorized("API key is revoked or expired")
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= lastUsedResolution {
+		if err = repo.TouchAPIKey(ctx, key.ID, now); err != nil {
+			return nil, beaconerr.ErrInternalError("failed to authenticate API key", err)
+		}
+	}
+
+	subject := apiKeySubject(key.ID)
+	permissions, err := d.repReg.GetRoleRepository().GetSubjectPermissions(ctx, subject)
+	if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to get API key permissions", err)
+	}
+
+	return &Principal{
+		Subject:     subject,
+		Name:        key.Name,
+		Permissions: permissions,
+	}, nil
+}
+
+func (d *DefaultService) Authenticate(ctx context.Context, token string) (*Principal, error) {
+	if strings.HasPrefix(token, APIKeyPrefix) || d.oidc == nil {
+		return d.AuthenticateAPIKey(ctx, token)
+	}
+
+	identity, err := d.oidc.Verify(ctx, token)
+	if err != nil {
+		return nil, beaconerr.ErrUnauthorized(fmt.Sprintf("invalid token: %s", err))
+	}
+
+	subjects := make([]model.Subject, 0, len(identity.Groups)+1)
+	subjects = append(subjects, model.Subject{Type: model.SubjectTypeUser, ID: identity.Username})
+	for _, group := range identity.Groups {
+		subjects = append(subjects, model.Subject{Type: model.SubjectTypeGroup, ID: group})
+	}
+
+	permissions, err := d.repReg.GetRoleRepository().GetSubjectPermissions(ctx, subjects...)
+	if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to get user permissions", err)
+	}
+
+	return &Principal{
+		Subject:     subjects[0],
+		Name:        identity.Username,
+		Groups:      identity.Groups,
+		Permissions: permissions,
+	}, nil
+}
+
+func (d *DefaultService) OIDCProvider() *OIDCProvider {
+	if d.oidc == nil {
+		return nil
+	}
+	return d.oidc.Provider()
+}
+
+// EnsureBootstrapAPIKey makes secret a valid API key named BootstrapAPIKeyName with the admin
+// role, so that the first keys can be created on a fresh installation. It does nothing if
+// the key already exists, which also means that a revoked bootstrap key stays revoked;
+// configure a new value to bootstrap again.
+func (d *DefaultService) EnsureBootstrapAPIKey(ctx context.Context, secret string) error {
+	if err := ValidateBootstrapAPIKey(secret); err != nil {
+		return err
+	}
+
+	err := d.repReg.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		key, txErr := r.GetAPIKeyRepository().CreateAPIKey(ctx, &model.APIKey{
+			Name:   BootstrapAPIKeyName,
+			Prefix: secret[:apiKeyDisplayLength],
+		}, hashAPIKey(secret))
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = audit.Record(ctx, r, model.AuditOperationCreateAPIKey, model.APIKeyResource(key.ID), nil, key)
+		if txErr != nil {
+			return txErr
+		}
+
+		return bindRole(ctx, r, model.RoleAdmin, apiKeySubject(key.ID))
+	})
+	if err != nil && !errors.Is(err, repository.ErrEntityAlreadyExists) {
+		return fmt.Errorf("failed to create bootstrap API key: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateBootstrapAPIKey checks that a configured bootstrap key has the API key prefix and
+// is long enough to be hard to guess.
+func ValidateBootstrapAPIKey(secret string) error {
+	if !strings.HasPrefix(secret, APIKeyPrefix) {
+		return fmt.Errorf("bootstrap API key must start with %q", APIKeyPrefix)
+	}
+	if len(secret)-len(APIKeyPrefix) < minBootstrapSecretLength {
+		return fmt.Errorf(
+			"bootstrap API key must have at least %d characters after %q",
+			minBootstrapSecretLength,
+			APIKeyPrefix,
+		)
+	}
+	return nil
+}
+
+func apiKeySubject(id uuid.UUID) model.Subject {
+	return model.Subject{Type: model.SubjectTypeAPIKey, ID: id.String()}
+}
+
+func generateAPIKey() (string, error) {
+	b := make([]byte, apiKeySecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return APIKeyPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashAPIKey returns the hash a key is stored under. Keys are long random values, so a fast
+// hash is enough to make a leaked hash useless.
+func hashAPIKey(secret string) []byte {
+	sum := sha256.Sum256([]byte(secret))
+	return sum[:]
+}
@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateAPIKey(t *testing.T) {
+	first, err := generateAPIKey()
+	require.NoError(t, err)
+	second, err := generateAPIKey()
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(first, APIKeyPrefix))
+	assert.NotEqual(t, first, second)
+	assert.NoError(t, ValidateBootstrapAPIKey(first))
+
+	assert.Equal(t, hashAPIKey(first), hashAPIKey(first))
+	assert.NotEqual(t, hashAPIKey(first), hashAPIKey(second))
+}
+
+func TestValidateBootstrapAPIKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		wantErr bool
+	}{
+		{name: "valid", key: APIKeyPrefix + strings.Repeat("a", minBootstrapSecretLength)},
+		{name: "missing prefix", key: strings.Repeat("a", 64), wantErr: true},
+		{name: "too short", key: APIKeyPrefix + "short", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBootstrapAPIKey(tt.key)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,22 +2,41 @@ package beaconerr
 
 import (
 	"errors"
+	"time"
 )
 
 type ErrorCode string
 
 const (
-	ErrorCodeZoneAlreadyExists        ErrorCode = "ZoneAlreadyExists"
-	ErrorCodeNoSuchZone               ErrorCode = "NoSuchZone"
-	ErrorCodeNoSuchChange             ErrorCode = "NoSuchChange"
-	ErrorCodeNoSuchResourceRecordSet  ErrorCode = "NoSuchResourceRecordSet"
-	ErrorCodeNoSuchDomainList         ErrorCode = "NoSuchDomainList"
-	ErrorCodeNoSuchFirewallRule       ErrorCode = "NoSuchFirewallRule"
-	ErrorCodeHostedZoneNotEmpty       ErrorCode = "HostedZoneNotEmpty"
-	ErrorCodeDomainExistsInDomainList ErrorCode = "DomainExistsInDomainList"
-	ErrorCodeDomainListInvalidState   ErrorCode = "DomainListInvalidState"
-	ErrorCodeInvalidArgument          ErrorCode = "InvalidArgument"
-	ErrorCodeInternalError            ErrorCode = "InternalError"
+	ErrorCodeZoneAlreadyExists         ErrorCode = "ZoneAlreadyExists"
+	ErrorCodeNoSuchZone                ErrorCode = "NoSuchZone"
+	ErrorCodeNoSuchChange              ErrorCode = "NoSuchChange"
+	ErrorCodeNoSuchResourceRecordSet   ErrorCode = "NoSuchResourceRecordSet"
+	ErrorCodeNoSuchZoneVersion         ErrorCode = "NoSuchZoneVersion"
+	ErrorCodeNoSuchZoneTemplate        ErrorCode = "NoSuchZoneTemplate"
+	ErrorCodeZoneTemplateAlreadyExists ErrorCode = "ZoneTemplateAlreadyExists"
+	ErrorCodeNoSuchDomainList          ErrorCode = "NoSuchDomainList"
+	ErrorCodeNoSuchFirewallRule        ErrorCode = "NoSuchFirewallRule"
+	ErrorCodeNoSuchAPIKey              ErrorCode = "NoSuchAPIKey"
+	ErrorCodeNoSuchRole                ErrorCode = "NoSuchRole"
+	ErrorCodeRoleAlreadyExists         ErrorCode = "RoleAlreadyExists"
+	ErrorCodeNoSuchRoleBinding         ErrorCode = "NoSuchRoleBinding"
+	ErrorCodeRoleBindingAlreadyExists  ErrorCode = "RoleBindingAlreadyExists"
+	ErrorCodeNoSuchWebhook             ErrorCode = "NoSuchWebhook"
+	ErrorCodeNoSuchWebhookDelivery     ErrorCode = "NoSuchWebhookDelivery"
+	ErrorCodeHostedZoneNotEmpty        ErrorCode = "HostedZoneNotEmpty"
+	ErrorCodeDomainExistsInDomainList  ErrorCode = "DomainExistsInDomainList"
+	ErrorCodeDomainListInvalidState    ErrorCode = "DomainListInvalidState"
+	ErrorCodePTRRecordConflict         ErrorCode = "PTRRecordConflict"
+	ErrorCodeInvalidArgument           ErrorCode = "InvalidArgument"
+	ErrorCodeInvalidChangeBatch        ErrorCode = "InvalidChangeBatch"
+	ErrorCodeInternalError             ErrorCode = "InternalError"
+	ErrorCodeUnauthorized              ErrorCode = "Unauthorized"
+	ErrorCodeAccessDenied              ErrorCode = "AccessDenied"
+	ErrorCodeThrottling                ErrorCode = "Throttling"
+	ErrorCodeQuotaExceeded             ErrorCode = "QuotaExceeded"
+	ErrorCodeIdempotencyKeyInUse       ErrorCode = "IdempotencyKeyInUse"
+	ErrorCodeIdempotencyKeyMismatch    ErrorCode = "IdempotencyKeyMismatch"
 )
 
 func (e ErrorCode) String() string {
@@ -135,6 +154,54 @@ func newBadRequestError(code ErrorCode, message string, cause error) *BadRequest
 	}
 }
 
+// UnauthorizedError is returned when a request does not carry valid credentials.
+type UnauthorizedError struct {
+	*BeaconError
+}
+
+func (e *UnauthorizedError) Unwrap() error {
+	return e.BeaconError
+}
+
+func ErrUnauthorized(message string) *UnauthorizedError {
+	return &UnauthorizedError{
+		BeaconError: NewBeaconError(ErrorCodeUnauthorized, message, nil),
+	}
+}
+
+// AccessDeniedError is returned when the caller is not allowed to perform an action.
+type AccessDeniedError struct {
+	*BeaconError
+}
+
+func (e *AccessDeniedError) Unwrap() error {
+	return e.BeaconError
+}
+
+func ErrAccessDenied(message string) *AccessDeniedError {
+	return &AccessDeniedError{
+		BeaconError: NewBeaconError(ErrorCodeAccessDenied, message, nil),
+	}
+}
+
+// ThrottlingError is returned when a client sends requests faster than it is allowed to.
+// RetryAfter is how long the client should wait before sending another request.
+type ThrottlingError struct {
+	*BeaconError
+	RetryAfter time.Duration
+}
+
+func (e *ThrottlingError) Unwrap() error {
+	return e.BeaconError
+}
+
+func ErrThrottling(message string, retryAfter time.Duration) *ThrottlingError {
+	return &ThrottlingError{
+		BeaconError: NewBeaconError(ErrorCodeThrottling, message, nil),
+		RetryAfter:  retryAfter,
+	}
+}
+
 type ZoneAlreadyExistsError struct {
 	*ConflictError
 }
@@ -177,6 +244,48 @@ func ErrNoSuchChange(message string) *NoSuchChangeError {
 	}
 }
 
+type NoSuchZoneVersionError struct {
+	*NoSuchError
+}
+
+func (e *NoSuchZoneVersionError) Unwrap() error {
+	return e.NoSuchError
+}
+
+func ErrNoSuchZoneVersion(message string) *NoSuchZoneVersionError {
+	return &NoSuchZoneVersionError{
+		NoSuchError: newNoSuchError(ErrorCodeNoSuchZoneVersion, message),
+	}
+}
+
+type NoSuchZoneTemplateError struct {
+	*NoSuchError
+}
+
+func (e *NoSuchZoneTemplateError) Unwrap() error {
+	return e.NoSuchError
+}
+
+func ErrNoSuchZoneTemplate(message string) *NoSuchZoneTemplateError {
+	return &NoSuchZoneTemplateError{
+		NoSuchError: newNoSuchError(ErrorCodeNoSuchZoneTemplate, message),
+	}
+}
+
+type ZoneTemplateAlreadyExistsError struct {
+	*ConflictError
+}
+
+func (e *ZoneTemplateAlreadyExistsError) Unwrap() error {
+	return e.ConflictError
+}
+
+func ErrZoneTemplateAlreadyExists(message string) *ZoneTemplateAlreadyExistsError {
+	return &ZoneTemplateAlreadyExistsError{
+		ConflictError: newConflictError(ErrorCodeZoneTemplateAlreadyExists, message),
+	}
+}
+
 type NoSuchResourceRecordSetError struct {
 	*NoSuchError
 }
@@ -213,6 +322,31 @@ func ErrInvalidArgument(message string, argument string) *InvalidArgumentError {
 	}
 }
 
+// ChangeViolation describes why a single action of a change was rejected. Action is the
+// index of the action in the change.
+type ChangeViolation struct {
+	Action  int
+	Name    string
+	Type    string
+	Message string
+}
+
+type InvalidChangeBatchError struct {
+	*BadRequestError
+	Violations []ChangeViolation
+}
+
+func (e *InvalidChangeBatchError) Unwrap() error {
+	return e.BadRequestError
+}
+
+func ErrInvalidChangeBatch(message string, violations []ChangeViolation) *InvalidChangeBatchError {
+	return &InvalidChangeBatchError{
+		BadRequestError: newBadRequestError(ErrorCodeInvalidChangeBatch, message, nil),
+		Violations:      violations,
+	}
+}
+
 type HostedZoneNotEmptyError struct {
 	*BadRequestError
 }
@@ -255,6 +389,104 @@ func ErrNoSuchFirewallRule(message string) *NoSuchFirewallRuleError {
 	}
 }
 
+type NoSuchAPIKeyError struct {
+	*NoSuchError
+}
+
+func (e *NoSuchAPIKeyError) Unwrap() error {
+	return e.NoSuchError
+}
+
+func ErrNoSuchAPIKey(message string) *NoSuchAPIKeyError {
+	return &NoSuchAPIKeyError{
+		NoSuchError: newNoSuchError(ErrorCodeNoSuchAPIKey, message),
+	}
+}
+
+type NoSuchRoleError struct {
+	*NoSuchError
+}
+
+func (e *NoSuchRoleError) Unwrap() error {
+	return e.NoSuchError
+}
+
+func ErrNoSuchRole(message string) *NoSuchRoleError {
+	return &NoSuchRoleError{
+		NoSuchError: newNoSuchError(ErrorCodeNoSuchRole, message),
+	}
+}
+
+type NoSuchWebhookError struct {
+	*NoSuchError
+}
+
+func (e *NoSuchWebhookError) Unwrap() error {
+	return e.NoSuchError
+}
+
+func ErrNoSuchWebhook(message string) *NoSuchWebhookError {
+	return &NoSuchWebhookError{
+		NoSuchError: newNoSuchError(ErrorCodeNoSuchWebhook, message),
+	}
+}
+
+type NoSuchWebhookDeliveryError struct {
+	*NoSuchError
+}
+
+func (e *NoSuchWebhookDeliveryError) Unwrap() error {
+	return e.NoSuchError
+}
+
+func ErrNoSuchWebhookDelivery(message string) *NoSuchWebhookDeliveryError {
+	return &NoSuchWebhookDeliveryError{
+		NoSuchError: newNoSuchError(ErrorCodeNoSuchWebhookDelivery, message),
+	}
+}
+
+type RoleAlreadyExistsError struct {
+	*ConflictError
+}
+
+func (e *RoleAlreadyExistsError) Unwrap() error {
+	return e.ConflictError
+}
+
+func ErrRoleAlreadyExists(message string) *RoleAlreadyExistsError {
+	return &RoleAlreadyExistsError{
+		ConflictError: newConflictError(ErrorCodeRoleAlreadyExists, message),
+	}
+}
+
+type NoSuchRoleBindingError struct {
+	*NoSuchError
+}
+
+func (e *NoSuchRoleBindingError) Unwrap() error {
+	return e.NoSuchError
+}
+
+func ErrNoSuchRoleBinding(message string) *NoSuchRoleBindingError {
+	return &NoSuchRoleBindingError{
+		NoSuchError: newNoSuchError(ErrorCodeNoSuchRoleBinding, message),
+	}
+}
+
+type RoleBindingAlreadyExistsError struct {
+	*ConflictError
+}
+
+func (e *RoleBindingAlreadyExistsError) Unwrap() error {
+	return e.ConflictError
+}
+
+func ErrRoleBindingAlreadyExists(message string) *RoleBindingAlreadyExistsError {
+	return &RoleBindingAlreadyExistsError{
+		ConflictError: newConflictError(ErrorCodeRoleBindingAlreadyExists, message),
+	}
+}
+
 type DomainExistsInDomainListError struct {
 	*ConflictError
 }
@@ -283,6 +515,68 @@ func ErrDomainListInvalidState(message string) *DomainListInvalidStateError {
 	}
 }
 
+type PTRRecordConflictError struct {
+	*ConflictError
+}
+
+func (e *PTRRecordConflictError) Unwrap() error {
+	return e.ConflictError
+}
+
+func ErrPTRRecordConflict(message string) *PTRRecordConflictError {
+	return &PTRRecordConflictError{
+		ConflictError: newConflictError(ErrorCodePTRRecordConflict, message),
+	}
+}
+
+// QuotaExceededError is returned when a change would store more than the configured quota
+// allows, such as more zones or more record sets in a zone.
+type QuotaExceededError struct {
+	*BadRequestError
+}
+
+func (e *QuotaExceededError) Unwrap() error {
+	return e.BadRequestError
+}
+
+func ErrQuotaExceeded(message string) *QuotaExceededError {
+	return &QuotaExceededError{
+		BadRequestError: newBadRequestError(ErrorCodeQuotaExceeded, message, nil),
+	}
+}
+
+// IdempotencyKeyInUseError is returned when a request is retried with the Idempotency-Key of
+// a request that is still being handled.
+type IdempotencyKeyInUseError struct {
+	*ConflictError
+}
+
+func (e *IdempotencyKeyInUseError) Unwrap() error {
+	return e.ConflictError
+}
+
+func ErrIdempotencyKeyInUse(message string) *IdempotencyKeyInUseError {
+	return &IdempotencyKeyInUseError{
+		ConflictError: newConflictError(ErrorCodeIdempotencyKeyInUse, message),
+	}
+}
+
+// IdempotencyKeyMismatchError is returned when an Idempotency-Key is reused for a request
+// that differs from the one it was first used for.
+type IdempotencyKeyMismatchError struct {
+	*BadRequestError
+}
+
+func (e *IdempotencyKeyMismatchError) Unwrap() error {
+	return e.BadRequestError
+}
+
+func ErrIdempotencyKeyMismatch(message string) *IdempotencyKeyMismatchError {
+	return &IdempotencyKeyMismatchError{
+		BadRequestError: newBadRequestError(ErrorCodeIdempotencyKeyMismatch, message, nil),
+	}
+}
+
 func IsNoSuchError(err error) bool {
 	var noSuchErr *NoSuchError
 	return errors.As(err, &noSuchErr)
@@ -302,3 +596,18 @@ func IsBadRequestError(err error) bool {
 	var badRequestErr *BadRequestError
 	return errors.As(err, &badRequestErr)
 }
+
+func IsUnauthorizedError(err error) bool {
+	var unauthorizedErr *UnauthorizedError
+	return errors.As(err, &unauthorizedErr)
+}
+
+func IsAccessDeniedError(err error) bool {
+	var accessDeniedErr *AccessDeniedError
+	return errors.As(err, &accessDeniedErr)
+}
+
+func IsThrottlingError(err error) bool {
+	var throttlingErr *ThrottlingError
+	return errors.As(err, &throttlingErr)
+}
@@ -0,0 +1,176 @@
+// Package changelog keeps the log of the changes the worker applies, and reads it back for
+// the watchers that stream it.
+package changelog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/davidseybold/beacondns/internal/firewall"
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/repository"
+	"github.com/davidseybold/beacondns/internal/zone"
+)
+
+// DefaultRetention is how long notifications are kept in the change log.
+const DefaultRetention = 7 * 24 * time.Hour
+
+// Recorder appends a notification to the change log for each change the worker applies. The
+// worker hands it each event it processes, and it records the notifications in the same
+// transaction.
+type Recorder struct {
+	retention time.Duration
+	now       func() time.Time
+}
+
+// NewRecorder returns a recorder that purges the notifications older than retention as it
+// records new ones. Zero means DefaultRetention.
+func NewRecorder(retention time.Duration) *Recorder {
+	if retention == 0 {
+		retention = DefaultRetention
+	}
+
+	return &Recorder{
+		retention: retention,
+		now:       time.Now,
+	}
+}
+
+// EventProcessed records the notifications of the changes the event applied. r is the
+// registry of the transaction the worker processed the event in.
+func (rec *Recorder) EventProcessed(ctx context.Context, r repository.Registry, event *model.Event) error {
+	var change *model.Change
+	if event.Type == zone.EventTypeChangeRRSet {
+		var payload zone.ChangeRRSetEvent
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to decode event: %w", err)
+		}
+
+		var err error
+		change, err = r.GetZoneRepository().GetChange(ctx, payload.ChangeID)
+		if err != nil && !errors.Is(err, repository.ErrEntityNotFound) {
+			return fmt.Errorf("failed to get change: %w", err)
+		}
+	}
+
+	notifications, err := notificationsForEvent(event, change)
+	if err != nil {
+		return err
+	}
+
+	repo := r.GetChangeLogRepository()
+	for i := range notifications {
+		if _, err = repo.CreateChangeNotification(ctx, &notifications[i]); err != nil {
+			return fmt.Errorf("failed to record change notification: %w", err)
+		}
+	}
+
+	if err = repo.PurgeChangeNotifications(ctx, rec.now().Add(-rec.retention)); err != nil {
+		return fmt.Errorf("failed to purge change log: %w", err)
+	}
+
+	return nil
+}
+
+// zoneChange is the data of the notifications about a zone, and of the record sets in it.
+type zoneChange struct {
+	ZoneName          string                   `json:"zoneName"`
+	ResourceRecordSet *model.ResourceRecordSet `json:"resourceRecordSet,omitempty"`
+}
+
+// firewallChange holds the ID every firewall event carries.
+type firewallChange struct {
+	ID uuid.UUID `json:"id"`
+}
+
+// notificationsForEvent returns the notifications of the changes an event applied. A change
+// to record sets is notified once per record set, with change holding the record sets
+// changed. If the change is nil, because it is no longer kept, the zone is notified as
+// changed instead. Events that change nothing watchers can see have no notifications.
+func notificationsForEvent(event *model.Event, change *model.Change) ([]model.ChangeNotification, error) {
+	switch event.Type {
+	case zone.EventTypeCreateZone, zone.EventTypeDeleteZone, zone.EventTypeChangeRRSet:
+		return zoneNotifications(event, change)
+	case firewall.EventTypeRuleCreated, firewall.EventTypeRuleDeleted, firewall.EventTypeRuleUpdated:
+		return firewallNotifications(event, model.FirewallRuleResource)
+	case firewall.EventTypeDomainListCreated,
+		firewall.EventTypeDomainListDeleted,
+		firewall.EventTypeDomainListDomainsAdded,
+		firewall.EventTypeDomainListDomainsRemoved,
+		firewall.EventTypeDomainListRefreshed:
+		return firewallNotifications(event, model.DomainListResource)
+	default:
+		return nil, nil
+	}
+}
+
+func zoneNotifications(event *model.Event, change *model.Change) ([]model.ChangeNotification, error) {
+	var payload zoneChange
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return nil, fmt.Errorf("failed to decode event: %w", err)
+	}
+
+	resource := model.ZoneResource(payload.ZoneName)
+	switch event.Type {
+	case zone.EventTypeCreateZone:
+		return newNotifications(model.ChangeTypeCreateZone, resource, zoneChange{ZoneName: payload.ZoneName})
+	case zone.EventTypeDeleteZone:
+		return newNotifications(model.ChangeTypeDeleteZone, resource, zoneChange{ZoneName: payload.ZoneName})
+	}
+
+	if change == nil {
+		return newNotifications(model.ChangeTypeUpsertRRSet, resource, zoneChange{ZoneName: payload.ZoneName})
+	}
+
+	notifications := make([]model.ChangeNotification, 0, len(change.Actions))
+	for _, action := range change.Actions {
+		changeType := model.ChangeTypeUpsertRRSet
+		if action.ActionType == model.ChangeActionTypeDelete {
+			changeType = model.ChangeTypeDeleteRRSet
+		}
+
+		rrSet := action.ResourceRecordSet
+		notification, err := newNotifications(
+			changeType,
+			model.ResourceRecordSetResource(payload.ZoneName, rrSet.Name, rrSet.Type),
+			zoneChange{ZoneName: payload.ZoneName, ResourceRecordSet: rrSet},
+		)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, notification...)
+	}
+
+	return notifications, nil
+}
+
+func firewallNotifications(event *model.Event, resource func(uuid.UUID) string) ([]model.ChangeNotification, error) {
+	var payload firewallChange
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return nil, fmt.Errorf("failed to decode event: %w", err)
+	}
+
+	return []model.ChangeNotification{{
+		Type:     event.Type,
+		Resource: resource(payload.ID),
+		Data:     event.Payload,
+	}}, nil
+}
+
+func newNotifications(changeType string, resource string, data any) ([]model.ChangeNotification, error) {
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode change notification: %w", err)
+	}
+
+	return []model.ChangeNotification{{
+		Type:     changeType,
+		Resource: resource,
+		Data:     dataJSON,
+	}}, nil
+}
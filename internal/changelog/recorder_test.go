@@ -0,0 +1,103 @@
+package changelog
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davidseybold/beacondns/internal/firewall"
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/zone"
+)
+
+func TestNotificationsForEvent(t *testing.T) {
+	www := &model.ResourceRecordSet{
+		Name:            "www.example.com.",
+		Type:            model.RRTypeA,
+		TTL:             300,
+		ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.1"}},
+	}
+	old := &model.ResourceRecordSet{Name: "old.example.com.", Type: model.RRTypeA}
+	change := &model.Change{
+		ID: uuid.New(),
+		Actions: []model.ChangeAction{
+			model.NewChangeAction(model.ChangeActionTypeUpsert, www),
+			model.NewChangeAction(model.ChangeActionTypeDelete, old),
+		},
+	}
+
+	t.Run("create zone", func(t *testing.T) {
+		notifications, err := notificationsForEvent(zone.NewCreateZoneEvent("example.com.", change.ID), nil)
+
+		require.NoError(t, err)
+		require.Len(t, notifications, 1)
+		assert.Equal(t, model.ChangeTypeCreateZone, notifications[0].Type)
+		assert.Equal(t, "zone/example.com.", notifications[0].Resource)
+		assert.JSONEq(t, `{"zoneName":"example.com."}`, string(notifications[0].Data))
+	})
+
+	t.Run("delete zone", func(t *testing.T) {
+		notifications, err := notificationsForEvent(zone.NewDeleteZoneEvent("example.com."), nil)
+
+		require.NoError(t, err)
+		require.Len(t, notifications, 1)
+		assert.Equal(t, model.ChangeTypeDeleteZone, notifications[0].Type)
+		assert.Equal(t, "zone/example.com.", notifications[0].Resource)
+	})
+
+	t.Run("change record sets", func(t *testing.T) {
+		notifications, err := notificationsForEvent(zone.NewChangeRRSetEvent("example.com.", change.ID), change)
+
+		require.NoError(t, err)
+		require.Len(t, notifications, 2)
+		assert.Equal(t, model.ChangeTypeUpsertRRSet, notifications[0].Type)
+		assert.Equal(t, "zone/example.com./rrset/www.example.com./A", notifications[0].Resource)
+		assert.JSONEq(t, `{
+			"zoneName": "example.com.",
+			"resourceRecordSet": {
+				"name": "www.example.com.",
+				"type": "A",
+				"ttl": 300,
+				"resourceRecords": [{"value": "192.0.2.1"}]
+			}
+		}`, string(notifications[0].Data))
+		assert.Equal(t, model.ChangeTypeDeleteRRSet, notifications[1].Type)
+		assert.Equal(t, "zone/example.com./rrset/old.example.com./A", notifications[1].Resource)
+	})
+
+	t.Run("change no longer kept", func(t *testing.T) {
+		notifications, err := notificationsForEvent(zone.NewChangeRRSetEvent("example.com.", change.ID), nil)
+
+		require.NoError(t, err)
+		require.Len(t, notifications, 1)
+		assert.Equal(t, model.ChangeTypeUpsertRRSet, notifications[0].Type)
+		assert.Equal(t, "zone/example.com.", notifications[0].Resource)
+	})
+
+	t.Run("firewall", func(t *testing.T) {
+		id := uuid.New()
+
+		notifications, err := notificationsForEvent(firewall.NewRuleDeletedEvent(id), nil)
+		require.NoError(t, err)
+		require.Len(t, notifications, 1)
+		assert.Equal(t, firewall.EventTypeRuleDeleted, notifications[0].Type)
+		assert.Equal(t, model.FirewallRuleResource(id), notifications[0].Resource)
+
+		event := firewall.NewDomainListDomainsAddedEvent(id, []string{"ads.example.com."})
+		notifications, err = notificationsForEvent(event, nil)
+		require.NoError(t, err)
+		require.Len(t, notifications, 1)
+		assert.Equal(t, firewall.EventTypeDomainListDomainsAdded, notifications[0].Type)
+		assert.Equal(t, model.DomainListResource(id), notifications[0].Resource)
+		assert.JSONEq(t, string(event.Payload), string(notifications[0].Data))
+	})
+
+	t.Run("unknown event", func(t *testing.T) {
+		notifications, err := notificationsForEvent(model.NewEvent("something.else", struct{}{}), nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, notifications)
+	})
+}
@@ -0,0 +1,85 @@
+package changelog
+
+import (
+	"context"
+	"errors"
+
+	"github.com/davidseybold/beacondns/internal/auth"
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/repository"
+)
+
+// listBatchSize is how many notifications ListChangesAfter reads at a time.
+const listBatchSize = 100
+
+type Service interface {
+	// ResumeFrom returns the id of the notification to stream the changes after. Without a
+	// lastEventID, the stream starts with the next change. If the notification lastEventID
+	// names is no longer kept, the changes since are lost and reset is true, along with the
+	// id of the latest notification. A lastEventID of 0 streams every change kept.
+	ResumeFrom(ctx context.Context, lastEventID *int64) (after int64, reset bool, err error)
+	// ListChangesAfter returns the notifications after the id that the principal carried by
+	// ctx may read, in the order they were committed, along with the id to list from next.
+	// The next id moves past the notifications left out, so it can move without any being
+	// returned.
+	ListChangesAfter(ctx context.Context, id int64) ([]model.ChangeNotification, int64, error)
+}
+
+type DefaultService struct {
+	repReg repository.TransactorRegistry
+}
+
+var _ Service = (*DefaultService)(nil)
+
+func NewService(repReg repository.TransactorRegistry) *DefaultService {
+	return &DefaultService{
+		repReg: repReg,
+	}
+}
+
+func (d *DefaultService) ResumeFrom(ctx context.Context, lastEventID *int64) (int64, bool, error) {
+	if _, ok := auth.PrincipalFromContext(ctx); !ok {
+		return 0, false, beaconerr.ErrAccessDenied("request is not authenticated")
+	}
+
+	repo := d.repReg.GetChangeLogRepository()
+
+	if lastEventID != nil {
+		if *lastEventID == 0 {
+			return 0, false, nil
+		}
+
+		_, err := repo.GetChangeNotification(ctx, *lastEventID)
+		if err == nil {
+			return *lastEventID, false, nil
+		} else if !errors.Is(err, repository.ErrEntityNotFound) {
+			return 0, false, beaconerr.ErrInternalError("failed to get change notification", err)
+		}
+	}
+
+	latest, err := repo.GetLatestChangeNotificationID(ctx)
+	if err != nil {
+		return 0, false, beaconerr.ErrInternalError("failed to get latest change notification", err)
+	}
+
+	return latest, lastEventID != nil, nil
+}
+
+func (d *DefaultService) ListChangesAfter(ctx context.Context, id int64) ([]model.ChangeNotification, int64, error) {
+	notifications, err := d.repReg.GetChangeLogRepository().ListChangeNotificationsAfter(ctx, id, listBatchSize)
+	if err != nil {
+		return nil, id, beaconerr.ErrInternalError("failed to list change notifications", err)
+	}
+
+	next := id
+	readable := make([]model.ChangeNotification, 0, len(notifications))
+	for _, notification := range notifications {
+		next = notification.ID
+		if auth.Can(ctx, model.ActionRead, notification.Resource) {
+			readable = append(readable, notification)
+		}
+	}
+
+	return readable, next, nil
+}
@@ -0,0 +1,14 @@
+package dns
+
+import (
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// RDataString returns the presentation format of the record data of rr, without the
+// owner name, TTL, class and type. The result is in the same format that ParseRRs accepts
+// as a resource record value.
+func RDataString(rr dns.RR) string {
+	return strings.TrimPrefix(rr.String(), rr.Header().String())
+}
@@ -1,6 +1,7 @@
 package dns
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"net"
@@ -27,6 +28,13 @@ const (
 	tlsaSelectorMaxFieldNumber     = 1
 	tlsaMatchingTypeMaxFieldNumber = 2
 	mxRecordFieldNumber            = 2
+	dnskeyRecordFieldNumber        = 4
+	dnskeyProtocol                 = 3
+	locRecordMinFieldNumber        = 5
+	locRecordMaxFieldNumber        = 12
+	uriRecordFieldNumber           = 3
+	hinfoRecordFieldNumber         = 2
+	rpRecordFieldNumber            = 2
 )
 
 var (
@@ -63,6 +71,15 @@ var (
 	ErrInvalidSSHFPType             = errors.New("SSHFP type must be between 0 and 2")
 	ErrInvalidCharacterString       = errors.New("value should be enclosed in quotation marks")
 	ErrInvalidMandatoryKey          = errors.New("invalid mandatory key")
+	ErrInvalidBase64                = errors.New("value is not valid base64")
+	ErrDNSKEYRecordFieldCount       = errors.New("DNSKEY record doesn't have 4 fields")
+	ErrInvalidDNSKEYProtocol        = errors.New("DNSKEY protocol must be 3")
+	ErrLOCRecordFieldCount          = errors.New("LOC record doesn't have between 5 and 12 fields")
+	ErrInvalidLOCValue              = errors.New("LOC record is invalid")
+	ErrURIRecordFieldCount          = errors.New("URI record doesn't have 3 fields")
+	ErrInvalidURITarget             = errors.New("URI target must be quoted")
+	ErrHINFORecordFieldCount        = errors.New("HINFO record doesn't have 2 fields")
+	ErrRPRecordFieldCount           = errors.New("RP record doesn't have 2 fields")
 )
 
 func ParseRRs(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
@@ -103,6 +120,24 @@ func ParseRRs(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
 		return PTR(rrset)
 	case model.RRTypeMX:
 		return MX(rrset)
+	case model.RRTypeDNSKEY:
+		return DNSKEY(rrset)
+	case model.RRTypeCDS:
+		return CDS(rrset)
+	case model.RRTypeCDNSKEY:
+		return CDNSKEY(rrset)
+	case model.RRTypeLOC:
+		return LOC(rrset)
+	case model.RRTypeURI:
+		return URI(rrset)
+	case model.RRTypeHINFO:
+		return HINFO(rrset)
+	case model.RRTypeDNAME:
+		return DNAME(rrset)
+	case model.RRTypeRP:
+		return RP(rrset)
+	case model.RRTypeOPENPGPKEY:
+		return OPENPGPKEY(rrset)
 	}
 
 	return nil, fmt.Errorf("invalid record type: %s", rrset.Type)
@@ -191,6 +226,58 @@ func CAA(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
 	return dnsRRs, nil
 }
 
+func CDNSKEY(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
+	if len(rrset.ResourceRecords) == 0 {
+		return nil, ErrNoResourceRecords
+	}
+
+	dnsRRs := make([]dns.RR, 0, len(rrset.ResourceRecords))
+	for _, rr := range rrset.ResourceRecords {
+		r := new(dns.CDNSKEY)
+		r.Hdr = createHeader(rrset.Name, dns.TypeCDNSKEY, rrset.TTL)
+
+		flags, protocol, algorithm, publicKey, err := dnskeyValue(rr.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		r.Flags = flags
+		r.Protocol = protocol
+		r.Algorithm = algorithm
+		r.PublicKey = publicKey
+
+		dnsRRs = append(dnsRRs, r)
+	}
+
+	return dnsRRs, nil
+}
+
+func CDS(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
+	if len(rrset.ResourceRecords) == 0 {
+		return nil, ErrNoResourceRecords
+	}
+
+	dnsRRs := make([]dns.RR, 0, len(rrset.ResourceRecords))
+	for _, rr := range rrset.ResourceRecords {
+		r := new(dns.CDS)
+		r.Hdr = createHeader(rrset.Name, dns.TypeCDS, rrset.TTL)
+
+		keyTag, algorithm, digestType, digest, err := dsValue(rr.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		r.KeyTag = keyTag
+		r.Algorithm = algorithm
+		r.DigestType = digestType
+		r.Digest = digest
+
+		dnsRRs = append(dnsRRs, r)
+	}
+
+	return dnsRRs, nil
+}
+
 func CNAME(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
 	if len(rrset.ResourceRecords) == 0 {
 		return nil, ErrNoResourceRecords
@@ -208,32 +295,98 @@ func CNAME(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
 	return dnsRRs, nil
 }
 
-func DS(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
+func DNAME(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
 	if len(rrset.ResourceRecords) == 0 {
 		return nil, ErrNoResourceRecords
 	}
 
 	dnsRRs := make([]dns.RR, 0, len(rrset.ResourceRecords))
 	for _, rr := range rrset.ResourceRecords {
-		r := new(dns.DS)
-		r.Hdr = createHeader(rrset.Name, dns.TypeDS, rrset.TTL)
+		r := new(dns.DNAME)
+		r.Hdr = createHeader(rrset.Name, dns.TypeDNAME, rrset.TTL)
 
-		parts := strings.Fields(rr.Value)
-		if len(parts) != dsRecordFieldNumber {
-			return nil, valueError(ErrDSRecordFieldCount, rr.Value)
+		if _, ok := dns.IsDomainName(rr.Value); !ok {
+			return nil, valueError(ErrInvalidDomainName, rr.Value)
 		}
 
-		keyTag, err := parse16BitUint(parts[0])
-		if err != nil {
-			return nil, err
-		}
+		r.Target = dns.Fqdn(rr.Value)
+		dnsRRs = append(dnsRRs, r)
+	}
+
+	return dnsRRs, nil
+}
+
+func DNSKEY(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
+	if len(rrset.ResourceRecords) == 0 {
+		return nil, ErrNoResourceRecords
+	}
 
-		algorithm, err := parse8BitUint(parts[1])
+	dnsRRs := make([]dns.RR, 0, len(rrset.ResourceRecords))
+	for _, rr := range rrset.ResourceRecords {
+		r := new(dns.DNSKEY)
+		r.Hdr = createHeader(rrset.Name, dns.TypeDNSKEY, rrset.TTL)
+
+		flags, protocol, algorithm, publicKey, err := dnskeyValue(rr.Value)
 		if err != nil {
 			return nil, err
 		}
 
-		digestType, err := parse8BitUint(parts[2])
+		r.Flags = flags
+		r.Protocol = protocol
+		r.Algorithm = algorithm
+		r.PublicKey = publicKey
+
+		dnsRRs = append(dnsRRs, r)
+	}
+
+	return dnsRRs, nil
+}
+
+// dnskeyValue parses the value of a DNSKEY or CDNSKEY record. The public key may be split
+// into several whitespace separated parts, as tools like dig print it.
+func dnskeyValue(value string) (uint16, uint8, uint8, string, error) {
+	parts := strings.Fields(value)
+	if len(parts) < dnskeyRecordFieldNumber {
+		return 0, 0, 0, "", valueError(ErrDNSKEYRecordFieldCount, value)
+	}
+
+	flags, err := parse16BitUint(parts[0])
+	if err != nil {
+		return 0, 0, 0, "", err
+	}
+
+	protocol, err := parse8BitUint(parts[1])
+	if err != nil {
+		return 0, 0, 0, "", err
+	}
+	if protocol != dnskeyProtocol {
+		return 0, 0, 0, "", valueError(ErrInvalidDNSKEYProtocol, parts[1])
+	}
+
+	algorithm, err := parse8BitUint(parts[2])
+	if err != nil {
+		return 0, 0, 0, "", err
+	}
+
+	publicKey, err := base64Value(strings.Join(parts[3:], ""))
+	if err != nil {
+		return 0, 0, 0, "", err
+	}
+
+	return flags, protocol, algorithm, publicKey, nil
+}
+
+func DS(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
+	if len(rrset.ResourceRecords) == 0 {
+		return nil, ErrNoResourceRecords
+	}
+
+	dnsRRs := make([]dns.RR, 0, len(rrset.ResourceRecords))
+	for _, rr := range rrset.ResourceRecords {
+		r := new(dns.DS)
+		r.Hdr = createHeader(rrset.Name, dns.TypeDS, rrset.TTL)
+
+		keyTag, algorithm, digestType, digest, err := dsValue(rr.Value)
 		if err != nil {
 			return nil, err
 		}
@@ -241,8 +394,66 @@ func DS(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
 		r.KeyTag = keyTag
 		r.Algorithm = algorithm
 		r.DigestType = digestType
-		r.Digest = parts[3]
+		r.Digest = digest
+
+		dnsRRs = append(dnsRRs, r)
+	}
+
+	return dnsRRs, nil
+}
+
+// dsValue parses the value of a DS or CDS record.
+func dsValue(value string) (uint16, uint8, uint8, string, error) {
+	parts := strings.Fields(value)
+	if len(parts) != dsRecordFieldNumber {
+		return 0, 0, 0, "", valueError(ErrDSRecordFieldCount, value)
+	}
+
+	keyTag, err := parse16BitUint(parts[0])
+	if err != nil {
+		return 0, 0, 0, "", err
+	}
+
+	algorithm, err := parse8BitUint(parts[1])
+	if err != nil {
+		return 0, 0, 0, "", err
+	}
+
+	digestType, err := parse8BitUint(parts[2])
+	if err != nil {
+		return 0, 0, 0, "", err
+	}
+
+	return keyTag, algorithm, digestType, parts[3], nil
+}
+
+func HINFO(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
+	if len(rrset.ResourceRecords) == 0 {
+		return nil, ErrNoResourceRecords
+	}
+
+	dnsRRs := make([]dns.RR, 0, len(rrset.ResourceRecords))
+	for _, rr := range rrset.ResourceRecords {
+		r := new(dns.HINFO)
+		r.Hdr = createHeader(rrset.Name, dns.TypeHINFO, rrset.TTL)
+
+		// The CPU and OS may be given as quoted strings, which can hold spaces, or as two
+		// plain words.
+		parts := strings.Fields(rr.Value)
+		if strings.Contains(rr.Value, `"`) {
+			var err error
+			parts, err = txtValue(rr.Value)
+			if err != nil {
+				return nil, err
+			}
+		}
 
+		if len(parts) != hinfoRecordFieldNumber {
+			return nil, valueError(ErrHINFORecordFieldCount, rr.Value)
+		}
+
+		r.Cpu = parts[0]
+		r.Os = parts[1]
 		dnsRRs = append(dnsRRs, r)
 	}
 
@@ -274,6 +485,41 @@ func HTTPS(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
 	return dnsRRs, nil
 }
 
+func LOC(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
+	if len(rrset.ResourceRecords) == 0 {
+		return nil, ErrNoResourceRecords
+	}
+
+	dnsRRs := make([]dns.RR, 0, len(rrset.ResourceRecords))
+	for _, rr := range rrset.ResourceRecords {
+		parts := strings.Fields(rr.Value)
+		if len(parts) < locRecordMinFieldNumber || len(parts) > locRecordMaxFieldNumber {
+			return nil, valueError(ErrLOCRecordFieldCount, rr.Value)
+		}
+
+		// The coordinates, altitude and precisions of RFC 1876 are left to the zone file
+		// parser, with everything that would change its meaning rejected up front.
+		if strings.ContainsAny(rr.Value, `;()"$`) {
+			return nil, valueError(ErrInvalidLOCValue, rr.Value)
+		}
+
+		parsed, err := dns.NewRR(". 0 IN LOC " + strings.Join(parts, " "))
+		if err != nil || parsed == nil {
+			return nil, valueError(ErrInvalidLOCValue, rr.Value)
+		}
+
+		r, ok := parsed.(*dns.LOC)
+		if !ok {
+			return nil, valueError(ErrInvalidLOCValue, rr.Value)
+		}
+		r.Hdr = createHeader(rrset.Name, dns.TypeLOC, rrset.TTL)
+
+		dnsRRs = append(dnsRRs, r)
+	}
+
+	return dnsRRs, nil
+}
+
 func MX(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
 	if len(rrset.ResourceRecords) == 0 {
 		return nil, ErrNoResourceRecords
@@ -380,6 +626,28 @@ func NS(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
 	return dnsRRs, nil
 }
 
+func OPENPGPKEY(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
+	if len(rrset.ResourceRecords) == 0 {
+		return nil, ErrNoResourceRecords
+	}
+
+	dnsRRs := make([]dns.RR, 0, len(rrset.ResourceRecords))
+	for _, rr := range rrset.ResourceRecords {
+		r := new(dns.OPENPGPKEY)
+		r.Hdr = createHeader(rrset.Name, dns.TypeOPENPGPKEY, rrset.TTL)
+
+		publicKey, err := base64Value(strings.Join(strings.Fields(rr.Value), ""))
+		if err != nil {
+			return nil, err
+		}
+
+		r.PublicKey = publicKey
+		dnsRRs = append(dnsRRs, r)
+	}
+
+	return dnsRRs, nil
+}
+
 func PTR(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
 	if len(rrset.ResourceRecords) == 0 {
 		return nil, ErrNoResourceRecords
@@ -396,6 +664,35 @@ func PTR(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
 	return dnsRRs, nil
 }
 
+func RP(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
+	if len(rrset.ResourceRecords) == 0 {
+		return nil, ErrNoResourceRecords
+	}
+
+	dnsRRs := make([]dns.RR, 0, len(rrset.ResourceRecords))
+	for _, rr := range rrset.ResourceRecords {
+		r := new(dns.RP)
+		r.Hdr = createHeader(rrset.Name, dns.TypeRP, rrset.TTL)
+
+		parts := strings.Fields(rr.Value)
+		if len(parts) != rpRecordFieldNumber {
+			return nil, valueError(ErrRPRecordFieldCount, rr.Value)
+		}
+
+		for _, part := range parts {
+			if _, ok := dns.IsDomainName(part); !ok {
+				return nil, valueError(ErrInvalidDomainName, part)
+			}
+		}
+
+		r.Mbox = dns.Fqdn(parts[0])
+		r.Txt = dns.Fqdn(parts[1])
+		dnsRRs = append(dnsRRs, r)
+	}
+
+	return dnsRRs, nil
+}
+
 func SOA(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
 	if len(rrset.ResourceRecords) == 0 {
 		return nil, ErrNoResourceRecords
@@ -855,6 +1152,57 @@ func txtValue(value string) ([]string, error) {
 	return results, nil
 }
 
+func URI(rrset *model.ResourceRecordSet) ([]dns.RR, error) {
+	if len(rrset.ResourceRecords) == 0 {
+		return nil, ErrNoResourceRecords
+	}
+
+	dnsRRs := make([]dns.RR, 0, len(rrset.ResourceRecords))
+	for _, rr := range rrset.ResourceRecords {
+		r := new(dns.URI)
+		r.Hdr = createHeader(rrset.Name, dns.TypeURI, rrset.TTL)
+
+		parts := strings.Fields(rr.Value)
+		if len(parts) != uriRecordFieldNumber {
+			return nil, valueError(ErrURIRecordFieldCount, rr.Value)
+		}
+
+		priority, err := parse16BitUint(parts[0])
+		if err != nil {
+			return nil, err
+		}
+
+		weight, err := parse16BitUint(parts[1])
+		if err != nil {
+			return nil, err
+		}
+
+		target := parts[2]
+		if !assertQuoted(target) || len(target) < 3 {
+			return nil, valueError(ErrInvalidURITarget, target)
+		}
+
+		r.Priority = priority
+		r.Weight = weight
+		r.Target = strings.Trim(target, "\"")
+		dnsRRs = append(dnsRRs, r)
+	}
+
+	return dnsRRs, nil
+}
+
+func base64Value(value string) (string, error) {
+	if value == "" {
+		return "", valueError(ErrInvalidBase64, value)
+	}
+
+	if _, err := base64.StdEncoding.DecodeString(value); err != nil {
+		return "", valueError(ErrInvalidBase64, value)
+	}
+
+	return value, nil
+}
+
 func valueError(err error, value string) error {
 	return fmt.Errorf("(%w) encountered with '%s'", err, value)
 }
@@ -1557,3 +1557,798 @@ func TestTLSA(t *testing.T) {
 		})
 	}
 }
+
+func TestDNSKEY(t *testing.T) {
+	tests := []struct {
+		name    string
+		rrset   *model.ResourceRecordSet
+		want    []dns.RR
+		wantErr error
+	}{
+		{
+			name: "valid DNSKEY record",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "DNSKEY",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "257 3 8 AwEAAagAIKlVZrpC6Ia7gEzahOR+9W29euxhJhVVLOyQbSEW0O8gcCjFFVQUTf6v58fLjwBd0YI0EzrAcQqBGCzh/RStIoO8g0NfnfL2MTJRkxoXbfDaUeVPQuYEhg37NZWAJQ9VnMVDxP/VHL496M/QZxkjf5/Efucp2gaDX6RS6CXpoY68LsvPVjR0ZSwzz1apAzvN9dlzEheX7ICJBBtuA6G3LQpzW5hOA2hzCTMjJPJ8LbqF6dsV6DoBQzgul0sGIcGOYl7OyQdXfZ57relSQageu+ipAdTTJ25AsRTAoub8ONGcLmqrAmRLKBP1dfwhYB4N7knNnulqQxA+Uk1ihz0="},
+				},
+			},
+			want: []dns.RR{
+				&dns.DNSKEY{
+					Hdr: dns.RR_Header{
+						Name:   "example.com.",
+						Rrtype: dns.TypeDNSKEY,
+						Class:  dns.ClassINET,
+						Ttl:    300,
+					},
+					Flags:     257,
+					Protocol:  3,
+					Algorithm: 8,
+					PublicKey: "AwEAAagAIKlVZrpC6Ia7gEzahOR+9W29euxhJhVVLOyQbSEW0O8gcCjFFVQUTf6v58fLjwBd0YI0EzrAcQqBGCzh/RStIoO8g0NfnfL2MTJRkxoXbfDaUeVPQuYEhg37NZWAJQ9VnMVDxP/VHL496M/QZxkjf5/Efucp2gaDX6RS6CXpoY68LsvPVjR0ZSwzz1apAzvN9dlzEheX7ICJBBtuA6G3LQpzW5hOA2hzCTMjJPJ8LbqF6dsV6DoBQzgul0sGIcGOYl7OyQdXfZ57relSQageu+ipAdTTJ25AsRTAoub8ONGcLmqrAmRLKBP1dfwhYB4N7knNnulqQxA+Uk1ihz0=",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "public key split over several fields",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "DNSKEY",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "257 3 8 AwEAAagAIKlVZrpC6Ia7gEzahOR+9W29euxhJhVVLOyQbSEW0O8gcCjFFVQUTf6v58fLjwBd0YI0EzrAcQqBGCzh/RStIoO8g0Nf nfL2MTJRkxoXbfDaUeVPQuYEhg37NZWAJQ9VnMVDxP/VHL496M/QZxkjf5/Efucp2gaDX6RS6CXpoY68LsvPVjR0ZSwzz1apAzvN9dlzEheX7ICJBBtuA6G3LQpzW5hOA2hzCTMjJPJ8LbqF6dsV6DoBQzgul0sGIcGOYl7OyQdXfZ57relSQageu+ipAdTTJ25AsRTAoub8ONGcLmqrAmRLKBP1dfwhYB4N7knNnulqQxA+Uk1ihz0="},
+				},
+			},
+			want: []dns.RR{
+				&dns.DNSKEY{
+					Hdr: dns.RR_Header{
+						Name:   "example.com.",
+						Rrtype: dns.TypeDNSKEY,
+						Class:  dns.ClassINET,
+						Ttl:    300,
+					},
+					Flags:     257,
+					Protocol:  3,
+					Algorithm: 8,
+					PublicKey: "AwEAAagAIKlVZrpC6Ia7gEzahOR+9W29euxhJhVVLOyQbSEW0O8gcCjFFVQUTf6v58fLjwBd0YI0EzrAcQqBGCzh/RStIoO8g0NfnfL2MTJRkxoXbfDaUeVPQuYEhg37NZWAJQ9VnMVDxP/VHL496M/QZxkjf5/Efucp2gaDX6RS6CXpoY68LsvPVjR0ZSwzz1apAzvN9dlzEheX7ICJBBtuA6G3LQpzW5hOA2hzCTMjJPJ8LbqF6dsV6DoBQzgul0sGIcGOYl7OyQdXfZ57relSQageu+ipAdTTJ25AsRTAoub8ONGcLmqrAmRLKBP1dfwhYB4N7knNnulqQxA+Uk1ihz0=",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid DNSKEY field count",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "DNSKEY",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "257 3 8"},
+				},
+			},
+			want:    nil,
+			wantErr: ErrDNSKEYRecordFieldCount,
+		},
+		{
+			name: "invalid DNSKEY protocol",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "DNSKEY",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "257 2 8 AwEAAagAIKlVZrpC6Ia7gEzahOR+9W29euxhJhVVLOyQbSEW0O8gcCjFFVQUTf6v58fLjwBd0YI0EzrAcQqBGCzh/RStIoO8g0NfnfL2MTJRkxoXbfDaUeVPQuYEhg37NZWAJQ9VnMVDxP/VHL496M/QZxkjf5/Efucp2gaDX6RS6CXpoY68LsvPVjR0ZSwzz1apAzvN9dlzEheX7ICJBBtuA6G3LQpzW5hOA2hzCTMjJPJ8LbqF6dsV6DoBQzgul0sGIcGOYl7OyQdXfZ57relSQageu+ipAdTTJ25AsRTAoub8ONGcLmqrAmRLKBP1dfwhYB4N7knNnulqQxA+Uk1ihz0="},
+				},
+			},
+			want:    nil,
+			wantErr: ErrInvalidDNSKEYProtocol,
+		},
+		{
+			name: "invalid DNSKEY public key",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "DNSKEY",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "257 3 8 not-base64!"},
+				},
+			},
+			want:    nil,
+			wantErr: ErrInvalidBase64,
+		},
+		{
+			name: "empty resource records",
+			rrset: &model.ResourceRecordSet{
+				Name:            "example.com.",
+				Type:            "DNSKEY",
+				TTL:             300,
+				ResourceRecords: []model.ResourceRecord{},
+			},
+			want:    nil,
+			wantErr: ErrNoResourceRecords,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DNSKEY(tt.rrset)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCDNSKEY(t *testing.T) {
+	tests := []struct {
+		name    string
+		rrset   *model.ResourceRecordSet
+		want    []dns.RR
+		wantErr error
+	}{
+		{
+			name: "delete CDNSKEY record",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "CDNSKEY",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "0 3 0 AA=="},
+				},
+			},
+			want: []dns.RR{
+				&dns.CDNSKEY{
+					DNSKEY: dns.DNSKEY{
+						Hdr: dns.RR_Header{
+							Name:   "example.com.",
+							Rrtype: dns.TypeCDNSKEY,
+							Class:  dns.ClassINET,
+							Ttl:    300,
+						},
+						Algorithm: 0,
+						Protocol:  3,
+						PublicKey: "AA==",
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid CDNSKEY field count",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "CDNSKEY",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "0 3"},
+				},
+			},
+			want:    nil,
+			wantErr: ErrDNSKEYRecordFieldCount,
+		},
+		{
+			name: "empty resource records",
+			rrset: &model.ResourceRecordSet{
+				Name:            "example.com.",
+				Type:            "CDNSKEY",
+				TTL:             300,
+				ResourceRecords: []model.ResourceRecord{},
+			},
+			want:    nil,
+			wantErr: ErrNoResourceRecords,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CDNSKEY(tt.rrset)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCDS(t *testing.T) {
+	tests := []struct {
+		name    string
+		rrset   *model.ResourceRecordSet
+		want    []dns.RR
+		wantErr error
+	}{
+		{
+			name: "valid CDS record",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "CDS",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "60485 8 2 E3D3C291F7D3C291F7D3C291F7D3C291F7D3C291F7D3C291F7D3C291F7D3C291"},
+				},
+			},
+			want: []dns.RR{
+				&dns.CDS{
+					DS: dns.DS{
+						Hdr: dns.RR_Header{
+							Name:   "example.com.",
+							Rrtype: dns.TypeCDS,
+							Class:  dns.ClassINET,
+							Ttl:    300,
+						},
+						KeyTag:     60485,
+						Algorithm:  8,
+						DigestType: 2,
+						Digest:     "E3D3C291F7D3C291F7D3C291F7D3C291F7D3C291F7D3C291F7D3C291F7D3C291",
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid CDS field count",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "CDS",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "60485 8"},
+				},
+			},
+			want:    nil,
+			wantErr: ErrDSRecordFieldCount,
+		},
+		{
+			name: "empty resource records",
+			rrset: &model.ResourceRecordSet{
+				Name:            "example.com.",
+				Type:            "CDS",
+				TTL:             300,
+				ResourceRecords: []model.ResourceRecord{},
+			},
+			want:    nil,
+			wantErr: ErrNoResourceRecords,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CDS(tt.rrset)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDNAME(t *testing.T) {
+	tests := []struct {
+		name    string
+		rrset   *model.ResourceRecordSet
+		want    []dns.RR
+		wantErr error
+	}{
+		{
+			name: "valid DNAME record",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "DNAME",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "example.net"},
+				},
+			},
+			want: []dns.RR{
+				&dns.DNAME{
+					Hdr: dns.RR_Header{
+						Name:   "example.com.",
+						Rrtype: dns.TypeDNAME,
+						Class:  dns.ClassINET,
+						Ttl:    300,
+					},
+					Target: "example.net.",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid DNAME target",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "DNAME",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "bad..name"},
+				},
+			},
+			want:    nil,
+			wantErr: ErrInvalidDomainName,
+		},
+		{
+			name: "empty resource records",
+			rrset: &model.ResourceRecordSet{
+				Name:            "example.com.",
+				Type:            "DNAME",
+				TTL:             300,
+				ResourceRecords: []model.ResourceRecord{},
+			},
+			want:    nil,
+			wantErr: ErrNoResourceRecords,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DNAME(tt.rrset)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHINFO(t *testing.T) {
+	tests := []struct {
+		name    string
+		rrset   *model.ResourceRecordSet
+		want    []dns.RR
+		wantErr error
+	}{
+		{
+			name: "quoted HINFO record",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "HINFO",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "\"Intel Xeon\" \"Linux\""},
+				},
+			},
+			want: []dns.RR{
+				&dns.HINFO{
+					Hdr: dns.RR_Header{
+						Name:   "example.com.",
+						Rrtype: dns.TypeHINFO,
+						Class:  dns.ClassINET,
+						Ttl:    300,
+					},
+					Cpu: "Intel Xeon",
+					Os:  "Linux",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unquoted HINFO record",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "HINFO",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "ARM64 FreeBSD"},
+				},
+			},
+			want: []dns.RR{
+				&dns.HINFO{
+					Hdr: dns.RR_Header{
+						Name:   "example.com.",
+						Rrtype: dns.TypeHINFO,
+						Class:  dns.ClassINET,
+						Ttl:    300,
+					},
+					Cpu: "ARM64",
+					Os:  "FreeBSD",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid HINFO field count",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "HINFO",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "ARM64"},
+				},
+			},
+			want:    nil,
+			wantErr: ErrHINFORecordFieldCount,
+		},
+		{
+			name: "empty resource records",
+			rrset: &model.ResourceRecordSet{
+				Name:            "example.com.",
+				Type:            "HINFO",
+				TTL:             300,
+				ResourceRecords: []model.ResourceRecord{},
+			},
+			want:    nil,
+			wantErr: ErrNoResourceRecords,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := HINFO(tt.rrset)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLOC(t *testing.T) {
+	tests := []struct {
+		name    string
+		rrset   *model.ResourceRecordSet
+		want    []dns.RR
+		wantErr error
+	}{
+		{
+			name: "valid LOC record",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "LOC",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "52 22 23.000 N 4 53 32.000 E -2.00m 0.00m 10000m 10m"},
+				},
+			},
+			want: []dns.RR{
+				&dns.LOC{
+					Hdr: dns.RR_Header{
+						Name:   "example.com.",
+						Rrtype: dns.TypeLOC,
+						Class:  dns.ClassINET,
+						Ttl:    300,
+					},
+					Version:   0,
+					Size:      0x0,
+					HorizPre:  0x16,
+					VertPre:   0x13,
+					Latitude:  0x8b3cf018,
+					Longitude: 0x810cbce0,
+					Altitude:  0x9895b8,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid LOC field count",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "LOC",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "52 N 4"},
+				},
+			},
+			want:    nil,
+			wantErr: ErrLOCRecordFieldCount,
+		},
+		{
+			name: "invalid LOC hemisphere",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "LOC",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "52 22 23.000 X 4 53 32.000 E -2.00m"},
+				},
+			},
+			want:    nil,
+			wantErr: ErrInvalidLOCValue,
+		},
+		{
+			name: "LOC record with a comment",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "LOC",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "52 N 4 E 10m ; 4 E"},
+				},
+			},
+			want:    nil,
+			wantErr: ErrInvalidLOCValue,
+		},
+		{
+			name: "empty resource records",
+			rrset: &model.ResourceRecordSet{
+				Name:            "example.com.",
+				Type:            "LOC",
+				TTL:             300,
+				ResourceRecords: []model.ResourceRecord{},
+			},
+			want:    nil,
+			wantErr: ErrNoResourceRecords,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LOC(tt.rrset)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestOPENPGPKEY(t *testing.T) {
+	tests := []struct {
+		name    string
+		rrset   *model.ResourceRecordSet
+		want    []dns.RR
+		wantErr error
+	}{
+		{
+			name: "valid OPENPGPKEY record",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "OPENPGPKEY",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "mQINBFit2jsBEADrbl5vjVxYeAE0g0IDYCBpHirv1Sjlqxx5gjtPhb2YhvyDMXjq"},
+				},
+			},
+			want: []dns.RR{
+				&dns.OPENPGPKEY{
+					Hdr: dns.RR_Header{
+						Name:   "example.com.",
+						Rrtype: dns.TypeOPENPGPKEY,
+						Class:  dns.ClassINET,
+						Ttl:    300,
+					},
+					PublicKey: "mQINBFit2jsBEADrbl5vjVxYeAE0g0IDYCBpHirv1Sjlqxx5gjtPhb2YhvyDMXjq",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid OPENPGPKEY public key",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "OPENPGPKEY",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "not base64"},
+				},
+			},
+			want:    nil,
+			wantErr: ErrInvalidBase64,
+		},
+		{
+			name: "empty resource records",
+			rrset: &model.ResourceRecordSet{
+				Name:            "example.com.",
+				Type:            "OPENPGPKEY",
+				TTL:             300,
+				ResourceRecords: []model.ResourceRecord{},
+			},
+			want:    nil,
+			wantErr: ErrNoResourceRecords,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := OPENPGPKEY(tt.rrset)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRP(t *testing.T) {
+	tests := []struct {
+		name    string
+		rrset   *model.ResourceRecordSet
+		want    []dns.RR
+		wantErr error
+	}{
+		{
+			name: "valid RP record",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "RP",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "hostmaster.example.com. contact.example.com."},
+				},
+			},
+			want: []dns.RR{
+				&dns.RP{
+					Hdr: dns.RR_Header{
+						Name:   "example.com.",
+						Rrtype: dns.TypeRP,
+						Class:  dns.ClassINET,
+						Ttl:    300,
+					},
+					Mbox: "hostmaster.example.com.",
+					Txt:  "contact.example.com.",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "no TXT record",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "RP",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "hostmaster.example.com. ."},
+				},
+			},
+			want: []dns.RR{
+				&dns.RP{
+					Hdr: dns.RR_Header{
+						Name:   "example.com.",
+						Rrtype: dns.TypeRP,
+						Class:  dns.ClassINET,
+						Ttl:    300,
+					},
+					Mbox: "hostmaster.example.com.",
+					Txt:  ".",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid RP field count",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "RP",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "hostmaster.example.com."},
+				},
+			},
+			want:    nil,
+			wantErr: ErrRPRecordFieldCount,
+		},
+		{
+			name: "empty resource records",
+			rrset: &model.ResourceRecordSet{
+				Name:            "example.com.",
+				Type:            "RP",
+				TTL:             300,
+				ResourceRecords: []model.ResourceRecord{},
+			},
+			want:    nil,
+			wantErr: ErrNoResourceRecords,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RP(tt.rrset)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestURI(t *testing.T) {
+	tests := []struct {
+		name    string
+		rrset   *model.ResourceRecordSet
+		want    []dns.RR
+		wantErr error
+	}{
+		{
+			name: "valid URI record",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "URI",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "10 1 \"https://www.example.com/path\""},
+				},
+			},
+			want: []dns.RR{
+				&dns.URI{
+					Hdr: dns.RR_Header{
+						Name:   "example.com.",
+						Rrtype: dns.TypeURI,
+						Class:  dns.ClassINET,
+						Ttl:    300,
+					},
+					Priority: 10,
+					Weight:   1,
+					Target:   "https://www.example.com/path",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid URI field count",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "URI",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "10 1"},
+				},
+			},
+			want:    nil,
+			wantErr: ErrURIRecordFieldCount,
+		},
+		{
+			name: "unquoted URI target",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "URI",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "10 1 https://www.example.com/"},
+				},
+			},
+			want:    nil,
+			wantErr: ErrInvalidURITarget,
+		},
+		{
+			name: "invalid URI priority",
+			rrset: &model.ResourceRecordSet{
+				Name: "example.com.",
+				Type: "URI",
+				TTL:  300,
+				ResourceRecords: []model.ResourceRecord{
+					{Value: "high 1 \"https://www.example.com/\""},
+				},
+			},
+			want:    nil,
+			wantErr: ErrInvalidInteger,
+		},
+		{
+			name: "empty resource records",
+			rrset: &model.ResourceRecordSet{
+				Name:            "example.com.",
+				Type:            "URI",
+				TTL:             300,
+				ResourceRecords: []model.ResourceRecord{},
+			},
+			want:    nil,
+			wantErr: ErrNoResourceRecords,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := URI(tt.rrset)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
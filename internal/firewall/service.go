@@ -8,6 +8,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/miekg/dns"
 
+	"github.com/davidseybold/beacondns/internal/audit"
+	"github.com/davidseybold/beacondns/internal/auth"
 	"github.com/davidseybold/beacondns/internal/beaconerr"
 	"github.com/davidseybold/beacondns/internal/model"
 	"github.com/davidseybold/beacondns/internal/repository"
@@ -18,32 +20,69 @@ type Service interface {
 	UpdateFirewallRule(ctx context.Context, rule *model.FirewallRule) (*model.FirewallRule, error)
 	DeleteFirewallRule(ctx context.Context, id uuid.UUID) error
 	GetFirewallRule(ctx context.Context, id uuid.UUID) (*model.FirewallRule, error)
-	GetFirewallRules(ctx context.Context) ([]model.FirewallRule, error)
-
-	CreateUnmanagedDomainList(ctx context.Context, name string, domains []string) (*model.DomainListInfo, error)
-	CreateManagedDomainList(ctx context.Context, name string, sourceURL string) (*model.DomainListInfo, error)
+	GetFirewallRules(ctx context.Context, opts model.ListOptions) (model.Page[model.FirewallRule], error)
+	UpdateFirewallRuleTags(ctx context.Context, id uuid.UUID, set model.Tags, remove []string) (model.Tags, error)
+
+	CreateUnmanagedDomainList(
+		ctx context.Context,
+		name string,
+		domains []string,
+		tags model.Tags,
+	) (*model.DomainListInfo, error)
+	CreateManagedDomainList(
+		ctx context.Context,
+		name string,
+		sourceURL string,
+		tags model.Tags,
+	) (*model.DomainListInfo, error)
 	DeleteDomainList(ctx context.Context, id uuid.UUID) error
 	RefreshManagedDomainList(ctx context.Context, id uuid.UUID) (*model.DomainListInfo, error)
 	AddDomainsToDomainList(ctx context.Context, id uuid.UUID, domains []string) error
 	RemoveDomainsFromDomainList(ctx context.Context, id uuid.UUID, domains []string) error
 	GetDomainList(ctx context.Context, id uuid.UUID) (*model.DomainListInfo, error)
-	GetDomainLists(ctx context.Context) ([]model.DomainListInfo, error)
-	GetDomainListDomains(ctx context.Context, id uuid.UUID) ([]string, error)
+	GetDomainLists(ctx context.Context, opts model.ListOptions) (model.Page[model.DomainListInfo], error)
+	UpdateDomainListTags(ctx context.Context, id uuid.UUID, set model.Tags, remove []string) (model.Tags, error)
+	GetDomainListDomains(ctx context.Context, id uuid.UUID, opts model.ListOptions) (model.Page[string], error)
+}
+
+// ServiceConfig holds the tunable behaviour of the firewall service.
+type ServiceConfig struct {
+	// MaxDomainsPerList limits the number of domains in a domain list, including the domains
+	// fetched for managed lists. There is no limit when it is zero.
+	MaxDomainsPerList int
 }
 
 type DefaultService struct {
-	repReg repository.TransactorRegistry
+	repReg            repository.TransactorRegistry
+	maxDomainsPerList int
 }
 
 var _ Service = (*DefaultService)(nil)
 
-func NewService(repReg repository.TransactorRegistry) *DefaultService {
+func NewService(repReg repository.TransactorRegistry, cfg ServiceConfig) *DefaultService {
 	return &DefaultService{
-		repReg: repReg,
+		repReg:            repReg,
+		maxDomainsPerList: cfg.MaxDomainsPerList,
+	}
+}
+
+// checkDomainQuota returns a quota exceeded error if a domain list with count domains holds
+// more than the service allows.
+func (d *DefaultService) checkDomainQuota(count int) error {
+	if d.maxDomainsPerList == 0 || count <= d.maxDomainsPerList {
+		return nil
 	}
+
+	return beaconerr.ErrQuotaExceeded(
+		fmt.Sprintf("the number of domains in a domain list is limited to %d", d.maxDomainsPerList),
+	)
 }
 
 func (d *DefaultService) AddDomainsToDomainList(ctx context.Context, id uuid.UUID, domains []string) error {
+	if err := auth.Authorize(ctx, model.ActionWrite, model.DomainListResource(id)); err != nil {
+		return err
+	}
+
 	info, err := d.repReg.GetFirewallRepository().GetDomainListInfo(ctx, id)
 	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
 		return beaconerr.ErrNoSuchDomainList("Domain list not found")
@@ -66,15 +105,27 @@ func (d *DefaultService) AddDomainsToDomainList(ctx context.Context, id uuid.UUI
 			return txErr
 		}
 
+		// The list is counted after the domains are added, so that domains added concurrently
+		// are counted too.
+		updated, txErr := r.GetFirewallRepository().GetDomainListInfo(ctx, id)
+		if txErr != nil {
+			return txErr
+		}
+		if txErr = d.checkDomainQuota(updated.DomainCount); txErr != nil {
+			return txErr
+		}
+
 		event := NewDomainListDomainsAddedEvent(id, fqdnDomains)
 		if txErr = d.repReg.GetEventRepository().CreateEvent(ctx, event); txErr != nil {
 			return fmt.Errorf("failed to save event: %w", txErr)
 		}
 
-		return nil
+		return audit.Record(ctx, r, model.AuditOperationAddDomains, model.DomainListResource(id), nil, fqdnDomains)
 	})
 	if err != nil && errors.Is(err, repository.ErrEntityAlreadyExists) {
 		return beaconerr.ErrDomainExistsInDomainList("domain already exists in domain list")
+	} else if err != nil && beaconerr.IsBadRequestError(err) {
+		return err
 	} else if err != nil {
 		return beaconerr.ErrInternalError("failed to add domains to domain list", err)
 	}
@@ -86,16 +137,30 @@ func (d *DefaultService) CreateUnmanagedDomainList(
 	ctx context.Context,
 	name string,
 	domains []string,
+	tags model.Tags,
 ) (*model.DomainListInfo, error) {
+	if err := auth.Authorize(ctx, model.ActionWrite, model.AllResources(model.ResourceKindDomainList)); err != nil {
+		return nil, err
+	}
+
+	if err := tags.Validate(); err != nil {
+		return nil, beaconerr.ErrInvalidArgument(err.Error(), "tags")
+	}
+
 	fqdnDomains := make([]string, 0, len(domains))
 	for _, domain := range domains {
 		fqdnDomains = append(fqdnDomains, dns.Fqdn(domain))
 	}
 
+	if err := d.checkDomainQuota(len(fqdnDomains)); err != nil {
+		return nil, err
+	}
+
 	dl := &model.DomainList{
 		ID:      uuid.New(),
 		Name:    name,
 		Domains: fqdnDomains,
+		Tags:    tags,
 	}
 
 	var info *model.DomainListInfo
@@ -111,7 +176,7 @@ func (d *DefaultService) CreateUnmanagedDomainList(
 			return fmt.Errorf("failed to save event: %w", txErr)
 		}
 
-		return nil
+		return audit.Record(ctx, r, model.AuditOperationCreateDomainList, model.DomainListResource(info.ID), nil, dl)
 	})
 	if err != nil {
 		return nil, beaconerr.ErrInternalError("failed to create domain list", err)
@@ -125,12 +190,22 @@ func (d *DefaultService) CreateManagedDomainList(
 	ctx context.Context,
 	name string,
 	sourceURL string,
+	tags model.Tags,
 ) (*model.DomainListInfo, error) {
+	if err := auth.Authorize(ctx, model.ActionWrite, model.AllResources(model.ResourceKindDomainList)); err != nil {
+		return nil, err
+	}
+
+	if err := tags.Validate(); err != nil {
+		return nil, beaconerr.ErrInvalidArgument(err.Error(), "tags")
+	}
+
 	dl := &model.DomainList{
 		ID:        uuid.New(),
 		Name:      name,
 		IsManaged: true,
 		SourceURL: &sourceURL,
+		Tags:      tags,
 	}
 
 	domains, err := fetchDomainListFromSourceURL(ctx, sourceURL)
@@ -138,6 +213,10 @@ func (d *DefaultService) CreateManagedDomainList(
 		return nil, beaconerr.ErrInternalError("failed to fetch domain list from source URL", err)
 	}
 
+	if err = d.checkDomainQuota(len(domains)); err != nil {
+		return nil, err
+	}
+
 	dl.Domains = domains
 
 	var info *model.DomainListInfo
@@ -148,7 +227,9 @@ func (d *DefaultService) CreateManagedDomainList(
 			return fmt.Errorf("failed to create domain list: %w", txErr)
 		}
 
-		return nil
+		// The domains come from the source URL and can number in the millions, so only the
+		// summary of the list is recorded.
+		return audit.Record(ctx, r, model.AuditOperationCreateDomainList, model.DomainListResource(info.ID), nil, info)
 	})
 	if err != nil {
 		return nil, beaconerr.ErrInternalError("failed to create domain list", err)
@@ -158,6 +239,10 @@ func (d *DefaultService) CreateManagedDomainList(
 }
 
 func (d *DefaultService) RefreshManagedDomainList(ctx context.Context, id uuid.UUID) (*model.DomainListInfo, error) {
+	if err := auth.Authorize(ctx, model.ActionWrite, model.DomainListResource(id)); err != nil {
+		return nil, err
+	}
+
 	info, err := d.repReg.GetFirewallRepository().GetDomainListInfo(ctx, id)
 	if err != nil {
 		return nil, beaconerr.ErrInternalError("failed to get domain list", err)
@@ -172,6 +257,10 @@ func (d *DefaultService) RefreshManagedDomainList(ctx context.Context, id uuid.U
 		return nil, beaconerr.ErrInternalError("failed to fetch domain list from source URL", err)
 	}
 
+	if err = d.checkDomainQuota(len(domains)); err != nil {
+		return nil, err
+	}
+
 	var updatedInfo *model.DomainListInfo
 	err = d.repReg.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
 		var txErr error
@@ -185,7 +274,8 @@ func (d *DefaultService) RefreshManagedDomainList(ctx context.Context, id uuid.U
 			return fmt.Errorf("failed to save event: %w", txErr)
 		}
 
-		return nil
+		return audit.Record(ctx, r, model.AuditOperationRefreshDomainList, model.DomainListResource(id),
+			info, updatedInfo)
 	})
 	if err != nil {
 		return nil, beaconerr.ErrInternalError("failed to refresh domain list", err)
@@ -198,6 +288,17 @@ func (d *DefaultService) CreateFirewallRule(
 	ctx context.Context,
 	rule *model.FirewallRule,
 ) (*model.FirewallRule, error) {
+	if err := auth.Authorize(ctx, model.ActionWrite, model.AllResources(model.ResourceKindFirewallRule)); err != nil {
+		return nil, err
+	}
+	if err := auth.Authorize(ctx, model.ActionRead, model.DomainListResource(rule.DomainListID)); err != nil {
+		return nil, err
+	}
+
+	if err := rule.Tags.Validate(); err != nil {
+		return nil, beaconerr.ErrInvalidArgument(err.Error(), "tags")
+	}
+
 	_, err := d.repReg.GetFirewallRepository().GetDomainListInfo(ctx, rule.DomainListID)
 	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
 		return nil, beaconerr.ErrNoSuchDomainList("domain list not found")
@@ -220,7 +321,8 @@ func (d *DefaultService) CreateFirewallRule(
 			return fmt.Errorf("failed to save event: %w", txErr)
 		}
 
-		return nil
+		return audit.Record(ctx, r, model.AuditOperationCreateFirewallRule, model.FirewallRuleResource(createdRule.ID),
+			nil, createdRule)
 	})
 	if err != nil {
 		return nil, beaconerr.ErrInternalError("failed to create firewall rule", err)
@@ -230,8 +332,17 @@ func (d *DefaultService) CreateFirewallRule(
 }
 
 func (d *DefaultService) DeleteDomainList(ctx context.Context, id uuid.UUID) error {
+	if err := auth.Authorize(ctx, model.ActionDelete, model.DomainListResource(id)); err != nil {
+		return err
+	}
+
 	err := d.repReg.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
-		txErr := r.GetFirewallRepository().DeleteDomainList(ctx, id)
+		info, txErr := r.GetFirewallRepository().GetDomainListInfo(ctx, id)
+		if txErr != nil {
+			return fmt.Errorf("failed to get domain list: %w", txErr)
+		}
+
+		txErr = r.GetFirewallRepository().DeleteDomainList(ctx, id)
 		if txErr != nil {
 			return fmt.Errorf("failed to delete domain list: %w", txErr)
 		}
@@ -241,7 +352,7 @@ func (d *DefaultService) DeleteDomainList(ctx context.Context, id uuid.UUID) err
 			return fmt.Errorf("failed to save event: %w", txErr)
 		}
 
-		return nil
+		return audit.Record(ctx, r, model.AuditOperationDeleteDomainList, model.DomainListResource(id), info, nil)
 	})
 	if err != nil {
 		return beaconerr.ErrInternalError("failed to delete domain list", err)
@@ -251,7 +362,11 @@ func (d *DefaultService) DeleteDomainList(ctx context.Context, id uuid.UUID) err
 }
 
 func (d *DefaultService) DeleteFirewallRule(ctx context.Context, id uuid.UUID) error {
-	_, err := d.repReg.GetFirewallRepository().GetFirewallRule(ctx, id)
+	if err := auth.Authorize(ctx, model.ActionDelete, model.FirewallRuleResource(id)); err != nil {
+		return err
+	}
+
+	rule, err := d.repReg.GetFirewallRepository().GetFirewallRule(ctx, id)
 	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
 		return beaconerr.ErrNoSuchFirewallRule("firewall rule not found")
 	} else if err != nil {
@@ -269,7 +384,7 @@ func (d *DefaultService) DeleteFirewallRule(ctx context.Context, id uuid.UUID) e
 			return fmt.Errorf("failed to save event: %w", txErr)
 		}
 
-		return nil
+		return audit.Record(ctx, r, model.AuditOperationDeleteFirewallRule, model.FirewallRuleResource(id), rule, nil)
 	})
 	if err != nil {
 		return beaconerr.ErrInternalError("failed to delete firewall rule", err)
@@ -279,6 +394,10 @@ func (d *DefaultService) DeleteFirewallRule(ctx context.Context, id uuid.UUID) e
 }
 
 func (d *DefaultService) GetDomainList(ctx context.Context, id uuid.UUID) (*model.DomainListInfo, error) {
+	if err := auth.Authorize(ctx, model.ActionRead, model.DomainListResource(id)); err != nil {
+		return nil, err
+	}
+
 	info, err := d.repReg.GetFirewallRepository().GetDomainListInfo(ctx, id)
 	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
 		return nil, beaconerr.ErrNoSuchDomainList("domain list not found")
@@ -289,16 +408,43 @@ func (d *DefaultService) GetDomainList(ctx context.Context, id uuid.UUID) (*mode
 	return info, nil
 }
 
-func (d *DefaultService) GetDomainListDomains(ctx context.Context, id uuid.UUID) ([]string, error) {
-	domains, err := d.repReg.GetFirewallRepository().GetDomainListDomains(ctx, id)
+// GetDomainListDomains returns a page of the domains of the domain list, sorted by domain.
+func (d *DefaultService) GetDomainListDomains(
+	ctx context.Context,
+	id uuid.UUID,
+	opts model.ListOptions,
+) (model.Page[string], error) {
+	if err := auth.Authorize(ctx, model.ActionRead, model.DomainListResource(id)); err != nil {
+		return model.Page[string]{}, err
+	}
+
+	if err := opts.Normalize(model.SortAscending, model.SortByDomain); err != nil {
+		return model.Page[string]{}, beaconerr.ErrInvalidArgument(err.Error(), "options")
+	}
+	if !opts.Tags.IsZero() {
+		return model.Page[string]{}, beaconerr.ErrInvalidArgument("domains cannot be filtered by tags", "options")
+	}
+
+	_, err := d.repReg.GetFirewallRepository().GetDomainListInfo(ctx, id)
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return model.Page[string]{}, beaconerr.ErrNoSuchDomainList("domain list not found")
+	} else if err != nil {
+		return model.Page[string]{}, beaconerr.ErrInternalError("failed to get domain list domains", err)
+	}
+
+	domains, err := d.repReg.GetFirewallRepository().ListDomainListDomains(ctx, id, opts)
 	if err != nil {
-		return nil, beaconerr.ErrInternalError("failed to get domain list domains", err)
+		return model.Page[string]{}, listError("failed to get domain list domains", err)
 	}
 
 	return domains, nil
 }
 
 func (d *DefaultService) GetFirewallRule(ctx context.Context, id uuid.UUID) (*model.FirewallRule, error) {
+	if err := auth.Authorize(ctx, model.ActionRead, model.FirewallRuleResource(id)); err != nil {
+		return nil, err
+	}
+
 	rule, err := d.repReg.GetFirewallRepository().GetFirewallRule(ctx, id)
 	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
 		return nil, beaconerr.ErrNoSuchFirewallRule("firewall rule not found")
@@ -309,25 +455,170 @@ func (d *DefaultService) GetFirewallRule(ctx context.Context, id uuid.UUID) (*mo
 	return rule, nil
 }
 
-func (d *DefaultService) GetDomainLists(ctx context.Context) ([]model.DomainListInfo, error) {
-	lists, err := d.repReg.GetFirewallRepository().ListDomainLists(ctx)
+// GetDomainLists returns a page of the domain lists, sorted by name or last update.
+func (d *DefaultService) GetDomainLists(
+	ctx context.Context,
+	opts model.ListOptions,
+) (model.Page[model.DomainListInfo], error) {
+	if err := opts.Normalize(model.SortAscending, model.SortByName, model.SortByUpdatedAt); err != nil {
+		return model.Page[model.DomainListInfo]{}, beaconerr.ErrInvalidArgument(err.Error(), "options")
+	}
+
+	lists, err := d.repReg.GetFirewallRepository().ListDomainLists(ctx, opts)
 	if err != nil {
-		return nil, beaconerr.ErrInternalError("failed to list domain lists", err)
+		return model.Page[model.DomainListInfo]{}, listError("failed to list domain lists", err)
 	}
 
-	return lists, nil
+	return auth.FilterPage(ctx, lists, func(list *model.DomainListInfo) string {
+		return model.DomainListResource(list.ID)
+	}), nil
 }
 
-func (d *DefaultService) GetFirewallRules(ctx context.Context) ([]model.FirewallRule, error) {
-	rules, err := d.repReg.GetFirewallRepository().ListFirewallRules(ctx)
+// GetFirewallRules returns a page of the firewall rules, sorted by priority or name.
+func (d *DefaultService) GetFirewallRules(
+	ctx context.Context,
+	opts model.ListOptions,
+) (model.Page[model.FirewallRule], error) {
+	if err := opts.Normalize(model.SortAscending, model.SortByPriority, model.SortByName); err != nil {
+		return model.Page[model.FirewallRule]{}, beaconerr.ErrInvalidArgument(err.Error(), "options")
+	}
+
+	rules, err := d.repReg.GetFirewallRepository().ListFirewallRules(ctx, opts)
 	if err != nil {
-		return nil, beaconerr.ErrInternalError("failed to list firewall rules", err)
+		return model.Page[model.FirewallRule]{}, listError("failed to list firewall rules", err)
+	}
+
+	return auth.FilterPage(ctx, rules, func(rule *model.FirewallRule) string {
+		return model.FirewallRuleResource(rule.ID)
+	}), nil
+}
+
+// listError maps the error of a list query. Whether a cursor fits the sort key is only known
+// once the query is built.
+func listError(message string, err error) error {
+	if errors.Is(err, model.ErrInvalidCursor) {
+		return beaconerr.ErrInvalidArgument(err.Error(), "cursor")
 	}
+	return beaconerr.ErrInternalError(message, err)
+}
 
-	return rules, nil
+// UpdateDomainListTags adds or overwrites the tags in set on the domain list and removes the
+// keys in remove. Tags do not affect filtering, so no event is published.
+func (d *DefaultService) UpdateDomainListTags(
+	ctx context.Context,
+	id uuid.UUID,
+	set model.Tags,
+	remove []string,
+) (model.Tags, error) {
+	if err := auth.Authorize(ctx, model.ActionWrite, model.DomainListResource(id)); err != nil {
+		return nil, err
+	}
+
+	tags, err := d.updateTags(ctx, set, tagsUpdate{
+		operation: model.AuditOperationUpdateDomainListTag,
+		target:    model.DomainListResource(id),
+		current: func(ctx context.Context, r repository.Registry) (model.Tags, error) {
+			info, err := r.GetFirewallRepository().GetDomainListInfo(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return info.Tags, nil
+		},
+		update: func(ctx context.Context, r repository.Registry) (model.Tags, error) {
+			return r.GetFirewallRepository().UpdateDomainListTags(ctx, id, set, remove)
+		},
+	})
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchDomainList("domain list not found")
+	} else if err != nil && beaconerr.IsBadRequestError(err) {
+		return nil, err
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to update domain list tags", err)
+	}
+
+	return tags, nil
+}
+
+// UpdateFirewallRuleTags adds or overwrites the tags in set on the firewall rule and removes
+// the keys in remove. Tags do not affect filtering, so no event is published.
+func (d *DefaultService) UpdateFirewallRuleTags(
+	ctx context.Context,
+	id uuid.UUID,
+	set model.Tags,
+	remove []string,
+) (model.Tags, error) {
+	if err := auth.Authorize(ctx, model.ActionWrite, model.FirewallRuleResource(id)); err != nil {
+		return nil, err
+	}
+
+	tags, err := d.updateTags(ctx, set, tagsUpdate{
+		operation: model.AuditOperationUpdateRuleTags,
+		target:    model.FirewallRuleResource(id),
+		current: func(ctx context.Context, r repository.Registry) (model.Tags, error) {
+			rule, err := r.GetFirewallRepository().GetFirewallRule(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return rule.Tags, nil
+		},
+		update: func(ctx context.Context, r repository.Registry) (model.Tags, error) {
+			return r.GetFirewallRepository().UpdateFirewallRuleTags(ctx, id, set, remove)
+		},
+	})
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchFirewallRule("firewall rule not found")
+	} else if err != nil && beaconerr.IsBadRequestError(err) {
+		return nil, err
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to update firewall rule tags", err)
+	}
+
+	return tags, nil
+}
+
+// tagsUpdate changes the tags of a resource. current returns the tags before the change,
+// which are recorded in the audit log along with the tags update returns.
+type tagsUpdate struct {
+	operation string
+	target    string
+	current   func(ctx context.Context, r repository.Registry) (model.Tags, error)
+	update    func(ctx context.Context, r repository.Registry) (model.Tags, error)
+}
+
+// updateTags validates the tags being set, runs the update in a transaction, and rolls it
+// back if the resulting tags are no longer valid.
+func (d *DefaultService) updateTags(ctx context.Context, set model.Tags, u tagsUpdate) (model.Tags, error) {
+	if err := set.Validate(); err != nil {
+		return nil, beaconerr.ErrInvalidArgument(err.Error(), "set")
+	}
+
+	var tags model.Tags
+	err := d.repReg.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		before, txErr := u.current(ctx, r)
+		if txErr != nil {
+			return txErr
+		}
+
+		tags, txErr = u.update(ctx, r)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = tags.Validate(); txErr != nil {
+			return beaconerr.ErrInvalidArgument(txErr.Error(), "set")
+		}
+
+		return audit.Record(ctx, r, u.operation, u.target, before, tags)
+	})
+
+	return tags, err
 }
 
 func (d *DefaultService) RemoveDomainsFromDomainList(ctx context.Context, id uuid.UUID, domains []string) error {
+	if err := auth.Authorize(ctx, model.ActionWrite, model.DomainListResource(id)); err != nil {
+		return err
+	}
+
 	info, err := d.repReg.GetFirewallRepository().GetDomainListInfo(ctx, id)
 	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
 		return beaconerr.ErrNoSuchDomainList("domain list not found")
@@ -355,7 +646,7 @@ func (d *DefaultService) RemoveDomainsFromDomainList(ctx context.Context, id uui
 			return fmt.Errorf("failed to save event: %w", txErr)
 		}
 
-		return nil
+		return audit.Record(ctx, r, model.AuditOperationRemoveDomains, model.DomainListResource(id), fqdnDomains, nil)
 	})
 	if err != nil {
 		return beaconerr.ErrInternalError("failed to remove domains from domain list", err)
@@ -368,7 +659,14 @@ func (d *DefaultService) UpdateFirewallRule(
 	ctx context.Context,
 	rule *model.FirewallRule,
 ) (*model.FirewallRule, error) {
-	rule, err := d.repReg.GetFirewallRepository().GetFirewallRule(ctx, rule.ID)
+	if err := auth.Authorize(ctx, model.ActionWrite, model.FirewallRuleResource(rule.ID)); err != nil {
+		return nil, err
+	}
+	if err := auth.Authorize(ctx, model.ActionRead, model.DomainListResource(rule.DomainListID)); err != nil {
+		return nil, err
+	}
+
+	existing, err := d.repReg.GetFirewallRepository().GetFirewallRule(ctx, rule.ID)
 	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
 		return nil, beaconerr.ErrNoSuchFirewallRule("firewall rule not found")
 	} else if err != nil {
@@ -395,7 +693,8 @@ func (d *DefaultService) UpdateFirewallRule(
 			return fmt.Errorf("failed to save event: %w", txErr)
 		}
 
-		return nil
+		return audit.Record(ctx, r, model.AuditOperationUpdateFirewallRule, model.FirewallRuleResource(rule.ID),
+			existing, updatedRule)
 	})
 	if err != nil {
 		return nil, beaconerr.ErrInternalError("failed to update firewall rule", err)
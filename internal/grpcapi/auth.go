@@ -0,0 +1,66 @@
+package grpcapi
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/davidseybold/beacondns/internal/api"
+	"github.com/davidseybold/beacondns/internal/audit"
+	"github.com/davidseybold/beacondns/internal/auth"
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+)
+
+// authenticate returns the context of a call carrying the principal its bearer token
+// authenticates, and rate limits the call to method, as the authenticate and rateLimit
+// middleware of the REST API do for requests.
+func (i *interceptor) authenticate(ctx context.Context, method string) (context.Context, error) {
+	principal, err := i.rateLimiter.Authenticate(peerIP(ctx), func() (*auth.Principal, error) {
+		var header string
+		if values := metadata.ValueFromIncomingContext(ctx, "authorization"); len(values) > 0 {
+			header = values[0]
+		}
+
+		scheme, secret, ok := strings.Cut(header, " ")
+		if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(secret) == "" {
+			return nil, beaconerr.ErrUnauthorized("missing bearer token")
+		}
+
+		return i.authService.Authenticate(ctx, strings.TrimSpace(secret))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = i.rateLimiter.Allow(rateLimitGroup(method), api.PrincipalRateLimitClient(principal)); err != nil {
+		return nil, err
+	}
+
+	ctx = auth.WithPrincipal(ctx, principal)
+	ctx = audit.WithActor(ctx, audit.Actor{
+		Subject:  principal.Subject,
+		Name:     principal.Name,
+		SourceIP: peerIP(ctx),
+	})
+
+	return ctx, nil
+}
+
+// peerIP returns the IP address of the client of a call, or an empty string if it is not
+// known.
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
@@ -0,0 +1,293 @@
+package grpcapi
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+	beacondnsv1 "github.com/davidseybold/beacondns/proto/beacondns/v1"
+)
+
+func convertProtoListOptionsToModel(opts *beacondnsv1.ListOptions) (model.ListOptions, error) {
+	filter, err := model.ParseTagFilter(opts.GetTags())
+	if err != nil {
+		return model.ListOptions{}, beaconerr.ErrInvalidArgument(err.Error(), "options.tags")
+	}
+
+	var order model.SortOrder
+	switch opts.GetOrder() {
+	case beacondnsv1.SortOrder_SORT_ORDER_ASCENDING:
+		order = model.SortAscending
+	case beacondnsv1.SortOrder_SORT_ORDER_DESCENDING:
+		order = model.SortDescending
+	case beacondnsv1.SortOrder_SORT_ORDER_UNSPECIFIED:
+	}
+
+	return model.ListOptions{
+		Limit:      int(opts.GetLimit()),
+		Cursor:     opts.GetCursor(),
+		NamePrefix: opts.GetNamePrefix(),
+		SortBy:     opts.GetSortBy(),
+		Order:      order,
+		Tags:       filter,
+	}, nil
+}
+
+func convertProtoResourceRecordSetToModel(rrSet *beacondnsv1.ResourceRecordSet) *model.ResourceRecordSet {
+	if rrSet == nil {
+		return nil
+	}
+
+	records := make([]model.ResourceRecord, len(rrSet.GetResourceRecords()))
+	for i, record := range rrSet.GetResourceRecords() {
+		records[i] = model.ResourceRecord{Value: record.GetValue()}
+	}
+
+	return &model.ResourceRecordSet{
+		Name:            rrSet.GetName(),
+		Type:            model.RRType(strings.ToUpper(rrSet.GetType())),
+		TTL:             rrSet.GetTtl(),
+		ResourceRecords: records,
+		Tags:            rrSet.GetTags(),
+		Comment:         rrSet.GetComment(),
+	}
+}
+
+func convertProtoResourceRecordSetsToModel(rrSets []*beacondnsv1.ResourceRecordSet) []model.ResourceRecordSet {
+	modelRRSets := make([]model.ResourceRecordSet, len(rrSets))
+	for i := range rrSets {
+		modelRRSets[i] = *convertProtoResourceRecordSetToModel(rrSets[i])
+	}
+	return modelRRSets
+}
+
+func convertModelResourceRecordSetToProto(rrSet *model.ResourceRecordSet) *beacondnsv1.ResourceRecordSet {
+	if rrSet == nil {
+		return nil
+	}
+
+	return &beacondnsv1.ResourceRecordSet{
+		Name:            rrSet.Name,
+		Type:            strings.ToUpper(string(rrSet.Type)),
+		Ttl:             rrSet.TTL,
+		ResourceRecords: convertModelResourceRecordsToProto(rrSet.ResourceRecords),
+		Tags:            rrSet.Tags,
+		Comment:         rrSet.Comment,
+	}
+}
+
+func convertModelResourceRecordSetsToProto(rrSets []model.ResourceRecordSet) []*beacondnsv1.ResourceRecordSet {
+	protoRRSets := make([]*beacondnsv1.ResourceRecordSet, len(rrSets))
+	for i := range rrSets {
+		protoRRSets[i] = convertModelResourceRecordSetToProto(&rrSets[i])
+	}
+	return protoRRSets
+}
+
+func convertModelResourceRecordsToProto(records []model.ResourceRecord) []*beacondnsv1.ResourceRecord {
+	protoRecords := make([]*beacondnsv1.ResourceRecord, len(records))
+	for i, record := range records {
+		protoRecords[i] = &beacondnsv1.ResourceRecord{Value: record.Value}
+	}
+	return protoRecords
+}
+
+func convertModelZoneInfoToProto(info *model.ZoneInfo) *beacondnsv1.Zone {
+	return &beacondnsv1.Zone{
+		Id:                     info.ID.String(),
+		Name:                   info.Name,
+		ResourceRecordSetCount: int32(info.ResourceRecordSetCount), //nolint:gosec // counts are bounded by quotas
+		Tags:                   info.Tags,
+	}
+}
+
+func convertModelZoneInfosToProto(infos []model.ZoneInfo) []*beacondnsv1.Zone {
+	zones := make([]*beacondnsv1.Zone, len(infos))
+	for i := range infos {
+		zones[i] = convertModelZoneInfoToProto(&infos[i])
+	}
+	return zones
+}
+
+func convertModelLintFindingsToProto(findings []model.LintFinding) []*beacondnsv1.LintFinding {
+	protoFindings := make([]*beacondnsv1.LintFinding, len(findings))
+	for i, finding := range findings {
+		protoFindings[i] = &beacondnsv1.LintFinding{
+			Rule:     finding.Rule,
+			Severity: convertModelLintSeverityToProto(finding.Severity),
+			Name:     finding.Name,
+			Type:     string(finding.Type),
+			Message:  finding.Message,
+		}
+	}
+	return protoFindings
+}
+
+func convertModelLintSeverityToProto(severity model.LintSeverity) beacondnsv1.LintSeverity {
+	switch severity {
+	case model.LintSeverityError:
+		return beacondnsv1.LintSeverity_LINT_SEVERITY_ERROR
+	case model.LintSeverityWarning:
+		return beacondnsv1.LintSeverity_LINT_SEVERITY_WARNING
+	case model.LintSeverityInfo:
+		return beacondnsv1.LintSeverity_LINT_SEVERITY_INFO
+	default:
+		return beacondnsv1.LintSeverity_LINT_SEVERITY_UNSPECIFIED
+	}
+}
+
+func convertModelChangeStatusToProto(status model.ChangeStatus) beacondnsv1.ChangeStatus {
+	switch status {
+	case model.ChangeStatusPending:
+		return beacondnsv1.ChangeStatus_CHANGE_STATUS_PENDING
+	case model.ChangeStatusDone:
+		return beacondnsv1.ChangeStatus_CHANGE_STATUS_DONE
+	default:
+		return beacondnsv1.ChangeStatus_CHANGE_STATUS_UNSPECIFIED
+	}
+}
+
+func convertModelZoneDiffToProto(diff *model.ZoneDiff) *beacondnsv1.ZoneDiff {
+	modified := make([]*beacondnsv1.ResourceRecordSetModification, len(diff.Modified))
+	for i := range diff.Modified {
+		modified[i] = &beacondnsv1.ResourceRecordSetModification{
+			Before:         convertModelResourceRecordSetToProto(&diff.Modified[i].Before),
+			After:          convertModelResourceRecordSetToProto(&diff.Modified[i].After),
+			AddedRecords:   convertModelResourceRecordsToProto(diff.Modified[i].AddedRecords),
+			RemovedRecords: convertModelResourceRecordsToProto(diff.Modified[i].RemovedRecords),
+		}
+	}
+
+	return &beacondnsv1.ZoneDiff{
+		Added:    convertModelResourceRecordSetsToProto(diff.Added),
+		Removed:  convertModelResourceRecordSetsToProto(diff.Removed),
+		Modified: modified,
+	}
+}
+
+func convertModelZoneTemplateToProto(template *model.ZoneTemplate) *beacondnsv1.ZoneTemplate {
+	return &beacondnsv1.ZoneTemplate{
+		Id:                 template.ID.String(),
+		Name:               template.Name,
+		Description:        template.Description,
+		ResourceRecordSets: convertModelResourceRecordSetsToProto(template.ResourceRecordSets),
+		CreatedAt:          timestamppb.New(template.CreatedAt),
+		UpdatedAt:          timestamppb.New(template.UpdatedAt),
+	}
+}
+
+func convertProtoFirewallRuleActionToModel(action beacondnsv1.FirewallRuleAction) model.FirewallRuleAction {
+	switch action {
+	case beacondnsv1.FirewallRuleAction_FIREWALL_RULE_ACTION_ALLOW:
+		return model.FirewallRuleActionAllow
+	case beacondnsv1.FirewallRuleAction_FIREWALL_RULE_ACTION_ALERT:
+		return model.FirewallRuleActionAlert
+	case beacondnsv1.FirewallRuleAction_FIREWALL_RULE_ACTION_BLOCK:
+		return model.FirewallRuleActionBlock
+	default:
+		return ""
+	}
+}
+
+func convertModelFirewallRuleActionToProto(action model.FirewallRuleAction) beacondnsv1.FirewallRuleAction {
+	switch action {
+	case model.FirewallRuleActionAllow:
+		return beacondnsv1.FirewallRuleAction_FIREWALL_RULE_ACTION_ALLOW
+	case model.FirewallRuleActionAlert:
+		return beacondnsv1.FirewallRuleAction_FIREWALL_RULE_ACTION_ALERT
+	case model.FirewallRuleActionBlock:
+		return beacondnsv1.FirewallRuleAction_FIREWALL_RULE_ACTION_BLOCK
+	default:
+		return beacondnsv1.FirewallRuleAction_FIREWALL_RULE_ACTION_UNSPECIFIED
+	}
+}
+
+func convertProtoBlockResponseTypeToModel(
+	responseType beacondnsv1.BlockResponseType,
+) *model.FirewallRuleBlockResponseType {
+	var t model.FirewallRuleBlockResponseType
+	switch responseType {
+	case beacondnsv1.BlockResponseType_BLOCK_RESPONSE_TYPE_NXDOMAIN:
+		t = model.FirewallRuleBlockResponseTypeNXDOMAIN
+	case beacondnsv1.BlockResponseType_BLOCK_RESPONSE_TYPE_NODATA:
+		t = model.FirewallRuleBlockResponseTypeNODATA
+	case beacondnsv1.BlockResponseType_BLOCK_RESPONSE_TYPE_OVERRIDE:
+		t = model.FirewallRuleBlockResponseTypeOverride
+	default:
+		return nil
+	}
+	return &t
+}
+
+func convertModelBlockResponseTypeToProto(
+	responseType *model.FirewallRuleBlockResponseType,
+) beacondnsv1.BlockResponseType {
+	if responseType == nil {
+		return beacondnsv1.BlockResponseType_BLOCK_RESPONSE_TYPE_UNSPECIFIED
+	}
+
+	switch *responseType {
+	case model.FirewallRuleBlockResponseTypeNXDOMAIN:
+		return beacondnsv1.BlockResponseType_BLOCK_RESPONSE_TYPE_NXDOMAIN
+	case model.FirewallRuleBlockResponseTypeNODATA:
+		return beacondnsv1.BlockResponseType_BLOCK_RESPONSE_TYPE_NODATA
+	case model.FirewallRuleBlockResponseTypeOverride:
+		return beacondnsv1.BlockResponseType_BLOCK_RESPONSE_TYPE_OVERRIDE
+	default:
+		return beacondnsv1.BlockResponseType_BLOCK_RESPONSE_TYPE_UNSPECIFIED
+	}
+}
+
+func convertModelFirewallRuleToProto(rule *model.FirewallRule) *beacondnsv1.FirewallRule {
+	return &beacondnsv1.FirewallRule{
+		Id:                rule.ID.String(),
+		Name:              rule.Name,
+		DomainListId:      rule.DomainListID.String(),
+		Action:            convertModelFirewallRuleActionToProto(rule.Action),
+		BlockResponseType: convertModelBlockResponseTypeToProto(rule.BlockResponseType),
+		BlockResponse:     convertModelResourceRecordSetToProto(rule.BlockResponse),
+		Priority:          uint32(rule.Priority), //nolint:gosec // priorities are stored as 32-bit integers
+		Tags:              rule.Tags,
+	}
+}
+
+func convertModelDomainListInfoToProto(info *model.DomainListInfo) *beacondnsv1.DomainList {
+	linkedRules := make([]string, len(info.LinkedRules))
+	for i, id := range info.LinkedRules {
+		linkedRules[i] = id.String()
+	}
+
+	domainList := &beacondnsv1.DomainList{
+		Id:          info.ID.String(),
+		Name:        info.Name,
+		IsManaged:   info.IsManaged,
+		DomainCount: int32(info.DomainCount), //nolint:gosec // counts are bounded by quotas
+		LinkedRules: linkedRules,
+		LastUpdated: convertTimeToProto(info.LastUpdated),
+		Tags:        info.Tags,
+	}
+	if info.SourceURL != nil {
+		domainList.SourceUrl = *info.SourceURL
+	}
+
+	return domainList
+}
+
+func convertTimeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func parseID(value string, field string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, beaconerr.ErrInvalidArgument("invalid id", field)
+	}
+	return id, nil
+}
@@ -0,0 +1,108 @@
+package grpcapi
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+)
+
+// ErrorDomain is the domain of the ErrorInfo detail every error status carries. Its reason is
+// the beaconerr code the REST API returns for the same error.
+const ErrorDomain = "beacondns.org"
+
+// toStatus maps an error returned by a service to the status of the call, as handleError in
+// the REST API maps it to the status of the response.
+func toStatus(err error) *status.Status {
+	var beaconErr *beaconerr.BeaconError
+	if !errors.As(err, &beaconErr) {
+		beaconErr = beaconerr.NewBeaconError(beaconerr.ErrorCodeInternalError, err.Error(), err)
+	}
+
+	code := codes.Internal
+	message := beaconErr.Message()
+	details := []protoadapt.MessageV1{}
+
+	switch {
+	case beaconerr.IsUnauthorizedError(err):
+		code = codes.Unauthenticated
+	case beaconerr.IsAccessDeniedError(err):
+		code = codes.PermissionDenied
+	case beaconerr.IsThrottlingError(err):
+		var throttlingErr *beaconerr.ThrottlingError
+		errors.As(err, &throttlingErr)
+		code = codes.ResourceExhausted
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(throttlingErr.RetryAfter)})
+	case beaconerr.IsNoSuchError(err):
+		code = codes.NotFound
+	case beaconerr.IsConflictError(err):
+		code = codes.AlreadyExists
+	case beaconerr.IsInternalError(err):
+		code = codes.Internal
+	case beaconerr.IsBadRequestError(err):
+		code = badRequestCode(beaconErr)
+		if violations := badRequestViolations(err); violations != nil {
+			details = append(details, violations)
+		}
+	default:
+		beaconErr = beaconerr.NewBeaconError(beaconerr.ErrorCodeInternalError, "", nil)
+		message = "An unexpected error occurred"
+	}
+
+	details = append(details, &errdetails.ErrorInfo{Reason: beaconErr.Code(), Domain: ErrorDomain})
+
+	st := status.New(code, message)
+	if withDetails, detailsErr := st.WithDetails(details...); detailsErr == nil {
+		st = withDetails
+	}
+
+	return st
+}
+
+// badRequestCode returns the code of a rejected request. Most are invalid arguments, but some
+// are rejected because of the state of what they change rather than their arguments.
+func badRequestCode(beaconErr *beaconerr.BeaconError) codes.Code {
+	switch beaconerr.ErrorCode(beaconErr.Code()) {
+	case beaconerr.ErrorCodeQuotaExceeded:
+		return codes.ResourceExhausted
+	case beaconerr.ErrorCodeHostedZoneNotEmpty, beaconerr.ErrorCodeDomainListInvalidState:
+		return codes.FailedPrecondition
+	default:
+		return codes.InvalidArgument
+	}
+}
+
+// badRequestViolations returns the field violations of a rejected request, or nil if the
+// error does not name any.
+func badRequestViolations(err error) *errdetails.BadRequest {
+	var invalidArgumentErr *beaconerr.InvalidArgumentError
+	if errors.As(err, &invalidArgumentErr) && invalidArgumentErr.Argument != "" {
+		return &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       invalidArgumentErr.Argument,
+				Description: invalidArgumentErr.Message(),
+			}},
+		}
+	}
+
+	var changeBatchErr *beaconerr.InvalidChangeBatchError
+	if !errors.As(err, &changeBatchErr) || len(changeBatchErr.Violations) == 0 {
+		return nil
+	}
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, len(changeBatchErr.Violations))
+	for i, violation := range changeBatchErr.Violations {
+		violations[i] = &errdetails.BadRequest_FieldViolation{
+			Field:       fmt.Sprintf("actions[%d]", violation.Action),
+			Description: fmt.Sprintf("%s %s: %s", violation.Name, violation.Type, violation.Message),
+		}
+	}
+
+	return &errdetails.BadRequest{FieldViolations: violations}
+}
@@ -0,0 +1,138 @@
+package grpcapi
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+)
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		code    codes.Code
+		reason  string
+		message string
+	}{
+		{
+			name:   "unauthorized",
+			err:    beaconerr.ErrUnauthorized("missing bearer token"),
+			code:   codes.Unauthenticated,
+			reason: "Unauthorized",
+		},
+		{
+			name:   "access denied",
+			err:    beaconerr.ErrAccessDenied("not allowed"),
+			code:   codes.PermissionDenied,
+			reason: "AccessDenied",
+		},
+		{
+			name:   "no such zone",
+			err:    beaconerr.ErrNoSuchZone("zone not found"),
+			code:   codes.NotFound,
+			reason: "NoSuchZone",
+		},
+		{
+			name:   "conflict",
+			err:    beaconerr.ErrZoneAlreadyExists("zone already exists"),
+			code:   codes.AlreadyExists,
+			reason: "ZoneAlreadyExists",
+		},
+		{
+			name:   "invalid argument",
+			err:    beaconerr.ErrInvalidArgument("zone name is required", "name"),
+			code:   codes.InvalidArgument,
+			reason: "InvalidArgument",
+		},
+		{
+			name:   "quota exceeded",
+			err:    beaconerr.ErrQuotaExceeded("too many zones"),
+			code:   codes.ResourceExhausted,
+			reason: "QuotaExceeded",
+		},
+		{
+			name:   "zone not empty",
+			err:    beaconerr.ErrHostedZoneNotEmpty("zone has record sets"),
+			code:   codes.FailedPrecondition,
+			reason: "HostedZoneNotEmpty",
+		},
+		{
+			name:   "internal",
+			err:    beaconerr.ErrInternalError("failed to get zone", errors.New("boom")),
+			code:   codes.Internal,
+			reason: "InternalError",
+		},
+		{
+			name:    "unexpected",
+			err:     errors.New("boom"),
+			code:    codes.Internal,
+			reason:  "InternalError",
+			message: "An unexpected error occurred",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := toStatus(tt.err)
+			assert.Equal(t, tt.code, st.Code())
+			if tt.message != "" {
+				assert.Equal(t, tt.message, st.Message())
+			}
+
+			info := findDetail[*errdetails.ErrorInfo](st.Details())
+			require.NotNil(t, info)
+			assert.Equal(t, tt.reason, info.GetReason())
+			assert.Equal(t, ErrorDomain, info.GetDomain())
+		})
+	}
+}
+
+func TestToStatusDetails(t *testing.T) {
+	t.Run("throttling carries the retry delay", func(t *testing.T) {
+		st := toStatus(beaconerr.ErrThrottling("rate limit exceeded", 2*time.Second))
+		assert.Equal(t, codes.ResourceExhausted, st.Code())
+
+		retry := findDetail[*errdetails.RetryInfo](st.Details())
+		require.NotNil(t, retry)
+		assert.Equal(t, 2*time.Second, retry.GetRetryDelay().AsDuration())
+	})
+
+	t.Run("invalid argument names the field", func(t *testing.T) {
+		st := toStatus(beaconerr.ErrInvalidArgument("zone name is required", "name"))
+
+		badRequest := findDetail[*errdetails.BadRequest](st.Details())
+		require.NotNil(t, badRequest)
+		require.Len(t, badRequest.GetFieldViolations(), 1)
+		assert.Equal(t, "name", badRequest.GetFieldViolations()[0].GetField())
+	})
+
+	t.Run("invalid change batch names the actions", func(t *testing.T) {
+		st := toStatus(beaconerr.ErrInvalidChangeBatch("invalid change", []beaconerr.ChangeViolation{
+			{Action: 1, Name: "www.example.com.", Type: "CNAME", Message: "conflicts with A"},
+		}))
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+
+		badRequest := findDetail[*errdetails.BadRequest](st.Details())
+		require.NotNil(t, badRequest)
+		require.Len(t, badRequest.GetFieldViolations(), 1)
+		assert.Equal(t, "actions[1]", badRequest.GetFieldViolations()[0].GetField())
+		assert.Equal(t, "www.example.com. CNAME: conflicts with A", badRequest.GetFieldViolations()[0].GetDescription())
+	})
+}
+
+func findDetail[T any](details []any) T {
+	var zero T
+	for _, detail := range details {
+		if d, ok := detail.(T); ok {
+			return d
+		}
+	}
+	return zero
+}
@@ -0,0 +1,378 @@
+package grpcapi
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/firewall"
+	"github.com/davidseybold/beacondns/internal/model"
+	beacondnsv1 "github.com/davidseybold/beacondns/proto/beacondns/v1"
+)
+
+// firewallServer serves the FirewallService of beacondns.v1 from the firewall service.
+type firewallServer struct {
+	beacondnsv1.UnimplementedFirewallServiceServer
+
+	firewallService firewall.Service
+}
+
+func (s *firewallServer) CreateFirewallRule(
+	ctx context.Context,
+	req *beacondnsv1.CreateFirewallRuleRequest,
+) (*beacondnsv1.CreateFirewallRuleResponse, error) {
+	rule, err := convertProtoFirewallRuleToModel(req.GetRule())
+	if err != nil {
+		return nil, err
+	}
+	rule.Tags = req.GetRule().GetTags()
+
+	created, err := s.firewallService.CreateFirewallRule(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.CreateFirewallRuleResponse{Rule: convertModelFirewallRuleToProto(created)}, nil
+}
+
+func (s *firewallServer) UpdateFirewallRule(
+	ctx context.Context,
+	req *beacondnsv1.UpdateFirewallRuleRequest,
+) (*beacondnsv1.UpdateFirewallRuleResponse, error) {
+	id, err := parseID(req.GetRule().GetId(), "rule.id")
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := convertProtoFirewallRuleToModel(req.GetRule())
+	if err != nil {
+		return nil, err
+	}
+	rule.ID = id
+
+	updated, err := s.firewallService.UpdateFirewallRule(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.UpdateFirewallRuleResponse{Rule: convertModelFirewallRuleToProto(updated)}, nil
+}
+
+// convertProtoFirewallRuleToModel validates a rule to create or update and converts it,
+// leaving out its ID and tags.
+func convertProtoFirewallRuleToModel(rule *beacondnsv1.FirewallRule) (*model.FirewallRule, error) {
+	if err := validateFirewallRule(rule); err != nil {
+		return nil, err
+	}
+
+	domainListID, err := parseID(rule.GetDomainListId(), "rule.domain_list_id")
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.FirewallRule{
+		Name:              rule.GetName(),
+		DomainListID:      domainListID,
+		Action:            convertProtoFirewallRuleActionToModel(rule.GetAction()),
+		BlockResponseType: convertProtoBlockResponseTypeToModel(rule.GetBlockResponseType()),
+		BlockResponse:     convertProtoResourceRecordSetToModel(rule.GetBlockResponse()),
+		Priority:          uint(rule.GetPriority()),
+	}, nil
+}
+
+func (s *firewallServer) DeleteFirewallRule(
+	ctx context.Context,
+	req *beacondnsv1.DeleteFirewallRuleRequest,
+) (*beacondnsv1.DeleteFirewallRuleResponse, error) {
+	id, err := parseID(req.GetId(), "id")
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.firewallService.DeleteFirewallRule(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.DeleteFirewallRuleResponse{}, nil
+}
+
+func (s *firewallServer) GetFirewallRule(
+	ctx context.Context,
+	req *beacondnsv1.GetFirewallRuleRequest,
+) (*beacondnsv1.GetFirewallRuleResponse, error) {
+	id, err := parseID(req.GetId(), "id")
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := s.firewallService.GetFirewallRule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.GetFirewallRuleResponse{Rule: convertModelFirewallRuleToProto(rule)}, nil
+}
+
+func (s *firewallServer) ListFirewallRules(
+	ctx context.Context,
+	req *beacondnsv1.ListFirewallRulesRequest,
+) (*beacondnsv1.ListFirewallRulesResponse, error) {
+	opts, err := convertProtoListOptionsToModel(req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.firewallService.GetFirewallRules(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &beacondnsv1.ListFirewallRulesResponse{
+		Rules:      make([]*beacondnsv1.FirewallRule, len(page.Items)),
+		NextCursor: page.NextCursor,
+	}
+	for i := range page.Items {
+		resp.Rules[i] = convertModelFirewallRuleToProto(&page.Items[i])
+	}
+
+	return resp, nil
+}
+
+func (s *firewallServer) UpdateFirewallRuleTags(
+	ctx context.Context,
+	req *beacondnsv1.UpdateFirewallRuleTagsRequest,
+) (*beacondnsv1.UpdateFirewallRuleTagsResponse, error) {
+	id, err := parseID(req.GetId(), "id")
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.firewallService.UpdateFirewallRuleTags(ctx, id, req.GetSet(), req.GetRemove())
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.UpdateFirewallRuleTagsResponse{Tags: tags}, nil
+}
+
+func (s *firewallServer) CreateDomainList(
+	ctx context.Context,
+	req *beacondnsv1.CreateDomainListRequest,
+) (*beacondnsv1.CreateDomainListResponse, error) {
+	if req.GetName() == "" {
+		return nil, beaconerr.ErrInvalidArgument("name is required", "name")
+	}
+
+	var info *model.DomainListInfo
+	var err error
+
+	if req.GetIsManaged() {
+		if req.GetSourceUrl() == "" {
+			return nil, beaconerr.ErrInvalidArgument("source url is required for a managed list", "source_url")
+		}
+		info, err = s.firewallService.CreateManagedDomainList(ctx, req.GetName(), req.GetSourceUrl(), req.GetTags())
+	} else {
+		info, err = s.firewallService.CreateUnmanagedDomainList(ctx, req.GetName(), req.GetDomains(), req.GetTags())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.CreateDomainListResponse{DomainList: convertModelDomainListInfoToProto(info)}, nil
+}
+
+func (s *firewallServer) DeleteDomainList(
+	ctx context.Context,
+	req *beacondnsv1.DeleteDomainListRequest,
+) (*beacondnsv1.DeleteDomainListResponse, error) {
+	id, err := parseID(req.GetId(), "id")
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.firewallService.DeleteDomainList(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.DeleteDomainListResponse{}, nil
+}
+
+func (s *firewallServer) RefreshDomainList(
+	ctx context.Context,
+	req *beacondnsv1.RefreshDomainListRequest,
+) (*beacondnsv1.RefreshDomainListResponse, error) {
+	id, err := parseID(req.GetId(), "id")
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := s.firewallService.RefreshManagedDomainList(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.RefreshDomainListResponse{DomainList: convertModelDomainListInfoToProto(info)}, nil
+}
+
+func (s *firewallServer) AddDomainListDomains(
+	ctx context.Context,
+	req *beacondnsv1.AddDomainListDomainsRequest,
+) (*beacondnsv1.AddDomainListDomainsResponse, error) {
+	id, err := parseID(req.GetId(), "id")
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetDomains()) == 0 {
+		return nil, beaconerr.ErrInvalidArgument("domains are required", "domains")
+	}
+
+	if err = s.firewallService.AddDomainsToDomainList(ctx, id, req.GetDomains()); err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.AddDomainListDomainsResponse{}, nil
+}
+
+func (s *firewallServer) RemoveDomainListDomains(
+	ctx context.Context,
+	req *beacondnsv1.RemoveDomainListDomainsRequest,
+) (*beacondnsv1.RemoveDomainListDomainsResponse, error) {
+	id, err := parseID(req.GetId(), "id")
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetDomains()) == 0 {
+		return nil, beaconerr.ErrInvalidArgument("domains are required", "domains")
+	}
+
+	if err = s.firewallService.RemoveDomainsFromDomainList(ctx, id, req.GetDomains()); err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.RemoveDomainListDomainsResponse{}, nil
+}
+
+func (s *firewallServer) GetDomainList(
+	ctx context.Context,
+	req *beacondnsv1.GetDomainListRequest,
+) (*beacondnsv1.GetDomainListResponse, error) {
+	id, err := parseID(req.GetId(), "id")
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := s.firewallService.GetDomainList(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.GetDomainListResponse{DomainList: convertModelDomainListInfoToProto(info)}, nil
+}
+
+func (s *firewallServer) ListDomainLists(
+	ctx context.Context,
+	req *beacondnsv1.ListDomainListsRequest,
+) (*beacondnsv1.ListDomainListsResponse, error) {
+	opts, err := convertProtoListOptionsToModel(req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.firewallService.GetDomainLists(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &beacondnsv1.ListDomainListsResponse{
+		DomainLists: make([]*beacondnsv1.DomainList, len(page.Items)),
+		NextCursor:  page.NextCursor,
+	}
+	for i := range page.Items {
+		resp.DomainLists[i] = convertModelDomainListInfoToProto(&page.Items[i])
+	}
+
+	return resp, nil
+}
+
+func (s *firewallServer) UpdateDomainListTags(
+	ctx context.Context,
+	req *beacondnsv1.UpdateDomainListTagsRequest,
+) (*beacondnsv1.UpdateDomainListTagsResponse, error) {
+	id, err := parseID(req.GetId(), "id")
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.firewallService.UpdateDomainListTags(ctx, id, req.GetSet(), req.GetRemove())
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.UpdateDomainListTagsResponse{Tags: tags}, nil
+}
+
+func (s *firewallServer) ListDomainListDomains(
+	ctx context.Context,
+	req *beacondnsv1.ListDomainListDomainsRequest,
+) (*beacondnsv1.ListDomainListDomainsResponse, error) {
+	id, err := parseID(req.GetId(), "id")
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := convertProtoListOptionsToModel(req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.firewallService.GetDomainListDomains(ctx, id, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.ListDomainListDomainsResponse{
+		Domains:    page.Items,
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+// StreamDomainListDomains sends the domains of a list a page at a time, reading the next
+// page only once the previous one has been sent, so that a list of any size is streamed in
+// bounded memory.
+func (s *firewallServer) StreamDomainListDomains(
+	req *beacondnsv1.StreamDomainListDomainsRequest,
+	stream grpc.ServerStreamingServer[beacondnsv1.StreamDomainListDomainsResponse],
+) error {
+	id, err := parseID(req.GetId(), "id")
+	if err != nil {
+		return err
+	}
+
+	opts, err := convertProtoListOptionsToModel(req.GetOptions())
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	for {
+		page, pageErr := s.firewallService.GetDomainListDomains(ctx, id, opts)
+		if pageErr != nil {
+			return pageErr
+		}
+
+		if len(page.Items) > 0 || page.NextCursor == "" {
+			if err = stream.Send(&beacondnsv1.StreamDomainListDomainsResponse{
+				Domains:    page.Items,
+				NextCursor: page.NextCursor,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if page.NextCursor == "" {
+			return nil
+		}
+		opts.Cursor = page.NextCursor
+	}
+}
@@ -0,0 +1,141 @@
+// Package grpcapi serves the zone and firewall services over gRPC, alongside the REST API in
+// package api. The services are defined by the protobuf files in proto/beacondns/v1, and
+// calls are authenticated, authorized and mapped to status codes as their REST
+// counterparts are.
+package grpcapi
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/davidseybold/beacondns/internal/api"
+	"github.com/davidseybold/beacondns/internal/auth"
+	"github.com/davidseybold/beacondns/internal/firewall"
+	"github.com/davidseybold/beacondns/internal/zone"
+	beacondnsv1 "github.com/davidseybold/beacondns/proto/beacondns/v1"
+)
+
+// NewServer returns a gRPC server that serves the zone and firewall services. Every call
+// must carry an API key or OIDC ID token as a bearer token in its authorization metadata.
+// Calls are rate limited by rateLimiter, which should be the one the REST API is limited by,
+// as the requests they correspond to are.
+func NewServer(
+	logger *slog.Logger,
+	zoneService zone.Service,
+	firewallService firewall.Service,
+	authService auth.Service,
+	rateLimiter *api.RateLimiter,
+	opts ...grpc.ServerOption,
+) *grpc.Server {
+	i := &interceptor{
+		logger:      logger,
+		authService: authService,
+		rateLimiter: rateLimiter,
+	}
+
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(i.unary),
+		grpc.ChainStreamInterceptor(i.stream),
+	)
+
+	server := grpc.NewServer(opts...)
+	beacondnsv1.RegisterZoneServiceServer(server, &zoneServer{zoneService: zoneService})
+	beacondnsv1.RegisterFirewallServiceServer(server, &firewallServer{firewallService: firewallService})
+
+	return server
+}
+
+// methodRateLimitGroups maps the methods that are not limited as the rest of their service is
+// to the REST route group of the requests they correspond to.
+var methodRateLimitGroups = map[string]string{
+	beacondnsv1.ZoneService_CreateReverseZones_FullMethodName: "reverse-zones",
+	beacondnsv1.ZoneService_ListDeletedZones_FullMethodName:   "deleted-zones",
+	beacondnsv1.ZoneService_RestoreZone_FullMethodName:        "deleted-zones",
+	beacondnsv1.ZoneService_CreateZoneTemplate_FullMethodName: "zone-templates",
+	beacondnsv1.ZoneService_UpdateZoneTemplate_FullMethodName: "zone-templates",
+	beacondnsv1.ZoneService_DeleteZoneTemplate_FullMethodName: "zone-templates",
+	beacondnsv1.ZoneService_GetZoneTemplate_FullMethodName:    "zone-templates",
+	beacondnsv1.ZoneService_ListZoneTemplates_FullMethodName:  "zone-templates",
+	beacondnsv1.ZoneService_ApplyZoneTemplate_FullMethodName:  "zone-templates",
+}
+
+// rateLimitGroup returns the REST route group whose rate limit applies to method.
+func rateLimitGroup(method string) string {
+	if group, ok := methodRateLimitGroups[method]; ok {
+		return group
+	}
+	if strings.HasPrefix(method, "/"+beacondnsv1.FirewallService_ServiceDesc.ServiceName+"/") {
+		return "firewall"
+	}
+	return "zones"
+}
+
+// interceptor authenticates and rate limits calls, and maps the errors they return to
+// statuses.
+type interceptor struct {
+	logger      *slog.Logger
+	authService auth.Service
+	rateLimiter *api.RateLimiter
+}
+
+func (i *interceptor) unary(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	ctx, err := i.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, i.handleError(info.FullMethod, err)
+	}
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, i.handleError(info.FullMethod, err)
+	}
+
+	return resp, nil
+}
+
+func (i *interceptor) stream(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := i.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return i.handleError(info.FullMethod, err)
+	}
+
+	if err = handler(srv, &serverStream{ServerStream: ss, ctx: ctx}); err != nil {
+		return i.handleError(info.FullMethod, err)
+	}
+
+	return nil
+}
+
+func (i *interceptor) handleError(method string, err error) error {
+	i.logger.Error("grpc error", "method", method, "err", err)
+
+	// Errors of the stream itself, such as the client going away, already carry a status.
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	return toStatus(err).Err()
+}
+
+// serverStream is a stream whose context carries the principal of the call.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
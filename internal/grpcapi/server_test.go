@@ -0,0 +1,227 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/davidseybold/beacondns/internal/api"
+	"github.com/davidseybold/beacondns/internal/audit"
+	"github.com/davidseybold/beacondns/internal/auth"
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/firewall"
+	"github.com/davidseybold/beacondns/internal/log"
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/zone"
+	beacondnsv1 "github.com/davidseybold/beacondns/proto/beacondns/v1"
+)
+
+const testToken = "beacon_test"
+
+// fakeAuthService authenticates testToken only.
+type fakeAuthService struct {
+	auth.Service
+}
+
+func (f *fakeAuthService) Authenticate(_ context.Context, token string) (*auth.Principal, error) {
+	if token != testToken {
+		return nil, beaconerr.ErrUnauthorized("invalid token")
+	}
+	return &auth.Principal{
+		Subject: model.Subject{Type: model.SubjectTypeAPIKey, ID: "test"},
+		Name:    "test",
+	}, nil
+}
+
+// fakeZoneService hosts the single zone example.com. and records the actor of each call.
+type fakeZoneService struct {
+	zone.Service
+
+	actor audit.Actor
+}
+
+func (f *fakeZoneService) GetZoneInfo(ctx context.Context, name string) (*model.ZoneInfo, error) {
+	f.actor = audit.ActorFromContext(ctx)
+	if name != "example.com." {
+		return nil, beaconerr.ErrNoSuchZone("zone not found")
+	}
+	return &model.ZoneInfo{ID: uuid.New(), Name: name, ResourceRecordSetCount: 2}, nil
+}
+
+// fakeFirewallService pages through a fixed list of domains, with the index of the next
+// domain as the cursor.
+type fakeFirewallService struct {
+	firewall.Service
+
+	domains []string
+}
+
+func (f *fakeFirewallService) GetDomainListDomains(
+	_ context.Context,
+	_ uuid.UUID,
+	opts model.ListOptions,
+) (model.Page[string], error) {
+	start := 0
+	if opts.Cursor != "" {
+		start, _ = strconv.Atoi(opts.Cursor)
+	}
+	end := min(start+opts.Limit, len(f.domains))
+
+	page := model.Page[string]{Items: f.domains[start:end]}
+	if end < len(f.domains) {
+		page.NextCursor = strconv.Itoa(end)
+	}
+	return page, nil
+}
+
+func newTestClient(
+	t *testing.T,
+	zoneService zone.Service,
+	firewallService firewall.Service,
+	rateLimits api.RateLimits,
+) *grpc.ClientConn {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	server := NewServer(
+		log.NewDiscardLogger(),
+		zoneService,
+		firewallService,
+		&fakeAuthService{},
+		api.NewRateLimiter(rateLimits),
+	)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+func withToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
+func TestServerAuthentication(t *testing.T) {
+	zoneService := &fakeZoneService{}
+	client := beacondnsv1.NewZoneServiceClient(newTestClient(t, zoneService, &fakeFirewallService{}, nil))
+	req := &beacondnsv1.GetZoneRequest{Name: "example.com."}
+
+	_, err := client.GetZone(t.Context(), req)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = client.GetZone(withToken(t.Context(), "wrong"), req)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	resp, err := client.GetZone(withToken(t.Context(), testToken), req)
+	require.NoError(t, err)
+	assert.Equal(t, "example.com.", resp.GetZone().GetName())
+	assert.Equal(t, int32(2), resp.GetZone().GetResourceRecordSetCount())
+	assert.Equal(t, "test", zoneService.actor.Name)
+	assert.NotEmpty(t, zoneService.actor.SourceIP)
+}
+
+func TestServerRateLimit(t *testing.T) {
+	client := beacondnsv1.NewZoneServiceClient(newTestClient(t, &fakeZoneService{}, &fakeFirewallService{}, api.RateLimits{
+		api.AuthRateLimitGroup: {Rate: 1, Burst: 2},
+		"zones":                {Rate: 1, Burst: 3},
+	}))
+	req := &beacondnsv1.GetZoneRequest{Name: "example.com."}
+
+	for range 3 {
+		_, err := client.GetZone(withToken(t.Context(), testToken), req)
+		require.NoError(t, err)
+	}
+
+	_, err := client.GetZone(withToken(t.Context(), testToken), req)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Failed authentications are limited per client IP.
+	for range 2 {
+		_, err = client.GetZone(withToken(t.Context(), "wrong"), req)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+
+	_, err = client.GetZone(withToken(t.Context(), "wrong"), req)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestServerErrors(t *testing.T) {
+	client := beacondnsv1.NewZoneServiceClient(newTestClient(t, &fakeZoneService{}, &fakeFirewallService{}, nil))
+	ctx := withToken(t.Context(), testToken)
+
+	_, err := client.GetZone(ctx, &beacondnsv1.GetZoneRequest{Name: "missing.com."})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, "zone not found", status.Convert(err).Message())
+
+	_, err = client.GetZone(ctx, &beacondnsv1.GetZoneRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.GetResourceRecordSet(ctx, &beacondnsv1.GetResourceRecordSetRequest{
+		ZoneName: "example.com.",
+		Name:     "www.example.com.",
+		Type:     "BOGUS",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestStreamDomainListDomains(t *testing.T) {
+	domains := []string{"a.com.", "b.com.", "c.com.", "d.com.", "e.com."}
+	conn := newTestClient(t, &fakeZoneService{}, &fakeFirewallService{domains: domains}, nil)
+	client := beacondnsv1.NewFirewallServiceClient(conn)
+
+	stream := func(ctx context.Context, cursor string) ([][]string, error) {
+		s, err := client.StreamDomainListDomains(ctx, &beacondnsv1.StreamDomainListDomainsRequest{
+			Id:      uuid.NewString(),
+			Options: &beacondnsv1.ListOptions{Limit: 2, Cursor: cursor},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var batches [][]string
+		for {
+			resp, recvErr := s.Recv()
+			if errors.Is(recvErr, io.EOF) {
+				return batches, nil
+			} else if recvErr != nil {
+				return batches, recvErr
+			}
+			batches = append(batches, resp.GetDomains())
+		}
+	}
+
+	batches, err := stream(withToken(t.Context(), testToken), "")
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"a.com.", "b.com."}, {"c.com.", "d.com."}, {"e.com."}}, batches)
+
+	batches, err = stream(withToken(t.Context(), testToken), "3")
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"d.com.", "e.com."}}, batches)
+
+	_, err = stream(t.Context(), "")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
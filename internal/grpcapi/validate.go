@@ -0,0 +1,111 @@
+package grpcapi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+	beacondnsv1 "github.com/davidseybold/beacondns/proto/beacondns/v1"
+)
+
+// The validation here mirrors the binding rules of the REST API requests, which protobuf
+// messages cannot express. The services validate everything else.
+
+// validateResourceRecordSetKey validates the fields that identify a record set and returns
+// its type.
+func validateResourceRecordSetKey(zoneName string, name string, rrType string) (model.RRType, error) {
+	if zoneName == "" {
+		return "", beaconerr.ErrInvalidArgument("zone name is required", "zone_name")
+	}
+
+	t := model.RRType(strings.ToUpper(rrType))
+	if _, ok := model.SupportedRRTypes[t]; !ok {
+		return "", beaconerr.ErrInvalidArgument("invalid record type", "type")
+	}
+
+	if name == "" {
+		return "", beaconerr.ErrInvalidArgument("name is required", "name")
+	}
+
+	return t, nil
+}
+
+// validateResourceRecordSet checks that the record set in field has its required fields.
+func validateResourceRecordSet(rrSet *beacondnsv1.ResourceRecordSet, field string) error {
+	switch {
+	case rrSet == nil:
+		return beaconerr.ErrInvalidArgument("record set is required", field)
+	case rrSet.GetName() == "":
+		return beaconerr.ErrInvalidArgument("name is required", field+".name")
+	case rrSet.GetType() == "":
+		return beaconerr.ErrInvalidArgument("type is required", field+".type")
+	case rrSet.GetTtl() == 0:
+		return beaconerr.ErrInvalidArgument("ttl is required", field+".ttl")
+	case len(rrSet.GetResourceRecords()) == 0:
+		return beaconerr.ErrInvalidArgument("at least one record is required", field+".resource_records")
+	}
+
+	for i, record := range rrSet.GetResourceRecords() {
+		if record.GetValue() == "" {
+			return beaconerr.ErrInvalidArgument(
+				"value is required",
+				fmt.Sprintf("%s.resource_records[%d].value", field, i),
+			)
+		}
+	}
+
+	return nil
+}
+
+func validateZoneTemplate(name string, rrSets []*beacondnsv1.ResourceRecordSet) error {
+	if name == "" {
+		return beaconerr.ErrInvalidArgument("template name is required", "name")
+	}
+	if len(rrSets) == 0 {
+		return beaconerr.ErrInvalidArgument("at least one record set is required", "resource_record_sets")
+	}
+
+	for i, rrSet := range rrSets {
+		if err := validateResourceRecordSet(rrSet, fmt.Sprintf("resource_record_sets[%d]", i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateFirewallRule checks the fields of a rule to create or update. A blocking rule needs
+// a block response type, and one that overrides the response needs the response.
+func validateFirewallRule(rule *beacondnsv1.FirewallRule) error {
+	switch {
+	case rule == nil:
+		return beaconerr.ErrInvalidArgument("rule is required", "rule")
+	case rule.GetName() == "":
+		return beaconerr.ErrInvalidArgument("name is required", "rule.name")
+	case rule.GetDomainListId() == "":
+		return beaconerr.ErrInvalidArgument("domain list id is required", "rule.domain_list_id")
+	case rule.GetAction() == beacondnsv1.FirewallRuleAction_FIREWALL_RULE_ACTION_UNSPECIFIED:
+		return beaconerr.ErrInvalidArgument("action is required", "rule.action")
+	case rule.GetPriority() == 0:
+		return beaconerr.ErrInvalidArgument("priority is required", "rule.priority")
+	}
+
+	if rule.GetAction() != beacondnsv1.FirewallRuleAction_FIREWALL_RULE_ACTION_BLOCK {
+		return nil
+	}
+
+	switch rule.GetBlockResponseType() {
+	case beacondnsv1.BlockResponseType_BLOCK_RESPONSE_TYPE_UNSPECIFIED:
+		return beaconerr.ErrInvalidArgument(
+			"block response type is required for the block action",
+			"rule.block_response_type",
+		)
+	case beacondnsv1.BlockResponseType_BLOCK_RESPONSE_TYPE_OVERRIDE:
+		return validateResourceRecordSet(rule.GetBlockResponse(), "rule.block_response")
+	case beacondnsv1.BlockResponseType_BLOCK_RESPONSE_TYPE_NXDOMAIN,
+		beacondnsv1.BlockResponseType_BLOCK_RESPONSE_TYPE_NODATA:
+	}
+
+	return nil
+}
@@ -0,0 +1,565 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/zone"
+	beacondnsv1 "github.com/davidseybold/beacondns/proto/beacondns/v1"
+)
+
+// zoneServer serves the ZoneService of beacondns.v1 from the zone service.
+type zoneServer struct {
+	beacondnsv1.UnimplementedZoneServiceServer
+
+	zoneService zone.Service
+}
+
+func (s *zoneServer) CreateZone(
+	ctx context.Context,
+	req *beacondnsv1.CreateZoneRequest,
+) (*beacondnsv1.CreateZoneResponse, error) {
+	if req.GetName() == "" {
+		return nil, beaconerr.ErrInvalidArgument("zone name is required", "name")
+	}
+
+	info, err := s.zoneService.CreateZone(ctx, req.GetName(), zone.CreateZoneOptions{
+		DelegateFromParent: req.GetDelegateFromParent(),
+		Template:           req.GetTemplate(),
+		Tags:               req.GetTags(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.CreateZoneResponse{Zone: convertModelZoneInfoToProto(info)}, nil
+}
+
+func (s *zoneServer) CreateReverseZones(
+	ctx context.Context,
+	req *beacondnsv1.CreateReverseZonesRequest,
+) (*beacondnsv1.CreateReverseZonesResponse, error) {
+	if req.GetCidr() == "" {
+		return nil, beaconerr.ErrInvalidArgument("cidr is required", "cidr")
+	}
+
+	infos, err := s.zoneService.CreateReverseZones(ctx, req.GetCidr(), zone.CreateZoneOptions{
+		DelegateFromParent: req.GetDelegateFromParent(),
+		Template:           req.GetTemplate(),
+		Tags:               req.GetTags(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.CreateReverseZonesResponse{Zones: convertModelZoneInfosToProto(infos)}, nil
+}
+
+func (s *zoneServer) DeleteZone(
+	ctx context.Context,
+	req *beacondnsv1.DeleteZoneRequest,
+) (*beacondnsv1.DeleteZoneResponse, error) {
+	if req.GetName() == "" {
+		return nil, beaconerr.ErrInvalidArgument("zone name is required", "name")
+	}
+
+	if err := s.zoneService.DeleteZone(ctx, req.GetName(), req.GetForce()); err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.DeleteZoneResponse{}, nil
+}
+
+func (s *zoneServer) ListDeletedZones(
+	ctx context.Context,
+	req *beacondnsv1.ListDeletedZonesRequest,
+) (*beacondnsv1.ListDeletedZonesResponse, error) {
+	opts, err := convertProtoListOptionsToModel(req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.zoneService.ListDeletedZones(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &beacondnsv1.ListDeletedZonesResponse{
+		DeletedZones: make([]*beacondnsv1.DeletedZone, len(page.Items)),
+		NextCursor:   page.NextCursor,
+	}
+	for i, deleted := range page.Items {
+		resp.DeletedZones[i] = &beacondnsv1.DeletedZone{
+			Id:         deleted.ID.String(),
+			Name:       deleted.Name,
+			DeletedAt:  convertTimeToProto(&deleted.DeletedAt),
+			PurgeAfter: convertTimeToProto(&deleted.PurgeAfter),
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *zoneServer) RestoreZone(
+	ctx context.Context,
+	req *beacondnsv1.RestoreZoneRequest,
+) (*beacondnsv1.RestoreZoneResponse, error) {
+	if req.GetName() == "" {
+		return nil, beaconerr.ErrInvalidArgument("zone name is required", "name")
+	}
+
+	info, err := s.zoneService.RestoreZone(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.RestoreZoneResponse{Zone: convertModelZoneInfoToProto(info)}, nil
+}
+
+func (s *zoneServer) GetZone(
+	ctx context.Context,
+	req *beacondnsv1.GetZoneRequest,
+) (*beacondnsv1.GetZoneResponse, error) {
+	if req.GetName() == "" {
+		return nil, beaconerr.ErrInvalidArgument("zone name is required", "name")
+	}
+
+	info, err := s.zoneService.GetZoneInfo(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.GetZoneResponse{Zone: convertModelZoneInfoToProto(info)}, nil
+}
+
+func (s *zoneServer) ListZones(
+	ctx context.Context,
+	req *beacondnsv1.ListZonesRequest,
+) (*beacondnsv1.ListZonesResponse, error) {
+	opts, err := convertProtoListOptionsToModel(req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.zoneService.ListZones(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.ListZonesResponse{
+		Zones:      convertModelZoneInfosToProto(page.Items),
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+func (s *zoneServer) UpdateZoneTags(
+	ctx context.Context,
+	req *beacondnsv1.UpdateZoneTagsRequest,
+) (*beacondnsv1.UpdateZoneTagsResponse, error) {
+	if req.GetName() == "" {
+		return nil, beaconerr.ErrInvalidArgument("zone name is required", "name")
+	}
+
+	tags, err := s.zoneService.UpdateZoneTags(ctx, req.GetName(), req.GetSet(), req.GetRemove())
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.UpdateZoneTagsResponse{Tags: tags}, nil
+}
+
+func (s *zoneServer) ListResourceRecordSets(
+	ctx context.Context,
+	req *beacondnsv1.ListResourceRecordSetsRequest,
+) (*beacondnsv1.ListResourceRecordSetsResponse, error) {
+	if req.GetZoneName() == "" {
+		return nil, beaconerr.ErrInvalidArgument("zone name is required", "zone_name")
+	}
+
+	opts, err := convertProtoListOptionsToModel(req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.zoneService.ListResourceRecordSets(
+		ctx,
+		req.GetZoneName(),
+		model.RRType(strings.ToUpper(req.GetType())),
+		opts,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.ListResourceRecordSetsResponse{
+		ResourceRecordSets: convertModelResourceRecordSetsToProto(page.Items),
+		NextCursor:         page.NextCursor,
+	}, nil
+}
+
+func (s *zoneServer) GetResourceRecordSet(
+	ctx context.Context,
+	req *beacondnsv1.GetResourceRecordSetRequest,
+) (*beacondnsv1.GetResourceRecordSetResponse, error) {
+	rrType, err := validateResourceRecordSetKey(req.GetZoneName(), req.GetName(), req.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	rrSet, err := s.zoneService.GetResourceRecordSet(ctx, req.GetZoneName(), req.GetName(), rrType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.GetResourceRecordSetResponse{
+		ResourceRecordSet: convertModelResourceRecordSetToProto(rrSet),
+	}, nil
+}
+
+func (s *zoneServer) UpsertResourceRecordSet(
+	ctx context.Context,
+	req *beacondnsv1.UpsertResourceRecordSetRequest,
+) (*beacondnsv1.UpsertResourceRecordSetResponse, error) {
+	if req.GetZoneName() == "" {
+		return nil, beaconerr.ErrInvalidArgument("zone name is required", "zone_name")
+	}
+	if err := validateResourceRecordSet(req.GetResourceRecordSet(), "resource_record_set"); err != nil {
+		return nil, err
+	}
+
+	rrSet := convertProtoResourceRecordSetToModel(req.GetResourceRecordSet())
+
+	if req.GetDryRun() {
+		diff, err := s.zoneService.PlanUpsertResourceRecordSet(ctx, req.GetZoneName(), rrSet,
+			zone.ResourceRecordSetOptions{ValidatePolicies: req.GetValidatePolicies()})
+		if err != nil {
+			return nil, err
+		}
+
+		return &beacondnsv1.UpsertResourceRecordSetResponse{Diff: convertModelZoneDiffToProto(diff)}, nil
+	}
+
+	newRRSet, warnings, err := s.zoneService.UpsertResourceRecordSet(
+		ctx,
+		req.GetZoneName(),
+		rrSet,
+		zone.ResourceRecordSetOptions{SyncPTR: req.GetSyncPtr(), ValidatePolicies: req.GetValidatePolicies()},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.UpsertResourceRecordSetResponse{
+		ResourceRecordSet: convertModelResourceRecordSetToProto(newRRSet),
+		Warnings:          convertModelLintFindingsToProto(warnings),
+	}, nil
+}
+
+func (s *zoneServer) DeleteResourceRecordSet(
+	ctx context.Context,
+	req *beacondnsv1.DeleteResourceRecordSetRequest,
+) (*beacondnsv1.DeleteResourceRecordSetResponse, error) {
+	rrType, err := validateResourceRecordSetKey(req.GetZoneName(), req.GetName(), req.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetDryRun() {
+		diff, planErr := s.zoneService.PlanDeleteResourceRecordSet(ctx, req.GetZoneName(), req.GetName(), rrType)
+		if planErr != nil {
+			return nil, planErr
+		}
+
+		return &beacondnsv1.DeleteResourceRecordSetResponse{Diff: convertModelZoneDiffToProto(diff)}, nil
+	}
+
+	err = s.zoneService.DeleteResourceRecordSet(
+		ctx,
+		req.GetZoneName(),
+		req.GetName(),
+		rrType,
+		zone.ResourceRecordSetOptions{SyncPTR: req.GetSyncPtr()},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.DeleteResourceRecordSetResponse{}, nil
+}
+
+func (s *zoneServer) ImportZone(
+	ctx context.Context,
+	req *beacondnsv1.ImportZoneRequest,
+) (*beacondnsv1.ImportZoneResponse, error) {
+	if req.GetZoneName() == "" {
+		return nil, beaconerr.ErrInvalidArgument("zone name is required", "zone_name")
+	}
+	if req.GetZoneFile() == "" {
+		return nil, beaconerr.ErrInvalidArgument("zone file is required", "zone_file")
+	}
+
+	res, err := s.zoneService.ImportZone(ctx, req.GetZoneName(), strings.NewReader(req.GetZoneFile()),
+		zone.ResourceRecordSetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &beacondnsv1.ImportZoneResponse{
+		ChangeId:           res.ChangeID.String(),
+		ResourceRecordSets: convertModelResourceRecordSetsToProto(res.ResourceRecordSets),
+		SkippedRecords:     make([]*beacondnsv1.SkippedRecord, len(res.SkippedRecords)),
+	}
+	for i, skipped := range res.SkippedRecords {
+		resp.SkippedRecords[i] = &beacondnsv1.SkippedRecord{
+			Name:   skipped.Name,
+			Type:   skipped.Type,
+			Reason: skipped.Reason,
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *zoneServer) ExportZone(
+	ctx context.Context,
+	req *beacondnsv1.ExportZoneRequest,
+) (*beacondnsv1.ExportZoneResponse, error) {
+	if req.GetZoneName() == "" {
+		return nil, beaconerr.ErrInvalidArgument("zone name is required", "zone_name")
+	}
+
+	zoneFile, err := s.zoneService.ExportZone(ctx, req.GetZoneName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.ExportZoneResponse{ZoneFile: string(zoneFile)}, nil
+}
+
+func (s *zoneServer) LintZone(
+	ctx context.Context,
+	req *beacondnsv1.LintZoneRequest,
+) (*beacondnsv1.LintZoneResponse, error) {
+	if req.GetZoneName() == "" {
+		return nil, beaconerr.ErrInvalidArgument("zone name is required", "zone_name")
+	}
+
+	findings, err := s.zoneService.LintZone(ctx, req.GetZoneName(), zone.LintOptions{Resolve: req.GetResolve()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.LintZoneResponse{Findings: convertModelLintFindingsToProto(findings)}, nil
+}
+
+func (s *zoneServer) ListZoneVersions(
+	ctx context.Context,
+	req *beacondnsv1.ListZoneVersionsRequest,
+) (*beacondnsv1.ListZoneVersionsResponse, error) {
+	if req.GetZoneName() == "" {
+		return nil, beaconerr.ErrInvalidArgument("zone name is required", "zone_name")
+	}
+
+	opts, err := convertProtoListOptionsToModel(req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.zoneService.ListZoneVersions(ctx, req.GetZoneName(), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &beacondnsv1.ListZoneVersionsResponse{
+		Versions:   make([]*beacondnsv1.ZoneVersion, len(page.Items)),
+		NextCursor: page.NextCursor,
+	}
+	for i, version := range page.Items {
+		resp.Versions[i] = &beacondnsv1.ZoneVersion{
+			Version:   int32(version.Version), //nolint:gosec // versions are stored as 32-bit integers
+			ChangeId:  version.ChangeID.String(),
+			Status:    convertModelChangeStatusToProto(version.Status),
+			CreatedAt: convertTimeToProto(&version.CreatedAt),
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *zoneServer) DiffZoneVersions(
+	ctx context.Context,
+	req *beacondnsv1.DiffZoneVersionsRequest,
+) (*beacondnsv1.DiffZoneVersionsResponse, error) {
+	if req.GetZoneName() == "" {
+		return nil, beaconerr.ErrInvalidArgument("zone name is required", "zone_name")
+	}
+	if req.GetFrom() < 1 {
+		return nil, beaconerr.ErrInvalidArgument("from must be a version", "from")
+	}
+	if req.GetTo() < 1 {
+		return nil, beaconerr.ErrInvalidArgument("to must be a version", "to")
+	}
+
+	diff, err := s.zoneService.DiffZoneVersions(ctx, req.GetZoneName(), int(req.GetFrom()), int(req.GetTo()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.DiffZoneVersionsResponse{Diff: convertModelZoneDiffToProto(diff)}, nil
+}
+
+func (s *zoneServer) RollbackZone(
+	ctx context.Context,
+	req *beacondnsv1.RollbackZoneRequest,
+) (*beacondnsv1.RollbackZoneResponse, error) {
+	if req.GetZoneName() == "" {
+		return nil, beaconerr.ErrInvalidArgument("zone name is required", "zone_name")
+	}
+	if req.GetVersion() < 1 {
+		return nil, beaconerr.ErrInvalidArgument("version must be a version", "version")
+	}
+
+	change, err := s.zoneService.RollbackZone(ctx, req.GetZoneName(), int(req.GetVersion()),
+		zone.ResourceRecordSetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.RollbackZoneResponse{
+		Change: &beacondnsv1.ChangeInfo{
+			Id:          change.ID.String(),
+			Status:      convertModelChangeStatusToProto(change.Status),
+			SubmittedAt: convertTimeToProto(change.SubmittedAt),
+		},
+	}, nil
+}
+
+func (s *zoneServer) CreateZoneTemplate(
+	ctx context.Context,
+	req *beacondnsv1.CreateZoneTemplateRequest,
+) (*beacondnsv1.CreateZoneTemplateResponse, error) {
+	if err := validateZoneTemplate(req.GetName(), req.GetResourceRecordSets()); err != nil {
+		return nil, err
+	}
+
+	template, err := s.zoneService.CreateZoneTemplate(ctx, &model.ZoneTemplate{
+		Name:               req.GetName(),
+		Description:        req.GetDescription(),
+		ResourceRecordSets: convertProtoResourceRecordSetsToModel(req.GetResourceRecordSets()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.CreateZoneTemplateResponse{ZoneTemplate: convertModelZoneTemplateToProto(template)}, nil
+}
+
+func (s *zoneServer) UpdateZoneTemplate(
+	ctx context.Context,
+	req *beacondnsv1.UpdateZoneTemplateRequest,
+) (*beacondnsv1.UpdateZoneTemplateResponse, error) {
+	if err := validateZoneTemplate(req.GetName(), req.GetResourceRecordSets()); err != nil {
+		return nil, err
+	}
+
+	template, err := s.zoneService.UpdateZoneTemplate(ctx, &model.ZoneTemplate{
+		Name:               req.GetName(),
+		Description:        req.GetDescription(),
+		ResourceRecordSets: convertProtoResourceRecordSetsToModel(req.GetResourceRecordSets()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.UpdateZoneTemplateResponse{ZoneTemplate: convertModelZoneTemplateToProto(template)}, nil
+}
+
+func (s *zoneServer) DeleteZoneTemplate(
+	ctx context.Context,
+	req *beacondnsv1.DeleteZoneTemplateRequest,
+) (*beacondnsv1.DeleteZoneTemplateResponse, error) {
+	if req.GetName() == "" {
+		return nil, beaconerr.ErrInvalidArgument("template name is required", "name")
+	}
+
+	if err := s.zoneService.DeleteZoneTemplate(ctx, req.GetName()); err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.DeleteZoneTemplateResponse{}, nil
+}
+
+func (s *zoneServer) GetZoneTemplate(
+	ctx context.Context,
+	req *beacondnsv1.GetZoneTemplateRequest,
+) (*beacondnsv1.GetZoneTemplateResponse, error) {
+	if req.GetName() == "" {
+		return nil, beaconerr.ErrInvalidArgument("template name is required", "name")
+	}
+
+	template, err := s.zoneService.GetZoneTemplate(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &beacondnsv1.GetZoneTemplateResponse{ZoneTemplate: convertModelZoneTemplateToProto(template)}, nil
+}
+
+func (s *zoneServer) ListZoneTemplates(
+	ctx context.Context,
+	req *beacondnsv1.ListZoneTemplatesRequest,
+) (*beacondnsv1.ListZoneTemplatesResponse, error) {
+	opts, err := convertProtoListOptionsToModel(req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.zoneService.ListZoneTemplates(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &beacondnsv1.ListZoneTemplatesResponse{
+		ZoneTemplates: make([]*beacondnsv1.ZoneTemplate, len(page.Items)),
+		NextCursor:    page.NextCursor,
+	}
+	for i := range page.Items {
+		resp.ZoneTemplates[i] = convertModelZoneTemplateToProto(&page.Items[i])
+	}
+
+	return resp, nil
+}
+
+func (s *zoneServer) ApplyZoneTemplate(
+	ctx context.Context,
+	req *beacondnsv1.ApplyZoneTemplateRequest,
+) (*beacondnsv1.ApplyZoneTemplateResponse, error) {
+	if req.GetName() == "" {
+		return nil, beaconerr.ErrInvalidArgument("template name is required", "name")
+	}
+	if len(req.GetZones()) == 0 {
+		return nil, beaconerr.ErrInvalidArgument("at least one zone is required", "zones")
+	}
+
+	drifts, err := s.zoneService.ApplyZoneTemplate(ctx, req.GetName(), req.GetZones(), req.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &beacondnsv1.ApplyZoneTemplateResponse{
+		Zones: make([]*beacondnsv1.ZoneTemplateDrift, len(drifts)),
+	}
+	for i := range drifts {
+		resp.Zones[i] = &beacondnsv1.ZoneTemplateDrift{
+			ZoneName: drifts[i].ZoneName,
+			Drift:    convertModelZoneDiffToProto(&drifts[i].Drift),
+		}
+		if drifts[i].ChangeID != nil {
+			resp.Zones[i].ChangeId = drifts[i].ChangeID.String()
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,233 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/davidseybold/beacondns/internal/auth"
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/log"
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/repository"
+)
+
+const (
+	// DefaultRetention is how long responses are replayed for when no retention is configured.
+	DefaultRetention = 24 * time.Hour
+
+	// reservationTimeout is how long a key stays reserved for a request that has not
+	// completed. After that the request is assumed to be lost, for example to a restart of
+	// the controller, and a retry handles the request again.
+	reservationTimeout = 5 * time.Minute
+
+	// purgeInterval is how often the keys that have expired are deleted.
+	purgeInterval = time.Hour
+
+	maxKeyLength = 255
+)
+
+// Service makes POST requests idempotent. A request carrying a key reserves it with Begin,
+// and its response is then either stored with Complete, to be replayed to retries of the
+// request, or discarded with Release, so that a retry handles the request again.
+type Service interface {
+	// Begin reserves key for a request whose method, path and body hash to requestHash. It
+	// returns the stored response if the request was already handled, and nil if the request
+	// should be handled now.
+	Begin(ctx context.Context, key string, requestHash []byte) (*model.IdempotentResponse, error)
+	Complete(ctx context.Context, key string, response model.IdempotentResponse) error
+	Release(ctx context.Context, key string) error
+}
+
+// ServiceConfig holds the tunable behaviour of the idempotency service.
+type ServiceConfig struct {
+	// Retention is how long the response to a request is replayed for. DefaultRetention is
+	// used when it is zero.
+	Retention time.Duration
+	// Logger receives the errors of the purges made by Start. They are discarded when it is
+	// nil.
+	Logger *slog.Logger
+}
+
+type DefaultService struct {
+	registry  repository.Registry
+	retention time.Duration
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+var _ Service = (*DefaultService)(nil)
+
+func NewService(r repository.Registry, cfg ServiceConfig) *DefaultService {
+	retention := cfg.Retention
+	if retention == 0 {
+		retention = DefaultRetention
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		logger = log.NewDiscardLogger()
+	}
+
+	return &DefaultService{
+		registry:  r,
+		retention: retention,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// Start deletes the keys whose response is no longer replayed, and the keys of requests that
+// never completed, every hour until ctx is canceled. Begin ignores such keys whether or not
+// they have been deleted yet.
+func (d *DefaultService) Start(ctx context.Context) error {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := d.purge(ctx); err != nil {
+				d.logger.ErrorContext(ctx, "failed to purge idempotency keys", "error", err)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (d *DefaultService) purge(ctx context.Context) error {
+	now := d.now()
+	return d.registry.GetIdempotencyKeyRepository().PurgeIdempotencyKeys(
+		ctx,
+		now.Add(-d.retention),
+		now.Add(-reservationTimeout),
+	)
+}
+
+func (d *DefaultService) Begin(
+	ctx context.Context,
+	key string,
+	requestHash []byte,
+) (*model.IdempotentResponse, error) {
+	subject, err := subjectFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = ValidateKey(key); err != nil {
+		return nil, beaconerr.ErrInvalidArgument(err.Error(), "Idempotency-Key")
+	}
+
+	repo := d.registry.GetIdempotencyKeyRepository()
+
+	// The key can be released or purged between failing to reserve it and reading it back, or
+	// found to have expired, in which case reserving it again succeeds.
+	now := d.now()
+	for range 2 {
+		_, err = repo.CreateIdempotencyKey(ctx, &model.IdempotencyKey{
+			Subject:     subject,
+			Key:         key,
+			RequestHash: requestHash,
+		})
+		if err == nil {
+			return nil, nil
+		} else if !errors.Is(err, repository.ErrEntityAlreadyExists) {
+			return nil, beaconerr.ErrInternalError("failed to reserve idempotency key", err)
+		}
+
+		existing, getErr := repo.GetIdempotencyKey(ctx, subject, key)
+		if getErr != nil && errors.Is(getErr, repository.ErrEntityNotFound) {
+			continue
+		} else if getErr != nil {
+			return nil, beaconerr.ErrInternalError("failed to get idempotency key", getErr)
+		}
+
+		if d.expired(existing, now) {
+			delErr := repo.DeleteExpiredIdempotencyKey(ctx, subject, key, now.Add(-d.retention),
+				now.Add(-reservationTimeout))
+			if delErr != nil {
+				return nil, beaconerr.ErrInternalError("failed to delete expired idempotency key", delErr)
+			}
+			continue
+		}
+
+		if !bytes.Equal(existing.RequestHash, requestHash) {
+			return nil, beaconerr.ErrIdempotencyKeyMismatch("idempotency key was used for a different request")
+		}
+
+		if existing.Response == nil {
+			return nil, beaconerr.ErrIdempotencyKeyInUse("a request with this idempotency key is still in progress")
+		}
+
+		return existing.Response, nil
+	}
+
+	return nil, beaconerr.ErrIdempotencyKeyInUse("a request with this idempotency key is still in progress")
+}
+
+// expired reports whether the response to the request of key is no longer replayed, or the
+// request it was reserved for is assumed to be lost.
+func (d *DefaultService) expired(key *model.IdempotencyKey, now time.Time) bool {
+	if key.Response == nil {
+		return key.CreatedAt.Before(now.Add(-reservationTimeout))
+	}
+	return key.CreatedAt.Before(now.Add(-d.retention))
+}
+
+func (d *DefaultService) Complete(ctx context.Context, key string, response model.IdempotentResponse) error {
+	subject, err := subjectFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = d.registry.GetIdempotencyKeyRepository().CompleteIdempotencyKey(ctx, subject, key, response)
+	if err != nil {
+		return beaconerr.ErrInternalError("failed to store response for idempotency key", err)
+	}
+
+	return nil
+}
+
+func (d *DefaultService) Release(ctx context.Context, key string) error {
+	subject, err := subjectFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = d.registry.GetIdempotencyKeyRepository().DeleteIdempotencyKey(ctx, subject, key); err != nil {
+		return beaconerr.ErrInternalError("failed to release idempotency key", err)
+	}
+
+	return nil
+}
+
+// ValidateKey checks that key can be used as an idempotency key: it must be between 1 and
+// 255 printable ASCII characters.
+func ValidateKey(key string) error {
+	if key == "" || len(key) > maxKeyLength {
+		return fmt.Errorf("idempotency key must be between 1 and %d characters", maxKeyLength)
+	}
+
+	for _, c := range key {
+		if c < '!' || c > '~' {
+			return errors.New("idempotency key must only contain printable ASCII characters")
+		}
+	}
+
+	return nil
+}
+
+// subjectFromContext returns the subject of the principal carried by ctx, which keys are
+// scoped to so that one principal can never replay the response to another.
+func subjectFromContext(ctx context.Context) (model.Subject, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return model.Subject{}, beaconerr.ErrAccessDenied("request is not authenticated")
+	}
+
+	return principal.Subject, nil
+}
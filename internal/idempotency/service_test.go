@@ -0,0 +1,161 @@
+package idempotency
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davidseybold/beacondns/internal/auth"
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/repository"
+)
+
+// fakeRegistry keeps the idempotency keys of a single subject in memory, and counts the
+// purges of the whole table.
+type fakeRegistry struct {
+	repository.Registry
+	repository.IdempotencyKeyRepository
+
+	keys   map[string]*model.IdempotencyKey
+	purges int
+}
+
+func (r *fakeRegistry) GetIdempotencyKeyRepository() repository.IdempotencyKeyRepository {
+	return r
+}
+
+func (r *fakeRegistry) CreateIdempotencyKey(
+	_ context.Context,
+	key *model.IdempotencyKey,
+) (*model.IdempotencyKey, error) {
+	if _, ok := r.keys[key.Key]; ok {
+		return nil, repository.ErrEntityAlreadyExists
+	}
+
+	created := *key
+	created.CreatedAt = time.Now()
+	r.keys[key.Key] = &created
+	return &created, nil
+}
+
+func (r *fakeRegistry) GetIdempotencyKey(
+	_ context.Context,
+	_ model.Subject,
+	key string,
+) (*model.IdempotencyKey, error) {
+	existing, ok := r.keys[key]
+	if !ok {
+		return nil, repository.ErrEntityNotFound
+	}
+	return existing, nil
+}
+
+func (r *fakeRegistry) DeleteExpiredIdempotencyKey(
+	_ context.Context,
+	_ model.Subject,
+	key string,
+	expiredBefore time.Time,
+	abandonedBefore time.Time,
+) error {
+	existing, ok := r.keys[key]
+	if ok && (existing.CreatedAt.Before(expiredBefore) ||
+		(existing.Response == nil && existing.CreatedAt.Before(abandonedBefore))) {
+		delete(r.keys, key)
+	}
+	return nil
+}
+
+func (r *fakeRegistry) PurgeIdempotencyKeys(_ context.Context, _ time.Time, _ time.Time) error {
+	r.purges++
+	return nil
+}
+
+func TestValidateKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		wantErr bool
+	}{
+		{name: "uuid", key: "0b8f5f0e-5b4c-4d8e-9a3c-2f1e6d7c8b9a"},
+		{name: "longest", key: strings.Repeat("k", maxKeyLength)},
+		{name: "empty", key: "", wantErr: true},
+		{name: "too long", key: strings.Repeat("k", maxKeyLength+1), wantErr: true},
+		{name: "space", key: "deploy 42", wantErr: true},
+		{name: "not ascii", key: "déploiement", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateKey(tt.key)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBegin(t *testing.T) {
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{
+		Subject: model.Subject{Type: model.SubjectTypeAPIKey, ID: "a"},
+	})
+	response := &model.IdempotentResponse{StatusCode: 201, Body: []byte("{}")}
+	hash := []byte("hash")
+
+	tests := []struct {
+		name     string
+		existing *model.IdempotencyKey
+		want     *model.IdempotentResponse
+		wantErr  func(error) bool
+	}{
+		{name: "new key"},
+		{
+			name:     "completed",
+			existing: &model.IdempotencyKey{RequestHash: hash, CreatedAt: time.Now(), Response: response},
+			want:     response,
+		},
+		{
+			name: "completed past retention",
+			existing: &model.IdempotencyKey{
+				RequestHash: hash,
+				CreatedAt:   time.Now().Add(-2 * time.Hour),
+				Response:    response,
+			},
+		},
+		{
+			name:     "in progress",
+			existing: &model.IdempotencyKey{RequestHash: hash, CreatedAt: time.Now()},
+			wantErr:  beaconerr.IsConflictError,
+		},
+		{
+			name:     "abandoned",
+			existing: &model.IdempotencyKey{RequestHash: hash, CreatedAt: time.Now().Add(-10 * time.Minute)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := &fakeRegistry{keys: map[string]*model.IdempotencyKey{}}
+			if tt.existing != nil {
+				registry.keys["key"] = tt.existing
+			}
+			service := NewService(registry, ServiceConfig{Retention: time.Hour})
+
+			got, err := service.Begin(ctx, "key", hash)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Zero(t, registry.purges)
+		})
+	}
+}
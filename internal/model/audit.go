@@ -0,0 +1,73 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Operations recorded in the audit log. They name the kind of resource changed, followed by
+// what was done to it.
+const (
+	AuditOperationCreateZone          = "zone.create"
+	AuditOperationDeleteZone          = "zone.delete"
+	AuditOperationRestoreZone         = "zone.restore"
+	AuditOperationUpdateZoneTags      = "zone.updateTags"
+	AuditOperationImportZone          = "zone.import"
+	AuditOperationRollbackZone        = "zone.rollback"
+	AuditOperationUpsertRRSet         = "zone.rrset.upsert"
+	AuditOperationDeleteRRSet         = "zone.rrset.delete"
+	AuditOperationChangeRRSets        = "zone.rrset.change"
+	AuditOperationSyncDelegation      = "zone.syncDelegation"
+	AuditOperationSyncPTR             = "zone.syncPTR"
+	AuditOperationCreateZoneTemplate  = "zoneTemplate.create"
+	AuditOperationUpdateZoneTemplate  = "zoneTemplate.update"
+	AuditOperationDeleteZoneTemplate  = "zoneTemplate.delete"
+	AuditOperationApplyZoneTemplate   = "zoneTemplate.apply"
+	AuditOperationCreateFirewallRule  = "firewallRule.create"
+	AuditOperationUpdateFirewallRule  = "firewallRule.update"
+	AuditOperationDeleteFirewallRule  = "firewallRule.delete"
+	AuditOperationUpdateRuleTags      = "firewallRule.updateTags"
+	AuditOperationCreateDomainList    = "domainList.create"
+	AuditOperationDeleteDomainList    = "domainList.delete"
+	AuditOperationRefreshDomainList   = "domainList.refresh"
+	AuditOperationAddDomains          = "domainList.addDomains"
+	AuditOperationRemoveDomains       = "domainList.removeDomains"
+	AuditOperationUpdateDomainListTag = "domainList.updateTags"
+	AuditOperationCreateAPIKey        = "apiKey.create"
+	AuditOperationRevokeAPIKey        = "apiKey.revoke"
+	AuditOperationCreateRole          = "role.create"
+	AuditOperationUpdateRole          = "role.update"
+	AuditOperationDeleteRole          = "role.delete"
+	AuditOperationCreateRoleBinding   = "roleBinding.create"
+	AuditOperationDeleteRoleBinding   = "roleBinding.delete"
+	AuditOperationCreateWebhook       = "webhook.create"
+	AuditOperationDeleteWebhook       = "webhook.delete"
+	AuditOperationRedeliverWebhook    = "webhook.redeliver"
+)
+
+// AuditEntry records a change made to a resource. Target is the resource changed, in the
+// format of Permission resources. Before and After hold the state of the resource on either
+// side of the change, and are empty for the side on which it did not exist. Entries are
+// numbered in the order their changes were committed.
+type AuditEntry struct {
+	ID        int64           `json:"id"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Actor     Subject         `json:"actor"`
+	ActorName string          `json:"actorName"`
+	SourceIP  string          `json:"sourceIp,omitempty"`
+	Operation string          `json:"operation"`
+	Target    string          `json:"target"`
+	Before    json.RawMessage `json:"before,omitempty"`
+	After     json.RawMessage `json:"after,omitempty"`
+}
+
+// AuditFilter selects audit entries. Unset fields match every entry.
+type AuditFilter struct {
+	Actor     *Subject
+	Operation string
+	// Target matches the entries of the resource and of the resources below it, so a zone
+	// also matches the changes to its record sets.
+	Target string
+	Since  *time.Time
+	Until  *time.Time
+}
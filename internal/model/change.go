@@ -0,0 +1,30 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Types of change notifications besides those of firewall events, which keep the type of
+// their event.
+const (
+	ChangeTypeCreateZone  = "zone.create"
+	ChangeTypeDeleteZone  = "zone.delete"
+	ChangeTypeUpsertRRSet = "zone.rrset.upsert"
+	ChangeTypeDeleteRRSet = "zone.rrset.delete"
+	// ChangeTypeReset tells a watcher that the changes after the one it resumed from are no
+	// longer kept, so whatever it built from earlier changes must be reloaded.
+	ChangeTypeReset = "reset"
+)
+
+// ChangeNotification tells watchers that a resource changed once the change was applied.
+// Resource is the resource changed, in the format of Permission resources, and Data
+// describes the change, such as the record set upserted. Notifications are numbered in the
+// order they were committed.
+type ChangeNotification struct {
+	ID        int64           `json:"id"`
+	Type      string          `json:"type"`
+	Resource  string          `json:"resource"`
+	Data      json.RawMessage `json:"data,omitempty"`
+	CreatedAt time.Time       `json:"createdAt"`
+}
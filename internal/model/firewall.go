@@ -14,6 +14,7 @@ type DomainListInfo struct {
 	DomainCount int         `json:"domainCount"`
 	LinkedRules []uuid.UUID `json:"linkedRules"`
 	LastUpdated *time.Time  `json:"lastUpdated,omitempty"`
+	Tags        Tags        `json:"tags,omitempty"`
 }
 
 type DomainList struct {
@@ -23,6 +24,7 @@ type DomainList struct {
 	SourceURL   *string    `json:"sourceUrl,omitempty"`
 	Domains     []string   `json:"domains"`
 	LastUpdated *time.Time `json:"lastUpdated,omitempty"`
+	Tags        Tags       `json:"tags,omitempty"`
 }
 
 type FirewallRuleAction string
@@ -61,4 +63,5 @@ type FirewallRule struct {
 	BlockResponseType *FirewallRuleBlockResponseType `json:"blockResponseType"`
 	BlockResponse     *ResourceRecordSet             `json:"blockResponse"`
 	Priority          uint                           `json:"priority"`
+	Tags              Tags                           `json:"tags,omitempty"`
 }
@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// IdempotencyKey records a POST request made with an Idempotency-Key header, so that a retry
+// of the request with the same key replays its response rather than repeating it. Keys are
+// scoped to the subject that made the request.
+type IdempotencyKey struct {
+	Subject     Subject
+	Key         string
+	RequestHash []byte
+	CreatedAt   time.Time
+	// Response is nil while the request is being handled.
+	Response *IdempotentResponse
+}
+
+// IdempotentResponse is the response replayed to retries of a request.
+type IdempotentResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
@@ -0,0 +1,131 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	DefaultListLimit = 100
+	MaxListLimit     = 1000
+)
+
+// Fields lists can be sorted by. Each list accepts a subset of them.
+const (
+	SortByName      = "name"
+	SortByType      = "type"
+	SortByDomain    = "domain"
+	SortByPriority  = "priority"
+	SortByUpdatedAt = "updatedAt"
+	SortByDeletedAt = "deletedAt"
+	SortByVersion   = "version"
+)
+
+type SortOrder string
+
+const (
+	SortAscending  SortOrder = "asc"
+	SortDescending SortOrder = "desc"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// ListOptions selects a page of a list. Pages are ordered by SortBy and then by a unique
+// column of the list, so paging with Cursor neither skips nor repeats items when the list
+// changes between requests.
+type ListOptions struct {
+	// Limit is the maximum number of items on the page.
+	Limit int
+	// Cursor is the NextCursor of the previous page. It is only valid with the same sort
+	// and order it was issued with.
+	Cursor     string
+	NamePrefix string
+	SortBy     string
+	Order      SortOrder
+	Tags       TagFilter
+
+	after []string
+}
+
+// Normalize fills in the defaults of unset options and validates them. sortFields are the
+// fields the list can be sorted by, the first being the default.
+func (o *ListOptions) Normalize(defaultOrder SortOrder, sortFields ...string) error {
+	switch {
+	case o.Limit == 0:
+		o.Limit = DefaultListLimit
+	case o.Limit < 0 || o.Limit > MaxListLimit:
+		return fmt.Errorf("invalid limit %d: must be between 1 and %d", o.Limit, MaxListLimit)
+	}
+
+	if o.SortBy == "" {
+		o.SortBy = sortFields[0]
+	} else if !slices.Contains(sortFields, o.SortBy) {
+		return fmt.Errorf("invalid sort %q: must be one of %s", o.SortBy, strings.Join(sortFields, ", "))
+	}
+
+	switch o.Order {
+	case "":
+		o.Order = defaultOrder
+	case SortAscending, SortDescending:
+	default:
+		return fmt.Errorf("invalid order %q: must be asc or desc", o.Order)
+	}
+
+	if o.Cursor == "" {
+		return nil
+	}
+
+	c, err := decodeCursor(o.Cursor)
+	if err != nil {
+		return err
+	}
+	if c.SortBy != o.SortBy || c.Order != o.Order {
+		return fmt.Errorf("%w: it was issued for sort %s %s", ErrInvalidCursor, c.SortBy, c.Order)
+	}
+	o.after = c.After
+
+	return nil
+}
+
+// After returns the sort key of the last item of the previous page, or nil for the first
+// page.
+func (o ListOptions) After() []string {
+	return o.after
+}
+
+// NextCursor returns the cursor of the page that follows the item with the given sort key.
+func (o ListOptions) NextCursor(key []string) string {
+	data, _ := json.Marshal(cursor{SortBy: o.SortBy, Order: o.Order, After: key})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// cursor is the state behind an opaque page cursor.
+type cursor struct {
+	SortBy string    `json:"s"`
+	Order  SortOrder `json:"o"`
+	After  []string  `json:"a"`
+}
+
+func decodeCursor(s string) (cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return cursor{}, ErrInvalidCursor
+	}
+
+	var c cursor
+	if err = json.Unmarshal(data, &c); err != nil || len(c.After) == 0 {
+		return cursor{}, ErrInvalidCursor
+	}
+
+	return c, nil
+}
+
+// Page is one page of a list. NextCursor is empty on the last page.
+type Page[T any] struct {
+	Items      []T
+	NextCursor string
+}
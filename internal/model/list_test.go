@@ -0,0 +1,51 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListOptionsNormalize(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		var opts ListOptions
+		require.NoError(t, opts.Normalize(SortAscending, SortByName, SortByType))
+
+		assert.Equal(t, DefaultListLimit, opts.Limit)
+		assert.Equal(t, SortByName, opts.SortBy)
+		assert.Equal(t, SortAscending, opts.Order)
+		assert.Nil(t, opts.After())
+	})
+
+	tests := []struct {
+		name string
+		opts ListOptions
+	}{
+		{name: "negative limit", opts: ListOptions{Limit: -1}},
+		{name: "limit too large", opts: ListOptions{Limit: MaxListLimit + 1}},
+		{name: "unknown sort", opts: ListOptions{SortBy: SortByPriority}},
+		{name: "unknown order", opts: ListOptions{Order: "up"}},
+		{name: "malformed cursor", opts: ListOptions{Cursor: "not a cursor"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, tt.opts.Normalize(SortAscending, SortByName, SortByType))
+		})
+	}
+}
+
+func TestListOptionsCursor(t *testing.T) {
+	first := ListOptions{SortBy: SortByType, Order: SortDescending}
+	require.NoError(t, first.Normalize(SortAscending, SortByName, SortByType))
+
+	next := first.NextCursor([]string{"MX", "example.com."})
+
+	second := ListOptions{Cursor: next, SortBy: SortByType, Order: SortDescending}
+	require.NoError(t, second.Normalize(SortAscending, SortByName, SortByType))
+	assert.Equal(t, []string{"MX", "example.com."}, second.After())
+
+	mismatched := ListOptions{Cursor: next}
+	assert.ErrorIs(t, mismatched.Normalize(SortAscending, SortByName, SortByType), ErrInvalidCursor)
+}
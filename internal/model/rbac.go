@@ -0,0 +1,317 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Action is what a permission allows to be done to a resource.
+type Action string
+
+const (
+	ActionRead   Action = "read"
+	ActionWrite  Action = "write"
+	ActionDelete Action = "delete"
+	// ActionAll stands for every action in a permission.
+	ActionAll Action = "*"
+)
+
+// Resource kinds are the first segment of a resource. See Permission for the format of
+// resources and the patterns that match them.
+const (
+	ResourceKindZone         = "zone"
+	ResourceKindZoneTemplate = "zone-template"
+	ResourceKindFirewallRule = "firewall-rule"
+	ResourceKindDomainList   = "domain-list"
+	ResourceKindAPIKey       = "api-key"
+	ResourceKindRole         = "role"
+	ResourceKindRoleBinding  = "role-binding"
+	ResourceKindWebhook      = "webhook"
+
+	// ResourceAll is a pattern that matches every resource.
+	ResourceAll = "*"
+
+	resourceSeparator = "/"
+	rrSetSegment      = "rrset"
+)
+
+// Names of the roles every installation has. They cannot be changed or deleted.
+const (
+	RoleAdmin  = "admin"
+	RoleViewer = "viewer"
+)
+
+var ErrInvalidPermission = errors.New("invalid permission")
+
+var roleNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,127}$`)
+
+// Permission allows actions on the resources that match any of its patterns.
+//
+// Resources are paths of segments separated by slashes, starting with their kind:
+//
+//	zone/<zone>
+//	zone/<zone>/rrset/<name>/<type>
+//	zone-template/<name>
+//	firewall-rule/<id>
+//	domain-list/<id>
+//	api-key/<id>
+//	role/<name>
+//	role-binding/<role>
+//	webhook/<id>
+//
+// Names are fully qualified and matched without regard to case. Each segment of a pattern is
+// a glob in the syntax of path.Match, so zone/*.example.com. matches every subdomain zone of
+// example.com. A pattern also matches the resources below the resource it names: zone/<zone>
+// covers the record sets of the zone, and zone/<zone>/rrset/<name> covers every type of
+// record set with the name. The pattern * matches every resource.
+//
+// Creating a resource whose ID is only known afterwards, such as a firewall rule, needs a
+// permission that matches the whole kind, such as firewall-rule/*. A role binding is named
+// after the role it grants.
+type Permission struct {
+	Actions   []Action `json:"actions"`
+	Resources []string `json:"resources"`
+}
+
+// Validate checks the actions and resource patterns of the permission.
+func (p Permission) Validate() error {
+	if len(p.Actions) == 0 {
+		return fmt.Errorf("%w: at least one action is required", ErrInvalidPermission)
+	}
+	for _, action := range p.Actions {
+		switch action {
+		case ActionRead, ActionWrite, ActionDelete, ActionAll:
+		default:
+			return fmt.Errorf("%w: unknown action %q", ErrInvalidPermission, action)
+		}
+	}
+
+	if len(p.Resources) == 0 {
+		return fmt.Errorf("%w: at least one resource is required", ErrInvalidPermission)
+	}
+	for _, resource := range p.Resources {
+		if err := validateResourcePattern(resource); err != nil {
+			return fmt.Errorf("%w: resource %q: %w", ErrInvalidPermission, resource, err)
+		}
+	}
+
+	return nil
+}
+
+// Allows reports whether the permission allows action on resource.
+func (p Permission) Allows(action Action, resource string) bool {
+	if !slices.Contains(p.Actions, action) && !slices.Contains(p.Actions, ActionAll) {
+		return false
+	}
+
+	return slices.ContainsFunc(p.Resources, func(pattern string) bool {
+		return MatchResource(pattern, resource)
+	})
+}
+
+// Covers reports whether the permission allows action on every resource that pattern
+// matches, which is what it takes to grant action on pattern to someone else.
+func (p Permission) Covers(action Action, pattern string) bool {
+	if !slices.Contains(p.Actions, action) && !slices.Contains(p.Actions, ActionAll) {
+		return false
+	}
+
+	return slices.ContainsFunc(p.Resources, func(held string) bool {
+		return CoverResource(held, pattern)
+	})
+}
+
+// CoverResource reports whether the resource pattern matches every resource that other
+// matches. A segment of other holding wildcards is only covered by a "*" segment or by the
+// same segment, which is stricter than need be, but never lets a pattern such as "zone/?"
+// cover a wider one such as "zone/*".
+func CoverResource(pattern string, other string) bool {
+	if pattern == ResourceAll {
+		return true
+	}
+
+	patternSegments := strings.Split(strings.ToLower(pattern), resourceSeparator)
+	otherSegments := strings.Split(strings.ToLower(other), resourceSeparator)
+	if len(patternSegments) > len(otherSegments) {
+		return false
+	}
+
+	for i, segment := range patternSegments {
+		switch {
+		case segment == "*" || segment == otherSegments[i]:
+		case strings.ContainsAny(otherSegments[i], `*?[\`):
+			return false
+		default:
+			if ok, err := path.Match(segment, otherSegments[i]); err != nil || !ok {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// MatchResource reports whether the resource pattern matches resource.
+func MatchResource(pattern string, resource string) bool {
+	if pattern == ResourceAll {
+		return true
+	}
+
+	patternSegments := strings.Split(strings.ToLower(pattern), resourceSeparator)
+	resourceSegments := strings.Split(strings.ToLower(resource), resourceSeparator)
+	if len(patternSegments) > len(resourceSegments) {
+		return false
+	}
+
+	for i, segment := range patternSegments {
+		if ok, err := path.Match(segment, resourceSegments[i]); err != nil || !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func validateResourcePattern(pattern string) error {
+	if pattern == ResourceAll {
+		return nil
+	}
+
+	segments := strings.Split(pattern, resourceSeparator)
+	for _, segment := range segments {
+		if segment == "" {
+			return errors.New("empty segment")
+		}
+		if _, err := path.Match(segment, ""); err != nil {
+			return fmt.Errorf("segment %q: %w", segment, err)
+		}
+	}
+
+	switch segments[0] {
+	case ResourceKindZone:
+		if len(segments) < 2 || len(segments) > 5 || len(segments) == 3 {
+			return errors.New("must be zone/<zone>, zone/<zone>/rrset/<name> or zone/<zone>/rrset/<name>/<type>")
+		}
+		if len(segments) > 3 && segments[2] != rrSetSegment {
+			return fmt.Errorf("unknown zone resource %q", segments[2])
+		}
+	case ResourceKindZoneTemplate,
+		ResourceKindFirewallRule,
+		ResourceKindDomainList,
+		ResourceKindAPIKey,
+		ResourceKindRole,
+		ResourceKindRoleBinding,
+		ResourceKindWebhook:
+		if len(segments) != 2 {
+			return fmt.Errorf("must be %s/<name or id>", segments[0])
+		}
+	default:
+		return fmt.Errorf("unknown resource kind %q", segments[0])
+	}
+
+	return nil
+}
+
+func ZoneResource(zoneName string) string {
+	return ResourceKindZone + resourceSeparator + zoneName
+}
+
+func ResourceRecordSetResource(zoneName string, name string, rrType RRType) string {
+	return strings.Join([]string{ResourceKindZone, zoneName, rrSetSegment, name, string(rrType)}, resourceSeparator)
+}
+
+func ZoneTemplateResource(name string) string {
+	return ResourceKindZoneTemplate + resourceSeparator + name
+}
+
+func FirewallRuleResource(id uuid.UUID) string {
+	return ResourceKindFirewallRule + resourceSeparator + id.String()
+}
+
+func DomainListResource(id uuid.UUID) string {
+	return ResourceKindDomainList + resourceSeparator + id.String()
+}
+
+func APIKeyResource(id uuid.UUID) string {
+	return ResourceKindAPIKey + resourceSeparator + id.String()
+}
+
+func RoleResource(name string) string {
+	return ResourceKindRole + resourceSeparator + name
+}
+
+func RoleBindingResource(roleName string) string {
+	return ResourceKindRoleBinding + resourceSeparator + roleName
+}
+
+func WebhookResource(id uuid.UUID) string {
+	return ResourceKindWebhook + resourceSeparator + id.String()
+}
+
+// AllResources returns the resource that stands for every resource of a kind, such as
+// firewall-rule/*. Only permissions that match every resource of the kind match it.
+func AllResources(kind string) string {
+	return kind + resourceSeparator + "*"
+}
+
+// Role is a named set of permissions that is granted through role bindings.
+type Role struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	Permissions []Permission `json:"permissions"`
+	// BuiltIn roles come with every installation and cannot be changed.
+	BuiltIn   bool      `json:"builtIn"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Validate checks the name and permissions of the role.
+func (r *Role) Validate() error {
+	if !roleNamePattern.MatchString(r.Name) {
+		return errors.New("invalid role name: must be at most 128 letters, digits, '.', '_' and '-', " +
+			"starting with a letter or digit")
+	}
+
+	for _, permission := range r.Permissions {
+		if err := permission.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type SubjectType string
+
+const (
+	SubjectTypeAPIKey SubjectType = "apiKey"
+	// SubjectTypeUser is a user signed in through OIDC, identified by their username claim.
+	SubjectTypeUser SubjectType = "user"
+	// SubjectTypeGroup is every user whose groups claim contains the group.
+	SubjectTypeGroup SubjectType = "group"
+	// SubjectTypeSystem is the controller itself, which the audit log names as the actor of
+	// the changes it makes on its own, such as creating the bootstrap API key. Roles cannot
+	// be bound to it.
+	SubjectTypeSystem SubjectType = "system"
+)
+
+// Subject is who a role is granted to.
+type Subject struct {
+	Type SubjectType `json:"type"`
+	ID   string      `json:"id"`
+}
+
+// RoleBinding grants a role to a subject.
+type RoleBinding struct {
+	ID        uuid.UUID `json:"id"`
+	Role      string    `json:"role"`
+	Subject   Subject   `json:"subject"`
+	CreatedAt time.Time `json:"createdAt"`
+}
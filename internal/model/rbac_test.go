@@ -0,0 +1,149 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchResource(t *testing.T) {
+	acme := ResourceRecordSetResource("example.com.", "_acme-challenge.www.example.com.", RRTypeTXT)
+
+	tests := []struct {
+		name     string
+		pattern  string
+		resource string
+		want     bool
+	}{
+		{name: "everything", pattern: ResourceAll, resource: acme, want: true},
+		{name: "zone", pattern: "zone/example.com.", resource: ZoneResource("example.com."), want: true},
+		{name: "zone covers record sets", pattern: "zone/example.com.", resource: acme, want: true},
+		{name: "other zone", pattern: "zone/example.org.", resource: acme, want: false},
+		{name: "zone glob", pattern: "zone/*.example.com.", resource: ZoneResource("dev.example.com."), want: true},
+		{name: "zone glob excludes apex", pattern: "zone/*.example.com.", resource: ZoneResource("example.com.")},
+		{name: "case insensitive", pattern: "zone/EXAMPLE.com.", resource: ZoneResource("example.COM."), want: true},
+		{name: "record name glob", pattern: "zone/*/rrset/_acme-challenge.*/TXT", resource: acme, want: true},
+		{name: "record type mismatch", pattern: "zone/*/rrset/_acme-challenge.*/A", resource: acme},
+		{name: "record name covers types", pattern: "zone/*/rrset/_acme-challenge.*", resource: acme, want: true},
+		{
+			name:     "record pattern does not cover zone",
+			pattern:  "zone/*/rrset/_acme-challenge.*",
+			resource: ZoneResource("example.com."),
+		},
+		{name: "kind", pattern: "firewall-rule/*", resource: FirewallRuleResource(uuid.New()), want: true},
+		{name: "other kind", pattern: "zone/*", resource: ZoneTemplateResource("web")},
+		{name: "whole kind", pattern: "firewall-rule/*", resource: AllResources(ResourceKindFirewallRule), want: true},
+		{
+			name:     "single resource does not cover kind",
+			pattern:  DomainListResource(uuid.New()),
+			resource: AllResources(ResourceKindDomainList),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MatchResource(tt.pattern, tt.resource))
+		})
+	}
+}
+
+func TestCoverResource(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		other   string
+		want    bool
+	}{
+		{name: "everything", pattern: ResourceAll, other: ResourceAll, want: true},
+		{name: "kind does not cover everything", pattern: "zone/*", other: ResourceAll},
+		{name: "same pattern", pattern: "zone/*.example.com.", other: "zone/*.EXAMPLE.com.", want: true},
+		{name: "glob covers resource", pattern: "zone/*.example.com.", other: "zone/dev.example.com.", want: true},
+		{name: "glob covers narrower glob", pattern: "zone/*", other: "zone/*.example.com.", want: true},
+		{name: "narrower glob", pattern: "zone/*.example.com.", other: "zone/*"},
+		{name: "single character glob", pattern: "zone/?", other: "zone/*"},
+		{name: "zone covers record sets", pattern: "zone/example.com.", other: "zone/example.com./rrset/*", want: true},
+		{name: "record sets do not cover zone", pattern: "zone/example.com./rrset/*", other: "zone/example.com."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CoverResource(tt.pattern, tt.other))
+		})
+	}
+}
+
+func TestPermissionAllows(t *testing.T) {
+	permission := Permission{
+		Actions:   []Action{ActionRead, ActionWrite},
+		Resources: []string{"zone/example.com.", "zone-template/*"},
+	}
+
+	assert.True(t, permission.Allows(ActionWrite, ZoneResource("example.com.")))
+	assert.True(t, permission.Allows(ActionRead, ZoneTemplateResource("web")))
+	assert.False(t, permission.Allows(ActionDelete, ZoneResource("example.com.")))
+	assert.False(t, permission.Allows(ActionRead, ZoneResource("example.org.")))
+
+	all := Permission{Actions: []Action{ActionAll}, Resources: []string{ResourceAll}}
+	assert.True(t, all.Allows(ActionDelete, RoleResource("admin")))
+}
+
+func TestPermissionValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		permission Permission
+		wantErr    bool
+	}{
+		{name: "everything", permission: Permission{Actions: []Action{ActionAll}, Resources: []string{"*"}}},
+		{
+			name:       "record set",
+			permission: Permission{Actions: []Action{ActionWrite}, Resources: []string{"zone/*/rrset/_acme-challenge.*/TXT"}},
+		},
+		{
+			name:       "no actions",
+			permission: Permission{Resources: []string{"*"}},
+			wantErr:    true,
+		},
+		{
+			name:       "unknown action",
+			permission: Permission{Actions: []Action{"admin"}, Resources: []string{"*"}},
+			wantErr:    true,
+		},
+		{
+			name:       "no resources",
+			permission: Permission{Actions: []Action{ActionRead}},
+			wantErr:    true,
+		},
+		{
+			name:       "unknown kind",
+			permission: Permission{Actions: []Action{ActionRead}, Resources: []string{"cluster/*"}},
+			wantErr:    true,
+		},
+		{
+			name:       "zone without rrset",
+			permission: Permission{Actions: []Action{ActionRead}, Resources: []string{"zone/example.com./records/www"}},
+			wantErr:    true,
+		},
+		{
+			name:       "empty segment",
+			permission: Permission{Actions: []Action{ActionRead}, Resources: []string{"zone//rrset/www"}},
+			wantErr:    true,
+		},
+		{
+			name:       "bad glob",
+			permission: Permission{Actions: []Action{ActionRead}, Resources: []string{"zone/[example.com."}},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.permission.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidPermission)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+)
+
+const (
+	MaxTags           = 50
+	MaxTagKeyLength   = 128
+	MaxTagValueLength = 256
+)
+
+var (
+	ErrTooManyTags     = fmt.Errorf("too many tags: at most %d are allowed", MaxTags)
+	ErrInvalidTagKey   = errors.New("invalid tag key")
+	ErrInvalidTagValue = errors.New("invalid tag value")
+	ErrInvalidTagQuery = errors.New("invalid tag filter: must be key or key:value")
+)
+
+// tagKeyPattern allows keys such as team, cost-center or app.example.com/owner. Colons are
+// not allowed since they separate the key from the value in a tag filter.
+var tagKeyPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._/-]*$`)
+
+// Tags are free-form key/value labels, such as team:payments, used to track the ownership of
+// zones, record sets and firewall objects.
+type Tags map[string]string
+
+// Validate checks the number of tags and the format of each key and value.
+func (t Tags) Validate() error {
+	if len(t) > MaxTags {
+		return ErrTooManyTags
+	}
+
+	for key, value := range t {
+		if err := validateTagKey(key); err != nil {
+			return err
+		}
+		if len(value) > MaxTagValueLength {
+			return fmt.Errorf("%w for key %s: longer than %d characters", ErrInvalidTagValue, key, MaxTagValueLength)
+		}
+	}
+
+	return nil
+}
+
+// Update returns a copy of the tags with the tags in set added or overwritten and the keys
+// in remove deleted. Keys in both are removed.
+func (t Tags) Update(set Tags, remove []string) Tags {
+	updated := make(Tags, len(t)+len(set))
+	maps.Copy(updated, t)
+	maps.Copy(updated, set)
+	for _, key := range remove {
+		delete(updated, key)
+	}
+	return updated
+}
+
+func validateTagKey(key string) error {
+	if len(key) > MaxTagKeyLength {
+		return fmt.Errorf("%w %q: longer than %d characters", ErrInvalidTagKey, key, MaxTagKeyLength)
+	}
+	if !tagKeyPattern.MatchString(key) {
+		return fmt.Errorf(
+			"%w %q: must start with a letter or digit and contain only letters, digits and . _ / -",
+			ErrInvalidTagKey,
+			key,
+		)
+	}
+	return nil
+}
+
+// TagFilter selects the objects that carry every tag in Match, and every key in Keys with
+// any value. The zero TagFilter selects everything.
+type TagFilter struct {
+	Match Tags
+	Keys  []string
+}
+
+// ParseTagFilter parses tag filters of the form key:value, which match a tag exactly, or key,
+// which matches any value of the key.
+func ParseTagFilter(filters []string) (TagFilter, error) {
+	var filter TagFilter
+	for _, f := range filters {
+		key, value, hasValue := strings.Cut(f, ":")
+		if err := validateTagKey(key); err != nil {
+			return TagFilter{}, fmt.Errorf("%w: %w", ErrInvalidTagQuery, err)
+		}
+
+		if !hasValue {
+			filter.Keys = append(filter.Keys, key)
+			continue
+		}
+
+		if filter.Match == nil {
+			filter.Match = Tags{}
+		}
+		if prev, ok := filter.Match[key]; ok && prev != value {
+			return TagFilter{}, fmt.Errorf("%w: conflicting values for key %s", ErrInvalidTagQuery, key)
+		}
+		filter.Match[key] = value
+	}
+
+	return filter, nil
+}
+
+// IsZero reports whether the filter selects everything.
+func (f TagFilter) IsZero() bool {
+	return len(f.Match) == 0 && len(f.Keys) == 0
+}
+
+// Matches reports whether tags satisfy the filter.
+func (f TagFilter) Matches(tags Tags) bool {
+	for key, value := range f.Match {
+		if v, ok := tags[key]; !ok || v != value {
+			return false
+		}
+	}
+
+	for _, key := range f.Keys {
+		if _, ok := tags[key]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
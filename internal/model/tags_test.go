@@ -0,0 +1,100 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagsValidate(t *testing.T) {
+	tooMany := Tags{}
+	for i := range MaxTags + 1 {
+		tooMany[strings.Repeat("k", i+1)] = "v"
+	}
+
+	tests := []struct {
+		name    string
+		tags    Tags
+		wantErr error
+	}{
+		{name: "nil tags"},
+		{name: "valid tags", tags: Tags{"team": "payments", "app.example.com/owner": "", "cost-center": "42"}},
+		{name: "too many tags", tags: tooMany, wantErr: ErrTooManyTags},
+		{name: "empty key", tags: Tags{"": "payments"}, wantErr: ErrInvalidTagKey},
+		{name: "key with colon", tags: Tags{"team:payments": ""}, wantErr: ErrInvalidTagKey},
+		{name: "key with space", tags: Tags{"owning team": "payments"}, wantErr: ErrInvalidTagKey},
+		{name: "key too long", tags: Tags{strings.Repeat("k", MaxTagKeyLength+1): ""}, wantErr: ErrInvalidTagKey},
+		{
+			name:    "value too long",
+			tags:    Tags{"team": strings.Repeat("v", MaxTagValueLength+1)},
+			wantErr: ErrInvalidTagValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.tags.Validate()
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestTagsUpdate(t *testing.T) {
+	tags := Tags{"team": "payments", "owner": "alice"}
+
+	updated := tags.Update(Tags{"team": "checkout", "env": "prod"}, []string{"owner", "missing"})
+
+	assert.Equal(t, Tags{"team": "checkout", "env": "prod"}, updated)
+	assert.Equal(t, Tags{"team": "payments", "owner": "alice"}, tags)
+	assert.Equal(t, Tags{"env": "prod"}, Tags(nil).Update(Tags{"env": "prod"}, nil))
+}
+
+func TestParseTagFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters []string
+		want    TagFilter
+		wantErr bool
+	}{
+		{name: "no filters", want: TagFilter{}},
+		{
+			name:    "key and value",
+			filters: []string{"team:payments", "url:https://example.com"},
+			want:    TagFilter{Match: Tags{"team": "payments", "url": "https://example.com"}},
+		},
+		{name: "key only", filters: []string{"owner"}, want: TagFilter{Keys: []string{"owner"}}},
+		{name: "empty value", filters: []string{"owner:"}, want: TagFilter{Match: Tags{"owner": ""}}},
+		{name: "repeated filter", filters: []string{"team:a", "team:a"}, want: TagFilter{Match: Tags{"team": "a"}}},
+		{name: "conflicting values", filters: []string{"team:a", "team:b"}, wantErr: true},
+		{name: "empty key", filters: []string{":payments"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTagFilter(tt.filters)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidTagQuery)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTagFilterMatches(t *testing.T) {
+	tags := Tags{"team": "payments", "env": "prod"}
+
+	assert.True(t, TagFilter{}.Matches(nil))
+	assert.True(t, TagFilter{}.Matches(tags))
+	assert.True(t, TagFilter{Match: Tags{"team": "payments"}, Keys: []string{"env"}}.Matches(tags))
+	assert.False(t, TagFilter{Match: Tags{"team": "checkout"}}.Matches(tags))
+	assert.False(t, TagFilter{Keys: []string{"owner"}}.Matches(tags))
+	assert.False(t, TagFilter{Match: Tags{"team": "payments"}}.Matches(nil))
+}
@@ -0,0 +1,86 @@
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Webhook delivers the events the worker processes to an HTTP endpoint. Events are patterns
+// of the event types delivered, in the syntax of path.Match, such as zone.* or
+// firewall.rule.create. Secret signs every delivery and is shown once, when the webhook is
+// created.
+type Webhook struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Secret    string    `json:"-"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Validate checks the URL and event patterns of the webhook.
+func (w *Webhook) Validate() error {
+	if w.Name == "" {
+		return errors.New("name is required")
+	}
+
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("url must be an absolute http or https URL")
+	}
+
+	if len(w.Events) == 0 {
+		return errors.New("at least one event pattern is required")
+	}
+	for _, pattern := range w.Events {
+		if _, err = path.Match(pattern, ""); err != nil || pattern == "" {
+			return fmt.Errorf("invalid event pattern %q", pattern)
+		}
+	}
+
+	return nil
+}
+
+// Matches reports whether events of eventType are delivered to the webhook.
+func (w *Webhook) Matches(eventType string) bool {
+	for _, pattern := range w.Events {
+		if ok, _ := path.Match(pattern, eventType); ok {
+			return true
+		}
+	}
+	return false
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryStatusPending deliveries are waiting for their first or next attempt.
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliveryStatusSucceeded deliveries were answered with a 2xx status.
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryStatusFailed deliveries ran out of attempts.
+	WebhookDeliveryStatusFailed WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is the delivery of an event to a webhook, which doubles as its entry in the
+// delivery log of the webhook. ResponseStatus and Error describe the last attempt.
+type WebhookDelivery struct {
+	ID             uuid.UUID             `json:"id"`
+	WebhookID      uuid.UUID             `json:"webhookId"`
+	EventID        uuid.UUID             `json:"eventId"`
+	EventType      string                `json:"eventType"`
+	Payload        json.RawMessage       `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	NextAttemptAt  *time.Time            `json:"nextAttemptAt,omitempty"`
+	LastAttemptAt  *time.Time            `json:"lastAttemptAt,omitempty"`
+	ResponseStatus *int                  `json:"responseStatus,omitempty"`
+	Error          string                `json:"error,omitempty"`
+	CreatedAt      time.Time             `json:"createdAt"`
+}
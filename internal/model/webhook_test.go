@@ -0,0 +1,47 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhook_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		webhook Webhook
+		wantErr bool
+	}{
+		{name: "valid", webhook: Webhook{Name: "cmdb", URL: "https://cmdb.example.com/h", Events: []string{"zone.*"}}},
+		{name: "no name", webhook: Webhook{URL: "https://example.com/h", Events: []string{"*"}}, wantErr: true},
+		{name: "relative url", webhook: Webhook{Name: "cmdb", URL: "/hook", Events: []string{"*"}}, wantErr: true},
+		{name: "ftp url", webhook: Webhook{Name: "cmdb", URL: "ftp://x.com", Events: []string{"*"}}, wantErr: true},
+		{name: "no events", webhook: Webhook{Name: "cmdb", URL: "https://cmdb.example.com/hook"}, wantErr: true},
+		{
+			name:    "invalid pattern",
+			webhook: Webhook{Name: "cmdb", URL: "https://cmdb.example.com/hook", Events: []string{"zone.["}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.webhook.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWebhook_Matches(t *testing.T) {
+	webhook := Webhook{Events: []string{"zone.*", "firewall.rule.create"}}
+
+	assert.True(t, webhook.Matches("zone.create"))
+	assert.True(t, webhook.Matches("zone.changeRRSet"))
+	assert.True(t, webhook.Matches("firewall.rule.create"))
+	assert.False(t, webhook.Matches("firewall.rule.delete"))
+	assert.False(t, webhook.Matches("firewall.domainlist.create"))
+}
@@ -26,6 +26,16 @@ const (
 	RRTypeDS    RRType = "DS"
 	RRTypeHTTPS RRType = "HTTPS"
 	RRTypeMX    RRType = "MX"
+
+	RRTypeDNSKEY     RRType = "DNSKEY"
+	RRTypeCDS        RRType = "CDS"
+	RRTypeCDNSKEY    RRType = "CDNSKEY"
+	RRTypeLOC        RRType = "LOC"
+	RRTypeURI        RRType = "URI"
+	RRTypeHINFO      RRType = "HINFO"
+	RRTypeDNAME      RRType = "DNAME"
+	RRTypeRP         RRType = "RP"
+	RRTypeOPENPGPKEY RRType = "OPENPGPKEY"
 )
 
 var SupportedRRTypes = map[RRType]struct{}{
@@ -45,18 +55,30 @@ var SupportedRRTypes = map[RRType]struct{}{
 	RRTypeDS:    {},
 	RRTypeHTTPS: {},
 	RRTypeMX:    {},
+
+	RRTypeDNSKEY:     {},
+	RRTypeCDS:        {},
+	RRTypeCDNSKEY:    {},
+	RRTypeLOC:        {},
+	RRTypeURI:        {},
+	RRTypeHINFO:      {},
+	RRTypeDNAME:      {},
+	RRTypeRP:         {},
+	RRTypeOPENPGPKEY: {},
 }
 
 type ZoneInfo struct {
 	ID                     uuid.UUID `json:"id"`
 	Name                   string    `json:"name"`
 	ResourceRecordSetCount int       `json:"resourceRecordSetCount"`
+	Tags                   Tags      `json:"tags,omitempty"`
 }
 
 type Zone struct {
 	ID                 uuid.UUID           `json:"id"`
 	Name               string              `json:"name"`
 	ResourceRecordSets []ResourceRecordSet `json:"resourceRecordSets"`
+	Tags               Tags                `json:"tags,omitempty"`
 }
 
 func NewZone(name string) *Zone {
@@ -66,11 +88,16 @@ func NewZone(name string) *Zone {
 	}
 }
 
+// MaxResourceRecordSetCommentLength is the longest free-form comment a record set can carry.
+const MaxResourceRecordSetCommentLength = 1024
+
 type ResourceRecordSet struct {
 	Name            string           `json:"name"`
 	Type            RRType           `json:"type"`
 	TTL             uint32           `json:"ttl"`
 	ResourceRecords []ResourceRecord `json:"resourceRecords"`
+	Tags            Tags             `json:"tags,omitempty"`
+	Comment         string           `json:"comment,omitempty"`
 }
 
 type ResourceRecord struct {
@@ -125,6 +152,98 @@ func NewNS(zoneName string, ttl uint32, nameServerNames []string) ResourceRecord
 	}
 }
 
+type ZoneImportResult struct {
+	ChangeID           uuid.UUID           `json:"changeId"`
+	ResourceRecordSets []ResourceRecordSet `json:"resourceRecordSets"`
+	SkippedRecords     []SkippedRecord     `json:"skippedRecords"`
+	Warnings           []LintFinding       `json:"warnings,omitempty"`
+}
+
+type SkippedRecord struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+type ZoneVersion struct {
+	Version            int                 `json:"version"`
+	ChangeID           uuid.UUID           `json:"changeId"`
+	Status             ChangeStatus        `json:"status"`
+	CreatedAt          time.Time           `json:"createdAt"`
+	ResourceRecordSets []ResourceRecordSet `json:"resourceRecordSets,omitempty"`
+}
+
+type ZoneDiff struct {
+	Added    []ResourceRecordSet             `json:"added"`
+	Removed  []ResourceRecordSet             `json:"removed"`
+	Modified []ResourceRecordSetModification `json:"modified"`
+}
+
+func (d *ZoneDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Modified) == 0
+}
+
+type ResourceRecordSetModification struct {
+	Before         ResourceRecordSet `json:"before"`
+	After          ResourceRecordSet `json:"after"`
+	AddedRecords   []ResourceRecord  `json:"addedRecords"`
+	RemovedRecords []ResourceRecord  `json:"removedRecords"`
+}
+
+// DeletedZone is a force-deleted zone kept in the trash so that it can be restored until
+// PurgeAfter.
+type DeletedZone struct {
+	ID                 uuid.UUID           `json:"id"`
+	Name               string              `json:"name"`
+	ResourceRecordSets []ResourceRecordSet `json:"resourceRecordSets,omitempty"`
+	Tags               Tags                `json:"tags,omitempty"`
+	DeletedAt          time.Time           `json:"deletedAt"`
+	PurgeAfter         time.Time           `json:"purgeAfter"`
+}
+
+// ZoneTemplate is a stored blueprint of record sets for new zones. The names and values of
+// its record sets may contain the ZoneTemplatePlaceholder, which stands for the name of the
+// zone the template is applied to.
+type ZoneTemplate struct {
+	ID                 uuid.UUID           `json:"id"`
+	Name               string              `json:"name"`
+	Description        string              `json:"description,omitempty"`
+	ResourceRecordSets []ResourceRecordSet `json:"resourceRecordSets"`
+	CreatedAt          time.Time           `json:"createdAt"`
+	UpdatedAt          time.Time           `json:"updatedAt"`
+}
+
+// ZoneTemplatePlaceholder is replaced with the name of the zone, without its trailing dot,
+// when a zone template is applied.
+const ZoneTemplatePlaceholder = "{{zone}}"
+
+// ZoneTemplateDrift is how the record sets of a zone differ from those of a template. Drift
+// holds the changes applying the template would make, and ChangeID is set once they have
+// been applied.
+type ZoneTemplateDrift struct {
+	ZoneName string     `json:"zoneName"`
+	Drift    ZoneDiff   `json:"drift"`
+	ChangeID *uuid.UUID `json:"changeId,omitempty"`
+}
+
+type LintSeverity string
+
+const (
+	LintSeverityError   LintSeverity = "ERROR"
+	LintSeverityWarning LintSeverity = "WARNING"
+	LintSeverityInfo    LintSeverity = "INFO"
+)
+
+// LintFinding is a problem found in the record sets of a zone. Name and Type identify the
+// record set the finding is about.
+type LintFinding struct {
+	Rule     string       `json:"rule"`
+	Severity LintSeverity `json:"severity"`
+	Name     string       `json:"name"`
+	Type     RRType       `json:"type"`
+	Message  string       `json:"message"`
+}
+
 type ChangeStatus string
 
 const (
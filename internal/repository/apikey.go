@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/davidseybold/beacondns/internal/db/postgres"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+const (
+	createAPIKeyQuery = `
+		INSERT INTO api_keys (name, prefix, key_hash, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, name, prefix, created_at, expires_at, last_used_at, revoked_at
+	`
+
+	getAPIKeyByHashQuery = `
+		SELECT id, name, prefix, created_at, expires_at, last_used_at, revoked_at
+		FROM api_keys
+		WHERE key_hash = $1
+	`
+
+	getAPIKeyQuery = `
+		SELECT id, name, prefix, created_at, expires_at, last_used_at, revoked_at
+		FROM api_keys
+		WHERE id = $1
+	`
+
+	listAPIKeysQuery = `
+		SELECT id, name, prefix, created_at, expires_at, last_used_at, revoked_at
+		FROM api_keys`
+
+	revokeAPIKeyQuery = `
+		UPDATE api_keys
+		SET revoked_at = COALESCE(revoked_at, now())
+		WHERE id = $1
+	`
+
+	touchAPIKeyQuery = `
+		UPDATE api_keys
+		SET last_used_at = $2
+		WHERE id = $1
+	`
+)
+
+var apiKeySortKeys = map[string]sortKey{
+	model.SortByName:      {{"name", "text"}, {"id", "uuid"}},
+	model.SortByCreatedAt: {{"created_at", "timestamptz"}, {"id", "uuid"}},
+}
+
+type APIKeyRepository interface {
+	// CreateAPIKey stores a key under the hash of its secret. It returns ErrEntityAlreadyExists
+	// if a key with the same hash exists.
+	CreateAPIKey(ctx context.Context, key *model.APIKey, hash []byte) (*model.APIKey, error)
+	GetAPIKey(ctx context.Context, id uuid.UUID) (*model.APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, hash []byte) (*model.APIKey, error)
+	ListAPIKeys(ctx context.Context, opts model.ListOptions) (model.Page[model.APIKey], error)
+	// RevokeAPIKey marks the key as revoked. Revoking a revoked key keeps the original time.
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) error
+	// TouchAPIKey records when the key was last used.
+	TouchAPIKey(ctx context.Context, id uuid.UUID, usedAt time.Time) error
+}
+
+var _ APIKeyRepository = (*PostgresAPIKeyRepository)(nil)
+
+type PostgresAPIKeyRepository struct {
+	db postgres.Queryer
+}
+
+func (p *PostgresAPIKeyRepository) CreateAPIKey(
+	ctx context.Context,
+	key *model.APIKey,
+	hash []byte,
+) (*model.APIKey, error) {
+	row := p.db.QueryRow(ctx, createAPIKeyQuery, key.Name, key.Prefix, hash, key.ExpiresAt)
+
+	created, err := scanAPIKey(row)
+	if err != nil {
+		return nil, handleError(err, "failed to execute create api key query: %w", err)
+	}
+
+	return created, nil
+}
+
+func (p *PostgresAPIKeyRepository) GetAPIKey(ctx context.Context, id uuid.UUID) (*model.APIKey, error) {
+	key, err := scanAPIKey(p.db.QueryRow(ctx, getAPIKeyQuery, id))
+	if err != nil {
+		return nil, handleError(err, "failed to scan api key: %w", err)
+	}
+
+	return key, nil
+}
+
+func (p *PostgresAPIKeyRepository) GetAPIKeyByHash(ctx context.Context, hash []byte) (*model.APIKey, error) {
+	key, err := scanAPIKey(p.db.QueryRow(ctx, getAPIKeyByHashQuery, hash))
+	if err != nil {
+		return nil, handleError(err, "failed to scan api key: %w", err)
+	}
+
+	return key, nil
+}
+
+// ListAPIKeys returns a page of the API keys, including revoked and expired ones, that match
+// the name prefix.
+func (p *PostgresAPIKeyRepository) ListAPIKeys(
+	ctx context.Context,
+	opts model.ListOptions,
+) (model.Page[model.APIKey], error) {
+	q := newKeysetQuery(listAPIKeysQuery)
+	q.namePrefix("name", opts.NamePrefix)
+
+	query, args, err := q.build(apiKeySortKeys[opts.SortBy], opts)
+	if err != nil {
+		return model.Page[model.APIKey]{}, err
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return model.Page[model.APIKey]{}, handleError(err, "failed to execute list api keys query: %w", err)
+	}
+	defer rows.Close()
+
+	keys := []model.APIKey{}
+	for rows.Next() {
+		key, scanErr := scanAPIKey(rows)
+		if scanErr != nil {
+			return model.Page[model.APIKey]{}, handleError(scanErr, "failed to scan api key: %w", scanErr)
+		}
+		keys = append(keys, *key)
+	}
+
+	if err = rows.Err(); err != nil {
+		return model.Page[model.APIKey]{}, handleError(err, "failed to read api keys: %w", err)
+	}
+
+	return newPage(keys, opts, func(key *model.APIKey) []string {
+		if opts.SortBy == model.SortByCreatedAt {
+			return []string{key.CreatedAt.Format(time.RFC3339Nano), key.ID.String()}
+		}
+		return []string{key.Name, key.ID.String()}
+	}), nil
+}
+
+func (p *PostgresAPIKeyRepository) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	tag, err := p.db.Exec(ctx, revokeAPIKeyQuery, id)
+	if err != nil {
+		return handleError(err, "failed to execute revoke api key query: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrEntityNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgresAPIKeyRepository) TouchAPIKey(ctx context.Context, id uuid.UUID, usedAt time.Time) error {
+	if _, err := p.db.Exec(ctx, touchAPIKeyQuery, id, usedAt); err != nil {
+		return handleError(err, "failed to execute touch api key query: %w", err)
+	}
+
+	return nil
+}
+
+func scanAPIKey(row pgx.Row) (*model.APIKey, error) {
+	var key model.APIKey
+	err := row.Scan(
+		&key.ID,
+		&key.Name,
+		&key.Prefix,
+		&key.CreatedAt,
+		&key.ExpiresAt,
+		&key.LastUsedAt,
+		&key.RevokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
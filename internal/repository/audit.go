@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/davidseybold/beacondns/internal/db/postgres"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+// auditLogLockID is the transaction-level advisory lock that serializes writes to the audit
+// log. Holding it until commit makes ids increase in commit order, so that a reader following
+// the log by id never skips an entry committed after one with a higher id.
+const auditLogLockID = 0x61756469
+
+const (
+	lockAuditLogQuery = `SELECT pg_advisory_xact_lock($1)`
+
+	createAuditEntryQuery = `
+		INSERT INTO audit_log (actor_type, actor_id, actor_name, source_ip, operation, target, before, after)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at, actor_type, actor_id, actor_name, source_ip, operation, target, before, after
+	`
+
+	listAuditEntriesQuery = `
+		SELECT id, created_at, actor_type, actor_id, actor_name, source_ip, operation, target, before, after
+		FROM audit_log`
+
+	listAuditEntriesAfterQuery = `
+		SELECT id, created_at, actor_type, actor_id, actor_name, source_ip, operation, target, before, after
+		FROM audit_log
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2
+	`
+)
+
+var auditSortKeys = map[string]sortKey{
+	model.SortByCreatedAt: {{"created_at", "timestamptz"}, {"id", "bigint"}},
+}
+
+type AuditRepository interface {
+	// CreateAuditEntry appends an entry to the audit log. It must run in the transaction that
+	// makes the change, which it then serializes with every other transaction writing to the
+	// log.
+	CreateAuditEntry(ctx context.Context, entry *model.AuditEntry) (*model.AuditEntry, error)
+	ListAuditEntries(
+		ctx context.Context,
+		filter model.AuditFilter,
+		opts model.ListOptions,
+	) (model.Page[model.AuditEntry], error)
+	// ListAuditEntriesAfter returns up to limit entries with an id greater than id, in id order.
+	ListAuditEntriesAfter(ctx context.Context, id int64, limit int) ([]model.AuditEntry, error)
+}
+
+var _ AuditRepository = (*PostgresAuditRepository)(nil)
+
+type PostgresAuditRepository struct {
+	db postgres.Queryer
+}
+
+func (p *PostgresAuditRepository) CreateAuditEntry(
+	ctx context.Context,
+	entry *model.AuditEntry,
+) (*model.AuditEntry, error) {
+	if _, err := p.db.Exec(ctx, lockAuditLogQuery, auditLogLockID); err != nil {
+		return nil, handleError(err, "failed to lock audit log: %w", err)
+	}
+
+	row := p.db.QueryRow(ctx, createAuditEntryQuery,
+		entry.Actor.Type,
+		entry.Actor.ID,
+		entry.ActorName,
+		entry.SourceIP,
+		entry.Operation,
+		entry.Target,
+		nullJSON(entry.Before),
+		nullJSON(entry.After),
+	)
+
+	created, err := scanAuditEntry(row)
+	if err != nil {
+		return nil, handleError(err, "failed to execute create audit entry query: %w", err)
+	}
+
+	return created, nil
+}
+
+// ListAuditEntries returns a page of the entries that match the filter.
+func (p *PostgresAuditRepository) ListAuditEntries(
+	ctx context.Context,
+	filter model.AuditFilter,
+	opts model.ListOptions,
+) (model.Page[model.AuditEntry], error) {
+	q := newKeysetQuery(listAuditEntriesQuery)
+	if filter.Actor != nil {
+		q.and(fmt.Sprintf("actor_type = %s AND actor_id = %s", q.arg(filter.Actor.Type), q.arg(filter.Actor.ID)))
+	}
+	if filter.Operation != "" {
+		q.and("operation = " + q.arg(filter.Operation))
+	}
+	if filter.Target != "" {
+		target := q.arg(filter.Target)
+		q.and(fmt.Sprintf("(target = %s OR starts_with(target, %s || '/'))", target, target))
+	}
+	if filter.Since != nil {
+		q.and("created_at >= " + q.arg(*filter.Since))
+	}
+	if filter.Until != nil {
+		q.and("created_at < " + q.arg(*filter.Until))
+	}
+
+	query, args, err := q.build(auditSortKeys[opts.SortBy], opts)
+	if err != nil {
+		return model.Page[model.AuditEntry]{}, err
+	}
+
+	entries, err := p.queryAuditEntries(ctx, query, args...)
+	if err != nil {
+		return model.Page[model.AuditEntry]{}, err
+	}
+
+	return newPage(entries, opts, func(entry *model.AuditEntry) []string {
+		return []string{entry.CreatedAt.Format(time.RFC3339Nano), strconv.FormatInt(entry.ID, 10)}
+	}), nil
+}
+
+func (p *PostgresAuditRepository) ListAuditEntriesAfter(
+	ctx context.Context,
+	id int64,
+	limit int,
+) ([]model.AuditEntry, error) {
+	return p.queryAuditEntries(ctx, listAuditEntriesAfterQuery, id, limit)
+}
+
+func (p *PostgresAuditRepository) queryAuditEntries(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]model.AuditEntry, error) {
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, handleError(err, "failed to execute list audit entries query: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []model.AuditEntry{}
+	for rows.Next() {
+		entry, scanErr := scanAuditEntry(rows)
+		if scanErr != nil {
+			return nil, handleError(scanErr, "failed to scan audit entry: %w", scanErr)
+		}
+		entries = append(entries, *entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, handleError(err, "failed to read audit entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+func scanAuditEntry(row pgx.Row) (*model.AuditEntry, error) {
+	var entry model.AuditEntry
+	var before, after []byte
+	err := row.Scan(
+		&entry.ID,
+		&entry.CreatedAt,
+		&entry.Actor.Type,
+		&entry.Actor.ID,
+		&entry.ActorName,
+		&entry.SourceIP,
+		&entry.Operation,
+		&entry.Target,
+		&before,
+		&after,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if before != nil {
+		entry.Before = json.RawMessage(before)
+	}
+	if after != nil {
+		entry.After = json.RawMessage(after)
+	}
+
+	return &entry, nil
+}
+
+// nullJSON stores an empty payload as NULL rather than invalid JSON.
+func nullJSON(data json.RawMessage) any {
+	if len(data) == 0 {
+		return nil
+	}
+	return []byte(data)
+}
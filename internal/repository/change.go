@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/davidseybold/beacondns/internal/db/postgres"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+// changeLogLockID is the transaction-level advisory lock that serializes writes to the change
+// log, for the same reason as auditLogLockID.
+const changeLogLockID = 0x6368616e
+
+const (
+	lockChangeLogQuery = `SELECT pg_advisory_xact_lock($1)`
+
+	createChangeNotificationQuery = `
+		INSERT INTO change_log (type, resource, data)
+		VALUES ($1, $2, $3)
+		RETURNING id, type, resource, data, created_at
+	`
+
+	getChangeNotificationQuery = `
+		SELECT id, type, resource, data, created_at
+		FROM change_log
+		WHERE id = $1
+	`
+
+	getLatestChangeNotificationIDQuery = `
+		SELECT COALESCE(MAX(id), 0)
+		FROM change_log
+	`
+
+	listChangeNotificationsAfterQuery = `
+		SELECT id, type, resource, data, created_at
+		FROM change_log
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2
+	`
+
+	purgeChangeNotificationsQuery = `
+		DELETE FROM change_log
+		WHERE created_at < $1
+	`
+)
+
+type ChangeLogRepository interface {
+	// CreateChangeNotification appends a notification to the change log. It must run in the
+	// transaction that applies the change, which it then serializes with every other
+	// transaction writing to the log.
+	CreateChangeNotification(
+		ctx context.Context,
+		notification *model.ChangeNotification,
+	) (*model.ChangeNotification, error)
+	GetChangeNotification(ctx context.Context, id int64) (*model.ChangeNotification, error)
+	// GetLatestChangeNotificationID returns the id of the last notification, or 0 if the log
+	// is empty.
+	GetLatestChangeNotificationID(ctx context.Context) (int64, error)
+	// ListChangeNotificationsAfter returns up to limit notifications with an id greater than
+	// id, in id order.
+	ListChangeNotificationsAfter(ctx context.Context, id int64, limit int) ([]model.ChangeNotification, error)
+	// PurgeChangeNotifications deletes the notifications created before before.
+	PurgeChangeNotifications(ctx context.Context, before time.Time) error
+}
+
+var _ ChangeLogRepository = (*PostgresChangeLogRepository)(nil)
+
+type PostgresChangeLogRepository struct {
+	db postgres.Queryer
+}
+
+func (p *PostgresChangeLogRepository) CreateChangeNotification(
+	ctx context.Context,
+	notification *model.ChangeNotification,
+) (*model.ChangeNotification, error) {
+	if _, err := p.db.Exec(ctx, lockChangeLogQuery, changeLogLockID); err != nil {
+		return nil, handleError(err, "failed to lock change log: %w", err)
+	}
+
+	row := p.db.QueryRow(ctx, createChangeNotificationQuery,
+		notification.Type,
+		notification.Resource,
+		nullJSON(notification.Data),
+	)
+
+	created, err := scanChangeNotification(row)
+	if err != nil {
+		return nil, handleError(err, "failed to execute create change notification query: %w", err)
+	}
+
+	return created, nil
+}
+
+func (p *PostgresChangeLogRepository) GetChangeNotification(
+	ctx context.Context,
+	id int64,
+) (*model.ChangeNotification, error) {
+	notification, err := scanChangeNotification(p.db.QueryRow(ctx, getChangeNotificationQuery, id))
+	if err != nil {
+		return nil, handleError(err, "failed to scan change notification: %w", err)
+	}
+
+	return notification, nil
+}
+
+func (p *PostgresChangeLogRepository) GetLatestChangeNotificationID(ctx context.Context) (int64, error) {
+	var id int64
+	if err := p.db.QueryRow(ctx, getLatestChangeNotificationIDQuery).Scan(&id); err != nil {
+		return 0, handleError(err, "failed to get latest change notification: %w", err)
+	}
+
+	return id, nil
+}
+
+func (p *PostgresChangeLogRepository) ListChangeNotificationsAfter(
+	ctx context.Context,
+	id int64,
+	limit int,
+) ([]model.ChangeNotification, error) {
+	rows, err := p.db.Query(ctx, listChangeNotificationsAfterQuery, id, limit)
+	if err != nil {
+		return nil, handleError(err, "failed to execute list change notifications query: %w", err)
+	}
+	defer rows.Close()
+
+	notifications := []model.ChangeNotification{}
+	for rows.Next() {
+		notification, scanErr := scanChangeNotification(rows)
+		if scanErr != nil {
+			return nil, handleError(scanErr, "failed to scan change notification: %w", scanErr)
+		}
+		notifications = append(notifications, *notification)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, handleError(err, "failed to read change notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+func (p *PostgresChangeLogRepository) PurgeChangeNotifications(ctx context.Context, before time.Time) error {
+	if _, err := p.db.Exec(ctx, purgeChangeNotificationsQuery, before); err != nil {
+		return handleError(err, "failed to purge change notifications: %w", err)
+	}
+
+	return nil
+}
+
+func scanChangeNotification(row pgx.Row) (*model.ChangeNotification, error) {
+	var notification model.ChangeNotification
+	var data []byte
+	err := row.Scan(
+		&notification.ID,
+		&notification.Type,
+		&notification.Resource,
+		&data,
+		&notification.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if data != nil {
+		notification.Data = json.RawMessage(data)
+	}
+
+	return &notification, nil
+}
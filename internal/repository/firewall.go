@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"encoding/json"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -13,22 +15,22 @@ import (
 
 const (
 	createFirewallRuleQuery = `
-		INSERT INTO firewall_rules (name, domain_list_id, action, block_response_type, block_response, priority)
-		VALUES ($1, $2, $3, $4, $5, $6)
-		RETURNING id, name, domain_list_id, action, block_response_type, block_response, priority
+		INSERT INTO firewall_rules (name, domain_list_id, action, block_response_type, block_response, priority, tags)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, name, domain_list_id, action, block_response_type, block_response, priority, tags
 	`
 
 	updateFirewallRuleQuery = `
 		UPDATE firewall_rules
 		SET name = $2, domain_list_id = $3, action = $4, block_response_type = $5, block_response = $6, priority = $7
 		WHERE id = $1
-		RETURNING id, name, domain_list_id, action, block_response_type, block_response, priority
+		RETURNING id, name, domain_list_id, action, block_response_type, block_response, priority, tags
 	`
 
 	createDomainListQuery = `
-		INSERT INTO domain_lists (name, is_managed, source_url)
-		VALUES ($1, $2, $3)
-		RETURNING id, name, is_managed, source_url, updated_at
+		INSERT INTO domain_lists (name, is_managed, source_url, tags)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, name, is_managed, source_url, updated_at, tags
 	`
 
 	createDomainListDomainsQuery = `
@@ -58,36 +60,31 @@ const (
 	`
 
 	getDomainListQuery = `
-		SELECT id, name, is_managed, source_url, updated_at
+		SELECT id, name, is_managed, source_url, updated_at, tags
 		FROM domain_lists
 		WHERE id = $1
 	`
 
 	getDomainListInfoQuery = `
-		SELECT dl.id, dl.name, dl.is_managed, dl.source_url, dl.updated_at, (SELECT COUNT(*) FROM domain_list_domains dld WHERE dld.domain_list_id = dl.id) AS domain_count
+		SELECT dl.id, dl.name, dl.is_managed, dl.source_url, dl.updated_at, dl.tags, (SELECT COUNT(*) FROM domain_list_domains dld WHERE dld.domain_list_id = dl.id) AS domain_count
 		FROM domain_lists dl
 		WHERE id = $1
 	`
 
 	getFirewallRuleQuery = `
-		SELECT id, name, domain_list_id, action, block_response_type, block_response, priority
+		SELECT id, name, domain_list_id, action, block_response_type, block_response, priority, tags
 		FROM firewall_rules
 		WHERE id = $1
 	`
 
 	listFirewallRulesQuery = `
-		SELECT id, name, domain_list_id, action, block_response_type, block_response, priority
-		FROM firewall_rules
-		ORDER BY priority ASC
-	`
+		SELECT id, name, domain_list_id, action, block_response_type, block_response, priority, tags
+		FROM firewall_rules`
 
 	listDomainListsQuery = `
-		SELECT dl.id, dl.name, dl.is_managed, dl.source_url, COUNT(dld.domain) AS domain_count
-		FROM domain_lists dl
-		INNER JOIN domain_list_domains dld ON dl.id = dld.domain_list_id
-		GROUP BY dl.id, dl.name
-		ORDER BY name ASC
-	`
+		SELECT dl.id, dl.name, dl.is_managed, dl.source_url, dl.updated_at, dl.tags,
+		       (SELECT COUNT(*) FROM domain_list_domains dld WHERE dld.domain_list_id = dl.id) AS domain_count
+		FROM domain_lists dl`
 
 	getDomainListDomainsQuery = `
 		SELECT domain	
@@ -95,6 +92,10 @@ const (
 		WHERE domain_list_id = $1
 	`
 
+	listDomainListDomainsQuery = `
+		SELECT domain
+		FROM domain_list_domains`
+
 	getDomainListLinkedRulesQuery = `
 		SELECT fr.id
 		FROM firewall_rules fr
@@ -102,10 +103,40 @@ const (
 	`
 
 	getFirewallRulesByDomainListIDQuery = `
-		SELECT id, name, domain_list_id, action, block_response_type, block_response, priority
+		SELECT id, name, domain_list_id, action, block_response_type, block_response, priority, tags
 		FROM firewall_rules
 		WHERE domain_list_id = $1
 	`
+
+	updateDomainListTagsQuery = `
+		UPDATE domain_lists
+		SET tags = (tags || $2::jsonb) - $3::text[]
+		WHERE id = $1
+		RETURNING tags
+	`
+
+	updateFirewallRuleTagsQuery = `
+		UPDATE firewall_rules
+		SET tags = (tags || $2::jsonb) - $3::text[]
+		WHERE id = $1
+		RETURNING tags
+	`
+)
+
+var (
+	firewallRuleSortKeys = map[string]sortKey{
+		model.SortByPriority: {{"priority", "int"}, {"id", "uuid"}},
+		model.SortByName:     {{"name", "text"}, {"id", "uuid"}},
+	}
+
+	domainListSortKeys = map[string]sortKey{
+		model.SortByName:      {{"dl.name", "text"}, {"dl.id", "uuid"}},
+		model.SortByUpdatedAt: {{"dl.updated_at", "timestamptz"}, {"dl.id", "uuid"}},
+	}
+
+	domainSortKeys = map[string]sortKey{
+		model.SortByDomain: {{"domain", "text"}},
+	}
 )
 
 type FirewallRepository interface {
@@ -113,7 +144,8 @@ type FirewallRepository interface {
 	UpdateFirewallRule(ctx context.Context, rule *model.FirewallRule) (*model.FirewallRule, error)
 	DeleteFirewallRule(ctx context.Context, id uuid.UUID) error
 	GetFirewallRule(ctx context.Context, id uuid.UUID) (*model.FirewallRule, error)
-	ListFirewallRules(ctx context.Context) ([]model.FirewallRule, error)
+	ListFirewallRules(ctx context.Context, opts model.ListOptions) (model.Page[model.FirewallRule], error)
+	UpdateFirewallRuleTags(ctx context.Context, id uuid.UUID, set model.Tags, remove []string) (model.Tags, error)
 	GetFirewallRulesByDomainListID(ctx context.Context, domainListID uuid.UUID) ([]model.FirewallRule, error)
 
 	CreateDomainList(ctx context.Context, list *model.DomainList) (*model.DomainListInfo, error)
@@ -121,7 +153,9 @@ type FirewallRepository interface {
 	GetDomainList(ctx context.Context, id uuid.UUID) (*model.DomainList, error)
 	GetDomainListInfo(ctx context.Context, id uuid.UUID) (*model.DomainListInfo, error)
 	GetDomainListDomains(ctx context.Context, id uuid.UUID) ([]string, error)
-	ListDomainLists(ctx context.Context) ([]model.DomainListInfo, error)
+	ListDomainListDomains(ctx context.Context, id uuid.UUID, opts model.ListOptions) (model.Page[string], error)
+	ListDomainLists(ctx context.Context, opts model.ListOptions) (model.Page[model.DomainListInfo], error)
+	UpdateDomainListTags(ctx context.Context, id uuid.UUID, set model.Tags, remove []string) (model.Tags, error)
 	OverwriteDomainListDomains(ctx context.Context, id uuid.UUID, domains []string) (*model.DomainListInfo, error)
 
 	AddDomainsToDomainList(ctx context.Context, id uuid.UUID, domains []string) error
@@ -138,10 +172,15 @@ func (p *PostgresFirewallRepository) CreateDomainList(
 	ctx context.Context,
 	list *model.DomainList,
 ) (*model.DomainListInfo, error) {
-	row := p.db.QueryRow(ctx, createDomainListQuery, list.Name, list.IsManaged, list.SourceURL)
+	tagsJSON, err := marshalTags(list.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	row := p.db.QueryRow(ctx, createDomainListQuery, list.Name, list.IsManaged, list.SourceURL, tagsJSON)
 
 	var info model.DomainListInfo
-	err := row.Scan(&info.ID, &info.Name, &info.IsManaged, &info.SourceURL, &info.LastUpdated)
+	err = row.Scan(&info.ID, &info.Name, &info.IsManaged, &info.SourceURL, &info.LastUpdated, &info.Tags)
 	if err != nil {
 		return nil, handleError(err, "failed to scan domain list: %w", err)
 	}
@@ -191,7 +230,8 @@ func (p *PostgresFirewallRepository) GetDomainList(ctx context.Context, id uuid.
 	row := p.db.QueryRow(ctx, getDomainListQuery, id)
 
 	var list model.DomainList
-	if err := row.Scan(&list.ID, &list.Name, &list.IsManaged, &list.SourceURL, &list.LastUpdated); err != nil {
+	err := row.Scan(&list.ID, &list.Name, &list.IsManaged, &list.SourceURL, &list.LastUpdated, &list.Tags)
+	if err != nil {
 		return nil, handleError(err, "failed to scan domain list: %w", err)
 	}
 
@@ -212,7 +252,7 @@ func (p *PostgresFirewallRepository) GetDomainListInfo(
 	row := p.db.QueryRow(ctx, getDomainListInfoQuery, id)
 
 	var info model.DomainListInfo
-	if err := row.Scan(&info.ID, &info.Name, &info.IsManaged, &info.SourceURL, &info.LastUpdated, &info.DomainCount); err != nil {
+	if err := row.Scan(&info.ID, &info.Name, &info.IsManaged, &info.SourceURL, &info.LastUpdated, &info.Tags, &info.DomainCount); err != nil {
 		return nil, handleError(err, "failed to scan domain list info: %w", err)
 	}
 
@@ -235,22 +275,99 @@ func (p *PostgresFirewallRepository) GetDomainListInfo(
 	return &info, nil
 }
 
-func (p *PostgresFirewallRepository) ListDomainLists(ctx context.Context) ([]model.DomainListInfo, error) {
-	rows, err := p.db.Query(ctx, listDomainListsQuery)
+// ListDomainLists returns a page of the domain lists that match the name prefix and tag
+// filter.
+func (p *PostgresFirewallRepository) ListDomainLists(
+	ctx context.Context,
+	opts model.ListOptions,
+) (model.Page[model.DomainListInfo], error) {
+	q := newKeysetQuery(listDomainListsQuery)
+	q.namePrefix("dl.name", opts.NamePrefix)
+	if err := q.tags("dl.tags", opts.Tags); err != nil {
+		return model.Page[model.DomainListInfo]{}, err
+	}
+
+	query, args, err := q.build(domainListSortKeys[opts.SortBy], opts)
 	if err != nil {
-		return nil, handleError(err, "failed to execute list domain lists query: %w", err)
+		return model.Page[model.DomainListInfo]{}, err
 	}
 
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return model.Page[model.DomainListInfo]{}, handleError(
+			err,
+			"failed to execute list domain lists query: %w",
+			err,
+		)
+	}
+	defer rows.Close()
+
 	lists := []model.DomainListInfo{}
 	for rows.Next() {
 		var info model.DomainListInfo
-		if err = rows.Scan(&info.ID, &info.Name, &info.IsManaged, &info.SourceURL, &info.DomainCount); err != nil {
-			return nil, handleError(err, "failed to scan domain list: %w", err)
+		err = rows.Scan(
+			&info.ID,
+			&info.Name,
+			&info.IsManaged,
+			&info.SourceURL,
+			&info.LastUpdated,
+			&info.Tags,
+			&info.DomainCount,
+		)
+		if err != nil {
+			return model.Page[model.DomainListInfo]{}, handleError(err, "failed to scan domain list: %w", err)
 		}
 		lists = append(lists, info)
 	}
 
-	return lists, nil
+	return newPage(lists, opts, func(info *model.DomainListInfo) []string {
+		if opts.SortBy == model.SortByUpdatedAt {
+			return []string{info.LastUpdated.Format(time.RFC3339Nano), info.ID.String()}
+		}
+		return []string{info.Name, info.ID.String()}
+	}), nil
+}
+
+// UpdateDomainListTags adds or overwrites the tags in set on the domain list, removes the keys
+// in remove, and returns the resulting tags.
+func (p *PostgresFirewallRepository) UpdateDomainListTags(
+	ctx context.Context,
+	id uuid.UUID,
+	set model.Tags,
+	remove []string,
+) (model.Tags, error) {
+	return p.updateTags(ctx, updateDomainListTagsQuery, id, set, remove)
+}
+
+// UpdateFirewallRuleTags adds or overwrites the tags in set on the firewall rule, removes the
+// keys in remove, and returns the resulting tags.
+func (p *PostgresFirewallRepository) UpdateFirewallRuleTags(
+	ctx context.Context,
+	id uuid.UUID,
+	set model.Tags,
+	remove []string,
+) (model.Tags, error) {
+	return p.updateTags(ctx, updateFirewallRuleTagsQuery, id, set, remove)
+}
+
+func (p *PostgresFirewallRepository) updateTags(
+	ctx context.Context,
+	query string,
+	id uuid.UUID,
+	set model.Tags,
+	remove []string,
+) (model.Tags, error) {
+	setJSON, remove, err := tagUpdateArgs(set, remove)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags model.Tags
+	if err = p.db.QueryRow(ctx, query, id, setJSON, remove).Scan(&tags); err != nil {
+		return nil, handleError(err, "failed to update tags: %w", err)
+	}
+
+	return tags, nil
 }
 
 func (p *PostgresFirewallRepository) AddDomainsToDomainList(ctx context.Context, id uuid.UUID, domains []string) error {
@@ -277,7 +394,12 @@ func (p *PostgresFirewallRepository) CreateFirewallRule(
 		}
 	}
 
-	_, err := p.db.Exec(
+	tagsJSON, err := marshalTags(rule.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = p.db.Exec(
 		ctx,
 		createFirewallRuleQuery,
 		rule.Name,
@@ -286,6 +408,7 @@ func (p *PostgresFirewallRepository) CreateFirewallRule(
 		rule.BlockResponseType,
 		blockResponse,
 		rule.Priority,
+		tagsJSON,
 	)
 	if err != nil {
 		return nil, handleError(err, "failed to execute create firewall rule query: %w", err)
@@ -311,6 +434,7 @@ func (p *PostgresFirewallRepository) GetFirewallRule(ctx context.Context, id uui
 		&rule.BlockResponseType,
 		&rule.BlockResponse,
 		&rule.Priority,
+		&rule.Tags,
 	)
 	if err != nil {
 		return nil, handleError(err, "failed to scan firewall rule: %w", err)
@@ -331,7 +455,7 @@ func (p *PostgresFirewallRepository) GetFirewallRulesByDomainListID(
 	rules := []model.FirewallRule{}
 	for rows.Next() {
 		var rule model.FirewallRule
-		if err = rows.Scan(&rule.ID, &rule.Name, &rule.DomainListID, &rule.Action, &rule.BlockResponseType, &rule.BlockResponse, &rule.Priority); err != nil {
+		if err = rows.Scan(&rule.ID, &rule.Name, &rule.DomainListID, &rule.Action, &rule.BlockResponseType, &rule.BlockResponse, &rule.Priority, &rule.Tags); err != nil {
 			return nil, handleError(err, "failed to scan firewall rule: %w", err)
 		}
 		rules = append(rules, rule)
@@ -340,22 +464,58 @@ func (p *PostgresFirewallRepository) GetFirewallRulesByDomainListID(
 	return rules, nil
 }
 
-func (p *PostgresFirewallRepository) ListFirewallRules(ctx context.Context) ([]model.FirewallRule, error) {
-	rows, err := p.db.Query(ctx, listFirewallRulesQuery)
+// ListFirewallRules returns a page of the firewall rules that match the name prefix and tag
+// filter.
+func (p *PostgresFirewallRepository) ListFirewallRules(
+	ctx context.Context,
+	opts model.ListOptions,
+) (model.Page[model.FirewallRule], error) {
+	q := newKeysetQuery(listFirewallRulesQuery)
+	q.namePrefix("name", opts.NamePrefix)
+	if err := q.tags("tags", opts.Tags); err != nil {
+		return model.Page[model.FirewallRule]{}, err
+	}
+
+	query, args, err := q.build(firewallRuleSortKeys[opts.SortBy], opts)
 	if err != nil {
-		return nil, handleError(err, "failed to execute list firewall rules query: %w", err)
+		return model.Page[model.FirewallRule]{}, err
 	}
 
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return model.Page[model.FirewallRule]{}, handleError(
+			err,
+			"failed to execute list firewall rules query: %w",
+			err,
+		)
+	}
+	defer rows.Close()
+
 	rules := []model.FirewallRule{}
 	for rows.Next() {
 		var rule model.FirewallRule
-		if err = rows.Scan(&rule.ID, &rule.Name, &rule.DomainListID, &rule.Action, &rule.BlockResponseType, &rule.BlockResponse, &rule.Priority); err != nil {
-			return nil, handleError(err, "failed to scan firewall rule: %w", err)
+		err = rows.Scan(
+			&rule.ID,
+			&rule.Name,
+			&rule.DomainListID,
+			&rule.Action,
+			&rule.BlockResponseType,
+			&rule.BlockResponse,
+			&rule.Priority,
+			&rule.Tags,
+		)
+		if err != nil {
+			return model.Page[model.FirewallRule]{}, handleError(err, "failed to scan firewall rule: %w", err)
 		}
 		rules = append(rules, rule)
 	}
 
-	return rules, nil
+	return newPage(rules, opts, func(rule *model.FirewallRule) []string {
+		if opts.SortBy == model.SortByName {
+			return []string{rule.Name, rule.ID.String()}
+		}
+		return []string{strconv.FormatUint(uint64(rule.Priority), 10), rule.ID.String()}
+	}), nil
 }
 
 func (p *PostgresFirewallRepository) RemoveDomainsFromDomainList(
@@ -420,6 +580,42 @@ func (p *PostgresFirewallRepository) GetDomainListDomains(ctx context.Context, i
 	return domains, nil
 }
 
+// ListDomainListDomains returns a page of the domains of the domain list that start with the
+// name prefix.
+func (p *PostgresFirewallRepository) ListDomainListDomains(
+	ctx context.Context,
+	id uuid.UUID,
+	opts model.ListOptions,
+) (model.Page[string], error) {
+	q := newKeysetQuery(listDomainListDomainsQuery)
+	q.and("domain_list_id = " + q.arg(id))
+	q.namePrefix("domain", opts.NamePrefix)
+
+	query, args, err := q.build(domainSortKeys[opts.SortBy], opts)
+	if err != nil {
+		return model.Page[string]{}, err
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return model.Page[string]{}, handleError(err, "failed to execute list domain list domains query: %w", err)
+	}
+	defer rows.Close()
+
+	domains := []string{}
+	for rows.Next() {
+		var domain string
+		if err = rows.Scan(&domain); err != nil {
+			return model.Page[string]{}, handleError(err, "failed to scan domain: %w", err)
+		}
+		domains = append(domains, domain)
+	}
+
+	return newPage(domains, opts, func(domain *string) []string {
+		return []string{*domain}
+	}), nil
+}
+
 func (p *PostgresFirewallRepository) OverwriteDomainListDomains(
 	ctx context.Context,
 	id uuid.UUID,
@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/davidseybold/beacondns/internal/db/postgres"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+const (
+	createIdempotencyKeyQuery = `
+		INSERT INTO idempotency_keys (subject_type, subject_id, key, request_hash)
+		VALUES ($1, $2, $3, $4)
+		RETURNING created_at
+	`
+
+	getIdempotencyKeyQuery = `
+		SELECT request_hash, created_at, status_code, content_type, body
+		FROM idempotency_keys
+		WHERE subject_type = $1 AND subject_id = $2 AND key = $3
+	`
+
+	completeIdempotencyKeyQuery = `
+		UPDATE idempotency_keys
+		SET status_code = $4, content_type = $5, body = $6
+		WHERE subject_type = $1 AND subject_id = $2 AND key = $3
+	`
+
+	deleteIdempotencyKeyQuery = `
+		DELETE FROM idempotency_keys
+		WHERE subject_type = $1 AND subject_id = $2 AND key = $3
+	`
+
+	deleteExpiredIdempotencyKeyQuery = `
+		DELETE FROM idempotency_keys
+		WHERE subject_type = $1 AND subject_id = $2 AND key = $3
+			AND (created_at < $4 OR (status_code IS NULL AND created_at < $5))
+	`
+
+	purgeIdempotencyKeysQuery = `
+		DELETE FROM idempotency_keys
+		WHERE created_at < $1 OR (status_code IS NULL AND created_at < $2)
+	`
+)
+
+type IdempotencyKeyRepository interface {
+	// CreateIdempotencyKey reserves the key of a request that is about to be handled. It
+	// returns ErrEntityAlreadyExists if the subject already used the key.
+	CreateIdempotencyKey(ctx context.Context, key *model.IdempotencyKey) (*model.IdempotencyKey, error)
+	GetIdempotencyKey(ctx context.Context, subject model.Subject, key string) (*model.IdempotencyKey, error)
+	// CompleteIdempotencyKey stores the response to the request the key was reserved for.
+	CompleteIdempotencyKey(
+		ctx context.Context,
+		subject model.Subject,
+		key string,
+		response model.IdempotentResponse,
+	) error
+	DeleteIdempotencyKey(ctx context.Context, subject model.Subject, key string) error
+	// DeleteExpiredIdempotencyKey deletes the key if it was created before expiredBefore, or
+	// reserved before abandonedBefore for a request that never completed, and otherwise
+	// leaves it alone.
+	DeleteExpiredIdempotencyKey(
+		ctx context.Context,
+		subject model.Subject,
+		key string,
+		expiredBefore time.Time,
+		abandonedBefore time.Time,
+	) error
+	// PurgeIdempotencyKeys deletes the keys created before expiredBefore, and the keys
+	// reserved before abandonedBefore for requests that never completed.
+	PurgeIdempotencyKeys(ctx context.Context, expiredBefore time.Time, abandonedBefore time.Time) error
+}
+
+var _ IdempotencyKeyRepository = (*PostgresIdempotencyKeyRepository)(nil)
+
+type PostgresIdempotencyKeyRepository struct {
+	db postgres.Queryer
+}
+
+func (p *PostgresIdempotencyKeyRepository) CreateIdempotencyKey(
+	ctx context.Context,
+	key *model.IdempotencyKey,
+) (*model.IdempotencyKey, error) {
+	created := *key
+	err := p.db.QueryRow(ctx, createIdempotencyKeyQuery,
+		key.Subject.Type,
+		key.Subject.ID,
+		key.Key,
+		key.RequestHash,
+	).Scan(&created.CreatedAt)
+	if err != nil {
+		return nil, handleError(err, "failed to create idempotency key: %w", err)
+	}
+
+	return &created, nil
+}
+
+func (p *PostgresIdempotencyKeyRepository) GetIdempotencyKey(
+	ctx context.Context,
+	subject model.Subject,
+	key string,
+) (*model.IdempotencyKey, error) {
+	idempotencyKey := model.IdempotencyKey{Subject: subject, Key: key}
+
+	var statusCode *int
+	var contentType *string
+	var body []byte
+	err := p.db.QueryRow(ctx, getIdempotencyKeyQuery, subject.Type, subject.ID, key).Scan(
+		&idempotencyKey.RequestHash,
+		&idempotencyKey.CreatedAt,
+		&statusCode,
+		&contentType,
+		&body,
+	)
+	if err != nil {
+		return nil, handleError(err, "failed to get idempotency key: %w", err)
+	}
+
+	if statusCode != nil {
+		idempotencyKey.Response = &model.IdempotentResponse{StatusCode: *statusCode, Body: body}
+		if contentType != nil {
+			idempotencyKey.Response.ContentType = *contentType
+		}
+	}
+
+	return &idempotencyKey, nil
+}
+
+func (p *PostgresIdempotencyKeyRepository) CompleteIdempotencyKey(
+	ctx context.Context,
+	subject model.Subject,
+	key string,
+	response model.IdempotentResponse,
+) error {
+	tag, err := p.db.Exec(ctx, completeIdempotencyKeyQuery,
+		subject.Type,
+		subject.ID,
+		key,
+		response.StatusCode,
+		response.ContentType,
+		response.Body,
+	)
+	if err != nil {
+		return handleError(err, "failed to complete idempotency key: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrEntityNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgresIdempotencyKeyRepository) DeleteIdempotencyKey(
+	ctx context.Context,
+	subject model.Subject,
+	key string,
+) error {
+	if _, err := p.db.Exec(ctx, deleteIdempotencyKeyQuery, subject.Type, subject.ID, key); err != nil {
+		return handleError(err, "failed to delete idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PostgresIdempotencyKeyRepository) DeleteExpiredIdempotencyKey(
+	ctx context.Context,
+	subject model.Subject,
+	key string,
+	expiredBefore time.Time,
+	abandonedBefore time.Time,
+) error {
+	_, err := p.db.Exec(ctx, deleteExpiredIdempotencyKeyQuery,
+		subject.Type,
+		subject.ID,
+		key,
+		expiredBefore,
+		abandonedBefore,
+	)
+	if err != nil {
+		return handleError(err, "failed to delete expired idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PostgresIdempotencyKeyRepository) PurgeIdempotencyKeys(
+	ctx context.Context,
+	expiredBefore time.Time,
+	abandonedBefore time.Time,
+) error {
+	if _, err := p.db.Exec(ctx, purgeIdempotencyKeysQuery, expiredBefore, abandonedBefore); err != nil {
+		return handleError(err, "failed to purge idempotency keys: %w", err)
+	}
+
+	return nil
+}
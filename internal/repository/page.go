@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+// sortKey is the ordering of a list for one sort field. The columns together must be unique
+// within the list, so that the key of the last row of a page identifies where the next page
+// starts. Cursors carry the key as text, and each value is cast back to the SQL type of its
+// column.
+type sortKey []sortColumn
+
+type sortColumn struct {
+	expr    string
+	sqlType string
+}
+
+// keysetQuery builds a query for one page of a list using keyset pagination: instead of an
+// OFFSET, the next page is selected with a row comparison against the sort key of the last
+// row of the previous page, which an index on the key columns can serve directly.
+type keysetQuery struct {
+	selectFrom string
+	where      []string
+	groupBy    string
+	args       []any
+}
+
+func newKeysetQuery(selectFrom string) *keysetQuery {
+	return &keysetQuery{selectFrom: selectFrom}
+}
+
+// arg adds a query argument and returns its placeholder.
+func (q *keysetQuery) arg(value any) string {
+	q.args = append(q.args, value)
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// and adds a condition to the WHERE clause.
+func (q *keysetQuery) and(condition string) {
+	q.where = append(q.where, condition)
+}
+
+// namePrefix restricts the rows to those where column starts with prefix.
+func (q *keysetQuery) namePrefix(column string, prefix string) {
+	if prefix != "" {
+		q.and(fmt.Sprintf("starts_with(%s, %s)", column, q.arg(prefix)))
+	}
+}
+
+// tags restricts the rows to those whose tags column matches the filter.
+func (q *keysetQuery) tags(column string, filter model.TagFilter) error {
+	match, keys, err := tagFilterArgs(filter)
+	if err != nil {
+		return err
+	}
+
+	q.and(fmt.Sprintf("%s @> %s::jsonb AND %s ?& %s::text[]", column, q.arg(match), column, q.arg(keys)))
+	return nil
+}
+
+// build returns the query and its arguments. The query fetches one row more than the limit,
+// which tells newPage whether there is a next page.
+func (q *keysetQuery) build(key sortKey, opts model.ListOptions) (string, []any, error) {
+	direction, comparison := "ASC", ">"
+	if opts.Order == model.SortDescending {
+		direction, comparison = "DESC", "<"
+	}
+
+	if after := opts.After(); after != nil {
+		if len(after) != len(key) {
+			return "", nil, model.ErrInvalidCursor
+		}
+
+		columns := make([]string, len(key))
+		values := make([]string, len(key))
+		for i, column := range key {
+			columns[i] = column.expr
+			values[i] = fmt.Sprintf("(%s::text)::%s", q.arg(after[i]), column.sqlType)
+		}
+		q.and(fmt.Sprintf("(%s) %s (%s)", strings.Join(columns, ", "), comparison, strings.Join(values, ", ")))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(q.selectFrom)
+	if len(q.where) > 0 {
+		sb.WriteString("\nWHERE ")
+		sb.WriteString(strings.Join(q.where, "\n\tAND "))
+	}
+	if q.groupBy != "" {
+		sb.WriteString("\nGROUP BY ")
+		sb.WriteString(q.groupBy)
+	}
+
+	orderBy := make([]string, len(key))
+	for i, column := range key {
+		orderBy[i] = column.expr + " " + direction
+	}
+	sb.WriteString("\nORDER BY ")
+	sb.WriteString(strings.Join(orderBy, ", "))
+	sb.WriteString("\nLIMIT ")
+	sb.WriteString(q.arg(opts.Limit + 1))
+
+	return sb.String(), q.args, nil
+}
+
+// newPage trims the extra row fetched by a keysetQuery and, if there was one, sets the cursor
+// of the next page from the sort key of the last item.
+func newPage[T any](items []T, opts model.ListOptions, key func(*T) []string) model.Page[T] {
+	if len(items) <= opts.Limit {
+		return model.Page[T]{Items: items}
+	}
+
+	items = items[:opts.Limit]
+	return model.Page[T]{Items: items, NextCursor: opts.NextCursor(key(&items[len(items)-1]))}
+}
@@ -0,0 +1,351 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/davidseybold/beacondns/internal/db/postgres"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+const (
+	createRoleQuery = `
+		INSERT INTO roles (name, description, permissions)
+		VALUES ($1, $2, $3)
+		RETURNING name, description, permissions, built_in, created_at, updated_at
+	`
+
+	updateRoleQuery = `
+		UPDATE roles
+		SET description = $2, permissions = $3, updated_at = now()
+		WHERE name = $1
+		RETURNING name, description, permissions, built_in, created_at, updated_at
+	`
+
+	getRoleQuery = `
+		SELECT name, description, permissions, built_in, created_at, updated_at
+		FROM roles
+		WHERE name = $1
+	`
+
+	listRolesQuery = `
+		SELECT name, description, permissions, built_in, created_at, updated_at
+		FROM roles`
+
+	deleteRoleQuery = `
+		DELETE FROM roles
+		WHERE name = $1
+	`
+
+	createRoleBindingQuery = `
+		INSERT INTO role_bindings (role_name, subject_type, subject_id)
+		VALUES ($1, $2, $3)
+		RETURNING id, role_name, subject_type, subject_id, created_at
+	`
+
+	getRoleBindingQuery = `
+		SELECT id, role_name, subject_type, subject_id, created_at
+		FROM role_bindings
+		WHERE id = $1
+	`
+
+	listRoleBindingsQuery = `
+		SELECT id, role_name, subject_type, subject_id, created_at
+		FROM role_bindings`
+
+	deleteRoleBindingQuery = `
+		DELETE FROM role_bindings
+		WHERE id = $1
+	`
+
+	getSubjectPermissionsQuery = `
+		SELECT r.permissions
+		FROM role_bindings rb
+		JOIN roles r ON r.name = rb.role_name
+		JOIN unnest($1::text[], $2::text[]) AS s(subject_type, subject_id)
+			ON rb.subject_type = s.subject_type AND rb.subject_id = s.subject_id
+	`
+)
+
+var (
+	roleSortKeys = map[string]sortKey{
+		model.SortByName: {{"name", "text"}},
+	}
+
+	roleBindingSortKeys = map[string]sortKey{
+		model.SortByName:      {{"role_name", "text"}, {"id", "uuid"}},
+		model.SortByCreatedAt: {{"created_at", "timestamptz"}, {"id", "uuid"}},
+	}
+)
+
+type RoleRepository interface {
+	CreateRole(ctx context.Context, role *model.Role) (*model.Role, error)
+	UpdateRole(ctx context.Context, role *model.Role) (*model.Role, error)
+	GetRole(ctx context.Context, name string) (*model.Role, error)
+	ListRoles(ctx context.Context, opts model.ListOptions) (model.Page[model.Role], error)
+	// DeleteRole deletes the role along with its bindings.
+	DeleteRole(ctx context.Context, name string) error
+
+	CreateRoleBinding(ctx context.Context, binding *model.RoleBinding) (*model.RoleBinding, error)
+	GetRoleBinding(ctx context.Context, id uuid.UUID) (*model.RoleBinding, error)
+	// ListRoleBindings returns a page of the role bindings, only those of subject if it is
+	// set. NamePrefix matches the start of the role name.
+	ListRoleBindings(
+		ctx context.Context,
+		subject *model.Subject,
+		opts model.ListOptions,
+	) (model.Page[model.RoleBinding], error)
+	DeleteRoleBinding(ctx context.Context, id uuid.UUID) error
+	// GetSubjectPermissions returns the permissions of every role bound to any of the subjects.
+	GetSubjectPermissions(ctx context.Context, subjects ...model.Subject) ([]model.Permission, error)
+}
+
+var _ RoleRepository = (*PostgresRoleRepository)(nil)
+
+type PostgresRoleRepository struct {
+	db postgres.Queryer
+}
+
+func (p *PostgresRoleRepository) CreateRole(ctx context.Context, role *model.Role) (*model.Role, error) {
+	permissionsJSON, err := marshalPermissions(role.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := scanRole(p.db.QueryRow(ctx, createRoleQuery, role.Name, role.Description, permissionsJSON))
+	if err != nil {
+		return nil, handleError(err, "failed to execute create role query: %w", err)
+	}
+
+	return created, nil
+}
+
+func (p *PostgresRoleRepository) UpdateRole(ctx context.Context, role *model.Role) (*model.Role, error) {
+	permissionsJSON, err := marshalPermissions(role.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := scanRole(p.db.QueryRow(ctx, updateRoleQuery, role.Name, role.Description, permissionsJSON))
+	if err != nil {
+		return nil, handleError(err, "failed to execute update role query: %w", err)
+	}
+
+	return updated, nil
+}
+
+func (p *PostgresRoleRepository) GetRole(ctx context.Context, name string) (*model.Role, error) {
+	role, err := scanRole(p.db.QueryRow(ctx, getRoleQuery, name))
+	if err != nil {
+		return nil, handleError(err, "failed to scan role: %w", err)
+	}
+
+	return role, nil
+}
+
+// ListRoles returns a page of the roles, built-in ones included, that match the name prefix.
+func (p *PostgresRoleRepository) ListRoles(
+	ctx context.Context,
+	opts model.ListOptions,
+) (model.Page[model.Role], error) {
+	q := newKeysetQuery(listRolesQuery)
+	q.namePrefix("name", opts.NamePrefix)
+
+	query, args, err := q.build(roleSortKeys[opts.SortBy], opts)
+	if err != nil {
+		return model.Page[model.Role]{}, err
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return model.Page[model.Role]{}, handleError(err, "failed to execute list roles query: %w", err)
+	}
+	defer rows.Close()
+
+	roles := []model.Role{}
+	for rows.Next() {
+		role, scanErr := scanRole(rows)
+		if scanErr != nil {
+			return model.Page[model.Role]{}, handleError(scanErr, "failed to scan role: %w", scanErr)
+		}
+		roles = append(roles, *role)
+	}
+
+	if err = rows.Err(); err != nil {
+		return model.Page[model.Role]{}, handleError(err, "failed to read roles: %w", err)
+	}
+
+	return newPage(roles, opts, func(role *model.Role) []string {
+		return []string{role.Name}
+	}), nil
+}
+
+func (p *PostgresRoleRepository) DeleteRole(ctx context.Context, name string) error {
+	tag, err := p.db.Exec(ctx, deleteRoleQuery, name)
+	if err != nil {
+		return handleError(err, "failed to execute delete role query: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrEntityNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgresRoleRepository) CreateRoleBinding(
+	ctx context.Context,
+	binding *model.RoleBinding,
+) (*model.RoleBinding, error) {
+	row := p.db.QueryRow(ctx, createRoleBindingQuery, binding.Role, binding.Subject.Type, binding.Subject.ID)
+
+	created, err := scanRoleBinding(row)
+	if err != nil {
+		return nil, handleError(err, "failed to execute create role binding query: %w", err)
+	}
+
+	return created, nil
+}
+
+func (p *PostgresRoleRepository) GetRoleBinding(ctx context.Context, id uuid.UUID) (*model.RoleBinding, error) {
+	binding, err := scanRoleBinding(p.db.QueryRow(ctx, getRoleBindingQuery, id))
+	if err != nil {
+		return nil, handleError(err, "failed to scan role binding: %w", err)
+	}
+
+	return binding, nil
+}
+
+func (p *PostgresRoleRepository) ListRoleBindings(
+	ctx context.Context,
+	subject *model.Subject,
+	opts model.ListOptions,
+) (model.Page[model.RoleBinding], error) {
+	q := newKeysetQuery(listRoleBindingsQuery)
+	q.namePrefix("role_name", opts.NamePrefix)
+	if subject != nil {
+		q.and("subject_type = " + q.arg(subject.Type))
+		q.and("subject_id = " + q.arg(subject.ID))
+	}
+
+	query, args, err := q.build(roleBindingSortKeys[opts.SortBy], opts)
+	if err != nil {
+		return model.Page[model.RoleBinding]{}, err
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return model.Page[model.RoleBinding]{}, handleError(err, "failed to execute list role bindings query: %w", err)
+	}
+	defer rows.Close()
+
+	bindings := []model.RoleBinding{}
+	for rows.Next() {
+		binding, scanErr := scanRoleBinding(rows)
+		if scanErr != nil {
+			return model.Page[model.RoleBinding]{}, handleError(scanErr, "failed to scan role binding: %w", scanErr)
+		}
+		bindings = append(bindings, *binding)
+	}
+
+	if err = rows.Err(); err != nil {
+		return model.Page[model.RoleBinding]{}, handleError(err, "failed to read role bindings: %w", err)
+	}
+
+	return newPage(bindings, opts, func(binding *model.RoleBinding) []string {
+		if opts.SortBy == model.SortByCreatedAt {
+			return []string{binding.CreatedAt.Format(time.RFC3339Nano), binding.ID.String()}
+		}
+		return []string{binding.Role, binding.ID.String()}
+	}), nil
+}
+
+func (p *PostgresRoleRepository) DeleteRoleBinding(ctx context.Context, id uuid.UUID) error {
+	tag, err := p.db.Exec(ctx, deleteRoleBindingQuery, id)
+	if err != nil {
+		return handleError(err, "failed to execute delete role binding query: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrEntityNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgresRoleRepository) GetSubjectPermissions(
+	ctx context.Context,
+	subjects ...model.Subject,
+) ([]model.Permission, error) {
+	types := make([]string, len(subjects))
+	ids := make([]string, len(subjects))
+	for i, subject := range subjects {
+		types[i] = string(subject.Type)
+		ids[i] = subject.ID
+	}
+
+	rows, err := p.db.Query(ctx, getSubjectPermissionsQuery, types, ids)
+	if err != nil {
+		return nil, handleError(err, "failed to execute get subject permissions query: %w", err)
+	}
+	defer rows.Close()
+
+	var permissions []model.Permission
+	for rows.Next() {
+		var rolePermissions []model.Permission
+		if err = rows.Scan(&rolePermissions); err != nil {
+			return nil, handleError(err, "failed to scan permissions: %w", err)
+		}
+		permissions = append(permissions, rolePermissions...)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, handleError(err, "failed to read permissions: %w", err)
+	}
+
+	return permissions, nil
+}
+
+func marshalPermissions(permissions []model.Permission) ([]byte, error) {
+	if permissions == nil {
+		permissions = []model.Permission{}
+	}
+
+	permissionsJSON, err := json.Marshal(permissions)
+	if err != nil {
+		return nil, handleError(err, "failed to marshal permissions: %w", err)
+	}
+
+	return permissionsJSON, nil
+}
+
+func scanRole(row pgx.Row) (*model.Role, error) {
+	var role model.Role
+	err := row.Scan(
+		&role.Name,
+		&role.Description,
+		&role.Permissions,
+		&role.BuiltIn,
+		&role.CreatedAt,
+		&role.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+func scanRoleBinding(row pgx.Row) (*model.RoleBinding, error) {
+	var binding model.RoleBinding
+	err := row.Scan(&binding.ID, &binding.Role, &binding.Subject.Type, &binding.Subject.ID, &binding.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &binding, nil
+}
@@ -16,6 +16,12 @@ type Registry interface {
 	GetZoneRepository() ZoneRepository
 	GetEventRepository() EventRepository
 	GetFirewallRepository() FirewallRepository
+	GetAPIKeyRepository() APIKeyRepository
+	GetRoleRepository() RoleRepository
+	GetAuditRepository() AuditRepository
+	GetIdempotencyKeyRepository() IdempotencyKeyRepository
+	GetWebhookRepository() WebhookRepository
+	GetChangeLogRepository() ChangeLogRepository
 }
 
 type Transactor interface {
@@ -57,6 +63,36 @@ func (r *PostgresRepositoryRegistry) GetFirewallRepository() FirewallRepository
 	return &PostgresFirewallRepository{db}
 }
 
+func (r *PostgresRepositoryRegistry) GetAPIKeyRepository() APIKeyRepository {
+	db := r.getQueryer()
+	return &PostgresAPIKeyRepository{db}
+}
+
+func (r *PostgresRepositoryRegistry) GetRoleRepository() RoleRepository {
+	db := r.getQueryer()
+	return &PostgresRoleRepository{db}
+}
+
+func (r *PostgresRepositoryRegistry) GetAuditRepository() AuditRepository {
+	db := r.getQueryer()
+	return &PostgresAuditRepository{db}
+}
+
+func (r *PostgresRepositoryRegistry) GetIdempotencyKeyRepository() IdempotencyKeyRepository {
+	db := r.getQueryer()
+	return &PostgresIdempotencyKeyRepository{db}
+}
+
+func (r *PostgresRepositoryRegistry) GetWebhookRepository() WebhookRepository {
+	db := r.getQueryer()
+	return &PostgresWebhookRepository{db}
+}
+
+func (r *PostgresRepositoryRegistry) GetChangeLogRepository() ChangeLogRepository {
+	db := r.getQueryer()
+	return &PostgresChangeLogRepository{db}
+}
+
 func (r *PostgresRepositoryRegistry) getQueryer() postgres.Queryer {
 	if r.queryer != nil {
 		return r.queryer
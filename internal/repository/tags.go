@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"encoding/json"
+
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+// marshalTags encodes tags for a tags column. Objects without tags are stored as an empty
+// object, since the columns are not nullable.
+func marshalTags(tags model.Tags) ([]byte, error) {
+	if tags == nil {
+		tags = model.Tags{}
+	}
+
+	tagsJSON, err := json.Marshal(tags)
+	if err != nil {
+		return nil, handleError(err, "failed to marshal tags: %w", err)
+	}
+
+	return tagsJSON, nil
+}
+
+// tagFilterArgs returns the arguments of a "tags @> $1::jsonb AND tags ?& $2::text[]"
+// condition, which matches the rows that carry every tag and every key of the filter.
+func tagFilterArgs(filter model.TagFilter) ([]byte, []string, error) {
+	match, err := marshalTags(filter.Match)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// A NULL array would make the condition NULL and filter out every row.
+	keys := filter.Keys
+	if keys == nil {
+		keys = []string{}
+	}
+
+	return match, keys, nil
+}
+
+// tagUpdateArgs returns the arguments of a "tags = (tags || $2::jsonb) - $3::text[]" update,
+// which merges set into the tags and then removes the keys in remove.
+func tagUpdateArgs(set model.Tags, remove []string) ([]byte, []string, error) {
+	setJSON, err := marshalTags(set)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if remove == nil {
+		remove = []string{}
+	}
+
+	return setJSON, remove, nil
+}
@@ -0,0 +1,380 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/davidseybold/beacondns/internal/db/postgres"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+const (
+	createWebhookQuery = `
+		INSERT INTO webhooks (name, url, events, secret)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, name, url, events, secret, created_at
+	`
+
+	getWebhookQuery = `
+		SELECT id, name, url, events, secret, created_at
+		FROM webhooks
+		WHERE id = $1
+	`
+
+	getWebhooksQuery = `
+		SELECT id, name, url, events, secret, created_at
+		FROM webhooks
+	`
+
+	listWebhooksQuery = `
+		SELECT id, name, url, events, secret, created_at
+		FROM webhooks`
+
+	deleteWebhookQuery = `
+		DELETE FROM webhooks
+		WHERE id = $1
+	`
+
+	createWebhookDeliveryQuery = `
+		INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload, next_attempt_at)
+		VALUES ($1, $2, $3, $4, now())
+		RETURNING id, webhook_id, event_id, event_type, payload, status, attempts, next_attempt_at,
+			last_attempt_at, response_status, last_error, created_at
+	`
+
+	getWebhookDeliveryQuery = `
+		SELECT id, webhook_id, event_id, event_type, payload, status, attempts, next_attempt_at,
+			last_attempt_at, response_status, last_error, created_at
+		FROM webhook_deliveries
+		WHERE webhook_id = $1 AND id = $2
+	`
+
+	listWebhookDeliveriesQuery = `
+		SELECT id, webhook_id, event_id, event_type, payload, status, attempts, next_attempt_at,
+			last_attempt_at, response_status, last_error, created_at
+		FROM webhook_deliveries`
+
+	claimWebhookDeliveriesQuery = `
+		UPDATE webhook_deliveries
+		SET attempts = attempts + 1, next_attempt_at = now() + $2 * INTERVAL '1 millisecond'
+		WHERE id IN (
+			SELECT id
+			FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= now()
+			ORDER BY next_attempt_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, webhook_id, event_id, event_type, payload, status, attempts, next_attempt_at,
+			last_attempt_at, response_status, last_error, created_at
+	`
+
+	updateWebhookDeliveryQuery = `
+		UPDATE webhook_deliveries
+		SET status = $2, next_attempt_at = $3, last_attempt_at = $4, response_status = $5, last_error = $6
+		WHERE id = $1
+	`
+
+	purgeWebhookDeliveriesQuery = `
+		DELETE FROM webhook_deliveries
+		WHERE created_at < $1 AND status <> 'pending'
+	`
+)
+
+var webhookSortKeys = map[string]sortKey{
+	model.SortByName:      {{"name", "text"}, {"id", "uuid"}},
+	model.SortByCreatedAt: {{"created_at", "timestamptz"}, {"id", "uuid"}},
+}
+
+var webhookDeliverySortKeys = map[string]sortKey{
+	model.SortByCreatedAt: {{"created_at", "timestamptz"}, {"id", "uuid"}},
+}
+
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error)
+	GetWebhook(ctx context.Context, id uuid.UUID) (*model.Webhook, error)
+	// GetWebhooks returns every webhook, for matching them against an event.
+	GetWebhooks(ctx context.Context) ([]model.Webhook, error)
+	ListWebhooks(ctx context.Context, opts model.ListOptions) (model.Page[model.Webhook], error)
+	// DeleteWebhook deletes the webhook along with its deliveries.
+	DeleteWebhook(ctx context.Context, id uuid.UUID) error
+
+	// CreateWebhookDelivery stores a pending delivery that is due right away.
+	CreateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) (*model.WebhookDelivery, error)
+	GetWebhookDelivery(ctx context.Context, webhookID uuid.UUID, id uuid.UUID) (*model.WebhookDelivery, error)
+	ListWebhookDeliveries(
+		ctx context.Context,
+		webhookID uuid.UUID,
+		opts model.ListOptions,
+	) (model.Page[model.WebhookDelivery], error)
+	// ClaimWebhookDeliveries returns up to limit pending deliveries that are due, counting an
+	// attempt for each. They are not due again until lease has passed, so a delivery whose
+	// outcome is never recorded is attempted again once the lease runs out.
+	ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]model.WebhookDelivery, error)
+	// UpdateWebhookDelivery records the status and outcome of the last attempt of a delivery.
+	UpdateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error
+	// PurgeWebhookDeliveries deletes the succeeded and failed deliveries created before
+	// before. Pending deliveries are kept until they run out of attempts.
+	PurgeWebhookDeliveries(ctx context.Context, before time.Time) error
+}
+
+var _ WebhookRepository = (*PostgresWebhookRepository)(nil)
+
+type PostgresWebhookRepository struct {
+	db postgres.Queryer
+}
+
+func (p *PostgresWebhookRepository) CreateWebhook(
+	ctx context.Context,
+	webhook *model.Webhook,
+) (*model.Webhook, error) {
+	row := p.db.QueryRow(ctx, createWebhookQuery, webhook.Name, webhook.URL, webhook.Events, webhook.Secret)
+
+	created, err := scanWebhook(row)
+	if err != nil {
+		return nil, handleError(err, "failed to execute create webhook query: %w", err)
+	}
+
+	return created, nil
+}
+
+func (p *PostgresWebhookRepository) GetWebhook(ctx context.Context, id uuid.UUID) (*model.Webhook, error) {
+	webhook, err := scanWebhook(p.db.QueryRow(ctx, getWebhookQuery, id))
+	if err != nil {
+		return nil, handleError(err, "failed to scan webhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+func (p *PostgresWebhookRepository) GetWebhooks(ctx context.Context) ([]model.Webhook, error) {
+	return p.queryWebhooks(ctx, getWebhooksQuery)
+}
+
+func (p *PostgresWebhookRepository) ListWebhooks(
+	ctx context.Context,
+	opts model.ListOptions,
+) (model.Page[model.Webhook], error) {
+	q := newKeysetQuery(listWebhooksQuery)
+	q.namePrefix("name", opts.NamePrefix)
+
+	query, args, err := q.build(webhookSortKeys[opts.SortBy], opts)
+	if err != nil {
+		return model.Page[model.Webhook]{}, err
+	}
+
+	webhooks, err := p.queryWebhooks(ctx, query, args...)
+	if err != nil {
+		return model.Page[model.Webhook]{}, err
+	}
+
+	return newPage(webhooks, opts, func(webhook *model.Webhook) []string {
+		if opts.SortBy == model.SortByCreatedAt {
+			return []string{webhook.CreatedAt.Format(time.RFC3339Nano), webhook.ID.String()}
+		}
+		return []string{webhook.Name, webhook.ID.String()}
+	}), nil
+}
+
+func (p *PostgresWebhookRepository) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	tag, err := p.db.Exec(ctx, deleteWebhookQuery, id)
+	if err != nil {
+		return handleError(err, "failed to execute delete webhook query: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrEntityNotFound
+	}
+
+	return nil
+}
+
+func (p *PostgresWebhookRepository) queryWebhooks(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]model.Webhook, error) {
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, handleError(err, "failed to execute list webhooks query: %w", err)
+	}
+	defer rows.Close()
+
+	webhooks := []model.Webhook{}
+	for rows.Next() {
+		webhook, scanErr := scanWebhook(rows)
+		if scanErr != nil {
+			return nil, handleError(scanErr, "failed to scan webhook: %w", scanErr)
+		}
+		webhooks = append(webhooks, *webhook)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, handleError(err, "failed to read webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+func (p *PostgresWebhookRepository) CreateWebhookDelivery(
+	ctx context.Context,
+	delivery *model.WebhookDelivery,
+) (*model.WebhookDelivery, error) {
+	row := p.db.QueryRow(ctx, createWebhookDeliveryQuery,
+		delivery.WebhookID,
+		delivery.EventID,
+		delivery.EventType,
+		delivery.Payload,
+	)
+
+	created, err := scanWebhookDelivery(row)
+	if err != nil {
+		return nil, handleError(err, "failed to execute create webhook delivery query: %w", err)
+	}
+
+	return created, nil
+}
+
+func (p *PostgresWebhookRepository) GetWebhookDelivery(
+	ctx context.Context,
+	webhookID uuid.UUID,
+	id uuid.UUID,
+) (*model.WebhookDelivery, error) {
+	delivery, err := scanWebhookDelivery(p.db.QueryRow(ctx, getWebhookDeliveryQuery, webhookID, id))
+	if err != nil {
+		return nil, handleError(err, "failed to scan webhook delivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+func (p *PostgresWebhookRepository) ListWebhookDeliveries(
+	ctx context.Context,
+	webhookID uuid.UUID,
+	opts model.ListOptions,
+) (model.Page[model.WebhookDelivery], error) {
+	q := newKeysetQuery(listWebhookDeliveriesQuery)
+	q.and("webhook_id = " + q.arg(webhookID))
+
+	query, args, err := q.build(webhookDeliverySortKeys[opts.SortBy], opts)
+	if err != nil {
+		return model.Page[model.WebhookDelivery]{}, err
+	}
+
+	deliveries, err := p.queryWebhookDeliveries(ctx, query, args...)
+	if err != nil {
+		return model.Page[model.WebhookDelivery]{}, err
+	}
+
+	return newPage(deliveries, opts, func(delivery *model.WebhookDelivery) []string {
+		return []string{delivery.CreatedAt.Format(time.RFC3339Nano), delivery.ID.String()}
+	}), nil
+}
+
+func (p *PostgresWebhookRepository) ClaimWebhookDeliveries(
+	ctx context.Context,
+	limit int,
+	lease time.Duration,
+) ([]model.WebhookDelivery, error) {
+	return p.queryWebhookDeliveries(ctx, claimWebhookDeliveriesQuery, limit, lease.Milliseconds())
+}
+
+func (p *PostgresWebhookRepository) UpdateWebhookDelivery(
+	ctx context.Context,
+	delivery *model.WebhookDelivery,
+) error {
+	_, err := p.db.Exec(ctx, updateWebhookDeliveryQuery,
+		delivery.ID,
+		delivery.Status,
+		delivery.NextAttemptAt,
+		delivery.LastAttemptAt,
+		delivery.ResponseStatus,
+		delivery.Error,
+	)
+	if err != nil {
+		return handleError(err, "failed to execute update webhook delivery query: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PostgresWebhookRepository) PurgeWebhookDeliveries(ctx context.Context, before time.Time) error {
+	if _, err := p.db.Exec(ctx, purgeWebhookDeliveriesQuery, before); err != nil {
+		return handleError(err, "failed to purge webhook deliveries: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PostgresWebhookRepository) queryWebhookDeliveries(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]model.WebhookDelivery, error) {
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, handleError(err, "failed to execute list webhook deliveries query: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := []model.WebhookDelivery{}
+	for rows.Next() {
+		delivery, scanErr := scanWebhookDelivery(rows)
+		if scanErr != nil {
+			return nil, handleError(scanErr, "failed to scan webhook delivery: %w", scanErr)
+		}
+		deliveries = append(deliveries, *delivery)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, handleError(err, "failed to read webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+func scanWebhook(row pgx.Row) (*model.Webhook, error) {
+	var webhook model.Webhook
+	err := row.Scan(
+		&webhook.ID,
+		&webhook.Name,
+		&webhook.URL,
+		&webhook.Events,
+		&webhook.Secret,
+		&webhook.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+func scanWebhookDelivery(row pgx.Row) (*model.WebhookDelivery, error) {
+	var delivery model.WebhookDelivery
+	var payload []byte
+	err := row.Scan(
+		&delivery.ID,
+		&delivery.WebhookID,
+		&delivery.EventID,
+		&delivery.EventType,
+		&payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.NextAttemptAt,
+		&delivery.LastAttemptAt,
+		&delivery.ResponseStatus,
+		&delivery.Error,
+		&delivery.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery.Payload = payload
+
+	return &delivery, nil
+}
@@ -5,8 +5,11 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
 
 	"github.com/davidseybold/beacondns/internal/db/postgres"
@@ -14,41 +17,58 @@ import (
 )
 
 const (
-	insertZoneQuery              = "INSERT INTO zones(id, name) VALUES ($1, $2);"
+	insertZoneQuery              = "INSERT INTO zones(id, name, tags) VALUES ($1, $2, $3);"
 	deleteZoneQuery              = "DELETE FROM zones WHERE name = $1;"
-	insertResourceRecordSetQuery = "INSERT INTO resource_record_sets (id, zone_id, name, record_type, ttl) VALUES ($1, $2, $3, $4, $5) RETURNING id;"
+	countZonesQuery              = "SELECT COUNT(*) FROM zones;"
+	insertResourceRecordSetQuery = "INSERT INTO resource_record_sets (id, zone_id, name, record_type, ttl, tags, comment) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id;"
 	insertResourceRecordQuery    = "INSERT INTO resource_records (resource_record_set_id, value) VALUES ($1, $2);"
 
 	selectZoneInfoQuery = `
 	SELECT z.id, z.name, 
-	       (SELECT COUNT(*) FROM resource_record_sets rrs WHERE rrs.zone_id = z.id) as record_count
+	       (SELECT COUNT(*) FROM resource_record_sets rrs WHERE rrs.zone_id = z.id) as record_count,
+	       z.tags
 	FROM zones z
 	WHERE z.name = $1
 	`
 
+	selectZoneInfoByIDQuery = `
+	SELECT z.id, z.name,
+	       (SELECT COUNT(*) FROM resource_record_sets rrs WHERE rrs.zone_id = z.id) as record_count,
+	       z.tags
+	FROM zones z
+	WHERE z.id = $1
+	`
+
 	selectZoneQuery = `
-	SELECT z.id, z.name
+	SELECT z.id, z.name, z.tags
 	FROM zones z
 	WHERE z.name = $1
 	`
 
+	selectZoneForUpdateQuery = selectZoneQuery + "FOR UPDATE OF z"
+
 	selectZoneInfosQuery = `
 	SELECT z.id, z.name, 
-	       (SELECT COUNT(*) FROM resource_record_sets rrs WHERE rrs.zone_id = z.id) as record_count
-	FROM zones z
-	ORDER BY z.name
-	LIMIT 1000
+	       (SELECT COUNT(*) FROM resource_record_sets rrs WHERE rrs.zone_id = z.id) as record_count,
+	       z.tags
+	FROM zones z`
+
+	updateZoneTagsQuery = `
+	UPDATE zones
+	SET tags = (tags || $2::jsonb) - $3::text[]
+	WHERE name = $1
+	RETURNING tags
 	`
 
 	upsertResourceRecordSetQuery = `
 	WITH zone_lookup AS (
 		SELECT id FROM zones WHERE name = $2
 	)
-	INSERT INTO resource_record_sets (id, zone_id, name, record_type, ttl)
-	SELECT $1, zone_lookup.id, $3, $4, $5
+	INSERT INTO resource_record_sets (id, zone_id, name, record_type, ttl, tags, comment)
+	SELECT $1, zone_lookup.id, $3, $4, $5, $6, $7
 	FROM zone_lookup
 	ON CONFLICT (zone_id, name, record_type)
-	DO UPDATE SET ttl = $5
+	DO UPDATE SET ttl = $5, tags = $6, comment = $7
 	RETURNING id;
 	`
 
@@ -66,7 +86,7 @@ const (
 	`
 
 	selectResourceRecordSetQuery = `
-	SELECT rrs.id, rrs.name, rrs.record_type, rrs.ttl
+	SELECT rrs.id, rrs.name, rrs.record_type, rrs.ttl, rrs.tags, rrs.comment
 	FROM resource_record_sets rrs
 	INNER JOIN zones z ON z.id = rrs.zone_id
 	WHERE z.name = $1 AND rrs.name = $2 AND rrs.record_type = $3
@@ -88,13 +108,23 @@ const (
 	`
 
 	selectResourceRecordSetsForZoneQuery = `
-	SELECT rrs.id, rrs.name, rrs.record_type, rrs.ttl
+	SELECT rrs.name, rrs.record_type, rrs.ttl, rrs.tags, rrs.comment,
+	       COALESCE(array_agg(rr.value ORDER BY rr.value) FILTER (WHERE rr.value IS NOT NULL), '{}') AS record_values
 	FROM resource_record_sets rrs
 	INNER JOIN zones z ON z.id = rrs.zone_id
+	LEFT JOIN resource_records rr ON rr.resource_record_set_id = rrs.id
 	WHERE z.name = $1
+	GROUP BY rrs.id, rrs.name, rrs.record_type, rrs.ttl, rrs.tags, rrs.comment
 	ORDER BY rrs.name, rrs.record_type
 	`
 
+	listResourceRecordSetsQuery = `
+	SELECT rrs.name, rrs.record_type, rrs.ttl, rrs.tags, rrs.comment,
+	       COALESCE(array_agg(rr.value ORDER BY rr.value) FILTER (WHERE rr.value IS NOT NULL), '{}') AS record_values
+	FROM resource_record_sets rrs
+	INNER JOIN zones z ON z.id = rrs.zone_id
+	LEFT JOIN resource_records rr ON rr.resource_record_set_id = rrs.id`
+
 	insertChangeQuery = `
 		INSERT INTO changes (id, zone_id, actions, status)
 		VALUES ($1, $2, $3, $4)
@@ -119,14 +149,111 @@ const (
 		SET status = $2
 		WHERE id = $1
 	`
+
+	insertZoneVersionQuery = `
+		INSERT INTO zone_versions (zone_id, version, change_id, resource_record_sets)
+		SELECT z.id, COALESCE((SELECT MAX(zv.version) FROM zone_versions zv WHERE zv.zone_id = z.id), 0) + 1, $2, $3
+		FROM zones z
+		WHERE z.name = $1
+		RETURNING version
+	`
+
+	selectZoneVersionsQuery = `
+		SELECT zv.version, zv.change_id, c.status, zv.created_at
+		FROM zone_versions zv
+		INNER JOIN zones z ON z.id = zv.zone_id
+		INNER JOIN changes c ON c.id = zv.change_id`
+
+	selectZoneVersionQuery = `
+		SELECT zv.version, zv.change_id, c.status, zv.created_at, zv.resource_record_sets
+		FROM zone_versions zv
+		INNER JOIN zones z ON z.id = zv.zone_id
+		INNER JOIN changes c ON c.id = zv.change_id
+		WHERE z.name = $1 AND zv.version = $2 AND c.status = 'DONE'
+	`
+
+	insertDeletedZoneQuery = `
+		INSERT INTO deleted_zones (zone_id, name, resource_record_sets, tags, purge_after)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	selectDeletedZonesQuery = `
+		SELECT zone_id, name, deleted_at, purge_after
+		FROM deleted_zones`
+
+	selectDeletedZoneQuery = `
+		SELECT zone_id, name, resource_record_sets, tags, deleted_at, purge_after
+		FROM deleted_zones
+		WHERE name = $1 AND purge_after > CURRENT_TIMESTAMP
+		ORDER BY deleted_at DESC
+		LIMIT 1
+	`
+
+	deleteDeletedZoneQuery = "DELETE FROM deleted_zones WHERE zone_id = $1;"
+	purgeDeletedZonesQuery = "DELETE FROM deleted_zones WHERE purge_after <= CURRENT_TIMESTAMP;"
+
+	insertZoneTemplateQuery = `
+		INSERT INTO zone_templates (id, name, description, resource_record_sets)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, name, description, resource_record_sets, created_at, updated_at
+	`
+
+	updateZoneTemplateQuery = `
+		UPDATE zone_templates
+		SET description = $2, resource_record_sets = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE name = $1
+		RETURNING id, name, description, resource_record_sets, created_at, updated_at
+	`
+
+	selectZoneTemplateQuery = `
+		SELECT id, name, description, resource_record_sets, created_at, updated_at
+		FROM zone_templates
+		WHERE name = $1
+	`
+
+	selectZoneTemplatesQuery = `
+		SELECT id, name, description, resource_record_sets, created_at, updated_at
+		FROM zone_templates`
+
+	deleteZoneTemplateQuery = "DELETE FROM zone_templates WHERE name = $1;"
+)
+
+var (
+	zoneSortKeys = map[string]sortKey{
+		model.SortByName: {{"z.name", "varchar"}},
+	}
+
+	resourceRecordSetSortKeys = map[string]sortKey{
+		model.SortByName: {{"rrs.name", "varchar"}, {"rrs.record_type", "varchar"}},
+		model.SortByType: {{"rrs.record_type", "varchar"}, {"rrs.name", "varchar"}},
+	}
+
+	zoneVersionSortKeys = map[string]sortKey{
+		model.SortByVersion: {{"zv.version", "int"}},
+	}
+
+	deletedZoneSortKeys = map[string]sortKey{
+		model.SortByDeletedAt: {{"deleted_at", "timestamptz"}, {"zone_id", "uuid"}},
+		model.SortByName:      {{"name", "varchar"}, {"zone_id", "uuid"}},
+	}
+
+	zoneTemplateSortKeys = map[string]sortKey{
+		model.SortByName: {{"name", "varchar"}},
+	}
 )
 
 type ZoneRepository interface {
 	CreateZone(ctx context.Context, zone *model.Zone) (*model.ZoneInfo, error)
 	DeleteZone(ctx context.Context, name string) error
 	GetZone(ctx context.Context, name string) (*model.Zone, error)
+	// GetZoneForUpdate is GetZone, but also locks the zone until the transaction ends, so
+	// that changes validated against the zone cannot race other changes to it.
+	GetZoneForUpdate(ctx context.Context, name string) (*model.Zone, error)
 	GetZoneInfo(ctx context.Context, name string) (*model.ZoneInfo, error)
-	ListZoneInfos(ctx context.Context) ([]model.ZoneInfo, error)
+	GetZoneInfoByID(ctx context.Context, id uuid.UUID) (*model.ZoneInfo, error)
+	ListZoneInfos(ctx context.Context, opts model.ListOptions) (model.Page[model.ZoneInfo], error)
+	CountZones(ctx context.Context) (int, error)
+	UpdateZoneTags(ctx context.Context, name string, set model.Tags, remove []string) (model.Tags, error)
 
 	GetResourceRecordSet(
 		ctx context.Context,
@@ -135,6 +262,12 @@ type ZoneRepository interface {
 		rrType model.RRType,
 	) (*model.ResourceRecordSet, error)
 	GetZoneResourceRecordSets(ctx context.Context, zoneName string) ([]model.ResourceRecordSet, error)
+	ListResourceRecordSets(
+		ctx context.Context,
+		zoneName string,
+		rrType model.RRType,
+		opts model.ListOptions,
+	) (model.Page[model.ResourceRecordSet], error)
 	UpsertResourceRecordSet(
 		ctx context.Context,
 		zoneName string,
@@ -149,6 +282,28 @@ type ZoneRepository interface {
 	GetChange(ctx context.Context, id uuid.UUID) (*model.Change, error)
 	GetChangesByZone(ctx context.Context, zoneName string) ([]model.Change, error)
 	UpdateChangeStatus(ctx context.Context, id uuid.UUID, status model.ChangeStatus) error
+
+	CreateZoneVersion(ctx context.Context, zoneName string, changeID uuid.UUID) (int, error)
+	// ListZoneVersions and GetZoneVersion only return the versions whose change is done, so
+	// that a version is not offered as a state of the zone before it has been served.
+	ListZoneVersions(
+		ctx context.Context,
+		zoneName string,
+		opts model.ListOptions,
+	) (model.Page[model.ZoneVersion], error)
+	GetZoneVersion(ctx context.Context, zoneName string, version int) (*model.ZoneVersion, error)
+
+	CreateDeletedZone(ctx context.Context, zone *model.Zone, purgeAfter time.Time) error
+	ListDeletedZones(ctx context.Context, opts model.ListOptions) (model.Page[model.DeletedZone], error)
+	GetDeletedZone(ctx context.Context, name string) (*model.DeletedZone, error)
+	DeleteDeletedZone(ctx context.Context, id uuid.UUID) error
+	PurgeDeletedZones(ctx context.Context) error
+
+	CreateZoneTemplate(ctx context.Context, template *model.ZoneTemplate) (*model.ZoneTemplate, error)
+	UpdateZoneTemplate(ctx context.Context, template *model.ZoneTemplate) (*model.ZoneTemplate, error)
+	GetZoneTemplate(ctx context.Context, name string) (*model.ZoneTemplate, error)
+	ListZoneTemplates(ctx context.Context, opts model.ListOptions) (model.Page[model.ZoneTemplate], error)
+	DeleteZoneTemplate(ctx context.Context, name string) error
 }
 
 type PostgresZoneRepository struct {
@@ -158,7 +313,12 @@ type PostgresZoneRepository struct {
 var _ ZoneRepository = (*PostgresZoneRepository)(nil)
 
 func (p *PostgresZoneRepository) CreateZone(ctx context.Context, zone *model.Zone) (*model.ZoneInfo, error) {
-	if _, err := p.db.Exec(ctx, insertZoneQuery, zone.ID, zone.Name); err != nil {
+	tagsJSON, err := marshalTags(zone.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = p.db.Exec(ctx, insertZoneQuery, zone.ID, zone.Name, tagsJSON); err != nil {
 		return nil, handleError(err, "failed to create zone: %w", err)
 	}
 
@@ -170,6 +330,7 @@ func (p *PostgresZoneRepository) CreateZone(ctx context.Context, zone *model.Zon
 		ID:                     zone.ID,
 		Name:                   zone.Name,
 		ResourceRecordSetCount: len(zone.ResourceRecordSets),
+		Tags:                   zone.Tags,
 	}, nil
 }
 
@@ -193,6 +354,11 @@ func (p *PostgresZoneRepository) insertResourceRecordSet(
 	zoneID uuid.UUID,
 	recordSet *model.ResourceRecordSet,
 ) error {
+	tagsJSON, err := marshalTags(recordSet.Tags)
+	if err != nil {
+		return err
+	}
+
 	row := p.db.QueryRow(
 		ctx,
 		insertResourceRecordSetQuery,
@@ -201,10 +367,12 @@ func (p *PostgresZoneRepository) insertResourceRecordSet(
 		recordSet.Name,
 		recordSet.Type,
 		recordSet.TTL,
+		tagsJSON,
+		recordSet.Comment,
 	)
 
 	var id uuid.UUID
-	err := row.Scan(&id)
+	err = row.Scan(&id)
 	if err != nil {
 		return handleError(err, "failed to insert resource record set: %w", err)
 	}
@@ -237,6 +405,11 @@ func (p *PostgresZoneRepository) UpsertResourceRecordSet(
 	zoneName string,
 	recordSet *model.ResourceRecordSet,
 ) (*model.ResourceRecordSet, error) {
+	tagsJSON, err := marshalTags(recordSet.Tags)
+	if err != nil {
+		return nil, err
+	}
+
 	row := p.db.QueryRow(
 		ctx,
 		upsertResourceRecordSetQuery,
@@ -245,10 +418,12 @@ func (p *PostgresZoneRepository) UpsertResourceRecordSet(
 		recordSet.Name,
 		recordSet.Type,
 		recordSet.TTL,
+		tagsJSON,
+		recordSet.Comment,
 	)
 
 	var id uuid.UUID
-	err := row.Scan(&id)
+	err = row.Scan(&id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to upsert resource record set: %w", err)
 	}
@@ -270,6 +445,8 @@ func (p *PostgresZoneRepository) UpsertResourceRecordSet(
 		Type:            recordSet.Type,
 		TTL:             recordSet.TTL,
 		ResourceRecords: recordSet.ResourceRecords,
+		Tags:            recordSet.Tags,
+		Comment:         recordSet.Comment,
 	}, nil
 }
 
@@ -292,9 +469,17 @@ func (p *PostgresZoneRepository) DeleteResourceRecordSet(
 }
 
 func (p *PostgresZoneRepository) GetZone(ctx context.Context, name string) (*model.Zone, error) {
-	row := p.db.QueryRow(ctx, selectZoneQuery, name)
+	return p.getZone(ctx, selectZoneQuery, name)
+}
+
+func (p *PostgresZoneRepository) GetZoneForUpdate(ctx context.Context, name string) (*model.Zone, error) {
+	return p.getZone(ctx, selectZoneForUpdateQuery, name)
+}
+
+func (p *PostgresZoneRepository) getZone(ctx context.Context, query string, name string) (*model.Zone, error) {
+	row := p.db.QueryRow(ctx, query, name)
 	var zone model.Zone
-	err := row.Scan(&zone.ID, &zone.Name)
+	err := row.Scan(&zone.ID, &zone.Name, &zone.Tags)
 	if err != nil && errors.Is(err, sql.ErrNoRows) {
 		return nil, ErrEntityNotFound
 	} else if err != nil {
@@ -320,34 +505,91 @@ func (p *PostgresZoneRepository) GetZoneResourceRecordSets(
 	}
 	defer rows.Close()
 
+	return scanResourceRecordSets(rows)
+}
+
+// ListResourceRecordSets returns a page of the record sets of the zone that match the record
+// type, name prefix and tag filter. An empty rrType matches every type.
+func (p *PostgresZoneRepository) ListResourceRecordSets(
+	ctx context.Context,
+	zoneName string,
+	rrType model.RRType,
+	opts model.ListOptions,
+) (model.Page[model.ResourceRecordSet], error) {
+	q := newKeysetQuery(listResourceRecordSetsQuery)
+	q.and("z.name = " + q.arg(zoneName))
+	if rrType != "" {
+		q.and("rrs.record_type = " + q.arg(rrType))
+	}
+	q.namePrefix("rrs.name", opts.NamePrefix)
+	if err := q.tags("rrs.tags", opts.Tags); err != nil {
+		return model.Page[model.ResourceRecordSet]{}, err
+	}
+	q.groupBy = "rrs.id, rrs.name, rrs.record_type, rrs.ttl, rrs.tags, rrs.comment"
+
+	query, args, err := q.build(resourceRecordSetSortKeys[opts.SortBy], opts)
+	if err != nil {
+		return model.Page[model.ResourceRecordSet]{}, err
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return model.Page[model.ResourceRecordSet]{}, handleError(
+			err,
+			"failed to list resource record sets: %w",
+			err,
+		)
+	}
+	defer rows.Close()
+
+	recordSets, err := scanResourceRecordSets(rows)
+	if err != nil {
+		return model.Page[model.ResourceRecordSet]{}, err
+	}
+
+	return newPage(recordSets, opts, func(rrSet *model.ResourceRecordSet) []string {
+		if opts.SortBy == model.SortByType {
+			return []string{string(rrSet.Type), rrSet.Name}
+		}
+		return []string{rrSet.Name, string(rrSet.Type)}
+	}), nil
+}
+
+func scanResourceRecordSets(rows pgx.Rows) ([]model.ResourceRecordSet, error) {
 	var recordSets []model.ResourceRecordSet
 	for rows.Next() {
 		var recordSet model.ResourceRecordSet
-		var rrSetID uuid.UUID
-		err = rows.Scan(&rrSetID, &recordSet.Name, &recordSet.Type, &recordSet.TTL)
-		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		var values []string
+		err := rows.Scan(
+			&recordSet.Name,
+			&recordSet.Type,
+			&recordSet.TTL,
+			&recordSet.Tags,
+			&recordSet.Comment,
+			&values,
+		)
+		if err != nil {
 			return nil, handleError(err, "failed to scan resource record set: %w", err)
-		} else if errors.Is(err, sql.ErrNoRows) {
-			continue
 		}
 
-		var records []model.ResourceRecord
-		records, err = p.getResourceRecordSetRecords(ctx, rrSetID)
-		if err != nil {
-			return nil, handleError(err, "failed to get resource records for resource record set: %w", err)
+		for _, value := range values {
+			recordSet.ResourceRecords = append(recordSet.ResourceRecords, model.ResourceRecord{Value: value})
 		}
-		recordSet.ResourceRecords = records
 
 		recordSets = append(recordSets, recordSet)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, handleError(err, "failed to scan resource record sets: %w", err)
+	}
+
 	return recordSets, nil
 }
 
 func (p *PostgresZoneRepository) GetZoneInfo(ctx context.Context, name string) (*model.ZoneInfo, error) {
 	row := p.db.QueryRow(ctx, selectZoneInfoQuery, name)
 	var zone model.ZoneInfo
-	err := row.Scan(&zone.ID, &zone.Name, &zone.ResourceRecordSetCount)
+	err := row.Scan(&zone.ID, &zone.Name, &zone.ResourceRecordSetCount, &zone.Tags)
 	if err != nil && errors.Is(err, sql.ErrNoRows) {
 		return nil, ErrEntityNotFound
 	} else if err != nil {
@@ -357,26 +599,89 @@ func (p *PostgresZoneRepository) GetZoneInfo(ctx context.Context, name string) (
 	return &zone, nil
 }
 
-func (p *PostgresZoneRepository) ListZoneInfos(ctx context.Context) ([]model.ZoneInfo, error) {
-	rows, err := p.db.Query(ctx, selectZoneInfosQuery)
+func (p *PostgresZoneRepository) GetZoneInfoByID(ctx context.Context, id uuid.UUID) (*model.ZoneInfo, error) {
+	row := p.db.QueryRow(ctx, selectZoneInfoByIDQuery, id)
+	var zone model.ZoneInfo
+	err := row.Scan(&zone.ID, &zone.Name, &zone.ResourceRecordSetCount, &zone.Tags)
+	if err != nil && errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrEntityNotFound
+	} else if err != nil {
+		return nil, handleError(err, "failed to get zone: %w", err)
+	}
+
+	return &zone, nil
+}
+
+func (p *PostgresZoneRepository) CountZones(ctx context.Context) (int, error) {
+	var count int
+	if err := p.db.QueryRow(ctx, countZonesQuery).Scan(&count); err != nil {
+		return 0, handleError(err, "failed to count zones: %w", err)
+	}
+
+	return count, nil
+}
+
+// ListZoneInfos returns a page of the zones that match the name prefix and tag filter.
+func (p *PostgresZoneRepository) ListZoneInfos(
+	ctx context.Context,
+	opts model.ListOptions,
+) (model.Page[model.ZoneInfo], error) {
+	q := newKeysetQuery(selectZoneInfosQuery)
+	q.namePrefix("z.name", opts.NamePrefix)
+	if err := q.tags("z.tags", opts.Tags); err != nil {
+		return model.Page[model.ZoneInfo]{}, err
+	}
+
+	query, args, err := q.build(zoneSortKeys[opts.SortBy], opts)
 	if err != nil {
-		return nil, handleError(err, "failed to list zone infos: %w", err)
+		return model.Page[model.ZoneInfo]{}, err
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return model.Page[model.ZoneInfo]{}, handleError(err, "failed to list zone infos: %w", err)
 	}
 	defer rows.Close()
 
-	var zoneInfos []model.ZoneInfo
+	zoneInfos := []model.ZoneInfo{}
 	for rows.Next() {
 		var zoneInfo model.ZoneInfo
-		err = rows.Scan(&zoneInfo.ID, &zoneInfo.Name, &zoneInfo.ResourceRecordSetCount)
-		if err != nil && !errors.Is(err, sql.ErrNoRows) {
-			return nil, handleError(err, "failed to scan zone info: %w", err)
-		} else if errors.Is(err, sql.ErrNoRows) {
-			continue
+		err = rows.Scan(&zoneInfo.ID, &zoneInfo.Name, &zoneInfo.ResourceRecordSetCount, &zoneInfo.Tags)
+		if err != nil {
+			return model.Page[model.ZoneInfo]{}, handleError(err, "failed to scan zone info: %w", err)
 		}
 		zoneInfos = append(zoneInfos, zoneInfo)
 	}
 
-	return zoneInfos, nil
+	if err = rows.Err(); err != nil {
+		return model.Page[model.ZoneInfo]{}, handleError(err, "failed to list zone infos: %w", err)
+	}
+
+	return newPage(zoneInfos, opts, func(z *model.ZoneInfo) []string {
+		return []string{z.Name}
+	}), nil
+}
+
+// UpdateZoneTags adds or overwrites the tags in set on the zone, removes the keys in remove,
+// and returns the resulting tags.
+func (p *PostgresZoneRepository) UpdateZoneTags(
+	ctx context.Context,
+	name string,
+	set model.Tags,
+	remove []string,
+) (model.Tags, error) {
+	setJSON, remove, err := tagUpdateArgs(set, remove)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags model.Tags
+	err = p.db.QueryRow(ctx, updateZoneTagsQuery, name, setJSON, remove).Scan(&tags)
+	if err != nil {
+		return nil, handleError(err, "failed to update zone tags: %w", err)
+	}
+
+	return tags, nil
 }
 
 func (p *PostgresZoneRepository) GetResourceRecordSet(
@@ -388,7 +693,14 @@ func (p *PostgresZoneRepository) GetResourceRecordSet(
 	row := p.db.QueryRow(ctx, selectResourceRecordSetQuery, zoneName, name, rrType)
 	var recordSet model.ResourceRecordSet
 	var rrSetID uuid.UUID
-	err := row.Scan(&rrSetID, &recordSet.Name, &recordSet.Type, &recordSet.TTL)
+	err := row.Scan(
+		&rrSetID,
+		&recordSet.Name,
+		&recordSet.Type,
+		&recordSet.TTL,
+		&recordSet.Tags,
+		&recordSet.Comment,
+	)
 	if err != nil && errors.Is(err, sql.ErrNoRows) {
 		return nil, ErrEntityNotFound
 	} else if err != nil {
@@ -530,3 +842,332 @@ func (p *PostgresZoneRepository) GetChangesByZone(ctx context.Context, zoneName
 
 	return changes, nil
 }
+
+// CreateZoneVersion snapshots the current resource record sets of the zone as the next
+// version of the zone, produced by the given change.
+func (p *PostgresZoneRepository) CreateZoneVersion(
+	ctx context.Context,
+	zoneName string,
+	changeID uuid.UUID,
+) (int, error) {
+	recordSets, err := p.GetZoneResourceRecordSets(ctx, zoneName)
+	if err != nil {
+		return 0, err
+	}
+
+	if recordSets == nil {
+		recordSets = []model.ResourceRecordSet{}
+	}
+
+	recordSetsJSON, err := json.Marshal(recordSets)
+	if err != nil {
+		return 0, handleError(err, "failed to marshal zone version: %w", err)
+	}
+
+	var version int
+	err = p.db.QueryRow(ctx, insertZoneVersionQuery, zoneName, changeID, recordSetsJSON).Scan(&version)
+	if err != nil {
+		return 0, handleError(err, "failed to insert zone version: %w", err)
+	}
+
+	return version, nil
+}
+
+func (p *PostgresZoneRepository) ListZoneVersions(
+	ctx context.Context,
+	zoneName string,
+	opts model.ListOptions,
+) (model.Page[model.ZoneVersion], error) {
+	q := newKeysetQuery(selectZoneVersionsQuery)
+	q.and("z.name = " + q.arg(zoneName))
+	q.and("c.status = " + q.arg(model.ChangeStatusDone))
+
+	query, args, err := q.build(zoneVersionSortKeys[opts.SortBy], opts)
+	if err != nil {
+		return model.Page[model.ZoneVersion]{}, err
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return model.Page[model.ZoneVersion]{}, handleError(err, "failed to list zone versions: %w", err)
+	}
+	defer rows.Close()
+
+	versions := []model.ZoneVersion{}
+	for rows.Next() {
+		var version model.ZoneVersion
+		err = rows.Scan(&version.Version, &version.ChangeID, &version.Status, &version.CreatedAt)
+		if err != nil {
+			return model.Page[model.ZoneVersion]{}, handleError(err, "failed to scan zone version: %w", err)
+		}
+		versions = append(versions, version)
+	}
+
+	return newPage(versions, opts, func(v *model.ZoneVersion) []string {
+		return []string{strconv.Itoa(v.Version)}
+	}), nil
+}
+
+func (p *PostgresZoneRepository) GetZoneVersion(
+	ctx context.Context,
+	zoneName string,
+	version int,
+) (*model.ZoneVersion, error) {
+	row := p.db.QueryRow(ctx, selectZoneVersionQuery, zoneName, version)
+
+	var zoneVersion model.ZoneVersion
+	var recordSetsJSON []byte
+	err := row.Scan(
+		&zoneVersion.Version,
+		&zoneVersion.ChangeID,
+		&zoneVersion.Status,
+		&zoneVersion.CreatedAt,
+		&recordSetsJSON,
+	)
+	if err != nil {
+		return nil, handleError(err, "failed to get zone version: %w", err)
+	}
+
+	err = json.Unmarshal(recordSetsJSON, &zoneVersion.ResourceRecordSets)
+	if err != nil {
+		return nil, handleError(err, "failed to unmarshal zone version: %w", err)
+	}
+
+	return &zoneVersion, nil
+}
+
+// CreateDeletedZone moves a copy of the zone and its resource record sets into the trash,
+// where it stays restorable until purgeAfter.
+func (p *PostgresZoneRepository) CreateDeletedZone(
+	ctx context.Context,
+	zone *model.Zone,
+	purgeAfter time.Time,
+) error {
+	recordSets := zone.ResourceRecordSets
+	if recordSets == nil {
+		recordSets = []model.ResourceRecordSet{}
+	}
+
+	recordSetsJSON, err := json.Marshal(recordSets)
+	if err != nil {
+		return handleError(err, "failed to marshal deleted zone: %w", err)
+	}
+
+	tagsJSON, err := marshalTags(zone.Tags)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.db.Exec(ctx, insertDeletedZoneQuery, zone.ID, zone.Name, recordSetsJSON, tagsJSON, purgeAfter)
+	if err != nil {
+		return handleError(err, "failed to insert deleted zone: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PostgresZoneRepository) ListDeletedZones(
+	ctx context.Context,
+	opts model.ListOptions,
+) (model.Page[model.DeletedZone], error) {
+	q := newKeysetQuery(selectDeletedZonesQuery)
+	q.and("purge_after > CURRENT_TIMESTAMP")
+	q.namePrefix("name", opts.NamePrefix)
+	if err := q.tags("tags", opts.Tags); err != nil {
+		return model.Page[model.DeletedZone]{}, err
+	}
+
+	query, args, err := q.build(deletedZoneSortKeys[opts.SortBy], opts)
+	if err != nil {
+		return model.Page[model.DeletedZone]{}, err
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return model.Page[model.DeletedZone]{}, handleError(err, "failed to list deleted zones: %w", err)
+	}
+	defer rows.Close()
+
+	zones := []model.DeletedZone{}
+	for rows.Next() {
+		var zone model.DeletedZone
+		err = rows.Scan(&zone.ID, &zone.Name, &zone.DeletedAt, &zone.PurgeAfter)
+		if err != nil {
+			return model.Page[model.DeletedZone]{}, handleError(err, "failed to scan deleted zone: %w", err)
+		}
+		zones = append(zones, zone)
+	}
+
+	return newPage(zones, opts, func(z *model.DeletedZone) []string {
+		if opts.SortBy == model.SortByName {
+			return []string{z.Name, z.ID.String()}
+		}
+		return []string{z.DeletedAt.Format(time.RFC3339Nano), z.ID.String()}
+	}), nil
+}
+
+// GetDeletedZone returns the most recently deleted zone with the given name that has not
+// yet expired from the trash.
+func (p *PostgresZoneRepository) GetDeletedZone(ctx context.Context, name string) (*model.DeletedZone, error) {
+	row := p.db.QueryRow(ctx, selectDeletedZoneQuery, name)
+
+	var zone model.DeletedZone
+	var recordSetsJSON []byte
+	err := row.Scan(&zone.ID, &zone.Name, &recordSetsJSON, &zone.Tags, &zone.DeletedAt, &zone.PurgeAfter)
+	if err != nil {
+		return nil, handleError(err, "failed to get deleted zone: %w", err)
+	}
+
+	err = json.Unmarshal(recordSetsJSON, &zone.ResourceRecordSets)
+	if err != nil {
+		return nil, handleError(err, "failed to unmarshal deleted zone: %w", err)
+	}
+
+	return &zone, nil
+}
+
+func (p *PostgresZoneRepository) DeleteDeletedZone(ctx context.Context, id uuid.UUID) error {
+	ct, err := p.db.Exec(ctx, deleteDeletedZoneQuery, id)
+	if err != nil {
+		return handleError(err, "failed to delete deleted zone: %w", err)
+	}
+
+	if ct.RowsAffected() == 0 {
+		return ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// PurgeDeletedZones permanently removes zones whose trash period has expired.
+func (p *PostgresZoneRepository) PurgeDeletedZones(ctx context.Context) error {
+	_, err := p.db.Exec(ctx, purgeDeletedZonesQuery)
+	if err != nil {
+		return handleError(err, "failed to purge deleted zones: %w", err)
+	}
+
+	return nil
+}
+
+func (p *PostgresZoneRepository) CreateZoneTemplate(
+	ctx context.Context,
+	template *model.ZoneTemplate,
+) (*model.ZoneTemplate, error) {
+	recordSetsJSON, err := marshalZoneTemplateRecordSets(template)
+	if err != nil {
+		return nil, err
+	}
+
+	row := p.db.QueryRow(
+		ctx,
+		insertZoneTemplateQuery,
+		template.ID,
+		template.Name,
+		template.Description,
+		recordSetsJSON,
+	)
+
+	return scanZoneTemplate(row)
+}
+
+// UpdateZoneTemplate replaces the description and record sets of the zone template with the
+// same name.
+func (p *PostgresZoneRepository) UpdateZoneTemplate(
+	ctx context.Context,
+	template *model.ZoneTemplate,
+) (*model.ZoneTemplate, error) {
+	recordSetsJSON, err := marshalZoneTemplateRecordSets(template)
+	if err != nil {
+		return nil, err
+	}
+
+	row := p.db.QueryRow(ctx, updateZoneTemplateQuery, template.Name, template.Description, recordSetsJSON)
+
+	return scanZoneTemplate(row)
+}
+
+func (p *PostgresZoneRepository) GetZoneTemplate(ctx context.Context, name string) (*model.ZoneTemplate, error) {
+	return scanZoneTemplate(p.db.QueryRow(ctx, selectZoneTemplateQuery, name))
+}
+
+func (p *PostgresZoneRepository) ListZoneTemplates(
+	ctx context.Context,
+	opts model.ListOptions,
+) (model.Page[model.ZoneTemplate], error) {
+	q := newKeysetQuery(selectZoneTemplatesQuery)
+	q.namePrefix("name", opts.NamePrefix)
+
+	query, args, err := q.build(zoneTemplateSortKeys[opts.SortBy], opts)
+	if err != nil {
+		return model.Page[model.ZoneTemplate]{}, err
+	}
+
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return model.Page[model.ZoneTemplate]{}, handleError(err, "failed to list zone templates: %w", err)
+	}
+	defer rows.Close()
+
+	templates := []model.ZoneTemplate{}
+	for rows.Next() {
+		template, scanErr := scanZoneTemplate(rows)
+		if scanErr != nil {
+			return model.Page[model.ZoneTemplate]{}, scanErr
+		}
+		templates = append(templates, *template)
+	}
+
+	return newPage(templates, opts, func(t *model.ZoneTemplate) []string {
+		return []string{t.Name}
+	}), nil
+}
+
+func (p *PostgresZoneRepository) DeleteZoneTemplate(ctx context.Context, name string) error {
+	ct, err := p.db.Exec(ctx, deleteZoneTemplateQuery, name)
+	if err != nil {
+		return handleError(err, "failed to delete zone template: %w", err)
+	}
+
+	if ct.RowsAffected() == 0 {
+		return ErrEntityNotFound
+	}
+
+	return nil
+}
+
+func marshalZoneTemplateRecordSets(template *model.ZoneTemplate) ([]byte, error) {
+	recordSets := template.ResourceRecordSets
+	if recordSets == nil {
+		recordSets = []model.ResourceRecordSet{}
+	}
+
+	recordSetsJSON, err := json.Marshal(recordSets)
+	if err != nil {
+		return nil, handleError(err, "failed to marshal zone template: %w", err)
+	}
+
+	return recordSetsJSON, nil
+}
+
+func scanZoneTemplate(row pgx.Row) (*model.ZoneTemplate, error) {
+	var template model.ZoneTemplate
+	var recordSetsJSON []byte
+	err := row.Scan(
+		&template.ID,
+		&template.Name,
+		&template.Description,
+		&recordSetsJSON,
+		&template.CreatedAt,
+		&template.UpdatedAt,
+	)
+	if err != nil {
+		return nil, handleError(err, "failed to scan zone template: %w", err)
+	}
+
+	err = json.Unmarshal(recordSetsJSON, &template.ResourceRecordSets)
+	if err != nil {
+		return nil, handleError(err, "failed to unmarshal zone template: %w", err)
+	}
+
+	return &template, nil
+}
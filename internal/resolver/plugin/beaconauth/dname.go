@@ -0,0 +1,60 @@
+package beaconauth
+
+import (
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+const maxNameLength = 255
+
+// lookupDNAME looks for a DNAME record at a name above qname in the zone. It returns the
+// DNAME record and whether one was found.
+func (b *BeaconAuth) lookupDNAME(zone, qname string) (*dns.DNAME, bool) {
+	for off, end := dns.NextLabel(qname, 0); !end; off, end = dns.NextLabel(qname, off) {
+		ancestor := qname[off:]
+		if !dns.IsSubDomain(zone, ancestor) {
+			break
+		}
+
+		rrs, ok := b.lookup(zone, ancestor, dns.Type(dns.TypeDNAME))
+		if ok && len(rrs) > 0 {
+			if dname, isDNAME := rrs[0].(*dns.DNAME); isDNAME {
+				return dname, true
+			}
+		}
+
+		if strings.EqualFold(ancestor, zone) {
+			break
+		}
+	}
+
+	return nil, false
+}
+
+// synthesizeCNAME returns the CNAME record that dname implies for qname, which must be
+// below the owner of dname (RFC 6672 section 3.1). It returns false if the substituted
+// name would be longer than a domain name may be, in which case the query is answered
+// with YXDOMAIN.
+func synthesizeCNAME(qname string, dname *dns.DNAME) (*dns.CNAME, bool) {
+	prefix := qname[:len(qname)-len(dname.Hdr.Name)]
+
+	target := prefix + dname.Target
+	if dname.Target == "." {
+		target = prefix
+	}
+
+	if len(target) > maxNameLength {
+		return nil, false
+	}
+
+	return &dns.CNAME{
+		Hdr: dns.RR_Header{
+			Name:   qname,
+			Rrtype: dns.TypeCNAME,
+			Class:  dns.ClassINET,
+			Ttl:    dname.Hdr.Ttl,
+		},
+		Target: target,
+	}, true
+}
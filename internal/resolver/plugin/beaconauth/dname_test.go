@@ -0,0 +1,69 @@
+package beaconauth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSynthesizeCNAME(t *testing.T) {
+	newDNAME := func(owner, target string) *dns.DNAME {
+		return &dns.DNAME{
+			Hdr:    dns.RR_Header{Name: owner, Rrtype: dns.TypeDNAME, Class: dns.ClassINET, Ttl: 300},
+			Target: target,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		qname  string
+		dname  *dns.DNAME
+		want   string
+		wantOk bool
+	}{
+		{
+			name:   "one label below the owner",
+			qname:  "www.old.example.com.",
+			dname:  newDNAME("old.example.com.", "new.example.net."),
+			want:   "www.new.example.net.",
+			wantOk: true,
+		},
+		{
+			name:   "several labels below the owner",
+			qname:  "a.b.old.example.com.",
+			dname:  newDNAME("old.example.com.", "new.example.net."),
+			want:   "a.b.new.example.net.",
+			wantOk: true,
+		},
+		{
+			name:   "root target",
+			qname:  "www.old.example.com.",
+			dname:  newDNAME("old.example.com.", "."),
+			want:   "www.",
+			wantOk: true,
+		},
+		{
+			name:   "substituted name too long",
+			qname:  strings.Repeat(strings.Repeat("a", 60)+".", 3) + "old.example.com.",
+			dname:  newDNAME("old.example.com.", strings.Repeat(strings.Repeat("b", 60)+".", 2)),
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := synthesizeCNAME(tt.qname, tt.dname)
+			assert.Equal(t, tt.wantOk, ok)
+			if !tt.wantOk {
+				return
+			}
+
+			assert.Equal(t, tt.qname, got.Hdr.Name)
+			assert.Equal(t, dns.TypeCNAME, got.Hdr.Rrtype)
+			assert.Equal(t, uint32(300), got.Hdr.Ttl)
+			assert.Equal(t, tt.want, got.Target)
+		})
+	}
+}
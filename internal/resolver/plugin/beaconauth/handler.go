@@ -23,6 +23,10 @@ func (b *BeaconAuth) ServeDNS(ctx context.Context, w dns.ResponseWriter, r *dns.
 
 	answers, ok := b.lookup(zone, qname, dns.Type(qtype))
 	if !ok {
+		if dname, found := b.lookupDNAME(zone, qname); found {
+			return b.dnameResponse(dname, state)
+		}
+
 		blog.Debug("no answers found, returning not found response")
 		return b.notFoundResponse(zone, state), nil
 	}
@@ -58,3 +62,28 @@ func (b *BeaconAuth) notFoundResponse(zone string, state request.Request) int {
 	_ = state.W.WriteMsg(m)
 	return dns.RcodeSuccess
 }
+
+// dnameResponse answers a query for a name below a DNAME with the DNAME record and the CNAME
+// record synthesised from it.
+func (b *BeaconAuth) dnameResponse(dname *dns.DNAME, state request.Request) (int, error) {
+	m := new(dns.Msg)
+	m.SetReply(state.Req)
+	m.Authoritative, m.RecursionAvailable, m.Compress = true, false, true
+
+	m.Answer = append(m.Answer, dname)
+	if cname, ok := synthesizeCNAME(state.Name(), dname); ok {
+		m.Answer = append(m.Answer, cname)
+	} else {
+		m.Rcode = dns.RcodeYXDomain
+	}
+
+	state.SizeAndDo(m)
+	m = state.Scrub(m)
+
+	err := state.W.WriteMsg(m)
+	if err != nil {
+		return dns.RcodeServerFailure, err
+	}
+
+	return dns.RcodeSuccess, nil
+}
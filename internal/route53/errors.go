@@ -0,0 +1,139 @@
+package route53
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+)
+
+// apiError is an error as Route 53 reports it. Clients tell errors apart by their code, and
+// retry those with a status of 500 and above as well as Throttling.
+type apiError struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.code + ": " + e.message
+}
+
+// errorType is whether the client or the server is to blame for the error.
+func (e *apiError) errorType() string {
+	if e.status >= http.StatusInternalServerError {
+		return "Receiver"
+	}
+	return "Sender"
+}
+
+func errMissingAuthenticationToken() *apiError {
+	return &apiError{http.StatusForbidden, "MissingAuthenticationToken", "request is missing an authorization header"}
+}
+
+func errIncompleteSignature(message string) *apiError {
+	return &apiError{http.StatusBadRequest, "IncompleteSignature", message}
+}
+
+func errInvalidClientTokenID() *apiError {
+	return &apiError{http.StatusForbidden, "InvalidClientTokenId", "the access key ID is not valid"}
+}
+
+func errSignatureDoesNotMatch() *apiError {
+	return &apiError{
+		http.StatusForbidden,
+		"SignatureDoesNotMatch",
+		"the request signature does not match the signature calculated from the request and secret access key",
+	}
+}
+
+func errContentSHA256Mismatch() *apiError {
+	return &apiError{
+		http.StatusBadRequest,
+		"InvalidInput",
+		"the x-amz-content-sha256 header must be the SHA-256 hash of the request body",
+	}
+}
+
+func errSignatureExpired(amzDate string) *apiError {
+	return &apiError{
+		http.StatusForbidden,
+		"SignatureDoesNotMatch",
+		fmt.Sprintf("signature expired: %s is more than %s from the server time", amzDate, maxClockSkew),
+	}
+}
+
+func errInvalidInput(message string) *apiError {
+	return &apiError{http.StatusBadRequest, "InvalidInput", message}
+}
+
+// toAPIError maps an error returned by the zone service to the Route 53 error clients expect
+// for it.
+func toAPIError(err error) *apiError {
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	var beaconErr *beaconerr.BeaconError
+	if !errors.As(err, &beaconErr) {
+		return &apiError{http.StatusInternalServerError, "InternalFailure", "An unexpected error occurred"}
+	}
+
+	message := beaconErr.Message()
+	switch beaconerr.ErrorCode(beaconErr.Code()) {
+	case beaconerr.ErrorCodeNoSuchZone:
+		return &apiError{http.StatusNotFound, "NoSuchHostedZone", message}
+	case beaconerr.ErrorCodeNoSuchChange:
+		return &apiError{http.StatusNotFound, "NoSuchChange", message}
+	case beaconerr.ErrorCodeZoneAlreadyExists:
+		return &apiError{http.StatusConflict, "HostedZoneAlreadyExists", message}
+	case beaconerr.ErrorCodeHostedZoneNotEmpty:
+		return &apiError{http.StatusBadRequest, "HostedZoneNotEmpty", message}
+	case beaconerr.ErrorCodeInvalidChangeBatch, beaconerr.ErrorCodePTRRecordConflict:
+		return &apiError{http.StatusBadRequest, "InvalidChangeBatch", changeBatchMessage(err, message)}
+	case beaconerr.ErrorCodeQuotaExceeded:
+		return &apiError{http.StatusBadRequest, "LimitsExceeded", message}
+	case beaconerr.ErrorCodeUnauthorized:
+		return &apiError{http.StatusForbidden, "InvalidClientTokenId", message}
+	case beaconerr.ErrorCodeAccessDenied:
+		return &apiError{http.StatusForbidden, "AccessDenied", message}
+	case beaconerr.ErrorCodeThrottling:
+		return &apiError{http.StatusBadRequest, "Throttling", message}
+	}
+
+	switch {
+	case beaconerr.IsNoSuchError(err):
+		return &apiError{http.StatusNotFound, beaconErr.Code(), message}
+	case beaconerr.IsConflictError(err):
+		return &apiError{http.StatusConflict, beaconErr.Code(), message}
+	case beaconerr.IsBadRequestError(err):
+		return &apiError{http.StatusBadRequest, "InvalidInput", message}
+	default:
+		return &apiError{http.StatusInternalServerError, "InternalFailure", "An unexpected error occurred"}
+	}
+}
+
+// changeBatchMessage lists the violations of a rejected change batch in the message, as
+// Route 53 does, since clients only show the message.
+func changeBatchMessage(err error, message string) string {
+	var changeBatchErr *beaconerr.InvalidChangeBatchError
+	if !errors.As(err, &changeBatchErr) || len(changeBatchErr.Violations) == 0 {
+		return message
+	}
+
+	violations := make([]string, len(changeBatchErr.Violations))
+	for i, violation := range changeBatchErr.Violations {
+		violations[i] = fmt.Sprintf(
+			"Change %d [name='%s', type='%s']: %s",
+			violation.Action+1,
+			violation.Name,
+			violation.Type,
+			violation.Message,
+		)
+	}
+
+	return "[" + strings.Join(violations, ", ") + "]"
+}
@@ -0,0 +1,140 @@
+package route53
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// The request and response bodies of the Route 53 API version 2013-04-01. Only the elements
+// Beacon can serve are declared; requests are decoded regardless of their namespace, and
+// unknown elements are ignored.
+
+type CreateHostedZoneRequest struct {
+	XMLName          xml.Name `xml:"CreateHostedZoneRequest"`
+	Name             string
+	CallerReference  string
+	HostedZoneConfig *HostedZoneConfig
+	VPC              *VPC   `xml:"VPC"`
+	DelegationSetID  string `xml:"DelegationSetId"`
+}
+
+type CreateHostedZoneResponse struct {
+	XMLName xml.Name `xml:"https://route53.amazonaws.com/doc/2013-04-01/ CreateHostedZoneResponse"`
+
+	HostedZone    HostedZone
+	ChangeInfo    ChangeInfo
+	DelegationSet DelegationSet
+}
+
+type ListHostedZonesResponse struct {
+	XMLName xml.Name `xml:"https://route53.amazonaws.com/doc/2013-04-01/ ListHostedZonesResponse"`
+
+	HostedZones []HostedZone `xml:"HostedZones>HostedZone"`
+	Marker      string
+	IsTruncated bool
+	NextMarker  string `xml:",omitempty"`
+	MaxItems    int
+}
+
+type ChangeResourceRecordSetsRequest struct {
+	XMLName     xml.Name `xml:"ChangeResourceRecordSetsRequest"`
+	ChangeBatch ChangeBatch
+}
+
+type ChangeResourceRecordSetsResponse struct {
+	XMLName xml.Name `xml:"https://route53.amazonaws.com/doc/2013-04-01/ ChangeResourceRecordSetsResponse"`
+
+	ChangeInfo ChangeInfo
+}
+
+type ListResourceRecordSetsResponse struct {
+	XMLName xml.Name `xml:"https://route53.amazonaws.com/doc/2013-04-01/ ListResourceRecordSetsResponse"`
+
+	ResourceRecordSets   []ResourceRecordSet `xml:"ResourceRecordSets>ResourceRecordSet"`
+	IsTruncated          bool
+	NextRecordName       string `xml:",omitempty"`
+	NextRecordType       string `xml:",omitempty"`
+	NextRecordIdentifier string `xml:",omitempty"`
+	MaxItems             int
+}
+
+type GetChangeResponse struct {
+	XMLName xml.Name `xml:"https://route53.amazonaws.com/doc/2013-04-01/ GetChangeResponse"`
+
+	ChangeInfo ChangeInfo
+}
+
+type HostedZone struct {
+	ID                     string `xml:"Id"`
+	Name                   string
+	CallerReference        string
+	Config                 HostedZoneConfig
+	ResourceRecordSetCount int
+}
+
+type HostedZoneConfig struct {
+	Comment     string `xml:",omitempty"`
+	PrivateZone bool
+}
+
+// VPC associates a private hosted zone with a VPC. Beacon only hosts public zones, so its
+// presence is rejected.
+type VPC struct {
+	VPCRegion string
+	VPCID     string `xml:"VPCId"`
+}
+
+type DelegationSet struct {
+	NameServers []string `xml:"NameServers>NameServer"`
+}
+
+type ChangeInfo struct {
+	ID          string `xml:"Id"`
+	Status      string
+	SubmittedAt time.Time
+	Comment     string `xml:",omitempty"`
+}
+
+type ChangeBatch struct {
+	Comment string   `xml:",omitempty"`
+	Changes []Change `xml:"Changes>Change"`
+}
+
+type Change struct {
+	Action            string
+	ResourceRecordSet ResourceRecordSet
+}
+
+// ResourceRecordSet is a record set. SetIdentifier and AliasTarget are only declared so that
+// the routing policies and alias records Beacon does not support can be rejected.
+type ResourceRecordSet struct {
+	Name            string
+	Type            string
+	SetIdentifier   string           `xml:",omitempty"`
+	TTL             *uint32          `xml:",omitempty"`
+	ResourceRecords []ResourceRecord `xml:"ResourceRecords>ResourceRecord"`
+	AliasTarget     *AliasTarget     `xml:",omitempty"`
+}
+
+type ResourceRecord struct {
+	Value string
+}
+
+type AliasTarget struct {
+	HostedZoneID         string `xml:"HostedZoneId"`
+	DNSName              string
+	EvaluateTargetHealth bool
+}
+
+type ErrorResponse struct {
+	XMLName xml.Name `xml:"https://route53.amazonaws.com/doc/2013-04-01/ ErrorResponse"`
+
+	Error     Error
+	RequestID string `xml:"RequestId"`
+}
+
+type Error struct {
+	Type    string
+	Code    string
+	Message string
+}
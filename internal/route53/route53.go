@@ -0,0 +1,132 @@
+// Package route53 serves a subset of the Amazon Route 53 API from the zone service, so that
+// tools built for Route 53, such as external-dns, cert-manager and the Terraform AWS
+// provider, can manage Beacon zones when pointed at it as a custom endpoint. Requests are
+// signed with AWS Signature Version 4 using locally configured credentials, and are made as
+// the principal of the API key each secret access key is.
+package route53
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/davidseybold/beacondns/internal/api"
+	"github.com/davidseybold/beacondns/internal/audit"
+	"github.com/davidseybold/beacondns/internal/auth"
+	"github.com/davidseybold/beacondns/internal/zone"
+)
+
+const (
+	apiVersion = "/2013-04-01"
+
+	requestIDKey    = "requestID"
+	requestIDHeader = "X-Amzn-Requestid"
+
+	// zoneRateLimitGroup is the route group of the REST API whose rate limit applies.
+	zoneRateLimitGroup = "zones"
+)
+
+// NewHTTPHandler returns a handler serving CreateHostedZone, ListHostedZones,
+// ChangeResourceRecordSets, ListResourceRecordSets and GetChange. Requests are rate limited
+// by rateLimiter as the zone requests of the REST API are, so it should be the one the REST
+// API is limited by.
+func NewHTTPHandler(
+	logger *slog.Logger,
+	zoneService zone.Service,
+	authService auth.Service,
+	credentials Credentials,
+	rateLimiter *api.RateLimiter,
+) http.Handler {
+	r := api.NewEngine()
+	// Clients differ in whether they send the record set paths with a trailing slash, so both
+	// are routed rather than redirected.
+	r.RedirectTrailingSlash = false
+
+	h := &handler{
+		logger:      logger,
+		zoneService: zoneService,
+		authService: authService,
+		credentials: credentials,
+		rateLimiter: rateLimiter,
+		now:         time.Now,
+	}
+
+	g := r.Group(apiVersion, h.requestID, h.authenticate)
+	g.POST("/hostedzone", h.CreateHostedZone)
+	g.GET("/hostedzone", h.ListHostedZones)
+	g.POST("/hostedzone/:id/rrset", h.ChangeResourceRecordSets)
+	g.POST("/hostedzone/:id/rrset/", h.ChangeResourceRecordSets)
+	g.GET("/hostedzone/:id/rrset", h.ListResourceRecordSets)
+	g.GET("/hostedzone/:id/rrset/", h.ListResourceRecordSets)
+	g.GET("/change/:id", h.GetChange)
+
+	return r
+}
+
+type handler struct {
+	zoneService zone.Service
+	authService auth.Service
+	credentials Credentials
+	rateLimiter *api.RateLimiter
+	now         func() time.Time
+	logger      *slog.Logger
+}
+
+// requestID gives every request an ID, which is returned in a header and in error responses.
+func (h *handler) requestID(c *gin.Context) {
+	id := uuid.NewString()
+	c.Set(requestIDKey, id)
+	c.Header(requestIDHeader, id)
+	c.Next()
+}
+
+// authenticate rejects requests whose signature does not verify against the configured
+// credentials, and makes the others as the principal of the secret access key they were
+// signed with. Clients that keep failing to authenticate are throttled per IP, and the
+// others per principal.
+func (h *handler) authenticate(c *gin.Context) {
+	principal, err := h.rateLimiter.Authenticate(c.ClientIP(), func() (*auth.Principal, error) {
+		secret, verifyErr := verifyRequest(c.Request, h.credentials, h.now())
+		if verifyErr != nil {
+			return nil, verifyErr
+		}
+		return h.authService.Authenticate(c.Request.Context(), secret)
+	})
+	if err != nil {
+		h.handleError(c, err)
+		c.Abort()
+		return
+	}
+
+	if err = h.rateLimiter.Allow(zoneRateLimitGroup, api.PrincipalRateLimitClient(principal)); err != nil {
+		h.handleError(c, err)
+		c.Abort()
+		return
+	}
+
+	ctx := auth.WithPrincipal(c.Request.Context(), principal)
+	ctx = audit.WithActor(ctx, audit.Actor{
+		Subject:  principal.Subject,
+		Name:     principal.Name,
+		SourceIP: c.RemoteIP(),
+	})
+	c.Request = c.Request.WithContext(ctx)
+	c.Next()
+}
+
+func (h *handler) handleError(c *gin.Context, err error) {
+	h.logger.Error("route53 api error", "err", err)
+
+	apiErr := toAPIError(err)
+	c.XML(apiErr.status, ErrorResponse{
+		Error: Error{
+			Type:    apiErr.errorType(),
+			Code:    apiErr.code,
+			Message: apiErr.message,
+		},
+		RequestID: c.GetString(requestIDKey),
+	})
+}
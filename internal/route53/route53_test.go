@@ -0,0 +1,544 @@
+package route53
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davidseybold/beacondns/internal/api"
+	"github.com/davidseybold/beacondns/internal/audit"
+	"github.com/davidseybold/beacondns/internal/auth"
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/log"
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/zone"
+)
+
+// fakeAuthService authenticates testSecret only.
+type fakeAuthService struct {
+	auth.Service
+}
+
+func (f *fakeAuthService) Authenticate(_ context.Context, token string) (*auth.Principal, error) {
+	if token != testSecret {
+		return nil, beaconerr.ErrUnauthorized("invalid API key")
+	}
+	return &auth.Principal{
+		Subject: model.Subject{Type: model.SubjectTypeAPIKey, ID: "test"},
+		Name:    "test",
+	}, nil
+}
+
+// fakeZoneService hosts the single zone example.com. with the record sets in rrSets, sorted
+// by name and type, and records the calls that change it.
+type fakeZoneService struct {
+	zone.Service
+
+	zoneID   uuid.UUID
+	changeID uuid.UUID
+	rrSets   []model.ResourceRecordSet
+
+	actor audit.Actor
+	batch []zone.ChangeBatchAction
+}
+
+func newFakeZoneService() *fakeZoneService {
+	return &fakeZoneService{
+		zoneID:   uuid.New(),
+		changeID: uuid.New(),
+		rrSets: []model.ResourceRecordSet{
+			{Name: "example.com.", Type: model.RRTypeNS, TTL: 172800, ResourceRecords: []model.ResourceRecord{
+				{Value: "ns1.beacondns.org."}, {Value: "ns2.beacondns.org."},
+			}},
+			{Name: "example.com.", Type: model.RRTypeSOA, TTL: 86400, ResourceRecords: []model.ResourceRecord{
+				{Value: "ns1.beacondns.org. hostmaster.beacondns.org. 1 7200 900 1209600 86400"},
+			}},
+			{Name: "example.com.", Type: model.RRTypeTXT, TTL: 300, ResourceRecords: []model.ResourceRecord{
+				{Value: `"v=spf1 -all"`},
+			}},
+			{Name: "www.example.com.", Type: model.RRTypeA, TTL: 300, ResourceRecords: []model.ResourceRecord{
+				{Value: "192.0.2.1"},
+			}},
+		},
+	}
+}
+
+func (f *fakeZoneService) CreateZone(
+	ctx context.Context,
+	name string,
+	_ zone.CreateZoneOptions,
+) (*model.ZoneInfo, error) {
+	f.actor = audit.ActorFromContext(ctx)
+	if name == "example.com" || name == "example.com." {
+		return nil, beaconerr.ErrZoneAlreadyExists("zone already exists")
+	}
+	return &model.ZoneInfo{ID: f.zoneID, Name: name, ResourceRecordSetCount: 2}, nil
+}
+
+// ListZones returns example.com. with a next cursor on the first page, and example.org. on
+// the second.
+func (f *fakeZoneService) ListZones(_ context.Context, opts model.ListOptions) (model.Page[model.ZoneInfo], error) {
+	if opts.Cursor == "" {
+		return model.Page[model.ZoneInfo]{
+			Items:      []model.ZoneInfo{{ID: f.zoneID, Name: "example.com.", ResourceRecordSetCount: len(f.rrSets)}},
+			NextCursor: "next",
+		}, nil
+	}
+	return model.Page[model.ZoneInfo]{Items: []model.ZoneInfo{{ID: uuid.New(), Name: "example.org."}}}, nil
+}
+
+func (f *fakeZoneService) GetResourceRecordSet(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ model.RRType,
+) (*model.ResourceRecordSet, error) {
+	return &f.rrSets[0], nil
+}
+
+func (f *fakeZoneService) ListZoneVersions(
+	_ context.Context,
+	_ string,
+	_ model.ListOptions,
+) (model.Page[model.ZoneVersion], error) {
+	return model.Page[model.ZoneVersion]{Items: []model.ZoneVersion{{
+		Version:   1,
+		ChangeID:  f.changeID,
+		Status:    model.ChangeStatusPending,
+		CreatedAt: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
+	}}}, nil
+}
+
+func (f *fakeZoneService) GetZoneInfoByID(_ context.Context, id uuid.UUID) (*model.ZoneInfo, error) {
+	if id != f.zoneID {
+		return nil, beaconerr.ErrNoSuchZone("zone not found")
+	}
+	return &model.ZoneInfo{ID: f.zoneID, Name: "example.com.", ResourceRecordSetCount: len(f.rrSets)}, nil
+}
+
+func (f *fakeZoneService) ChangeResourceRecordSets(
+	_ context.Context,
+	_ string,
+	batch []zone.ChangeBatchAction,
+	_ zone.ResourceRecordSetOptions,
+) (*model.Change, error) {
+	f.batch = batch
+	if batch[0].ActionType == zone.ChangeBatchActionCreate {
+		return nil, beaconerr.ErrInvalidChangeBatch("invalid change", []beaconerr.ChangeViolation{{
+			Action:  0,
+			Name:    batch[0].ResourceRecordSet.Name,
+			Type:    string(batch[0].ResourceRecordSet.Type),
+			Message: "cannot create resource record set: it already exists",
+		}})
+	}
+
+	submittedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	return &model.Change{ID: f.changeID, Status: model.ChangeStatusPending, SubmittedAt: &submittedAt}, nil
+}
+
+func (f *fakeZoneService) GetChange(_ context.Context, id uuid.UUID) (*model.Change, error) {
+	if id != f.changeID {
+		return nil, beaconerr.ErrNoSuchChange("change not found")
+	}
+	return &model.Change{ID: f.changeID, Status: model.ChangeStatusDone}, nil
+}
+
+// ListResourceRecordSets pages through rrSets, with cursors built from the name and type of
+// a record set as the repository builds them.
+func (f *fakeZoneService) ListResourceRecordSets(
+	_ context.Context,
+	_ string,
+	_ model.RRType,
+	opts model.ListOptions,
+) (model.Page[model.ResourceRecordSet], error) {
+	start := 0
+	if opts.Cursor != "" {
+		start = len(f.rrSets)
+		for i, rrSet := range f.rrSets {
+			if opts.NextCursor([]string{rrSet.Name, ""}) == opts.Cursor {
+				start = i
+				break
+			}
+			if opts.NextCursor([]string{rrSet.Name, string(rrSet.Type)}) == opts.Cursor {
+				start = i + 1
+				break
+			}
+		}
+	}
+
+	end := min(start+opts.Limit, len(f.rrSets))
+	page := model.Page[model.ResourceRecordSet]{Items: f.rrSets[start:end]}
+	if end < len(f.rrSets) {
+		last := f.rrSets[end-1]
+		page.NextCursor = opts.NextCursor([]string{last.Name, string(last.Type)})
+	}
+	return page, nil
+}
+
+type testServer struct {
+	t           *testing.T
+	handler     http.Handler
+	zoneService *fakeZoneService
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	return newRateLimitedTestServer(t, nil)
+}
+
+func newRateLimitedTestServer(t *testing.T, rateLimits api.RateLimits) *testServer {
+	t.Helper()
+
+	zoneService := newFakeZoneService()
+	credentials := Credentials{testAccessKeyID: testSecret}
+	return &testServer{
+		t: t,
+		handler: NewHTTPHandler(
+			log.NewDiscardLogger(),
+			zoneService,
+			&fakeAuthService{},
+			credentials,
+			api.NewRateLimiter(rateLimits),
+		),
+		zoneService: zoneService,
+	}
+}
+
+// do sends a signed request and decodes the response body into resp.
+func (s *testServer) do(method string, path string, body string, resp any) *httptest.ResponseRecorder {
+	s.t.Helper()
+
+	r := httptest.NewRequest(method, "http://beacon.example.com"+path, strings.NewReader(body))
+	signRequest(s.t, r, body, time.Now())
+
+	w := httptest.NewRecorder()
+	s.handler.ServeHTTP(w, r)
+	if resp != nil {
+		require.NoError(s.t, xml.Unmarshal(w.Body.Bytes(), resp), w.Body.String())
+	}
+	return w
+}
+
+func (s *testServer) hostedZonePath() string {
+	return apiVersion + hostedZonePrefix + formatID(s.zoneService.zoneID)
+}
+
+func TestAuthentication(t *testing.T) {
+	s := newTestServer(t)
+
+	r := httptest.NewRequest(http.MethodGet, "http://beacon.example.com/2013-04-01/hostedzone", nil)
+	w := httptest.NewRecorder()
+	s.handler.ServeHTTP(w, r)
+
+	var resp ErrorResponse
+	require.NoError(t, xml.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, "MissingAuthenticationToken", resp.Error.Code)
+	assert.Equal(t, "Sender", resp.Error.Type)
+	assert.NotEmpty(t, resp.RequestID)
+	assert.Equal(t, resp.RequestID, w.Header().Get(requestIDHeader))
+}
+
+func TestRateLimit(t *testing.T) {
+	s := newRateLimitedTestServer(t, api.RateLimits{
+		api.AuthRateLimitGroup: {Rate: 1, Burst: 1},
+		"zones":                {Rate: 1, Burst: 2},
+	})
+
+	for range 2 {
+		w := s.do(http.MethodGet, "/2013-04-01/hostedzone", "", nil)
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+
+	var resp ErrorResponse
+	w := s.do(http.MethodGet, "/2013-04-01/hostedzone", "", &resp)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "Throttling", resp.Error.Code)
+
+	// Unsigned requests are throttled per client IP once they have failed too often.
+	unsigned := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		s.handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "http://beacon.example.com/2013-04-01/hostedzone", nil))
+		return w
+	}
+	assert.Equal(t, http.StatusForbidden, unsigned().Code)
+	assert.Equal(t, http.StatusBadRequest, unsigned().Code)
+}
+
+func TestCreateHostedZone(t *testing.T) {
+	s := newTestServer(t)
+
+	var resp CreateHostedZoneResponse
+	w := s.do(http.MethodPost, "/2013-04-01/hostedzone", `<?xml version="1.0" encoding="UTF-8"?>
+<CreateHostedZoneRequest xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+  <Name>example.org.</Name>
+  <CallerReference>ref-1</CallerReference>
+  <HostedZoneConfig><Comment>managed by terraform</Comment></HostedZoneConfig>
+</CreateHostedZoneRequest>`, &resp)
+
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+	assert.Equal(t, s.hostedZonePath(), w.Header().Get("Location"))
+	assert.Equal(t, hostedZonePrefix+formatID(s.zoneService.zoneID), resp.HostedZone.ID)
+	assert.Equal(t, "example.org.", resp.HostedZone.Name)
+	assert.Equal(t, "ref-1", resp.HostedZone.CallerReference)
+	assert.Equal(t, "managed by terraform", resp.HostedZone.Config.Comment)
+	assert.Equal(t, changePrefix+formatID(s.zoneService.changeID), resp.ChangeInfo.ID)
+	assert.Equal(t, changeStatusPending, resp.ChangeInfo.Status)
+	assert.Equal(t, []string{"ns1.beacondns.org.", "ns2.beacondns.org."}, resp.DelegationSet.NameServers)
+	assert.Equal(t, "test", s.zoneService.actor.Name)
+
+	var errResp ErrorResponse
+	w = s.do(http.MethodPost, "/2013-04-01/hostedzone",
+		`<CreateHostedZoneRequest>
+  <Name>example.com.</Name>
+  <CallerReference>ref-2</CallerReference>
+</CreateHostedZoneRequest>`,
+		&errResp)
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Equal(t, "HostedZoneAlreadyExists", errResp.Error.Code)
+
+	w = s.do(http.MethodPost, "/2013-04-01/hostedzone", `<CreateHostedZoneRequest>
+  <Name>example.net.</Name>
+  <CallerReference>ref-3</CallerReference>
+  <HostedZoneConfig><PrivateZone>true</PrivateZone></HostedZoneConfig>
+</CreateHostedZoneRequest>`, &errResp)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "InvalidInput", errResp.Error.Code)
+}
+
+func TestListHostedZones(t *testing.T) {
+	s := newTestServer(t)
+
+	var resp ListHostedZonesResponse
+	w := s.do(http.MethodGet, "/2013-04-01/hostedzone?maxitems=1", "", &resp)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	require.Len(t, resp.HostedZones, 1)
+	assert.Equal(t, hostedZonePrefix+formatID(s.zoneService.zoneID), resp.HostedZones[0].ID)
+	assert.Equal(t, "example.com.", resp.HostedZones[0].Name)
+	assert.Equal(t, 4, resp.HostedZones[0].ResourceRecordSetCount)
+	assert.True(t, resp.IsTruncated)
+	assert.Equal(t, "next", resp.NextMarker)
+	assert.Equal(t, 1, resp.MaxItems)
+
+	w = s.do(http.MethodGet, "/2013-04-01/hostedzone?marker=next", "", &resp)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "next", resp.Marker)
+	assert.False(t, resp.IsTruncated)
+	assert.Equal(t, maxHostedZones, resp.MaxItems)
+
+	var errResp ErrorResponse
+	w = s.do(http.MethodGet, "/2013-04-01/hostedzone?maxitems=none", "", &errResp)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "InvalidInput", errResp.Error.Code)
+}
+
+func TestChangeResourceRecordSets(t *testing.T) {
+	s := newTestServer(t)
+
+	var resp ChangeResourceRecordSetsResponse
+	w := s.do(http.MethodPost, s.hostedZonePath()+"/rrset/", `<?xml version="1.0" encoding="UTF-8"?>
+<ChangeResourceRecordSetsRequest xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+  <ChangeBatch>
+    <Comment>external-dns</Comment>
+    <Changes>
+      <Change>
+        <Action>UPSERT</Action>
+        <ResourceRecordSet>
+          <Name>api.example.com</Name>
+          <Type>a</Type>
+          <TTL>60</TTL>
+          <ResourceRecords>
+            <ResourceRecord><Value>192.0.2.10</Value></ResourceRecord>
+            <ResourceRecord><Value>192.0.2.11</Value></ResourceRecord>
+          </ResourceRecords>
+        </ResourceRecordSet>
+      </Change>
+      <Change>
+        <Action>DELETE</Action>
+        <ResourceRecordSet>
+          <Name>www.example.com.</Name>
+          <Type>A</Type>
+          <TTL>300</TTL>
+          <ResourceRecords><ResourceRecord><Value>192.0.2.1</Value></ResourceRecord></ResourceRecords>
+        </ResourceRecordSet>
+      </Change>
+    </Changes>
+  </ChangeBatch>
+</ChangeResourceRecordSetsRequest>`, &resp)
+
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, changePrefix+formatID(s.zoneService.changeID), resp.ChangeInfo.ID)
+	assert.Equal(t, changeStatusPending, resp.ChangeInfo.Status)
+	assert.Equal(t, "external-dns", resp.ChangeInfo.Comment)
+
+	require.Len(t, s.zoneService.batch, 2)
+	assert.Equal(t, zone.ChangeBatchActionUpsert, s.zoneService.batch[0].ActionType)
+	assert.Equal(t, &model.ResourceRecordSet{
+		Name:            "api.example.com",
+		Type:            model.RRTypeA,
+		TTL:             60,
+		ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.10"}, {Value: "192.0.2.11"}},
+	}, s.zoneService.batch[0].ResourceRecordSet)
+	assert.Equal(t, zone.ChangeBatchActionDelete, s.zoneService.batch[1].ActionType)
+
+	tests := []struct {
+		name   string
+		path   string
+		body   string
+		status int
+		code   string
+	}{
+		{
+			name: "invalid change batch",
+			path: s.hostedZonePath() + "/rrset",
+			body: `<ChangeResourceRecordSetsRequest><ChangeBatch><Changes><Change>
+  <Action>CREATE</Action>
+  <ResourceRecordSet><Name>www.example.com.</Name><Type>A</Type><TTL>300</TTL>
+    <ResourceRecords><ResourceRecord><Value>192.0.2.1</Value></ResourceRecord></ResourceRecords>
+  </ResourceRecordSet>
+</Change></Changes></ChangeBatch></ChangeResourceRecordSetsRequest>`,
+			status: http.StatusBadRequest,
+			code:   "InvalidChangeBatch",
+		},
+		{
+			name: "alias record",
+			path: s.hostedZonePath() + "/rrset",
+			body: `<ChangeResourceRecordSetsRequest><ChangeBatch><Changes><Change>
+  <Action>UPSERT</Action>
+  <ResourceRecordSet><Name>www.example.com.</Name><Type>A</Type>
+    <AliasTarget><HostedZoneId>Z2FDTNDATAQYW2</HostedZoneId><DNSName>d1.cloudfront.net.</DNSName></AliasTarget>
+  </ResourceRecordSet>
+</Change></Changes></ChangeBatch></ChangeResourceRecordSetsRequest>`,
+			status: http.StatusBadRequest,
+			code:   "InvalidInput",
+		},
+		{
+			name: "empty change batch",
+			path: s.hostedZonePath() + "/rrset",
+			body: `<ChangeResourceRecordSetsRequest>
+  <ChangeBatch><Changes/></ChangeBatch>
+</ChangeResourceRecordSetsRequest>`,
+			status: http.StatusBadRequest,
+			code:   "InvalidInput",
+		},
+		{
+			name: "unknown hosted zone",
+			path: apiVersion + hostedZonePrefix + formatID(uuid.New()) + "/rrset",
+			body: `<ChangeResourceRecordSetsRequest><ChangeBatch><Changes><Change>
+  <Action>UPSERT</Action>
+  <ResourceRecordSet><Name>www.example.com.</Name><Type>A</Type><TTL>300</TTL></ResourceRecordSet>
+</Change></Changes></ChangeBatch></ChangeResourceRecordSetsRequest>`,
+			status: http.StatusNotFound,
+			code:   "NoSuchHostedZone",
+		},
+		{
+			name:   "malformed hosted zone ID",
+			path:   apiVersion + hostedZonePrefix + "Z123/rrset",
+			body:   `<ChangeResourceRecordSetsRequest/>`,
+			status: http.StatusNotFound,
+			code:   "NoSuchHostedZone",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errResp ErrorResponse
+			w := s.do(http.MethodPost, tt.path, tt.body, &errResp)
+			assert.Equal(t, tt.status, w.Code)
+			assert.Equal(t, tt.code, errResp.Error.Code)
+		})
+	}
+
+	t.Run("violations are listed in the message", func(t *testing.T) {
+		var errResp ErrorResponse
+		s.do(http.MethodPost, s.hostedZonePath()+"/rrset", tests[0].body, &errResp)
+		assert.Equal(t,
+			"[Change 1 [name='www.example.com.', type='A']: cannot create resource record set: it already exists]",
+			errResp.Error.Message,
+		)
+	})
+}
+
+func TestListResourceRecordSets(t *testing.T) {
+	s := newTestServer(t)
+
+	list := func(params url.Values) ListResourceRecordSetsResponse {
+		t.Helper()
+
+		var resp ListResourceRecordSetsResponse
+		w := s.do(http.MethodGet, s.hostedZonePath()+"/rrset?"+params.Encode(), "", &resp)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		return resp
+	}
+
+	names := func(resp ListResourceRecordSetsResponse) []string {
+		var names []string
+		for _, rrSet := range resp.ResourceRecordSets {
+			names = append(names, rrSet.Name+" "+rrSet.Type)
+		}
+		return names
+	}
+
+	resp := list(url.Values{})
+	assert.False(t, resp.IsTruncated)
+	assert.Equal(t, maxResourceRecordSets, resp.MaxItems)
+	require.Len(t, resp.ResourceRecordSets, 4)
+	assert.Equal(t, uint32(300), *resp.ResourceRecordSets[3].TTL)
+	assert.Equal(t, []ResourceRecord{{Value: "192.0.2.1"}}, resp.ResourceRecordSets[3].ResourceRecords)
+
+	t.Run("pages with the identifier", func(t *testing.T) {
+		resp := list(url.Values{"maxitems": {"2"}})
+		assert.Equal(t, []string{"example.com. NS", "example.com. SOA"}, names(resp))
+		require.True(t, resp.IsTruncated)
+		assert.Equal(t, "example.com.", resp.NextRecordName)
+		assert.Equal(t, "TXT", resp.NextRecordType)
+
+		resp = list(url.Values{
+			"maxitems":   {"2"},
+			"name":       {resp.NextRecordName},
+			"type":       {resp.NextRecordType},
+			"identifier": {resp.NextRecordIdentifier},
+		})
+		assert.Equal(t, []string{"example.com. TXT", "www.example.com. A"}, names(resp))
+		assert.False(t, resp.IsTruncated)
+	})
+
+	t.Run("starts at the name and type", func(t *testing.T) {
+		resp := list(url.Values{"maxitems": {"1"}, "name": {"example.com"}, "type": {"SOA"}})
+		assert.Equal(t, []string{"example.com. SOA"}, names(resp))
+		assert.True(t, resp.IsTruncated)
+
+		resp = list(url.Values{"name": {"www.example.com."}})
+		assert.Equal(t, []string{"www.example.com. A"}, names(resp))
+	})
+
+	t.Run("type requires a name", func(t *testing.T) {
+		var errResp ErrorResponse
+		w := s.do(http.MethodGet, s.hostedZonePath()+"/rrset?type=A", "", &errResp)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "InvalidInput", errResp.Error.Code)
+	})
+}
+
+func TestGetChange(t *testing.T) {
+	s := newTestServer(t)
+
+	var resp GetChangeResponse
+	w := s.do(http.MethodGet, apiVersion+changePrefix+formatID(s.zoneService.changeID), "", &resp)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, changeStatusInSync, resp.ChangeInfo.Status)
+
+	var errResp ErrorResponse
+	w = s.do(http.MethodGet, apiVersion+changePrefix+formatID(uuid.New()), "", &errResp)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "NoSuchChange", errResp.Error.Code)
+}
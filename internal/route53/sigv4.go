@@ -0,0 +1,296 @@
+package route53
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/davidseybold/beacondns/internal/auth"
+)
+
+const (
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	signingService   = "route53"
+	scopeTerminator  = "aws4_request"
+	amzDateFormat    = "20060102T150405Z"
+
+	// maxClockSkew is how far the time a request was signed at may be from the time it is
+	// received, as AWS allows.
+	maxClockSkew = 15 * time.Minute
+	// maxBodySize bounds the request bodies read to hash them.
+	maxBodySize = 1 << 20
+)
+
+// Credentials maps the access key IDs that clients sign requests with to their secret
+// access keys. Each secret access key is a Beacon API key, and requests signed with it are
+// made as the principal of the key.
+type Credentials map[string]string
+
+// ParseCredentials parses credentials of the form "accessKeyID:secretAccessKey,...", such as
+// "external-dns:beacon_xxxx,cert-manager:beacon_yyyy".
+func ParseCredentials(s string) (Credentials, error) {
+	credentials := Credentials{}
+	if strings.TrimSpace(s) == "" {
+		return credentials, nil
+	}
+
+	for part := range strings.SplitSeq(s, ",") {
+		accessKeyID, secret, ok := strings.Cut(strings.TrimSpace(part), ":")
+		if !ok || accessKeyID == "" {
+			// The part is left out of the error, since it may be a secret access key.
+			return nil, errors.New("invalid Route 53 credentials: must be of the form accessKeyID:secretAccessKey")
+		}
+		if !strings.HasPrefix(secret, auth.APIKeyPrefix) {
+			return nil, fmt.Errorf(
+				"invalid Route 53 credentials for %q: the secret access key must be an API key",
+				accessKeyID,
+			)
+		}
+		if _, ok = credentials[accessKeyID]; ok {
+			return nil, fmt.Errorf("invalid Route 53 credentials: duplicate access key ID %q", accessKeyID)
+		}
+
+		credentials[accessKeyID] = secret
+	}
+
+	return credentials, nil
+}
+
+// authorization is the parsed Authorization header of a request signed with Signature
+// Version 4.
+type authorization struct {
+	accessKeyID   string
+	date          string
+	region        string
+	service       string
+	signedHeaders []string
+	signature     string
+}
+
+func (a *authorization) scope() string {
+	return strings.Join([]string{a.date, a.region, a.service, scopeTerminator}, "/")
+}
+
+// parseAuthorization parses a header of the form "AWS4-HMAC-SHA256
+// Credential=AKID/20250101/us-east-1/route53/aws4_request, SignedHeaders=host;x-amz-date,
+// Signature=...".
+func parseAuthorization(header string) (*authorization, error) {
+	algorithm, params, ok := strings.Cut(header, " ")
+	if !ok || algorithm != signingAlgorithm {
+		return nil, errIncompleteSignature("authorization header must use " + signingAlgorithm)
+	}
+
+	var a authorization
+	var credential string
+	for param := range strings.SplitSeq(params, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		switch key {
+		case "Credential":
+			credential = value
+		case "SignedHeaders":
+			a.signedHeaders = strings.Split(value, ";")
+		case "Signature":
+			a.signature = value
+		}
+	}
+
+	scope := strings.Split(credential, "/")
+	if len(scope) != 5 || scope[4] != scopeTerminator || scope[0] == "" {
+		return nil, errIncompleteSignature("authorization header has an invalid credential")
+	}
+	a.accessKeyID, a.date, a.region, a.service = scope[0], scope[1], scope[2], scope[3]
+
+	if a.signature == "" || len(a.signedHeaders) == 0 {
+		return nil, errIncompleteSignature("authorization header must include SignedHeaders and Signature")
+	}
+	if !slices.Contains(a.signedHeaders, "host") || !slices.Contains(a.signedHeaders, "x-amz-date") {
+		return nil, errIncompleteSignature("the host and x-amz-date headers must be signed")
+	}
+
+	return &a, nil
+}
+
+// verifyRequest checks the Signature Version 4 signature of the request against the
+// credentials and returns the secret access key it was signed with. The body of the request
+// is read to hash it and replaced, so that handlers can still read it.
+func verifyRequest(r *http.Request, credentials Credentials, now time.Time) (string, error) {
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		return "", errMissingAuthenticationToken()
+	}
+
+	a, err := parseAuthorization(header)
+	if err != nil {
+		return "", err
+	}
+
+	secret, ok := credentials[a.accessKeyID]
+	if !ok {
+		return "", errInvalidClientTokenID()
+	}
+	if a.service != signingService {
+		return "", errIncompleteSignature("credential scope must be for the " + signingService + " service")
+	}
+
+	amzDate := r.Header.Get("X-Amz-Date")
+	signedAt, err := time.Parse(amzDateFormat, amzDate)
+	if err != nil || !strings.HasPrefix(amzDate, a.date) {
+		return "", errIncompleteSignature("x-amz-date header is missing or does not match the credential scope")
+	}
+	if skew := now.Sub(signedAt); skew > maxClockSkew || skew < -maxClockSkew {
+		return "", errSignatureExpired(amzDate)
+	}
+
+	payloadHash, err := hashPayload(r)
+	if err != nil {
+		return "", err
+	}
+
+	expected := computeSignature(secret, r, a, amzDate, payloadHash)
+	if !hmac.Equal([]byte(expected), []byte(a.signature)) {
+		return "", errSignatureDoesNotMatch()
+	}
+
+	return secret, nil
+}
+
+// hashPayload returns the hash of the request body that is signed. The body is always
+// hashed, since Route 53 signatures have no unsigned payloads, so an x-amz-content-sha256
+// header, which some clients send anyway, must hold the same hash.
+func hashPayload(r *http.Request) (string, error) {
+	var body []byte
+	if r.Body != nil {
+		var err error
+		body, err = io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+		if err != nil {
+			return "", errInvalidInput("failed to read request body")
+		}
+		if len(body) > maxBodySize {
+			return "", errInvalidInput("request body is too large")
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	sum := sha256.Sum256(body)
+	payloadHash := hex.EncodeToString(sum[:])
+
+	if contentHash := r.Header.Get("X-Amz-Content-Sha256"); contentHash != "" && contentHash != payloadHash {
+		return "", errContentSHA256Mismatch()
+	}
+
+	return payloadHash, nil
+}
+
+// computeSignature returns the signature of the request for the scope and signed headers of
+// a, as described in the AWS Signature Version 4 documentation.
+func computeSignature(secret string, r *http.Request, a *authorization, amzDate string, payloadHash string) string {
+	canonical := canonicalRequest(r, a.signedHeaders, payloadHash)
+	canonicalHash := sha256.Sum256([]byte(canonical))
+
+	stringToSign := strings.Join([]string{
+		signingAlgorithm,
+		amzDate,
+		a.scope(),
+		hex.EncodeToString(canonicalHash[:]),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+secret), a.date)
+	key = hmacSHA256(key, a.region)
+	key = hmacSHA256(key, a.service)
+	key = hmacSHA256(key, scopeTerminator)
+
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func canonicalRequest(r *http.Request, signedHeaders []string, payloadHash string) string {
+	var headers strings.Builder
+	for _, name := range signedHeaders {
+		var value string
+		if name == "host" {
+			value = r.Host
+		} else {
+			values := slices.Clone(r.Header.Values(name))
+			for i, v := range values {
+				values[i] = strings.Join(strings.Fields(v), " ")
+			}
+			value = strings.Join(values, ",")
+		}
+		headers.WriteString(name + ":" + value + "\n")
+	}
+
+	return strings.Join([]string{
+		r.Method,
+		canonicalURI(r.URL),
+		canonicalQuery(r.URL),
+		headers.String(),
+		strings.Join(signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+}
+
+// canonicalURI encodes the escaped path of the request once more, as clients do for every
+// service but S3.
+func canonicalURI(u *url.URL) string {
+	path := u.EscapedPath()
+	if path == "" {
+		return "/"
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = uriEncode(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func canonicalQuery(u *url.URL) string {
+	query := u.Query()
+	params := make([][2]string, 0, len(query))
+	for key, values := range query {
+		for _, value := range values {
+			params = append(params, [2]string{uriEncode(key), uriEncode(value)})
+		}
+	}
+	slices.SortFunc(params, func(a, b [2]string) int {
+		if c := strings.Compare(a[0], b[0]); c != 0 {
+			return c
+		}
+		return strings.Compare(a[1], b[1])
+	})
+
+	encoded := make([]string, len(params))
+	for i, param := range params {
+		encoded[i] = param[0] + "=" + param[1]
+	}
+	return strings.Join(encoded, "&")
+}
+
+// uriEncode percent-encodes every byte of s but the unreserved characters of RFC 3986.
+func uriEncode(s string) string {
+	var b strings.Builder
+	for i := range len(s) {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
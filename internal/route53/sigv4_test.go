@@ -0,0 +1,210 @@
+package route53
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAccessKeyID = "external-dns"
+	testSecret      = "beacon_test"
+)
+
+// signRequest signs r with the test credentials at now, the way AWS SDKs sign Route 53
+// requests.
+func signRequest(t *testing.T, r *http.Request, body string, now time.Time) {
+	t.Helper()
+
+	amzDate := now.UTC().Format(amzDateFormat)
+	r.Header.Set("X-Amz-Date", amzDate)
+
+	a := &authorization{
+		accessKeyID:   testAccessKeyID,
+		date:          amzDate[:8],
+		region:        "us-east-1",
+		service:       signingService,
+		signedHeaders: []string{"host", "x-amz-date"},
+	}
+
+	payloadHash, err := hashPayload(httptest.NewRequest(r.Method, r.URL.String(), strings.NewReader(body)))
+	require.NoError(t, err)
+
+	signature := computeSignature(testSecret, r, a, amzDate, payloadHash)
+	r.Header.Set("Authorization", signingAlgorithm+" Credential="+testAccessKeyID+"/"+a.scope()+
+		", SignedHeaders=host;x-amz-date, Signature="+signature)
+}
+
+// TestComputeSignature checks signatures against the get-vanilla examples of the AWS
+// Signature Version 4 test suite.
+func TestComputeSignature(t *testing.T) {
+	tests := []struct {
+		name      string
+		url       string
+		signature string
+	}{
+		{
+			name:      "get vanilla",
+			url:       "https://example.amazonaws.com/",
+			signature: "5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31",
+		},
+		{
+			name:      "get vanilla query order",
+			url:       "https://example.amazonaws.com/?Param2=value2&Param1=value1",
+			signature: "b97d918cfa904a5beff61c982a1b6f458b799221646efd99d3219ec94cdf2500",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			r.Header.Set("X-Amz-Date", "20150830T123600Z")
+
+			payloadHash, err := hashPayload(r)
+			require.NoError(t, err)
+
+			a := &authorization{
+				date:          "20150830",
+				region:        "us-east-1",
+				service:       "service",
+				signedHeaders: []string{"host", "x-amz-date"},
+			}
+			secret := "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"
+			signature := computeSignature(secret, r, a, "20150830T123600Z", payloadHash)
+			assert.Equal(t, tt.signature, signature)
+		})
+	}
+}
+
+func TestVerifyRequest(t *testing.T) {
+	credentials := Credentials{testAccessKeyID: testSecret}
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	body := "<ChangeResourceRecordSetsRequest/>"
+
+	newRequest := func() *http.Request {
+		return httptest.NewRequest(
+			http.MethodPost,
+			"http://beacon.example.com/2013-04-01/hostedzone/ABC/rrset",
+			strings.NewReader(body),
+		)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(r *http.Request)
+		at     time.Time
+		code   string
+	}{
+		{
+			name:   "valid signature",
+			modify: func(*http.Request) {},
+			at:     now,
+		},
+		{
+			name:   "missing authorization",
+			modify: func(r *http.Request) { r.Header.Del("Authorization") },
+			at:     now,
+			code:   "MissingAuthenticationToken",
+		},
+		{
+			name: "unknown access key",
+			modify: func(r *http.Request) {
+				header := strings.Replace(r.Header.Get("Authorization"), testAccessKeyID, "other", 1)
+				r.Header.Set("Authorization", header)
+			},
+			at:   now,
+			code: "InvalidClientTokenId",
+		},
+		{
+			name: "tampered body",
+			modify: func(r *http.Request) {
+				r.Body = httptest.NewRequest(http.MethodPost, "/", strings.NewReader("<tampered/>")).Body
+			},
+			at:   now,
+			code: "SignatureDoesNotMatch",
+		},
+		{
+			name: "content hash of body",
+			modify: func(r *http.Request) {
+				sum := sha256.Sum256([]byte(body))
+				r.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(sum[:]))
+			},
+			at: now,
+		},
+		{
+			name: "unsigned payload",
+			modify: func(r *http.Request) {
+				r.Header.Set("X-Amz-Content-Sha256", "UNSIGNED-PAYLOAD")
+				r.Body = httptest.NewRequest(http.MethodPost, "/", strings.NewReader("<tampered/>")).Body
+			},
+			at:   now,
+			code: "InvalidInput",
+		},
+		{
+			name: "content hash of other body",
+			modify: func(r *http.Request) {
+				r.Header.Set("X-Amz-Content-Sha256", strings.Repeat("0", 64))
+			},
+			at:   now,
+			code: "InvalidInput",
+		},
+		{
+			name:   "tampered path",
+			modify: func(r *http.Request) { r.URL.Path = "/2013-04-01/hostedzone/DEF/rrset" },
+			at:     now,
+			code:   "SignatureDoesNotMatch",
+		},
+		{
+			name:   "expired",
+			modify: func(*http.Request) {},
+			at:     now.Add(time.Hour),
+			code:   "SignatureDoesNotMatch",
+		},
+		{
+			name:   "other algorithm",
+			modify: func(r *http.Request) { r.Header.Set("Authorization", "AWS3 Credential=x") },
+			at:     now,
+			code:   "IncompleteSignature",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRequest()
+			signRequest(t, r, body, now)
+			tt.modify(r)
+
+			secret, err := verifyRequest(r, credentials, tt.at)
+			if tt.code != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.code, toAPIError(err).code)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testSecret, secret)
+		})
+	}
+}
+
+func TestParseCredentials(t *testing.T) {
+	credentials, err := ParseCredentials("external-dns:beacon_a, cert-manager:beacon_b")
+	require.NoError(t, err)
+	assert.Equal(t, Credentials{"external-dns": "beacon_a", "cert-manager": "beacon_b"}, credentials)
+
+	credentials, err = ParseCredentials("")
+	require.NoError(t, err)
+	assert.Empty(t, credentials)
+
+	for _, s := range []string{"beacon_a", ":beacon_a", "external-dns:secret", "a:beacon_a,a:beacon_b"} {
+		_, err = ParseCredentials(s)
+		assert.Error(t, err, s)
+	}
+}
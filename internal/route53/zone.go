@@ -0,0 +1,410 @@
+package route53
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/miekg/dns"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/zone"
+)
+
+const (
+	hostedZonePrefix = "/hostedzone/"
+	changePrefix     = "/change/"
+
+	// maxHostedZones and maxResourceRecordSets are the largest pages Route 53 returns, which
+	// are also the default page sizes.
+	maxHostedZones        = 100
+	maxResourceRecordSets = 300
+
+	changeStatusPending = "PENDING"
+	changeStatusInSync  = "INSYNC"
+)
+
+// CreateHostedZone creates a public zone. The caller reference and comment are returned but
+// not stored, since Beacon zones have no place for them.
+func (h *handler) CreateHostedZone(c *gin.Context) {
+	var req CreateHostedZoneRequest
+	if err := c.ShouldBindXML(&req); err != nil {
+		h.handleError(c, errInvalidInput("invalid request body: "+err.Error()))
+		return
+	}
+
+	switch {
+	case req.Name == "":
+		h.handleError(c, errInvalidInput("Name is required"))
+		return
+	case req.CallerReference == "":
+		h.handleError(c, errInvalidInput("CallerReference is required"))
+		return
+	case req.VPC != nil || req.HostedZoneConfig != nil && req.HostedZoneConfig.PrivateZone:
+		h.handleError(c, errInvalidInput("private hosted zones are not supported"))
+		return
+	case req.DelegationSetID != "":
+		h.handleError(c, errInvalidInput("reusable delegation sets are not supported"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	info, err := h.zoneService.CreateZone(ctx, req.Name, zone.CreateZoneOptions{})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	nameServers, err := h.nameServers(ctx, info.Name)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	changeInfo, err := h.creationChangeInfo(ctx, info.Name)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	hostedZone := convertZoneInfoToHostedZone(info)
+	hostedZone.CallerReference = req.CallerReference
+	if req.HostedZoneConfig != nil {
+		hostedZone.Config.Comment = req.HostedZoneConfig.Comment
+	}
+
+	c.Header("Location", apiVersion+hostedZone.ID)
+	c.XML(http.StatusCreated, CreateHostedZoneResponse{
+		HostedZone:    hostedZone,
+		ChangeInfo:    *changeInfo,
+		DelegationSet: DelegationSet{NameServers: nameServers},
+	})
+}
+
+// nameServers returns the name servers of the NS record set at the apex of the zone.
+func (h *handler) nameServers(ctx context.Context, zoneName string) ([]string, error) {
+	rrSet, err := h.zoneService.GetResourceRecordSet(ctx, zoneName, zoneName, model.RRTypeNS)
+	if err != nil {
+		return nil, err
+	}
+
+	nameServers := make([]string, len(rrSet.ResourceRecords))
+	for i, record := range rrSet.ResourceRecords {
+		nameServers[i] = record.Value
+	}
+
+	return nameServers, nil
+}
+
+// creationChangeInfo returns the change that created the zone, which is the change of its
+// first version.
+func (h *handler) creationChangeInfo(ctx context.Context, zoneName string) (*ChangeInfo, error) {
+	versions, err := h.zoneService.ListZoneVersions(ctx, zoneName, model.ListOptions{
+		Limit:  1,
+		SortBy: model.SortByVersion,
+		Order:  model.SortAscending,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(versions.Items) == 0 {
+		return nil, beaconerr.ErrInternalError("zone has no versions", nil)
+	}
+
+	version := versions.Items[0]
+	return &ChangeInfo{
+		ID:          changePrefix + formatID(version.ChangeID),
+		Status:      convertChangeStatus(version.Status),
+		SubmittedAt: version.CreatedAt,
+	}, nil
+}
+
+// ListHostedZones returns a page of the zones, sorted by name. The marker is the cursor of
+// the zone service.
+func (h *handler) ListHostedZones(c *gin.Context) {
+	maxItems, err := parseMaxItems(c.Query("maxitems"), maxHostedZones)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	page, err := h.zoneService.ListZones(c.Request.Context(), model.ListOptions{
+		Limit:  maxItems,
+		Cursor: c.Query("marker"),
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	resp := ListHostedZonesResponse{
+		HostedZones: make([]HostedZone, len(page.Items)),
+		Marker:      c.Query("marker"),
+		IsTruncated: page.NextCursor != "",
+		NextMarker:  page.NextCursor,
+		MaxItems:    maxItems,
+	}
+	for i := range page.Items {
+		resp.HostedZones[i] = convertZoneInfoToHostedZone(&page.Items[i])
+	}
+
+	c.XML(http.StatusOK, resp)
+}
+
+// ChangeResourceRecordSets applies a change batch to the record sets of a zone as a single
+// change.
+func (h *handler) ChangeResourceRecordSets(c *gin.Context) {
+	id, err := parseHostedZoneID(c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	var req ChangeResourceRecordSetsRequest
+	if err = c.ShouldBindXML(&req); err != nil {
+		h.handleError(c, errInvalidInput("invalid request body: "+err.Error()))
+		return
+	}
+
+	batch, err := convertChangesToModel(req.ChangeBatch.Changes)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	info, err := h.zoneService.GetZoneInfoByID(ctx, id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	change, err := h.zoneService.ChangeResourceRecordSets(ctx, info.Name, batch, zone.ResourceRecordSetOptions{})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	changeInfo := convertChangeToChangeInfo(change)
+	changeInfo.Comment = req.ChangeBatch.Comment
+	c.XML(http.StatusOK, ChangeResourceRecordSetsResponse{ChangeInfo: changeInfo})
+}
+
+// ListResourceRecordSets returns a page of the record sets of a zone, sorted by name and
+// type. A page starts after the record set named by the identifier returned with the
+// previous page, which is the cursor of the zone service, or else at the record set named by
+// the name and type parameters, as Route 53 pages do.
+func (h *handler) ListResourceRecordSets(c *gin.Context) {
+	id, err := parseHostedZoneID(c.Param("id"))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	maxItems, err := parseMaxItems(c.Query("maxitems"), maxResourceRecordSets)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	startName := c.Query("name")
+	startType := model.RRType(strings.ToUpper(c.Query("type")))
+	if startType != "" && startName == "" {
+		h.handleError(c, errInvalidInput("the type parameter can only be given with the name parameter"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	info, err := h.zoneService.GetZoneInfoByID(ctx, id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	// One record set more than is returned is listed, so that the page can name the record
+	// set the next one starts at. The cursor of a list sorted by name is after the name and
+	// type of a record set.
+	opts := model.ListOptions{Limit: maxItems + 1, SortBy: model.SortByName, Order: model.SortAscending}
+	skip := func(*model.ResourceRecordSet) bool { return false }
+	if identifier := c.Query("identifier"); identifier != "" {
+		opts.Cursor = identifier
+	} else if startName != "" {
+		startName = dns.Fqdn(startName)
+		opts.Cursor = opts.NextCursor([]string{startName, ""})
+		skip = func(rrSet *model.ResourceRecordSet) bool {
+			return strings.EqualFold(rrSet.Name, startName) && rrSet.Type < startType
+		}
+	}
+
+	var rrSets []model.ResourceRecordSet
+	for {
+		page, listErr := h.zoneService.ListResourceRecordSets(ctx, info.Name, "", opts)
+		if listErr != nil {
+			h.handleError(c, listErr)
+			return
+		}
+
+		for i := range page.Items {
+			if !skip(&page.Items[i]) {
+				rrSets = append(rrSets, page.Items[i])
+			}
+		}
+
+		if len(rrSets) > maxItems || page.NextCursor == "" {
+			break
+		}
+		opts.Cursor = page.NextCursor
+	}
+
+	resp := ListResourceRecordSetsResponse{MaxItems: maxItems}
+	if len(rrSets) > maxItems {
+		next, last := rrSets[maxItems], rrSets[maxItems-1]
+		resp.IsTruncated = true
+		resp.NextRecordName = next.Name
+		resp.NextRecordType = string(next.Type)
+		resp.NextRecordIdentifier = opts.NextCursor([]string{last.Name, string(last.Type)})
+		rrSets = rrSets[:maxItems]
+	}
+
+	resp.ResourceRecordSets = make([]ResourceRecordSet, len(rrSets))
+	for i := range rrSets {
+		resp.ResourceRecordSets[i] = convertModelResourceRecordSet(&rrSets[i])
+	}
+
+	c.XML(http.StatusOK, resp)
+}
+
+// GetChange returns the status of a change, which is in sync once it has been applied to
+// the DNS servers.
+func (h *handler) GetChange(c *gin.Context) {
+	id, err := uuid.Parse(strings.TrimPrefix(c.Param("id"), changePrefix))
+	if err != nil {
+		h.handleError(c, &apiError{http.StatusNotFound, "NoSuchChange", "no change found with ID: " + c.Param("id")})
+		return
+	}
+
+	change, err := h.zoneService.GetChange(c.Request.Context(), id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.XML(http.StatusOK, GetChangeResponse{ChangeInfo: convertChangeToChangeInfo(change)})
+}
+
+// formatID formats an ID the way Route 53 IDs look, as upper case letters and digits.
+func formatID(id uuid.UUID) string {
+	return strings.ToUpper(strings.ReplaceAll(id.String(), "-", ""))
+}
+
+// parseHostedZoneID parses a hosted zone ID, with or without the /hostedzone/ prefix that
+// Route 53 returns it with.
+func parseHostedZoneID(s string) (uuid.UUID, error) {
+	id, err := uuid.Parse(strings.TrimPrefix(s, hostedZonePrefix))
+	if err != nil {
+		return uuid.Nil, &apiError{http.StatusNotFound, "NoSuchHostedZone", "no hosted zone found with ID: " + s}
+	}
+	return id, nil
+}
+
+// parseMaxItems parses the maxitems parameter, which defaults to and is capped at limit.
+func parseMaxItems(s string, limit int) (int, error) {
+	if s == "" {
+		return limit, nil
+	}
+
+	maxItems, err := strconv.Atoi(s)
+	if err != nil || maxItems < 1 {
+		return 0, errInvalidInput("maxitems must be a positive integer")
+	}
+
+	return min(maxItems, limit), nil
+}
+
+func convertZoneInfoToHostedZone(info *model.ZoneInfo) HostedZone {
+	return HostedZone{
+		ID:                     hostedZonePrefix + formatID(info.ID),
+		Name:                   info.Name,
+		ResourceRecordSetCount: info.ResourceRecordSetCount,
+	}
+}
+
+func convertChangeToChangeInfo(change *model.Change) ChangeInfo {
+	changeInfo := ChangeInfo{
+		ID:     changePrefix + formatID(change.ID),
+		Status: convertChangeStatus(change.Status),
+	}
+	if change.SubmittedAt != nil {
+		changeInfo.SubmittedAt = *change.SubmittedAt
+	}
+	return changeInfo
+}
+
+func convertChangeStatus(status model.ChangeStatus) string {
+	if status == model.ChangeStatusDone {
+		return changeStatusInSync
+	}
+	return changeStatusPending
+}
+
+// convertChangesToModel converts the changes of a change batch, rejecting the routing
+// policies and alias records Beacon does not support. The actions themselves are checked by
+// the zone service.
+func convertChangesToModel(changes []Change) ([]zone.ChangeBatchAction, error) {
+	if len(changes) == 0 {
+		return nil, errInvalidInput("ChangeBatch must contain at least one change")
+	}
+
+	batch := make([]zone.ChangeBatchAction, len(changes))
+	for i, change := range changes {
+		rrSet := change.ResourceRecordSet
+		switch {
+		case rrSet.AliasTarget != nil:
+			return nil, errInvalidInput("alias records are not supported")
+		case rrSet.SetIdentifier != "":
+			return nil, errInvalidInput("routing policies are not supported")
+		case rrSet.TTL == nil:
+			return nil, errInvalidInput("TTL is required for record set " + rrSet.Name)
+		}
+
+		batch[i] = zone.ChangeBatchAction{
+			ActionType:        zone.ChangeBatchActionType(strings.ToUpper(change.Action)),
+			ResourceRecordSet: convertResourceRecordSetToModel(&rrSet),
+		}
+	}
+
+	return batch, nil
+}
+
+func convertResourceRecordSetToModel(rrSet *ResourceRecordSet) *model.ResourceRecordSet {
+	records := make([]model.ResourceRecord, len(rrSet.ResourceRecords))
+	for i, record := range rrSet.ResourceRecords {
+		records[i] = model.ResourceRecord{Value: record.Value}
+	}
+
+	return &model.ResourceRecordSet{
+		Name:            rrSet.Name,
+		Type:            model.RRType(strings.ToUpper(rrSet.Type)),
+		TTL:             *rrSet.TTL,
+		ResourceRecords: records,
+	}
+}
+
+func convertModelResourceRecordSet(rrSet *model.ResourceRecordSet) ResourceRecordSet {
+	records := make([]ResourceRecord, len(rrSet.ResourceRecords))
+	for i, record := range rrSet.ResourceRecords {
+		records[i] = ResourceRecord{Value: record.Value}
+	}
+
+	ttl := rrSet.TTL
+	return ResourceRecordSet{
+		Name:            rrSet.Name,
+		Type:            string(rrSet.Type),
+		TTL:             &ttl,
+		ResourceRecords: records,
+	}
+}
@@ -0,0 +1,80 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+	"syscall"
+)
+
+// ErrDestinationNotAllowed is returned when a delivery would connect to an address that is
+// internal to the network of the controller.
+var ErrDestinationNotAllowed = errors.New("webhook destination is not allowed")
+
+// ParseAllowedNetworks parses the networks deliveries may be sent to even though they are
+// internal. Each is a CIDR prefix such as "10.1.0.0/16", or a single address.
+func ParseAllowedNetworks(networks []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(networks))
+	for _, network := range networks {
+		network = strings.TrimSpace(network)
+		if network == "" {
+			continue
+		}
+
+		if addr, err := netip.ParseAddr(network); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(network)
+		if err != nil {
+			return nil, fmt.Errorf("invalid webhook allowed network %q: %w", network, err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
+}
+
+// newHTTPClient returns the client deliveries are sent with by default. It refuses to connect
+// to loopback, private, link-local, multicast and unspecified addresses, unless they fall
+// within one of allowedNetworks, so that a webhook cannot be used to reach services internal
+// to the network of the controller. The check is made on the address that is dialed, after
+// the host name is resolved, so it also covers redirects and names that resolve to internal
+// addresses.
+func newHTTPClient(allowedNetworks []netip.Prefix) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: deliveryTimeout,
+		Control: func(_ string, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !allowedDestination(addrPort.Addr(), allowedNetworks) {
+				return fmt.Errorf("%w: %s", ErrDestinationNotAllowed, addrPort.Addr())
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would be dialed in place of the destination, bypassing the check.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{Timeout: deliveryTimeout, Transport: transport}
+}
+
+// allowedDestination reports whether deliveries may connect to addr.
+func allowedDestination(addr netip.Addr, allowedNetworks []netip.Prefix) bool {
+	addr = addr.Unmap()
+	if slices.ContainsFunc(allowedNetworks, func(prefix netip.Prefix) bool { return prefix.Contains(addr) }) {
+		return true
+	}
+
+	return addr.IsGlobalUnicast() && !addr.IsPrivate()
+}
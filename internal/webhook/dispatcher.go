@@ -0,0 +1,315 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/davidseybold/beacondns/internal/log"
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/repository"
+)
+
+// Headers sent with every delivery. SignatureHeader holds "sha256=" followed by the hex
+// HMAC-SHA256 of the timestamp, a dot and the body, keyed with the secret of the webhook.
+const (
+	EventHeader     = "X-Beacon-Event"
+	DeliveryHeader  = "X-Beacon-Delivery"
+	TimestampHeader = "X-Beacon-Timestamp"
+	SignatureHeader = "X-Beacon-Signature"
+)
+
+const (
+	// DefaultMaxAttempts is how many times a delivery is attempted before it fails.
+	DefaultMaxAttempts = 8
+	// DefaultDeliveryRetention is how long succeeded and failed deliveries are kept in the
+	// delivery log.
+	DefaultDeliveryRetention = 7 * 24 * time.Hour
+
+	dispatchInterval  = time.Second
+	purgeInterval     = time.Hour
+	dispatchBatchSize = 20
+	deliveryTimeout   = 10 * time.Second
+	// deliveryLease is how long a claimed delivery is left alone before it is attempted
+	// again, in case the controller stopped before recording the outcome.
+	deliveryLease = time.Minute
+	// maxResponseBodyRead is how much of a response is read, so that the connection can be
+	// reused, before it is discarded.
+	maxResponseBodyRead = 64 * 1024
+	// maxErrorLength is how much of a failed response is kept in the delivery log.
+	maxErrorLength = 512
+
+	retryBaseDelay = 10 * time.Second
+	retryMaxDelay  = time.Hour
+)
+
+type DispatcherConfig struct {
+	// MaxAttempts is how many times a delivery is attempted before it fails. Zero means
+	// DefaultMaxAttempts.
+	MaxAttempts int
+	// DeliveryRetention is how long succeeded and failed deliveries are kept. Zero means
+	// DefaultDeliveryRetention.
+	DeliveryRetention time.Duration
+	// AllowedNetworks are the networks the default client may deliver to even though they
+	// are internal, such as a private network that hosts the receivers.
+	AllowedNetworks []netip.Prefix
+	// HTTPClient sends the deliveries. Zero means a client with a timeout of ten seconds that
+	// refuses to connect to internal addresses outside AllowedNetworks.
+	HTTPClient *http.Client
+}
+
+// Dispatcher delivers events to the webhooks that subscribe to them. The worker hands it each
+// event it processes, and it queues a delivery to every matching webhook in the same
+// transaction. Deliveries that are not answered with a 2xx status are attempted again with
+// exponential backoff until they run out of attempts.
+type Dispatcher struct {
+	registry    repository.TransactorRegistry
+	logger      *slog.Logger
+	client      *http.Client
+	maxAttempts int
+	retention   time.Duration
+	now         func() time.Time
+}
+
+func NewDispatcher(registry repository.TransactorRegistry, l *slog.Logger, cfg DispatcherConfig) *Dispatcher {
+	if l == nil {
+		l = log.NewDiscardLogger()
+	}
+
+	maxAttempts := cfg.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+
+	retention := cfg.DeliveryRetention
+	if retention == 0 {
+		retention = DefaultDeliveryRetention
+	}
+
+	client := cfg.HTTPClient
+	if client == nil {
+		client = newHTTPClient(cfg.AllowedNetworks)
+	}
+
+	return &Dispatcher{
+		registry:    registry,
+		logger:      l,
+		client:      client,
+		maxAttempts: maxAttempts,
+		retention:   retention,
+		now:         time.Now,
+	}
+}
+
+// EventProcessed queues a delivery of the event to every webhook that subscribes to its type.
+// r is the registry of the transaction the worker processed the event in.
+func (d *Dispatcher) EventProcessed(ctx context.Context, r repository.Registry, event *model.Event) error {
+	repo := r.GetWebhookRepository()
+
+	webhooks, err := repo.GetWebhooks(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get webhooks: %w", err)
+	}
+
+	var payload []byte
+	for i := range webhooks {
+		if !webhooks[i].Matches(event.Type) {
+			continue
+		}
+
+		if payload == nil {
+			if payload, err = json.Marshal(event); err != nil {
+				return fmt.Errorf("failed to encode event: %w", err)
+			}
+		}
+
+		_, err = repo.CreateWebhookDelivery(ctx, &model.WebhookDelivery{
+			WebhookID: webhooks[i].ID,
+			EventID:   event.ID,
+			EventType: event.Type,
+			Payload:   payload,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to queue webhook delivery: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Start attempts the deliveries that are due every second, and purges the deliveries that
+// are past their retention every hour, until ctx is canceled.
+func (d *Dispatcher) Start(ctx context.Context) error {
+	ticker := time.NewTicker(dispatchInterval)
+	defer ticker.Stop()
+
+	purgeTicker := time.NewTicker(purgeInterval)
+	defer purgeTicker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := d.dispatch(ctx); err != nil {
+				d.logger.ErrorContext(ctx, "failed to dispatch webhook deliveries", "error", err)
+			}
+		case <-purgeTicker.C:
+			if err := d.purge(ctx); err != nil {
+				d.logger.ErrorContext(ctx, "failed to purge webhook deliveries", "error", err)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// dispatch attempts the deliveries that are due, in batches, until none are left.
+func (d *Dispatcher) dispatch(ctx context.Context) error {
+	repo := d.registry.GetWebhookRepository()
+
+	for {
+		deliveries, err := repo.ClaimWebhookDeliveries(ctx, dispatchBatchSize, deliveryLease)
+		if err != nil {
+			return err
+		}
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		var wg sync.WaitGroup
+		for i := range deliveries {
+			wg.Add(1)
+			go func(delivery *model.WebhookDelivery) {
+				defer wg.Done()
+				if deliverErr := d.deliver(ctx, delivery); deliverErr != nil {
+					d.logger.ErrorContext(ctx, "failed to deliver webhook",
+						"delivery", delivery.ID, "webhook", delivery.WebhookID, "error", deliverErr)
+				}
+			}(&deliveries[i])
+		}
+		wg.Wait()
+
+		if len(deliveries) < dispatchBatchSize {
+			return nil
+		}
+	}
+}
+
+// purge deletes the succeeded and failed deliveries that are older than the retention.
+func (d *Dispatcher) purge(ctx context.Context) error {
+	return d.registry.GetWebhookRepository().PurgeWebhookDeliveries(ctx, d.now().Add(-d.retention))
+}
+
+// deliver attempts a claimed delivery and records the outcome.
+func (d *Dispatcher) deliver(ctx context.Context, delivery *model.WebhookDelivery) error {
+	repo := d.registry.GetWebhookRepository()
+
+	webhook, err := repo.GetWebhook(ctx, delivery.WebhookID)
+	if errors.Is(err, repository.ErrEntityNotFound) {
+		// The webhook was deleted along with its deliveries after this one was claimed.
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	status, err := d.send(ctx, webhook, delivery)
+	if ctx.Err() != nil {
+		// The controller is stopping. The delivery is attempted again once its lease runs out.
+		return nil
+	}
+
+	d.recordAttempt(delivery, status, err)
+
+	return repo.UpdateWebhookDelivery(ctx, delivery)
+}
+
+// send posts the payload of the delivery to the webhook and returns the status of the
+// response. A status outside 2xx is returned along with an error.
+func (d *Dispatcher) send(ctx context.Context, webhook *model.Webhook, delivery *model.WebhookDelivery) (int, error) {
+	timestamp := d.now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "beacondns-webhook")
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, delivery.ID.String())
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodyRead))
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		if len(body) > maxErrorLength {
+			body = body[:maxErrorLength]
+		}
+		return resp.StatusCode, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, body)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// recordAttempt sets the outcome of the last attempt of the delivery, along with when it is
+// attempted next, if at all.
+func (d *Dispatcher) recordAttempt(delivery *model.WebhookDelivery, status int, err error) {
+	now := d.now()
+	delivery.LastAttemptAt = &now
+	delivery.ResponseStatus = nil
+	if status != 0 {
+		delivery.ResponseStatus = &status
+	}
+	delivery.Error = ""
+	delivery.NextAttemptAt = nil
+
+	switch {
+	case err == nil:
+		delivery.Status = model.WebhookDeliveryStatusSucceeded
+	case delivery.Attempts >= d.maxAttempts:
+		delivery.Status = model.WebhookDeliveryStatusFailed
+		delivery.Error = err.Error()
+	default:
+		delivery.Status = model.WebhookDeliveryStatusPending
+		delivery.Error = err.Error()
+		next := now.Add(retryDelay(delivery.Attempts))
+		delivery.NextAttemptAt = &next
+	}
+}
+
+// retryDelay returns how long to wait after the given number of attempts before attempting a
+// delivery again, doubling from ten seconds up to an hour.
+func retryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, retryMaxDelay)
+}
+
+// Sign returns the signature of a delivery, as sent in SignatureHeader. Receivers verify a
+// delivery by computing it from the secret, the timestamp header and the body, and comparing
+// it to the header in constant time.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,200 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+// loopback lets the dispatcher deliver to test servers.
+var loopback = []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")}
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"type":"zone.create"}`)
+
+	signature := Sign("secret", 1700000000, body)
+
+	assert.Equal(t, "sha256=f29c66367b499337ccfb7e8bb7068f725cec6faecff1252ee2cbf6ea764c622e", signature)
+	assert.NotEqual(t, signature, Sign("other", 1700000000, body))
+	assert.NotEqual(t, signature, Sign("secret", 1700000001, body))
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: 10 * time.Second},
+		{attempts: 2, want: 20 * time.Second},
+		{attempts: 3, want: 40 * time.Second},
+		{attempts: 9, want: 2560 * time.Second},
+		{attempts: 10, want: time.Hour},
+		{attempts: 100, want: time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.attempts), func(t *testing.T) {
+			assert.Equal(t, tt.want, retryDelay(tt.attempts))
+		})
+	}
+}
+
+func TestDispatcher_Send(t *testing.T) {
+	delivery := &model.WebhookDelivery{
+		ID:        uuid.New(),
+		EventType: "firewall.rule.create",
+		Payload:   []byte(`{"type":"firewall.rule.create"}`),
+	}
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, string(delivery.Payload), string(body))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "firewall.rule.create", r.Header.Get(EventHeader))
+		assert.Equal(t, delivery.ID.String(), r.Header.Get(DeliveryHeader))
+		assert.Equal(t, strconv.FormatInt(now.Unix(), 10), r.Header.Get(TimestampHeader))
+
+		want := Sign("whsec_test", now.Unix(), body)
+		assert.True(t, hmac.Equal([]byte(want), []byte(r.Header.Get(SignatureHeader))))
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	d := NewDispatcher(nil, nil, DispatcherConfig{AllowedNetworks: loopback})
+	d.now = func() time.Time { return now }
+
+	status, err := d.send(t.Context(), &model.Webhook{URL: server.URL, Secret: "whsec_test"}, delivery)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, status)
+}
+
+func TestDispatcher_SendFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	d := NewDispatcher(nil, nil, DispatcherConfig{AllowedNetworks: loopback})
+
+	status, err := d.send(t.Context(), &model.Webhook{URL: server.URL}, &model.WebhookDelivery{ID: uuid.New()})
+
+	require.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+	assert.Contains(t, err.Error(), "unavailable")
+}
+
+func TestDispatcher_SendInternalDestination(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	d := NewDispatcher(nil, nil, DispatcherConfig{})
+
+	status, err := d.send(t.Context(), &model.Webhook{URL: server.URL}, &model.WebhookDelivery{ID: uuid.New()})
+
+	require.ErrorIs(t, err, ErrDestinationNotAllowed)
+	assert.Zero(t, status)
+	assert.False(t, called)
+}
+
+func TestAllowedDestination(t *testing.T) {
+	allowed := []netip.Prefix{netip.MustParsePrefix("10.1.0.0/16")}
+
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{addr: "93.184.216.34", want: true},
+		{addr: "2606:2800:220:1:248:1893:25c8:1946", want: true},
+		{addr: "127.0.0.1", want: false},
+		{addr: "::1", want: false},
+		{addr: "::ffff:127.0.0.1", want: false},
+		{addr: "10.0.0.1", want: false},
+		{addr: "10.1.2.3", want: true},
+		{addr: "::ffff:10.1.2.3", want: true},
+		{addr: "172.16.0.1", want: false},
+		{addr: "192.168.1.1", want: false},
+		{addr: "169.254.169.254", want: false},
+		{addr: "fe80::1", want: false},
+		{addr: "fd00::1", want: false},
+		{addr: "0.0.0.0", want: false},
+		{addr: "224.0.0.1", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			assert.Equal(t, tt.want, allowedDestination(netip.MustParseAddr(tt.addr), allowed))
+		})
+	}
+}
+
+func TestParseAllowedNetworks(t *testing.T) {
+	networks, err := ParseAllowedNetworks([]string{"10.1.2.3/16", " 192.168.1.10 ", "", "fd00::/8"})
+	require.NoError(t, err)
+	assert.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("10.1.0.0/16"),
+		netip.MustParsePrefix("192.168.1.10/32"),
+		netip.MustParsePrefix("fd00::/8"),
+	}, networks)
+
+	_, err = ParseAllowedNetworks([]string{"not-a-network"})
+	assert.Error(t, err)
+}
+
+func TestDispatcher_RecordAttempt(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	d := NewDispatcher(nil, nil, DispatcherConfig{MaxAttempts: 3})
+	d.now = func() time.Time { return now }
+
+	t.Run("success", func(t *testing.T) {
+		delivery := &model.WebhookDelivery{Attempts: 1, Error: "earlier failure"}
+
+		d.recordAttempt(delivery, http.StatusOK, nil)
+
+		assert.Equal(t, model.WebhookDeliveryStatusSucceeded, delivery.Status)
+		assert.Equal(t, http.StatusOK, *delivery.ResponseStatus)
+		assert.Empty(t, delivery.Error)
+		assert.Nil(t, delivery.NextAttemptAt)
+		assert.Equal(t, now, *delivery.LastAttemptAt)
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		delivery := &model.WebhookDelivery{Attempts: 2}
+
+		d.recordAttempt(delivery, 0, errors.New("connection refused"))
+
+		assert.Equal(t, model.WebhookDeliveryStatusPending, delivery.Status)
+		assert.Nil(t, delivery.ResponseStatus)
+		assert.Equal(t, "connection refused", delivery.Error)
+		assert.Equal(t, now.Add(20*time.Second), *delivery.NextAttemptAt)
+	})
+
+	t.Run("out of attempts", func(t *testing.T) {
+		delivery := &model.WebhookDelivery{Attempts: 3}
+
+		d.recordAttempt(delivery, http.StatusInternalServerError, errors.New("unexpected status 500"))
+
+		assert.Equal(t, model.WebhookDeliveryStatusFailed, delivery.Status)
+		assert.Equal(t, http.StatusInternalServerError, *delivery.ResponseStatus)
+		assert.Nil(t, delivery.NextAttemptAt)
+	})
+}
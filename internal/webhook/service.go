@@ -0,0 +1,269 @@
+// Package webhook manages webhook subscriptions and delivers the events the worker processes
+// to them.
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/davidseybold/beacondns/internal/audit"
+	"github.com/davidseybold/beacondns/internal/auth"
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/repository"
+)
+
+const (
+	// SecretPrefix starts every generated webhook secret.
+	SecretPrefix = "whsec_"
+
+	secretBytes = 32
+	// minSecretLength is the minimum length of a secret chosen by the caller.
+	minSecretLength = 16
+)
+
+type Service interface {
+	// CreateWebhook creates a webhook. A secret is generated unless the webhook has one. The
+	// secret is returned on the created webhook and cannot be retrieved again.
+	CreateWebhook(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error)
+	GetWebhook(ctx context.Context, id uuid.UUID) (*model.Webhook, error)
+	ListWebhooks(ctx context.Context, opts model.ListOptions) (model.Page[model.Webhook], error)
+	DeleteWebhook(ctx context.Context, id uuid.UUID) error
+	// ListWebhookDeliveries returns a page of the delivery log of a webhook, newest first
+	// unless sorted otherwise.
+	ListWebhookDeliveries(
+		ctx context.Context,
+		id uuid.UUID,
+		opts model.ListOptions,
+	) (model.Page[model.WebhookDelivery], error)
+	// RedeliverWebhookDelivery sends the event of a delivery to the webhook again, as a new
+	// delivery with attempts of its own.
+	RedeliverWebhookDelivery(ctx context.Context, id uuid.UUID, deliveryID uuid.UUID) (*model.WebhookDelivery, error)
+}
+
+type DefaultService struct {
+	repReg repository.TransactorRegistry
+}
+
+var _ Service = (*DefaultService)(nil)
+
+func NewService(repReg repository.TransactorRegistry) *DefaultService {
+	return &DefaultService{
+		repReg: repReg,
+	}
+}
+
+// CreateWebhook needs write access to every webhook, since the ID of the webhook is only
+// known once it is created.
+func (d *DefaultService) CreateWebhook(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	if err := auth.Authorize(ctx, model.ActionWrite, model.AllResources(model.ResourceKindWebhook)); err != nil {
+		return nil, err
+	}
+
+	if err := webhook.Validate(); err != nil {
+		return nil, beaconerr.ErrInvalidArgument(err.Error(), "webhook")
+	}
+
+	toCreate := *webhook
+	if toCreate.Secret == "" {
+		secret, err := generateSecret()
+		if err != nil {
+			return nil, beaconerr.ErrInternalError("failed to generate webhook secret", err)
+		}
+		toCreate.Secret = secret
+	} else if len(toCreate.Secret) < minSecretLength {
+		return nil, beaconerr.ErrInvalidArgument("webhook secret must be at least 16 characters", "secret")
+	}
+
+	var created *model.Webhook
+	err := d.repReg.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		var txErr error
+		created, txErr = r.GetWebhookRepository().CreateWebhook(ctx, &toCreate)
+		if txErr != nil {
+			return txErr
+		}
+
+		return audit.Record(ctx, r, model.AuditOperationCreateWebhook, model.WebhookResource(created.ID), nil, created)
+	})
+	if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to create webhook", err)
+	}
+
+	return created, nil
+}
+
+// GetWebhook returns the webhook without its secret.
+func (d *DefaultService) GetWebhook(ctx context.Context, id uuid.UUID) (*model.Webhook, error) {
+	if err := auth.Authorize(ctx, model.ActionRead, model.WebhookResource(id)); err != nil {
+		return nil, err
+	}
+
+	webhook, err := d.repReg.GetWebhookRepository().GetWebhook(ctx, id)
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchWebhook("webhook not found")
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to get webhook", err)
+	}
+
+	webhook.Secret = ""
+
+	return webhook, nil
+}
+
+// ListWebhooks returns the webhooks without their secrets.
+func (d *DefaultService) ListWebhooks(
+	ctx context.Context,
+	opts model.ListOptions,
+) (model.Page[model.Webhook], error) {
+	if err := normalizeListOptions(&opts, model.SortAscending, model.SortByName, model.SortByCreatedAt); err != nil {
+		return model.Page[model.Webhook]{}, err
+	}
+
+	webhooks, err := d.repReg.GetWebhookRepository().ListWebhooks(ctx, opts)
+	if err != nil {
+		return model.Page[model.Webhook]{}, listError("failed to list webhooks", err)
+	}
+
+	for i := range webhooks.Items {
+		webhooks.Items[i].Secret = ""
+	}
+
+	return auth.FilterPage(ctx, webhooks, func(webhook *model.Webhook) string {
+		return model.WebhookResource(webhook.ID)
+	}), nil
+}
+
+func (d *DefaultService) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	if err := auth.Authorize(ctx, model.ActionDelete, model.WebhookResource(id)); err != nil {
+		return err
+	}
+
+	err := d.repReg.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		repo := r.GetWebhookRepository()
+
+		existing, txErr := repo.GetWebhook(ctx, id)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = repo.DeleteWebhook(ctx, id); txErr != nil {
+			return txErr
+		}
+
+		return audit.Record(ctx, r, model.AuditOperationDeleteWebhook, model.WebhookResource(id), existing, nil)
+	})
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return beaconerr.ErrNoSuchWebhook("webhook not found")
+	} else if err != nil {
+		return beaconerr.ErrInternalError("failed to delete webhook", err)
+	}
+
+	return nil
+}
+
+func (d *DefaultService) ListWebhookDeliveries(
+	ctx context.Context,
+	id uuid.UUID,
+	opts model.ListOptions,
+) (model.Page[model.WebhookDelivery], error) {
+	if err := auth.Authorize(ctx, model.ActionRead, model.WebhookResource(id)); err != nil {
+		return model.Page[model.WebhookDelivery]{}, err
+	}
+
+	if err := normalizeListOptions(&opts, model.SortDescending, model.SortByCreatedAt); err != nil {
+		return model.Page[model.WebhookDelivery]{}, err
+	}
+
+	repo := d.repReg.GetWebhookRepository()
+	if _, err := repo.GetWebhook(ctx, id); err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return model.Page[model.WebhookDelivery]{}, beaconerr.ErrNoSuchWebhook("webhook not found")
+	} else if err != nil {
+		return model.Page[model.WebhookDelivery]{}, beaconerr.ErrInternalError("failed to get webhook", err)
+	}
+
+	deliveries, err := repo.ListWebhookDeliveries(ctx, id, opts)
+	if err != nil {
+		return model.Page[model.WebhookDelivery]{}, listError("failed to list webhook deliveries", err)
+	}
+
+	return deliveries, nil
+}
+
+func (d *DefaultService) RedeliverWebhookDelivery(
+	ctx context.Context,
+	id uuid.UUID,
+	deliveryID uuid.UUID,
+) (*model.WebhookDelivery, error) {
+	if err := auth.Authorize(ctx, model.ActionWrite, model.WebhookResource(id)); err != nil {
+		return nil, err
+	}
+
+	var created *model.WebhookDelivery
+	err := d.repReg.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		repo := r.GetWebhookRepository()
+
+		if _, txErr := repo.GetWebhook(ctx, id); txErr != nil && errors.Is(txErr, repository.ErrEntityNotFound) {
+			return beaconerr.ErrNoSuchWebhook("webhook not found")
+		} else if txErr != nil {
+			return txErr
+		}
+
+		delivery, txErr := repo.GetWebhookDelivery(ctx, id, deliveryID)
+		if txErr != nil && errors.Is(txErr, repository.ErrEntityNotFound) {
+			return beaconerr.ErrNoSuchWebhookDelivery("webhook delivery not found")
+		} else if txErr != nil {
+			return txErr
+		}
+
+		created, txErr = repo.CreateWebhookDelivery(ctx, &model.WebhookDelivery{
+			WebhookID: id,
+			EventID:   delivery.EventID,
+			EventType: delivery.EventType,
+			Payload:   delivery.Payload,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		return audit.Record(ctx, r, model.AuditOperationRedeliverWebhook, model.WebhookResource(id), nil, created)
+	})
+	if err != nil && beaconerr.IsNoSuchError(err) {
+		return nil, err
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to redeliver webhook delivery", err)
+	}
+
+	return created, nil
+}
+
+// normalizeListOptions normalizes the options of a list that cannot be filtered by tags.
+func normalizeListOptions(opts *model.ListOptions, defaultOrder model.SortOrder, sortFields ...string) error {
+	if err := opts.Normalize(defaultOrder, sortFields...); err != nil {
+		return beaconerr.ErrInvalidArgument(err.Error(), "options")
+	}
+	if !opts.Tags.IsZero() {
+		return beaconerr.ErrInvalidArgument("webhooks cannot be filtered by tags", "options")
+	}
+	return nil
+}
+
+// listError maps the error of a list query. Whether a cursor fits the sort key is only known
+// once the query is built.
+func listError(message string, err error) error {
+	if errors.Is(err, model.ErrInvalidCursor) {
+		return beaconerr.ErrInvalidArgument(err.Error(), "cursor")
+	}
+	return beaconerr.ErrInternalError(message, err)
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, secretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return SecretPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -21,13 +21,25 @@ type EventProcessor interface {
 	Events() []string
 }
 
+// EventObserver is told about each event once it is processed, in the transaction that
+// deletes the event, so that what it does is only kept if the event is.
+type EventObserver interface {
+	EventProcessed(ctx context.Context, r repository.Registry, event *model.Event) error
+}
+
 type Worker struct {
 	logger           *slog.Logger
 	eventToProcessor map[string]EventProcessor
+	observers        []EventObserver
 	registry         repository.TransactorRegistry
 }
 
-func New(registry repository.TransactorRegistry, l *slog.Logger, eventProcessors []EventProcessor) *Worker {
+func New(
+	registry repository.TransactorRegistry,
+	l *slog.Logger,
+	eventProcessors []EventProcessor,
+	observers []EventObserver,
+) *Worker {
 	if l == nil {
 		l = log.NewDiscardLogger()
 	}
@@ -42,6 +54,7 @@ func New(registry repository.TransactorRegistry, l *slog.Logger, eventProcessors
 	return &Worker{
 		logger:           l,
 		eventToProcessor: eventToProcessor,
+		observers:        observers,
 		registry:         registry,
 	}
 }
@@ -87,6 +100,12 @@ func (w *Worker) processEvents(ctx context.Context) error {
 			return fmt.Errorf("error processing event: %w", err)
 		}
 
+		for _, observer := range w.observers {
+			if err = observer.EventProcessed(ctx, r, event); err != nil {
+				return fmt.Errorf("error observing event: %w", err)
+			}
+		}
+
 		err = r.GetEventRepository().DeleteEvent(ctx, event.ID)
 		if err != nil {
 			return fmt.Errorf("failed to delete event: %w", err)
@@ -0,0 +1,105 @@
+package zone
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/miekg/dns"
+
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+// ChangeBatchActionType is the action of an entry in a change batch. The actions are those
+// of Route 53 change batches, which check the record sets they create and delete against the
+// zone more strictly than the actions of a change do.
+type ChangeBatchActionType string
+
+const (
+	// ChangeBatchActionCreate adds a record set that must not exist yet.
+	ChangeBatchActionCreate ChangeBatchActionType = "CREATE"
+	// ChangeBatchActionUpsert adds a record set or replaces the existing one.
+	ChangeBatchActionUpsert ChangeBatchActionType = "UPSERT"
+	// ChangeBatchActionDelete deletes a record set whose TTL and records match the existing
+	// ones.
+	ChangeBatchActionDelete ChangeBatchActionType = "DELETE"
+)
+
+// ChangeBatchAction is an entry in a change batch.
+type ChangeBatchAction struct {
+	ActionType        ChangeBatchActionType
+	ResourceRecordSet *model.ResourceRecordSet
+}
+
+var (
+	ErrInvalidBatchAction = errors.New("invalid change batch action")
+	ErrRecordSetExists    = errors.New("cannot create resource record set: it already exists")
+	ErrRecordSetMismatch  = errors.New(
+		"cannot delete resource record set: the TTL or records do not match the existing ones",
+	)
+)
+
+// changeBatchActions converts a change batch into the actions of a change to zone, checking
+// that created record sets do not exist yet and that deleted ones match the existing ones.
+// A delete of a record set that does not exist is left for validateChanges to report. The
+// actions delete the existing record sets, so that their tags and comment are kept in the
+// change. All violations found are returned as ValidationErrors.
+func changeBatchActions(zone *model.Zone, batch []ChangeBatchAction) ([]model.ChangeAction, error) {
+	existing := make(map[string]*model.ResourceRecordSet, len(zone.ResourceRecordSets))
+	for i := range zone.ResourceRecordSets {
+		rrSet := &zone.ResourceRecordSets[i]
+		existing[rrSetKey(rrSet.Name, rrSet.Type)] = rrSet
+	}
+
+	var errs ValidationErrors
+	actions := make([]model.ChangeAction, len(batch))
+	for i, entry := range batch {
+		rrSet := entry.ResourceRecordSet
+		if rrSet == nil {
+			errs = append(errs, &ValidationError{Action: i, Err: ErrMissingRecordSet})
+			continue
+		}
+		rrSet.Name = dns.Fqdn(rrSet.Name)
+
+		current, exists := existing[rrSetKey(rrSet.Name, rrSet.Type)]
+		switch entry.ActionType {
+		case ChangeBatchActionCreate:
+			if exists {
+				errs = append(errs, newValidationError(i, rrSet, ErrRecordSetExists))
+				continue
+			}
+			actions[i] = model.NewChangeAction(model.ChangeActionTypeUpsert, rrSet)
+		case ChangeBatchActionUpsert:
+			actions[i] = model.NewChangeAction(model.ChangeActionTypeUpsert, rrSet)
+		case ChangeBatchActionDelete:
+			if !exists {
+				actions[i] = model.NewChangeAction(model.ChangeActionTypeDelete, rrSet)
+				continue
+			}
+			if !matchesResourceRecordSet(current, rrSet) {
+				errs = append(errs, newValidationError(i, rrSet, ErrRecordSetMismatch))
+				continue
+			}
+			actions[i] = model.NewChangeAction(model.ChangeActionTypeDelete, current)
+		default:
+			err := fmt.Errorf("%w: %q", ErrInvalidBatchAction, entry.ActionType)
+			errs = append(errs, newValidationError(i, rrSet, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return actions, nil
+}
+
+// matchesResourceRecordSet reports whether rrSet has the TTL and records of current, in any
+// order.
+func matchesResourceRecordSet(current, rrSet *model.ResourceRecordSet) bool {
+	if current.TTL != rrSet.TTL || len(current.ResourceRecords) != len(rrSet.ResourceRecords) {
+		return false
+	}
+
+	return len(recordsDifference(current.ResourceRecords, rrSet.ResourceRecords)) == 0 &&
+		len(recordsDifference(rrSet.ResourceRecords, current.ResourceRecords)) == 0
+}
@@ -0,0 +1,168 @@
+package zone
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+func TestChangeBatchActions(t *testing.T) {
+	zone := &model.Zone{
+		Name: "example.com.",
+		ResourceRecordSets: []model.ResourceRecordSet{
+			{
+				Name:            "www.example.com.",
+				Type:            model.RRTypeA,
+				TTL:             300,
+				ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.1"}, {Value: "192.0.2.2"}},
+				Comment:         "web servers",
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		batch      []ChangeBatchAction
+		wantAction model.ChangeActionType
+		wantErr    error
+	}{
+		{
+			name: "create a new record set",
+			batch: []ChangeBatchAction{{
+				ActionType: ChangeBatchActionCreate,
+				ResourceRecordSet: &model.ResourceRecordSet{
+					Name:            "api.example.com",
+					Type:            model.RRTypeA,
+					TTL:             300,
+					ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.3"}},
+				},
+			}},
+			wantAction: model.ChangeActionTypeUpsert,
+		},
+		{
+			name: "create an existing record set",
+			batch: []ChangeBatchAction{{
+				ActionType: ChangeBatchActionCreate,
+				ResourceRecordSet: &model.ResourceRecordSet{
+					Name:            "WWW.example.com.",
+					Type:            model.RRTypeA,
+					TTL:             300,
+					ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.3"}},
+				},
+			}},
+			wantErr: ErrRecordSetExists,
+		},
+		{
+			name: "upsert an existing record set",
+			batch: []ChangeBatchAction{{
+				ActionType: ChangeBatchActionUpsert,
+				ResourceRecordSet: &model.ResourceRecordSet{
+					Name:            "www.example.com.",
+					Type:            model.RRTypeA,
+					TTL:             60,
+					ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.3"}},
+				},
+			}},
+			wantAction: model.ChangeActionTypeUpsert,
+		},
+		{
+			name: "delete a matching record set",
+			batch: []ChangeBatchAction{{
+				ActionType: ChangeBatchActionDelete,
+				ResourceRecordSet: &model.ResourceRecordSet{
+					Name:            "www.example.com.",
+					Type:            model.RRTypeA,
+					TTL:             300,
+					ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.2"}, {Value: "192.0.2.1"}},
+				},
+			}},
+			wantAction: model.ChangeActionTypeDelete,
+		},
+		{
+			name: "delete with other records",
+			batch: []ChangeBatchAction{{
+				ActionType: ChangeBatchActionDelete,
+				ResourceRecordSet: &model.ResourceRecordSet{
+					Name:            "www.example.com.",
+					Type:            model.RRTypeA,
+					TTL:             300,
+					ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.1"}},
+				},
+			}},
+			wantErr: ErrRecordSetMismatch,
+		},
+		{
+			name: "delete with another TTL",
+			batch: []ChangeBatchAction{{
+				ActionType: ChangeBatchActionDelete,
+				ResourceRecordSet: &model.ResourceRecordSet{
+					Name:            "www.example.com.",
+					Type:            model.RRTypeA,
+					TTL:             60,
+					ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.1"}, {Value: "192.0.2.2"}},
+				},
+			}},
+			wantErr: ErrRecordSetMismatch,
+		},
+		{
+			name: "unknown action",
+			batch: []ChangeBatchAction{{
+				ActionType:        "REPLACE",
+				ResourceRecordSet: &model.ResourceRecordSet{Name: "www.example.com.", Type: model.RRTypeA},
+			}},
+			wantErr: ErrInvalidBatchAction,
+		},
+		{
+			name:    "missing record set",
+			batch:   []ChangeBatchAction{{ActionType: ChangeBatchActionUpsert}},
+			wantErr: ErrMissingRecordSet,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actions, err := changeBatchActions(zone, tt.batch)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				var errs ValidationErrors
+				require.ErrorAs(t, err, &errs)
+				assert.Equal(t, 0, errs[0].Action)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, actions, 1)
+			assert.Equal(t, tt.wantAction, actions[0].ActionType)
+		})
+	}
+
+	t.Run("delete keeps the metadata of the existing record set", func(t *testing.T) {
+		actions, err := changeBatchActions(zone, []ChangeBatchAction{{
+			ActionType: ChangeBatchActionDelete,
+			ResourceRecordSet: &model.ResourceRecordSet{
+				Name:            "www.example.com",
+				Type:            model.RRTypeA,
+				TTL:             300,
+				ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.1"}, {Value: "192.0.2.2"}},
+			},
+		}})
+		require.NoError(t, err)
+		assert.Equal(t, "web servers", actions[0].ResourceRecordSet.Comment)
+	})
+
+	t.Run("delete of a missing record set is left to validation", func(t *testing.T) {
+		rrSet := &model.ResourceRecordSet{Name: "missing.example.com.", Type: model.RRTypeA, TTL: 300}
+		actions, err := changeBatchActions(zone, []ChangeBatchAction{{
+			ActionType:        ChangeBatchActionDelete,
+			ResourceRecordSet: rrSet,
+		}})
+		require.NoError(t, err)
+
+		change := model.NewChange(zone.ID, model.ChangeStatusPending, actions)
+		require.ErrorIs(t, validateChanges(zone, &change), ErrNoSuchRecordSet)
+	})
+}
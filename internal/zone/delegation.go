@@ -0,0 +1,219 @@
+package zone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	bdns "github.com/davidseybold/beacondns/internal/dns"
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/repository"
+)
+
+type delegationMode int
+
+const (
+	// delegationModeSync updates the delegation in the parent zone only if one exists.
+	delegationModeSync delegationMode = iota
+	// delegationModeCreate creates the delegation in the parent zone if it does not exist.
+	delegationModeCreate
+	// delegationModeRemove removes the delegation from the parent zone.
+	delegationModeRemove
+)
+
+// syncParentDelegation brings the NS and DS record sets for childName in its closest hosted
+// parent zone in line with the apex of the child zone. The parent is updated through a
+// change of its own, written with the given registry so that it commits together with the
+// change to the child. Any extra record sets, such as the CNAMEs of an RFC 2317 delegation,
+// are upserted in the parent as part of the same change, and removing the delegation of an
+// RFC 2317 classless zone also removes those CNAMEs. Other CNAMEs that alias into the child
+// are left alone. Nothing happens when no parent zone is hosted.
+func syncParentDelegation(
+	ctx context.Context,
+	r repository.Registry,
+	childName string,
+	mode delegationMode,
+	extra ...model.ResourceRecordSet,
+) error {
+	parent, err := findParentZone(ctx, r, childName)
+	if err != nil || parent == nil {
+		return err
+	}
+
+	var current []model.ResourceRecordSet
+	for _, rrSet := range parent.ResourceRecordSets {
+		if isDelegationRecordSet(childName, rrSet) ||
+			(mode == delegationModeRemove && isClasslessDelegationCNAME(childName, rrSet)) {
+			current = append(current, rrSet)
+		}
+	}
+
+	if mode == delegationModeSync && !slices.ContainsFunc(current, func(rrSet model.ResourceRecordSet) bool {
+		return rrSet.Type == model.RRTypeNS
+	}) {
+		return nil
+	}
+
+	var desired []model.ResourceRecordSet
+	if mode != delegationModeRemove {
+		childRRSets, getErr := r.GetZoneRepository().GetZoneResourceRecordSets(ctx, childName)
+		if getErr != nil {
+			return getErr
+		}
+
+		desired, err = delegationRecordSets(childName, childRRSets)
+		if err != nil {
+			return err
+		}
+		desired = append(desired, extra...)
+	}
+
+	actions := diffToChangeActions(diffResourceRecordSets(current, desired))
+	if len(actions) == 0 {
+		return nil
+	}
+
+	change := model.NewChange(parent.ID, model.ChangeStatusPending, actions)
+	if err = validateChanges(parent, &change); err != nil {
+		return invalidChangeError(err, "failed to update delegation in parent zone "+parent.Name)
+	}
+
+	return writeChange(ctx, r, model.AuditOperationSyncDelegation, parent, &change)
+}
+
+// findParentZone returns the closest hosted zone that encloses zoneName, or nil if there is
+// none. The zone is locked for the transaction of r, since it is returned to be changed.
+func findParentZone(ctx context.Context, r repository.Registry, zoneName string) (*model.Zone, error) {
+	zoneName = dns.Fqdn(zoneName)
+	for off, end := dns.NextLabel(zoneName, 0); !end; off, end = dns.NextLabel(zoneName, off) {
+		parent, err := r.GetZoneRepository().GetZoneForUpdate(ctx, zoneName[off:])
+		if errors.Is(err, repository.ErrEntityNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		return parent, nil
+	}
+
+	return nil, nil
+}
+
+// delegationRecordSets returns the record sets a parent zone needs to delegate to the child
+// zone: a copy of the apex NS record set and, when the child is signed, DS records for each
+// of its secure entry point keys.
+func delegationRecordSets(childName string, childRRSets []model.ResourceRecordSet) ([]model.ResourceRecordSet, error) {
+	var delegation []model.ResourceRecordSet
+	for _, rrSet := range childRRSets {
+		if !strings.EqualFold(dns.Fqdn(rrSet.Name), childName) {
+			continue
+		}
+
+		switch rrSet.Type {
+		case model.RRTypeNS:
+			delegation = append(delegation, model.ResourceRecordSet{
+				Name:            childName,
+				Type:            model.RRTypeNS,
+				TTL:             rrSet.TTL,
+				ResourceRecords: slices.Clone(rrSet.ResourceRecords),
+			})
+		case model.RRTypeDNSKEY:
+			ds, err := dsRecordSet(childName, rrSet)
+			if err != nil {
+				return nil, err
+			}
+			if ds != nil {
+				delegation = append(delegation, *ds)
+			}
+		}
+	}
+
+	return delegation, nil
+}
+
+func dsRecordSet(childName string, dnskeySet model.ResourceRecordSet) (*model.ResourceRecordSet, error) {
+	var records []model.ResourceRecord
+	for _, rr := range dnskeySet.ResourceRecords {
+		parsed, err := dns.NewRR(fmt.Sprintf("%s %d IN DNSKEY %s", childName, dnskeySet.TTL, rr.Value))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse DNSKEY record %q: %w", rr.Value, err)
+		}
+
+		key, ok := parsed.(*dns.DNSKEY)
+		if !ok || key.Flags&dns.SEP == 0 {
+			continue
+		}
+
+		if ds := key.ToDS(dns.SHA256); ds != nil {
+			records = append(records, model.ResourceRecord{Value: bdns.RDataString(ds)})
+		}
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	return &model.ResourceRecordSet{
+		Name:            childName,
+		Type:            model.RRTypeDS,
+		TTL:             dnskeySet.TTL,
+		ResourceRecords: records,
+	}, nil
+}
+
+func isDelegationRecordSet(childName string, rrSet model.ResourceRecordSet) bool {
+	return strings.EqualFold(dns.Fqdn(rrSet.Name), childName) &&
+		(rrSet.Type == model.RRTypeNS || rrSet.Type == model.RRTypeDS)
+}
+
+// isClasslessDelegationCNAME reports whether rrSet is one of the CNAME record sets the
+// enclosing zone of an RFC 2317 classless zone holds to delegate an address into it, such as
+// 5.2.0.192.in-addr.arpa. CNAME 5.0-63.2.0.192.in-addr.arpa. for the zone
+// 0-63.2.0.192.in-addr.arpa. It is false for any zone not named after an address range.
+func isClasslessDelegationCNAME(zoneName string, rrSet model.ResourceRecordSet) bool {
+	if rrSet.Type != model.RRTypeCNAME || len(rrSet.ResourceRecords) != 1 {
+		return false
+	}
+
+	zoneName = dns.Fqdn(zoneName)
+	if !dns.IsSubDomain(ipv4ReverseSuffix, zoneName) {
+		return false
+	}
+
+	labels := dns.SplitDomainName(zoneName)
+	first, last, ok := strings.Cut(labels[0], "-")
+	if !ok {
+		return false
+	}
+	firstAddr, err := strconv.Atoi(first)
+	if err != nil {
+		return false
+	}
+	lastAddr, err := strconv.Atoi(last)
+	if err != nil || firstAddr < 0 || lastAddr > 255 || firstAddr > lastAddr {
+		return false
+	}
+
+	host, owner, _ := strings.Cut(dns.Fqdn(rrSet.Name), ".")
+	hostAddr, err := strconv.Atoi(host)
+	if err != nil || hostAddr < firstAddr || hostAddr > lastAddr ||
+		!strings.EqualFold(owner, strings.Join(labels[1:], ".")+".") {
+		return false
+	}
+
+	return strings.EqualFold(dns.Fqdn(rrSet.ResourceRecords[0].Value), host+"."+zoneName)
+}
+
+// changesDelegation reports whether any of the actions touch the apex record sets of the
+// zone that a parent zone copies into its delegation.
+func changesDelegation(zoneName string, actions []model.ChangeAction) bool {
+	return slices.ContainsFunc(actions, func(action model.ChangeAction) bool {
+		return strings.EqualFold(dns.Fqdn(action.ResourceRecordSet.Name), zoneName) &&
+			(action.ResourceRecordSet.Type == model.RRTypeNS || action.ResourceRecordSet.Type == model.RRTypeDNSKEY)
+	})
+}
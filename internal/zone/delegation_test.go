@@ -0,0 +1,198 @@
+package zone
+
+import (
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bdns "github.com/davidseybold/beacondns/internal/dns"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+func TestDelegationRecordSets(t *testing.T) {
+	ksk := generateDNSKEY(t, "dev.example.com.", dns.ZONE|dns.SEP)
+	zsk := generateDNSKEY(t, "dev.example.com.", dns.ZONE)
+
+	childRRSets := []model.ResourceRecordSet{
+		model.NewNS("dev.example.com.", 172800, []string{"ns1.beacondns.org.", "ns2.beacondns.org."}),
+		{
+			Name:            "www.dev.example.com.",
+			Type:            model.RRTypeNS,
+			TTL:             300,
+			ResourceRecords: []model.ResourceRecord{{Value: "ns.elsewhere.net."}},
+		},
+		{
+			Name: "dev.example.com.",
+			Type: model.RRTypeDNSKEY,
+			TTL:  3600,
+			ResourceRecords: []model.ResourceRecord{
+				{Value: bdns.RDataString(ksk)},
+				{Value: bdns.RDataString(zsk)},
+			},
+		},
+	}
+
+	got, err := delegationRecordSets("dev.example.com.", childRRSets)
+	require.NoError(t, err)
+
+	want := []model.ResourceRecordSet{
+		model.NewNS("dev.example.com.", 172800, []string{"ns1.beacondns.org.", "ns2.beacondns.org."}),
+		{
+			Name:            "dev.example.com.",
+			Type:            model.RRTypeDS,
+			TTL:             3600,
+			ResourceRecords: []model.ResourceRecord{{Value: bdns.RDataString(ksk.ToDS(dns.SHA256))}},
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestDelegationRecordSetsUnsigned(t *testing.T) {
+	childRRSets := []model.ResourceRecordSet{
+		model.NewNS("dev.example.com.", 172800, []string{"ns1.beacondns.org."}),
+	}
+
+	got, err := delegationRecordSets("dev.example.com.", childRRSets)
+	require.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, model.RRTypeNS, got[0].Type)
+}
+
+func TestChangesDelegation(t *testing.T) {
+	apexNS := model.NewNS("dev.example.com.", 300, []string{"ns1.beacondns.org."})
+	childNS := model.NewNS("sub.dev.example.com.", 300, []string{"ns1.beacondns.org."})
+	apexA := model.ResourceRecordSet{Name: "dev.example.com.", Type: model.RRTypeA}
+
+	assert.True(t, changesDelegation("dev.example.com.", []model.ChangeAction{
+		model.NewChangeAction(model.ChangeActionTypeUpsert, &apexA),
+		model.NewChangeAction(model.ChangeActionTypeUpsert, &apexNS),
+	}))
+	assert.False(t, changesDelegation("dev.example.com.", []model.ChangeAction{
+		model.NewChangeAction(model.ChangeActionTypeUpsert, &apexA),
+		model.NewChangeAction(model.ChangeActionTypeUpsert, &childNS),
+	}))
+}
+
+func TestIsClasslessDelegationCNAME(t *testing.T) {
+	cname := func(name, target string) model.ResourceRecordSet {
+		return model.ResourceRecordSet{
+			Name:            name,
+			Type:            model.RRTypeCNAME,
+			TTL:             300,
+			ResourceRecords: []model.ResourceRecord{{Value: target}},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		zoneName string
+		rrSet    model.ResourceRecordSet
+		want     bool
+	}{
+		{
+			name:     "address in range",
+			zoneName: "0-63.2.0.192.in-addr.arpa.",
+			rrSet:    cname("5.2.0.192.in-addr.arpa.", "5.0-63.2.0.192.in-addr.arpa."),
+			want:     true,
+		},
+		{
+			name:     "address out of range",
+			zoneName: "0-63.2.0.192.in-addr.arpa.",
+			rrSet:    cname("64.2.0.192.in-addr.arpa.", "64.0-63.2.0.192.in-addr.arpa."),
+		},
+		{
+			name:     "other target in zone",
+			zoneName: "0-63.2.0.192.in-addr.arpa.",
+			rrSet:    cname("5.2.0.192.in-addr.arpa.", "6.0-63.2.0.192.in-addr.arpa."),
+		},
+		{
+			name:     "owner outside enclosing zone",
+			zoneName: "0-63.2.0.192.in-addr.arpa.",
+			rrSet:    cname("5.3.0.192.in-addr.arpa.", "5.0-63.2.0.192.in-addr.arpa."),
+		},
+		{
+			name:     "forward zone",
+			zoneName: "dev.example.com.",
+			rrSet:    cname("api.example.com.", "lb.dev.example.com."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isClasslessDelegationCNAME(tt.zoneName, tt.rrSet))
+		})
+	}
+}
+
+func TestSyncParentDelegationRemoveKeepsCNAMEs(t *testing.T) {
+	cname := func(name, target string) model.ResourceRecordSet {
+		return model.ResourceRecordSet{
+			Name:            name,
+			Type:            model.RRTypeCNAME,
+			TTL:             300,
+			ResourceRecords: []model.ResourceRecord{{Value: target}},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		childName string
+		parent    string
+		removed   []model.ResourceRecordSet
+		kept      []model.ResourceRecordSet
+	}{
+		{
+			name:      "forward zone",
+			childName: "dev.example.com.",
+			parent:    "example.com.",
+			removed:   []model.ResourceRecordSet{model.NewNS("dev.example.com.", 300, []string{"ns1.beacondns.org."})},
+			kept:      []model.ResourceRecordSet{cname("api.example.com.", "lb.dev.example.com.")},
+		},
+		{
+			name:      "classless zone",
+			childName: "0-63.2.0.192.in-addr.arpa.",
+			parent:    "2.0.192.in-addr.arpa.",
+			removed: []model.ResourceRecordSet{
+				model.NewNS("0-63.2.0.192.in-addr.arpa.", 300, []string{"ns1.beacondns.org."}),
+				cname("5.2.0.192.in-addr.arpa.", "5.0-63.2.0.192.in-addr.arpa."),
+			},
+			kept: []model.ResourceRecordSet{cname("www.2.0.192.in-addr.arpa.", "host.0-63.2.0.192.in-addr.arpa.")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parent := newHostedZone(tt.parent)
+			parent.ResourceRecordSets = append(parent.ResourceRecordSets, tt.removed...)
+			parent.ResourceRecordSets = append(parent.ResourceRecordSets, tt.kept...)
+			registry := newFakeRegistry(parent)
+
+			require.NoError(t, syncParentDelegation(adminContext(), registry, tt.childName, delegationModeRemove))
+
+			rrSets := registry.zones.zones[tt.parent].ResourceRecordSets
+			for _, rrSet := range tt.removed {
+				assert.NotContains(t, rrSets, rrSet)
+			}
+			for _, rrSet := range tt.kept {
+				assert.Contains(t, rrSets, rrSet)
+			}
+		})
+	}
+}
+
+func generateDNSKEY(t *testing.T, name string, flags uint16) *dns.DNSKEY {
+	t.Helper()
+
+	key := &dns.DNSKEY{
+		Hdr:       dns.RR_Header{Name: name, Rrtype: dns.TypeDNSKEY, Class: dns.ClassINET, Ttl: 3600},
+		Flags:     flags,
+		Protocol:  3,
+		Algorithm: dns.ECDSAP256SHA256,
+	}
+	_, err := key.Generate(256)
+	require.NoError(t, err)
+
+	return key
+}
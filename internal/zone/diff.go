@@ -0,0 +1,154 @@
+package zone
+
+import (
+	"strings"
+
+	"github.com/miekg/dns"
+
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+// diffResourceRecordSets compares two states of a zone and reports the record sets that
+// were added, removed or modified going from before to after. Record sets are matched by
+// name and type, and a record set is modified when its TTL or any of its values differ.
+func diffResourceRecordSets(before, after []model.ResourceRecordSet) model.ZoneDiff {
+	diff := model.ZoneDiff{
+		Added:    []model.ResourceRecordSet{},
+		Removed:  []model.ResourceRecordSet{},
+		Modified: []model.ResourceRecordSetModification{},
+	}
+
+	beforeByKey := make(map[string]model.ResourceRecordSet, len(before))
+	for _, rrSet := range before {
+		beforeByKey[rrSetKey(rrSet.Name, rrSet.Type)] = rrSet
+	}
+
+	afterKeys := make(map[string]struct{}, len(after))
+	for _, rrSet := range after {
+		key := rrSetKey(rrSet.Name, rrSet.Type)
+		afterKeys[key] = struct{}{}
+
+		prev, ok := beforeByKey[key]
+		if !ok {
+			diff.Added = append(diff.Added, rrSet)
+			continue
+		}
+
+		addedRecords := recordsDifference(rrSet.ResourceRecords, prev.ResourceRecords)
+		removedRecords := recordsDifference(prev.ResourceRecords, rrSet.ResourceRecords)
+		if prev.TTL == rrSet.TTL && len(addedRecords) == 0 && len(removedRecords) == 0 {
+			continue
+		}
+
+		diff.Modified = append(diff.Modified, model.ResourceRecordSetModification{
+			Before:         prev,
+			After:          rrSet,
+			AddedRecords:   addedRecords,
+			RemovedRecords: removedRecords,
+		})
+	}
+
+	for _, rrSet := range before {
+		if _, ok := afterKeys[rrSetKey(rrSet.Name, rrSet.Type)]; !ok {
+			diff.Removed = append(diff.Removed, rrSet)
+		}
+	}
+
+	return diff
+}
+
+// diffToChangeActions returns the change actions that turn the before state of a diff into
+// its after state. SOA record sets are left out since they are managed by Beacon. Since a
+// diff only covers the DNS data of record sets, modified record sets keep their tags and
+// comment.
+func diffToChangeActions(diff model.ZoneDiff) []model.ChangeAction {
+	actions := make([]model.ChangeAction, 0, len(diff.Added)+len(diff.Modified)+len(diff.Removed))
+
+	for i := range diff.Added {
+		if diff.Added[i].Type == model.RRTypeSOA {
+			continue
+		}
+		actions = append(actions, model.NewChangeAction(model.ChangeActionTypeUpsert, &diff.Added[i]))
+	}
+
+	for _, modification := range diff.Modified {
+		if modification.After.Type == model.RRTypeSOA {
+			continue
+		}
+		rrSet := modification.After
+		rrSet.Tags = modification.Before.Tags
+		rrSet.Comment = modification.Before.Comment
+		actions = append(actions, model.NewChangeAction(model.ChangeActionTypeUpsert, &rrSet))
+	}
+
+	for i := range diff.Removed {
+		if diff.Removed[i].Type == model.RRTypeSOA {
+			continue
+		}
+		actions = append(actions, model.NewChangeAction(model.ChangeActionTypeDelete, &diff.Removed[i]))
+	}
+
+	return actions
+}
+
+// projectChanges returns the resource record sets the zone would contain after applying the
+// change actions to rrSets, in order. rrSets itself is left untouched.
+func projectChanges(rrSets []model.ResourceRecordSet, actions []model.ChangeAction) []model.ResourceRecordSet {
+	projected := make([]model.ResourceRecordSet, 0, len(rrSets)+len(actions))
+	index := make(map[string]int, len(rrSets))
+	for _, rrSet := range rrSets {
+		index[rrSetKey(rrSet.Name, rrSet.Type)] = len(projected)
+		projected = append(projected, rrSet)
+	}
+
+	removed := make(map[string]struct{})
+	for _, action := range actions {
+		key := rrSetKey(action.ResourceRecordSet.Name, action.ResourceRecordSet.Type)
+		switch action.ActionType {
+		case model.ChangeActionTypeUpsert:
+			delete(removed, key)
+			if i, ok := index[key]; ok {
+				projected[i] = *action.ResourceRecordSet
+				continue
+			}
+			index[key] = len(projected)
+			projected = append(projected, *action.ResourceRecordSet)
+		case model.ChangeActionTypeDelete:
+			removed[key] = struct{}{}
+		}
+	}
+
+	if len(removed) == 0 {
+		return projected
+	}
+
+	result := make([]model.ResourceRecordSet, 0, len(projected))
+	for _, rrSet := range projected {
+		if _, ok := removed[rrSetKey(rrSet.Name, rrSet.Type)]; !ok {
+			result = append(result, rrSet)
+		}
+	}
+
+	return result
+}
+
+func rrSetKey(name string, rrType model.RRType) string {
+	return strings.ToLower(dns.Fqdn(name)) + "/" + string(rrType)
+}
+
+// recordsDifference returns the records in a that are not in b.
+func recordsDifference(a, b []model.ResourceRecord) []model.ResourceRecord {
+	inB := make(map[string]struct{}, len(b))
+	for _, rr := range b {
+		inB[rr.Value] = struct{}{}
+	}
+
+	var diff []model.ResourceRecord
+	for _, rr := range a {
+		if _, ok := inB[rr.Value]; !ok {
+			diff = append(diff, rr)
+		}
+	}
+
+	return diff
+}
@@ -0,0 +1,199 @@
+package zone
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+func TestDiffResourceRecordSets(t *testing.T) {
+	soa := model.ResourceRecordSet{
+		Name: "example.com.",
+		Type: model.RRTypeSOA,
+		TTL:  86400,
+		ResourceRecords: []model.ResourceRecord{
+			{Value: "ns1.beacondns.org. hostmaster.beacondns.org. 1 7200 900 1209600 86400"},
+		},
+	}
+	www := model.ResourceRecordSet{
+		Name:            "www.example.com.",
+		Type:            model.RRTypeA,
+		TTL:             300,
+		ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.1"}},
+	}
+	wwwUpdated := model.ResourceRecordSet{
+		Name:            "WWW.example.com.",
+		Type:            model.RRTypeA,
+		TTL:             300,
+		ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.1"}, {Value: "192.0.2.2"}},
+	}
+	mail := model.ResourceRecordSet{
+		Name:            "example.com.",
+		Type:            model.RRTypeMX,
+		TTL:             3600,
+		ResourceRecords: []model.ResourceRecord{{Value: "10 mail.example.com."}},
+	}
+	txt := model.ResourceRecordSet{
+		Name:            "example.com.",
+		Type:            model.RRTypeTXT,
+		TTL:             3600,
+		ResourceRecords: []model.ResourceRecord{{Value: "\"v=spf1 -all\""}},
+	}
+	txtNewTTL := txt
+	txtNewTTL.TTL = 60
+
+	tests := []struct {
+		name   string
+		before []model.ResourceRecordSet
+		after  []model.ResourceRecordSet
+		want   model.ZoneDiff
+	}{
+		{
+			name:   "identical",
+			before: []model.ResourceRecordSet{soa, www},
+			after:  []model.ResourceRecordSet{soa, www},
+			want: model.ZoneDiff{
+				Added:    []model.ResourceRecordSet{},
+				Removed:  []model.ResourceRecordSet{},
+				Modified: []model.ResourceRecordSetModification{},
+			},
+		},
+		{
+			name:   "added, removed and modified",
+			before: []model.ResourceRecordSet{soa, www, mail},
+			after:  []model.ResourceRecordSet{soa, wwwUpdated, txt},
+			want: model.ZoneDiff{
+				Added:   []model.ResourceRecordSet{txt},
+				Removed: []model.ResourceRecordSet{mail},
+				Modified: []model.ResourceRecordSetModification{
+					{
+						Before:       www,
+						After:        wwwUpdated,
+						AddedRecords: []model.ResourceRecord{{Value: "192.0.2.2"}},
+					},
+				},
+			},
+		},
+		{
+			name:   "ttl change",
+			before: []model.ResourceRecordSet{txt},
+			after:  []model.ResourceRecordSet{txtNewTTL},
+			want: model.ZoneDiff{
+				Added:   []model.ResourceRecordSet{},
+				Removed: []model.ResourceRecordSet{},
+				Modified: []model.ResourceRecordSetModification{
+					{Before: txt, After: txtNewTTL},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := diffResourceRecordSets(tt.before, tt.after)
+			assert.Equal(t, tt.want, diff)
+			assert.Equal(t, tt.name == "identical", diff.IsEmpty())
+		})
+	}
+}
+
+func TestDiffToChangeActions(t *testing.T) {
+	soa := model.ResourceRecordSet{Name: "example.com.", Type: model.RRTypeSOA}
+	added := model.ResourceRecordSet{Name: "a.example.com.", Type: model.RRTypeA}
+	removed := model.ResourceRecordSet{Name: "b.example.com.", Type: model.RRTypeA}
+	modified := model.ResourceRecordSet{Name: "c.example.com.", Type: model.RRTypeA, TTL: 60}
+	tagged := model.ResourceRecordSet{
+		Name:    "c.example.com.",
+		Type:    model.RRTypeA,
+		TTL:     300,
+		Tags:    model.Tags{"team": "payments"},
+		Comment: "checkout frontend",
+	}
+
+	diff := model.ZoneDiff{
+		Added:   []model.ResourceRecordSet{added, soa},
+		Removed: []model.ResourceRecordSet{removed},
+		Modified: []model.ResourceRecordSetModification{
+			{Before: tagged, After: modified},
+		},
+	}
+
+	actions := diffToChangeActions(diff)
+
+	assert.Len(t, actions, 3)
+	assert.Equal(t, model.ChangeActionTypeUpsert, actions[0].ActionType)
+	assert.Equal(t, added, *actions[0].ResourceRecordSet)
+	assert.Equal(t, model.ChangeActionTypeUpsert, actions[1].ActionType)
+	assert.Equal(t, modified.TTL, actions[1].ResourceRecordSet.TTL)
+	assert.Equal(t, tagged.Tags, actions[1].ResourceRecordSet.Tags)
+	assert.Equal(t, tagged.Comment, actions[1].ResourceRecordSet.Comment)
+	assert.Equal(t, model.ChangeActionTypeDelete, actions[2].ActionType)
+	assert.Equal(t, removed, *actions[2].ResourceRecordSet)
+}
+
+func TestProjectChanges(t *testing.T) {
+	www := model.ResourceRecordSet{
+		Name:            "www.example.com.",
+		Type:            model.RRTypeA,
+		TTL:             300,
+		ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.1"}},
+	}
+	wwwUpdated := model.ResourceRecordSet{
+		Name:            "www.example.com.",
+		Type:            model.RRTypeA,
+		TTL:             60,
+		ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.2"}},
+	}
+	api := model.ResourceRecordSet{
+		Name:            "api.example.com.",
+		Type:            model.RRTypeA,
+		TTL:             300,
+		ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.3"}},
+	}
+
+	tests := []struct {
+		name    string
+		rrSets  []model.ResourceRecordSet
+		actions []model.ChangeAction
+		want    []model.ResourceRecordSet
+	}{
+		{
+			name:    "upsert new record set",
+			rrSets:  []model.ResourceRecordSet{www},
+			actions: []model.ChangeAction{model.NewChangeAction(model.ChangeActionTypeUpsert, &api)},
+			want:    []model.ResourceRecordSet{www, api},
+		},
+		{
+			name:    "upsert existing record set",
+			rrSets:  []model.ResourceRecordSet{www, api},
+			actions: []model.ChangeAction{model.NewChangeAction(model.ChangeActionTypeUpsert, &wwwUpdated)},
+			want:    []model.ResourceRecordSet{wwwUpdated, api},
+		},
+		{
+			name:    "delete record set",
+			rrSets:  []model.ResourceRecordSet{www, api},
+			actions: []model.ChangeAction{model.NewChangeAction(model.ChangeActionTypeDelete, &www)},
+			want:    []model.ResourceRecordSet{api},
+		},
+		{
+			name:   "delete then upsert",
+			rrSets: []model.ResourceRecordSet{www},
+			actions: []model.ChangeAction{
+				model.NewChangeAction(model.ChangeActionTypeDelete, &www),
+				model.NewChangeAction(model.ChangeActionTypeUpsert, &wwwUpdated),
+			},
+			want: []model.ResourceRecordSet{wwwUpdated},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := slices.Clone(tt.rrSets)
+			assert.Equal(t, tt.want, projectChanges(tt.rrSets, tt.actions))
+			assert.Equal(t, before, tt.rrSets)
+		})
+	}
+}
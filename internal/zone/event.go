@@ -24,15 +24,26 @@ func NewCreateZoneEvent(zoneName string, changeID uuid.UUID) *model.Event {
 	})
 }
 
+// ChangeRRSetEvent announces a change to the record sets of a zone. PTRChanges are the
+// changes made to hosted reverse zones to keep their PTR records in line with it, which are
+// applied along with it rather than through events of their own.
 type ChangeRRSetEvent struct {
+	ZoneName   string       `json:"zoneName"`
+	ChangeID   uuid.UUID    `json:"changeId"`
+	PTRChanges []ZoneChange `json:"ptrChanges,omitempty"`
+}
+
+// ZoneChange names a change and the zone it was made to.
+type ZoneChange struct {
 	ZoneName string    `json:"zoneName"`
 	ChangeID uuid.UUID `json:"changeId"`
 }
 
-func NewChangeRRSetEvent(zoneName string, changeID uuid.UUID) *model.Event {
+func NewChangeRRSetEvent(zoneName string, changeID uuid.UUID, ptrChanges ...ZoneChange) *model.Event {
 	return model.NewEvent(EventTypeChangeRRSet, &ChangeRRSetEvent{
-		ZoneName: zoneName,
-		ChangeID: changeID,
+		ZoneName:   zoneName,
+		ChangeID:   changeID,
+		PTRChanges: ptrChanges,
 	})
 }
 
@@ -0,0 +1,91 @@
+package zone
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	bdns "github.com/davidseybold/beacondns/internal/dns"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+// writeZoneFile writes the zone as an RFC 1035 master file. The SOA record set is written
+// first, followed by the apex NS record set and then every other record set in canonical
+// name order (RFC 4034 section 6.1) and type order, so that exporting the same zone twice
+// yields identical output.
+func writeZoneFile(w io.Writer, zone *model.Zone) error {
+	zoneName := dns.Fqdn(zone.Name)
+
+	rrSets := slices.Clone(zone.ResourceRecordSets)
+	slices.SortStableFunc(rrSets, func(a, b model.ResourceRecordSet) int {
+		if c := cmp.Compare(exportRank(zoneName, a), exportRank(zoneName, b)); c != 0 {
+			return c
+		}
+		if c := compareCanonicalNames(a.Name, b.Name); c != 0 {
+			return c
+		}
+		return cmp.Compare(dns.StringToType[string(a.Type)], dns.StringToType[string(b.Type)])
+	})
+
+	if _, err := fmt.Fprintf(w, "$ORIGIN %s\n", zoneName); err != nil {
+		return err
+	}
+
+	for i := range rrSets {
+		rrs, err := bdns.ParseRRs(&rrSets[i])
+		if err != nil {
+			return fmt.Errorf("failed to parse record set %s %s: %w", rrSets[i].Name, rrSets[i].Type, err)
+		}
+
+		lines := make([]string, 0, len(rrs))
+		for _, rr := range rrs {
+			lines = append(lines, rr.String())
+		}
+		slices.Sort(lines)
+
+		for _, line := range lines {
+			if _, err = fmt.Fprintln(w, line); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+const (
+	exportRankSOA = iota
+	exportRankApexNS
+	exportRankOther
+)
+
+func exportRank(zoneName string, rrSet model.ResourceRecordSet) int {
+	isApex := dns.Fqdn(strings.ToLower(rrSet.Name)) == strings.ToLower(zoneName)
+	switch {
+	case isApex && rrSet.Type == model.RRTypeSOA:
+		return exportRankSOA
+	case isApex && rrSet.Type == model.RRTypeNS:
+		return exportRankApexNS
+	default:
+		return exportRankOther
+	}
+}
+
+// compareCanonicalNames orders domain names by their labels compared right to left,
+// case-insensitively.
+func compareCanonicalNames(a, b string) int {
+	aLabels := dns.SplitDomainName(strings.ToLower(dns.Fqdn(a)))
+	bLabels := dns.SplitDomainName(strings.ToLower(dns.Fqdn(b)))
+
+	for i, j := len(aLabels)-1, len(bLabels)-1; i >= 0 && j >= 0; i, j = i-1, j-1 {
+		if c := strings.Compare(aLabels[i], bLabels[j]); c != 0 {
+			return c
+		}
+	}
+
+	return cmp.Compare(len(aLabels), len(bLabels))
+}
@@ -0,0 +1,119 @@
+package zone
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+func TestWriteZoneFile(t *testing.T) {
+	zone := &model.Zone{
+		Name: "example.com.",
+		ResourceRecordSets: []model.ResourceRecordSet{
+			{
+				Name:            "www.example.com.",
+				Type:            model.RRTypeA,
+				TTL:             300,
+				ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.2"}, {Value: "192.0.2.1"}},
+			},
+			{
+				Name:            "example.com.",
+				Type:            model.RRTypeNS,
+				TTL:             172800,
+				ResourceRecords: []model.ResourceRecord{{Value: "ns1.beacondns.org."}, {Value: "ns2.beacondns.org."}},
+			},
+			{
+				Name:            "a.b.example.com.",
+				Type:            model.RRTypeTXT,
+				TTL:             300,
+				ResourceRecords: []model.ResourceRecord{{Value: `"v=spf1 -all"`}},
+			},
+			{
+				Name:            "b.example.com.",
+				Type:            model.RRTypeMX,
+				TTL:             300,
+				ResourceRecords: []model.ResourceRecord{{Value: "10 mail.example.com."}},
+			},
+			model.NewSOA("example.com.", 86400, "ns1.beacondns.org.", hostmasterEmail, 1, 7200, 900, 1209600, 86400),
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeZoneFile(&buf, zone))
+
+	want := strings.Join([]string{
+		"$ORIGIN example.com.",
+		"example.com.\t86400\tIN\tSOA\tns1.beacondns.org. hostmaster.beacondns.org. 1 7200 900 1209600 86400",
+		"example.com.\t172800\tIN\tNS\tns1.beacondns.org.",
+		"example.com.\t172800\tIN\tNS\tns2.beacondns.org.",
+		"b.example.com.\t300\tIN\tMX\t10 mail.example.com.",
+		"a.b.example.com.\t300\tIN\tTXT\t\"v=spf1 -all\"",
+		"www.example.com.\t300\tIN\tA\t192.0.2.1",
+		"www.example.com.\t300\tIN\tA\t192.0.2.2",
+		"",
+	}, "\n")
+	assert.Equal(t, want, buf.String())
+
+	zp := dns.NewZoneParser(strings.NewReader(buf.String()), "", "")
+	count := 0
+	for _, ok := zp.Next(); ok; _, ok = zp.Next() {
+		count++
+	}
+	require.NoError(t, zp.Err())
+	assert.Equal(t, 7, count)
+}
+
+func TestWriteZoneFileRoundTrip(t *testing.T) {
+	zoneFile := `
+www 300 IN A 192.0.2.1
+www 300 IN AAAA 2001:db8::1
+mail 300 IN MX 10 mx.example.net.
+_sip._tcp 300 IN SRV 10 5 5060 sip.example.com.
+txt 300 IN TXT "part one" "part two"
+caa 300 IN CAA 0 issue "letsencrypt.org"
+alias 300 IN CNAME www
+`
+
+	parsed, err := parseZoneFile("example.com.", strings.NewReader(zoneFile))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeZoneFile(&buf, &model.Zone{
+		Name:               "example.com.",
+		ResourceRecordSets: parsed.resourceRecordSets,
+	}))
+
+	reparsed, err := parseZoneFile("example.com.", &buf)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, parsed.resourceRecordSets, reparsed.resourceRecordSets)
+}
+
+func TestCompareCanonicalNames(t *testing.T) {
+	names := []string{
+		"z.example.com.",
+		"example.com.",
+		"A.example.com.",
+		"yljkjljk.a.example.com.",
+		"*.z.example.com.",
+		"zabc.a.example.com.",
+	}
+
+	want := []string{
+		"example.com.",
+		"A.example.com.",
+		"yljkjljk.a.example.com.",
+		"zabc.a.example.com.",
+		"z.example.com.",
+		"*.z.example.com.",
+	}
+
+	slices.SortFunc(names, compareCanonicalNames)
+	assert.Equal(t, want, names)
+}
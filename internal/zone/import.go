@@ -0,0 +1,96 @@
+package zone
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	bdns "github.com/davidseybold/beacondns/internal/dns"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+const (
+	skipReasonUnsupportedType  = "unsupported record type"
+	skipReasonUnsupportedClass = "unsupported record class"
+	skipReasonManaged          = "record is managed by Beacon"
+	skipReasonOutsideZone      = "record is not within zone"
+)
+
+type parsedZoneFile struct {
+	resourceRecordSets []model.ResourceRecordSet
+	skippedRecords     []model.SkippedRecord
+}
+
+// parseZoneFile parses an RFC 1035 master file for the given zone and groups its records
+// into resource record sets. Records that cannot be imported are returned as skipped
+// records instead of failing the parse. The apex SOA and NS records are always skipped
+// since Beacon manages them for every hosted zone.
+func parseZoneFile(zoneName string, r io.Reader) (*parsedZoneFile, error) {
+	zoneName = dns.Fqdn(strings.ToLower(zoneName))
+
+	zp := dns.NewZoneParser(r, zoneName, "")
+	zp.SetIncludeAllowed(false)
+
+	result := &parsedZoneFile{}
+	setIndex := make(map[string]int)
+
+	for rr, ok := zp.Next(); ok; rr, ok = zp.Next() {
+		hdr := rr.Header()
+		name := strings.ToLower(hdr.Name)
+		rrType := model.RRType(dns.TypeToString[hdr.Rrtype])
+
+		skip := func(reason string) {
+			result.skippedRecords = append(result.skippedRecords, model.SkippedRecord{
+				Name:   name,
+				Type:   string(rrType),
+				Reason: reason,
+			})
+		}
+
+		switch {
+		case hdr.Class != dns.ClassINET:
+			skip(skipReasonUnsupportedClass)
+			continue
+		case !dns.IsSubDomain(zoneName, name):
+			skip(skipReasonOutsideZone)
+			continue
+		case name == zoneName && (rrType == model.RRTypeSOA || rrType == model.RRTypeNS):
+			skip(skipReasonManaged)
+			continue
+		}
+
+		if _, ok = model.SupportedRRTypes[rrType]; !ok {
+			skip(skipReasonUnsupportedType)
+			continue
+		}
+
+		key := name + "/" + string(rrType)
+		value := model.ResourceRecord{Value: bdns.RDataString(rr)}
+
+		i, exists := setIndex[key]
+		if !exists {
+			setIndex[key] = len(result.resourceRecordSets)
+			result.resourceRecordSets = append(result.resourceRecordSets, model.ResourceRecordSet{
+				Name:            name,
+				Type:            rrType,
+				TTL:             hdr.Ttl,
+				ResourceRecords: []model.ResourceRecord{value},
+			})
+			continue
+		}
+
+		// RFC 2181 requires all records of an RRSet to share a TTL. Use the lowest one
+		// when the zone file disagrees with itself.
+		set := &result.resourceRecordSets[i]
+		set.TTL = min(set.TTL, hdr.Ttl)
+		set.ResourceRecords = append(set.ResourceRecords, value)
+	}
+
+	if err := zp.Err(); err != nil {
+		return nil, fmt.Errorf("failed to parse zone file: %w", err)
+	}
+
+	return result, nil
+}
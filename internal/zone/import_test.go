@@ -0,0 +1,119 @@
+package zone
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	tests := []struct {
+		name        string
+		zoneName    string
+		zoneFile    string
+		wantRRSets  []model.ResourceRecordSet
+		wantSkipped []model.SkippedRecord
+		wantErr     bool
+	}{
+		{
+			name:     "groups records into record sets",
+			zoneName: "example.com",
+			zoneFile: `
+www 300 IN A 192.0.2.1
+www 300 IN A 192.0.2.2
+mail 600 IN MX 10 mx1
+`,
+			wantRRSets: []model.ResourceRecordSet{
+				{
+					Name: "www.example.com.",
+					Type: model.RRTypeA,
+					TTL:  300,
+					ResourceRecords: []model.ResourceRecord{
+						{Value: "192.0.2.1"},
+						{Value: "192.0.2.2"},
+					},
+				},
+				{
+					Name:            "mail.example.com.",
+					Type:            model.RRTypeMX,
+					TTL:             600,
+					ResourceRecords: []model.ResourceRecord{{Value: "10 mx1.example.com."}},
+				},
+			},
+		},
+		{
+			name:     "uses lowest ttl for a record set",
+			zoneName: "example.com.",
+			zoneFile: `
+WWW 300 IN A 192.0.2.1
+www 60 IN A 192.0.2.2
+`,
+			wantRRSets: []model.ResourceRecordSet{
+				{
+					Name: "www.example.com.",
+					Type: model.RRTypeA,
+					TTL:  60,
+					ResourceRecords: []model.ResourceRecord{
+						{Value: "192.0.2.1"},
+						{Value: "192.0.2.2"},
+					},
+				},
+			},
+		},
+		{
+			name:     "skips managed, unsupported and out of zone records",
+			zoneName: "example.com",
+			zoneFile: `
+@ 3600 IN SOA ns1.other.net. hostmaster.other.net. 1 7200 900 1209600 86400
+@ 3600 IN NS ns1.other.net.
+host 300 IN AFSDB 1 afs.example.com.
+ns1.other.net. 300 IN A 192.0.2.10
+txt 300 IN TXT "hello world"
+`,
+			wantRRSets: []model.ResourceRecordSet{
+				{
+					Name:            "txt.example.com.",
+					Type:            model.RRTypeTXT,
+					TTL:             300,
+					ResourceRecords: []model.ResourceRecord{{Value: `"hello world"`}},
+				},
+			},
+			wantSkipped: []model.SkippedRecord{
+				{Name: "example.com.", Type: "SOA", Reason: skipReasonManaged},
+				{Name: "example.com.", Type: "NS", Reason: skipReasonManaged},
+				{Name: "host.example.com.", Type: "AFSDB", Reason: skipReasonUnsupportedType},
+				{Name: "ns1.other.net.", Type: "A", Reason: skipReasonOutsideZone},
+			},
+		},
+		{
+			name:     "invalid zone file",
+			zoneName: "example.com",
+			zoneFile: "www 300 IN A not-an-address\n",
+			wantErr:  true,
+		},
+		{
+			name:     "include is not allowed",
+			zoneName: "example.com",
+			zoneFile: "$INCLUDE /etc/passwd\n",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := parseZoneFile(tt.zoneName, strings.NewReader(tt.zoneFile))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRRSets, parsed.resourceRecordSets)
+			assert.Equal(t, tt.wantSkipped, parsed.skippedRecords)
+		})
+	}
+}
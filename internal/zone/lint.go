@@ -0,0 +1,397 @@
+package zone
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	bdns "github.com/davidseybold/beacondns/internal/dns"
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/recursive"
+)
+
+const (
+	lintRuleDanglingCNAME      = "dangling-cname"
+	lintRuleAliasTarget        = "alias-target"
+	lintRuleMissingAddress     = "missing-address"
+	lintRuleInBailiwickGlue    = "in-bailiwick-glue"
+	lintRuleOutOfZoneGlue      = "out-of-zone-glue"
+	lintRuleUnresolvableTarget = "unresolvable-target"
+)
+
+// Resolver resolves names outside of the zones hosted in Beacon. The zone linter uses it to
+// check that the names record sets point at exist.
+type Resolver interface {
+	Resolve(ctx context.Context, qname string, qtype uint16) (*recursive.Result, error)
+}
+
+var _ Resolver = (*recursive.Resolver)(nil)
+
+type lintRule func(zone *lintZone) []model.LintFinding
+
+var lintRules = []lintRule{
+	danglingCNAMERule,
+	aliasTargetRule,
+	missingAddressRule,
+	inBailiwickGlueRule,
+	txtPolicyRule,
+}
+
+// lintZoneRecords runs every lint rule over the record sets of the zone. Unlike the rules in
+// validate.go, which reject a change, lint rules report problems in the stored state of a
+// zone that DNS still lets it serve.
+func lintZoneRecords(zone *model.Zone) []model.LintFinding {
+	lz := newLintZone(zone)
+
+	findings := []model.LintFinding{}
+	for _, rule := range lintRules {
+		findings = append(findings, rule(lz)...)
+	}
+
+	return findings
+}
+
+// danglingCNAMERule reports CNAME record sets that alias a name inside the zone that does not
+// exist.
+func danglingCNAMERule(zone *lintZone) []model.LintFinding {
+	var findings []model.LintFinding
+	for _, rrSet := range zone.rrSets {
+		if rrSet.Type != model.RRTypeCNAME {
+			continue
+		}
+
+		for _, target := range recordTargets(rrSet) {
+			if !zone.authoritative(target) || zone.exists(target) {
+				continue
+			}
+
+			findings = append(findings, newLintFinding(
+				lintRuleDanglingCNAME,
+				model.LintSeverityError,
+				rrSet,
+				"CNAME target %s does not exist in the zone", target,
+			))
+		}
+	}
+
+	return findings
+}
+
+// aliasTargetRule reports MX, SRV and NS record sets that point at a CNAME in the zone. RFC
+// 2181 section 10.3 requires their targets to be names with address records.
+func aliasTargetRule(zone *lintZone) []model.LintFinding {
+	var findings []model.LintFinding
+	for _, rrSet := range zone.rrSets {
+		if rrSet.Type != model.RRTypeMX && rrSet.Type != model.RRTypeSRV && rrSet.Type != model.RRTypeNS {
+			continue
+		}
+
+		for _, target := range recordTargets(rrSet) {
+			if !zone.has(target, model.RRTypeCNAME) {
+				continue
+			}
+
+			findings = append(findings, newLintFinding(
+				lintRuleAliasTarget,
+				model.LintSeverityError,
+				rrSet,
+				"%s target %s is a CNAME, but %s records must point at a name with address records (RFC 2181 section 10.3)",
+				rrSet.Type, target, rrSet.Type,
+			))
+		}
+	}
+
+	return findings
+}
+
+// missingAddressRule reports MX and SRV record sets that point at a name inside the zone that
+// has no address records.
+func missingAddressRule(zone *lintZone) []model.LintFinding {
+	var findings []model.LintFinding
+	for _, rrSet := range zone.rrSets {
+		if rrSet.Type != model.RRTypeMX && rrSet.Type != model.RRTypeSRV {
+			continue
+		}
+
+		for _, target := range recordTargets(rrSet) {
+			if !zone.authoritative(target) || zone.has(target, model.RRTypeCNAME) || zone.hasAddress(target, true) {
+				continue
+			}
+
+			findings = append(findings, newLintFinding(
+				lintRuleMissingAddress,
+				model.LintSeverityWarning,
+				rrSet,
+				"%s target %s has no A or AAAA records in the zone", rrSet.Type, target,
+			))
+		}
+	}
+
+	return findings
+}
+
+// inBailiwickGlueRule reports NS record sets with a name server inside the zone that has no
+// address records. Resolvers cannot reach such a name server without the glue records. Name
+// servers outside the zone cannot have glue, and are checked by lintResolution instead.
+func inBailiwickGlueRule(zone *lintZone) []model.LintFinding {
+	var findings []model.LintFinding
+	for _, rrSet := range zone.rrSets {
+		if rrSet.Type != model.RRTypeNS {
+			continue
+		}
+
+		for _, target := range recordTargets(rrSet) {
+			if !dns.IsSubDomain(zone.name, target) || zone.hasAddress(target, false) {
+				continue
+			}
+
+			findings = append(findings, newLintFinding(
+				lintRuleInBailiwickGlue,
+				model.LintSeverityError,
+				rrSet,
+				"name server %s is inside the zone but has no A or AAAA glue records", target,
+			))
+		}
+	}
+
+	return findings
+}
+
+// lintResolution resolves the names outside the zone that CNAME, MX, SRV and NS record sets
+// point at and reports those that do not exist or have no address records. Name servers
+// outside the zone have no glue to fall back on, so one without address records makes the
+// delegation lame and is reported as an error. Names that could not be resolved at all are
+// reported for information only, since the failure may be transient.
+func lintResolution(ctx context.Context, resolver Resolver, zone *model.Zone) []model.LintFinding {
+	type resolution struct {
+		hasAddress bool
+		nxdomain   bool
+		err        error
+	}
+
+	resolved := make(map[string]resolution)
+	var findings []model.LintFinding
+	for _, rrSet := range zone.ResourceRecordSets {
+		switch rrSet.Type {
+		case model.RRTypeCNAME, model.RRTypeMX, model.RRTypeSRV, model.RRTypeNS:
+		default:
+			continue
+		}
+
+		for _, target := range recordTargets(rrSet) {
+			if dns.IsSubDomain(zone.Name, target) {
+				continue
+			}
+
+			res, ok := resolved[target]
+			if !ok {
+				res.hasAddress, res.nxdomain, res.err = resolveAddress(ctx, resolver, target)
+				resolved[target] = res
+			}
+
+			switch {
+			case res.err != nil:
+				findings = append(findings, newLintFinding(
+					lintRuleUnresolvableTarget,
+					model.LintSeverityInfo,
+					rrSet,
+					"could not resolve %s target %s: %s", rrSet.Type, target, res.err,
+				))
+			case rrSet.Type == model.RRTypeCNAME && res.nxdomain:
+				findings = append(findings, newLintFinding(
+					lintRuleUnresolvableTarget,
+					model.LintSeverityWarning,
+					rrSet,
+					"CNAME target %s does not exist", target,
+				))
+			case rrSet.Type == model.RRTypeNS && !res.hasAddress:
+				findings = append(findings, newLintFinding(
+					lintRuleOutOfZoneGlue,
+					model.LintSeverityError,
+					rrSet,
+					"out-of-zone name server %s has no glue and does not resolve to an A or AAAA record", target,
+				))
+			case rrSet.Type != model.RRTypeCNAME && !res.hasAddress:
+				findings = append(findings, newLintFinding(
+					lintRuleUnresolvableTarget,
+					model.LintSeverityWarning,
+					rrSet,
+					"%s target %s does not resolve to an A or AAAA record", rrSet.Type, target,
+				))
+			}
+		}
+	}
+
+	return findings
+}
+
+func resolveAddress(ctx context.Context, resolver Resolver, name string) (bool, bool, error) {
+	for _, qtype := range []uint16{dns.TypeA, dns.TypeAAAA} {
+		result, err := resolver.Resolve(ctx, name, qtype)
+		if err != nil {
+			return false, false, err
+		}
+
+		msg := result.AnswerPacket
+		if msg == nil {
+			continue
+		}
+
+		if msg.Rcode == dns.RcodeNameError {
+			return false, true, nil
+		}
+
+		if slices.ContainsFunc(msg.Answer, func(rr dns.RR) bool {
+			return rr.Header().Rrtype == qtype
+		}) {
+			return true, false, nil
+		}
+	}
+
+	return false, false, nil
+}
+
+func newLintFinding(
+	rule string,
+	severity model.LintSeverity,
+	rrSet model.ResourceRecordSet,
+	format string,
+	args ...any,
+) model.LintFinding {
+	return model.LintFinding{
+		Rule:     rule,
+		Severity: severity,
+		Name:     rrSet.Name,
+		Type:     rrSet.Type,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+// recordTargets returns the names that the records of a CNAME, MX, SRV or NS record set point
+// at. The root name, which MX and SRV records use to say that there is no service, and
+// records that do not parse are left out.
+func recordTargets(rrSet model.ResourceRecordSet) []string {
+	rrs, err := bdns.ParseRRs(&rrSet)
+	if err != nil {
+		return nil
+	}
+
+	var targets []string
+	for _, rr := range rrs {
+		var target string
+		switch v := rr.(type) {
+		case *dns.CNAME:
+			target = v.Target
+		case *dns.MX:
+			target = v.Mx
+		case *dns.SRV:
+			target = v.Target
+		case *dns.NS:
+			target = v.Ns
+		}
+
+		if target == "" || target == "." {
+			continue
+		}
+		targets = append(targets, strings.ToLower(dns.Fqdn(target)))
+	}
+
+	return targets
+}
+
+// lintZone indexes the record sets of a zone by owner name for the lint rules.
+type lintZone struct {
+	name   string
+	rrSets []model.ResourceRecordSet
+	names  map[string]map[model.RRType]struct{}
+	// txt holds the text of the TXT records at each name.
+	txt map[string][]string
+}
+
+func newLintZone(zone *model.Zone) *lintZone {
+	lz := &lintZone{
+		name:   strings.ToLower(dns.Fqdn(zone.Name)),
+		rrSets: zone.ResourceRecordSets,
+		names:  make(map[string]map[model.RRType]struct{}),
+		txt:    make(map[string][]string),
+	}
+
+	for _, rrSet := range zone.ResourceRecordSets {
+		name := strings.ToLower(dns.Fqdn(rrSet.Name))
+		if lz.names[name] == nil {
+			lz.names[name] = make(map[model.RRType]struct{})
+		}
+		lz.names[name][rrSet.Type] = struct{}{}
+
+		if rrSet.Type == model.RRTypeTXT {
+			lz.txt[name] = txtRecordValues(rrSet)
+		}
+	}
+
+	return lz
+}
+
+func (z *lintZone) has(name string, rrType model.RRType) bool {
+	_, ok := z.names[name][rrType]
+	return ok
+}
+
+// lookup returns the record types that exist at name, falling back to the closest wildcard
+// that would answer for it.
+func (z *lintZone) lookup(name string) map[model.RRType]struct{} {
+	if types, ok := z.names[name]; ok {
+		return types
+	}
+
+	for off, end := dns.NextLabel(name, 0); !end; off, end = dns.NextLabel(name, off) {
+		ancestor := name[off:]
+		if types, ok := z.names["*."+ancestor]; ok {
+			return types
+		}
+		if _, ok := z.names[ancestor]; ok || ancestor == z.name {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (z *lintZone) exists(name string) bool {
+	return len(z.lookup(name)) > 0
+}
+
+// hasAddress reports whether name has A or AAAA records. Glue records cannot be synthesised
+// from a wildcard, so wildcards are only considered if wildcard is set.
+func (z *lintZone) hasAddress(name string, wildcard bool) bool {
+	types := z.names[name]
+	if wildcard {
+		types = z.lookup(name)
+	}
+
+	_, a := types[model.RRTypeA]
+	_, aaaa := types[model.RRTypeAAAA]
+	return a || aaaa
+}
+
+// authoritative reports whether the zone holds the authoritative data for name, that is
+// whether name is inside the zone and not at or below a delegation to a child zone.
+func (z *lintZone) authoritative(name string) bool {
+	if !dns.IsSubDomain(z.name, name) {
+		return false
+	}
+
+	for off, end := 0, false; !end; off, end = dns.NextLabel(name, off) {
+		ancestor := name[off:]
+		if ancestor == z.name {
+			break
+		}
+		if z.has(ancestor, model.RRTypeNS) {
+			return false
+		}
+	}
+
+	return true
+}
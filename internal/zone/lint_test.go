@@ -0,0 +1,196 @@
+package zone
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/recursive"
+)
+
+func lintTestRRSet(name string, rrType model.RRType, values ...string) model.ResourceRecordSet {
+	records := make([]model.ResourceRecord, len(values))
+	for i, value := range values {
+		records[i] = model.ResourceRecord{Value: value}
+	}
+
+	return model.ResourceRecordSet{Name: name, Type: rrType, TTL: 300, ResourceRecords: records}
+}
+
+func TestLintZoneRecords(t *testing.T) {
+	tests := []struct {
+		name   string
+		rrSets []model.ResourceRecordSet
+		want   []model.LintFinding
+	}{
+		{
+			name: "healthy zone",
+			rrSets: []model.ResourceRecordSet{
+				lintTestRRSet("example.com.", model.RRTypeNS, "ns1.example.com.", "ns.elsewhere.net."),
+				lintTestRRSet("ns1.example.com.", model.RRTypeA, "192.0.2.53"),
+				lintTestRRSet("example.com.", model.RRTypeMX, "10 mail.example.com.", "20 mx.elsewhere.net."),
+				lintTestRRSet("mail.example.com.", model.RRTypeAAAA, "2001:db8::25"),
+				lintTestRRSet("www.example.com.", model.RRTypeCNAME, "host.example.com."),
+				lintTestRRSet("*.example.com.", model.RRTypeA, "192.0.2.1"),
+				lintTestRRSet("_sip._tcp.example.com.", model.RRTypeSRV, "0 5 5060 ."),
+				lintTestRRSet("app.example.com.", model.RRTypeCNAME, "app.dev.example.com."),
+				lintTestRRSet("dev.example.com.", model.RRTypeNS, "ns.elsewhere.net."),
+			},
+			want: []model.LintFinding{},
+		},
+		{
+			name: "dangling CNAME",
+			rrSets: []model.ResourceRecordSet{
+				lintTestRRSet("www.example.com.", model.RRTypeCNAME, "missing.example.com."),
+			},
+			want: []model.LintFinding{
+				{
+					Rule:     lintRuleDanglingCNAME,
+					Severity: model.LintSeverityError,
+					Name:     "www.example.com.",
+					Type:     model.RRTypeCNAME,
+					Message:  "CNAME target missing.example.com. does not exist in the zone",
+				},
+			},
+		},
+		{
+			name: "MX and SRV pointing at a CNAME",
+			rrSets: []model.ResourceRecordSet{
+				lintTestRRSet("example.com.", model.RRTypeMX, "10 mail.example.com."),
+				lintTestRRSet("_sip._tcp.example.com.", model.RRTypeSRV, "0 5 5060 mail.example.com."),
+				lintTestRRSet("mail.example.com.", model.RRTypeCNAME, "mx.elsewhere.net."),
+			},
+			want: []model.LintFinding{
+				{
+					Rule:     lintRuleAliasTarget,
+					Severity: model.LintSeverityError,
+					Name:     "example.com.",
+					Type:     model.RRTypeMX,
+					Message: "MX target mail.example.com. is a CNAME, but MX records must point at a name " +
+						"with address records (RFC 2181 section 10.3)",
+				},
+				{
+					Rule:     lintRuleAliasTarget,
+					Severity: model.LintSeverityError,
+					Name:     "_sip._tcp.example.com.",
+					Type:     model.RRTypeSRV,
+					Message: "SRV target mail.example.com. is a CNAME, but SRV records must point at a name " +
+						"with address records (RFC 2181 section 10.3)",
+				},
+			},
+		},
+		{
+			name: "MX without address",
+			rrSets: []model.ResourceRecordSet{
+				lintTestRRSet("example.com.", model.RRTypeMX, "10 mail.example.com."),
+				lintTestRRSet("mail.example.com.", model.RRTypeTXT, "\"v=spf1 -all\""),
+			},
+			want: []model.LintFinding{
+				{
+					Rule:     lintRuleMissingAddress,
+					Severity: model.LintSeverityWarning,
+					Name:     "example.com.",
+					Type:     model.RRTypeMX,
+					Message:  "MX target mail.example.com. has no A or AAAA records in the zone",
+				},
+			},
+		},
+		{
+			name: "delegation without glue",
+			rrSets: []model.ResourceRecordSet{
+				lintTestRRSet("dev.example.com.", model.RRTypeNS, "ns1.dev.example.com.", "ns2.dev.example.com."),
+				lintTestRRSet("ns1.dev.example.com.", model.RRTypeA, "192.0.2.53"),
+				lintTestRRSet("*.example.com.", model.RRTypeA, "192.0.2.1"),
+			},
+			want: []model.LintFinding{
+				{
+					Rule:     lintRuleInBailiwickGlue,
+					Severity: model.LintSeverityError,
+					Name:     "dev.example.com.",
+					Type:     model.RRTypeNS,
+					Message:  "name server ns2.dev.example.com. is inside the zone but has no A or AAAA glue records",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zone := &model.Zone{Name: "example.com.", ResourceRecordSets: tt.rrSets}
+			assert.Equal(t, tt.want, lintZoneRecords(zone))
+		})
+	}
+}
+
+type fakeResolver map[string]*dns.Msg
+
+func (f fakeResolver) Resolve(_ context.Context, qname string, qtype uint16) (*recursive.Result, error) {
+	msg, ok := f[qname+"/"+dns.TypeToString[qtype]]
+	if !ok {
+		return nil, errors.New("timeout")
+	}
+
+	return &recursive.Result{Qname: qname, Qtype: qtype, AnswerPacket: msg}, nil
+}
+
+func TestLintResolution(t *testing.T) {
+	nxdomain := &dns.Msg{MsgHdr: dns.MsgHdr{Rcode: dns.RcodeNameError}}
+	noData := &dns.Msg{}
+	answer, err := dns.NewRR("mx.elsewhere.net. 300 IN A 192.0.2.25")
+	assert.NoError(t, err)
+
+	resolver := fakeResolver{
+		"mx.elsewhere.net./A":          {Answer: []dns.RR{answer}},
+		"gone.elsewhere.net./A":        nxdomain,
+		"txt-only.elsewhere.net./A":    noData,
+		"txt-only.elsewhere.net./AAAA": noData,
+	}
+
+	zone := &model.Zone{
+		Name: "example.com.",
+		ResourceRecordSets: []model.ResourceRecordSet{
+			lintTestRRSet("example.com.", model.RRTypeMX, "10 mx.elsewhere.net.", "20 txt-only.elsewhere.net."),
+			lintTestRRSet("old.example.com.", model.RRTypeCNAME, "gone.elsewhere.net."),
+			lintTestRRSet("docs.example.com.", model.RRTypeCNAME, "txt-only.elsewhere.net."),
+			lintTestRRSet("dev.example.com.", model.RRTypeNS, "ns.slow.net."),
+			lintTestRRSet("test.example.com.", model.RRTypeNS, "mx.elsewhere.net.", "gone.elsewhere.net."),
+			lintTestRRSet("www.example.com.", model.RRTypeCNAME, "host.example.com."),
+		},
+	}
+
+	want := []model.LintFinding{
+		{
+			Rule:     lintRuleUnresolvableTarget,
+			Severity: model.LintSeverityWarning,
+			Name:     "example.com.",
+			Type:     model.RRTypeMX,
+			Message:  "MX target txt-only.elsewhere.net. does not resolve to an A or AAAA record",
+		},
+		{
+			Rule:     lintRuleUnresolvableTarget,
+			Severity: model.LintSeverityWarning,
+			Name:     "old.example.com.",
+			Type:     model.RRTypeCNAME,
+			Message:  "CNAME target gone.elsewhere.net. does not exist",
+		},
+		{
+			Rule:     lintRuleUnresolvableTarget,
+			Severity: model.LintSeverityInfo,
+			Name:     "dev.example.com.",
+			Type:     model.RRTypeNS,
+			Message:  "could not resolve NS target ns.slow.net.: timeout",
+		},
+		{
+			Rule:     lintRuleOutOfZoneGlue,
+			Severity: model.LintSeverityError,
+			Name:     "test.example.com.",
+			Type:     model.RRTypeNS,
+			Message:  "out-of-zone name server gone.elsewhere.net. has no glue and does not resolve to an A or AAAA record",
+		},
+	}
+	assert.Equal(t, want, lintResolution(t.Context(), resolver, zone))
+}
@@ -123,12 +123,29 @@ func (p *EventProcessor) processChangeRRSetEvent(ctx context.Context, event *mod
 		return err
 	}
 
-	change, err := p.repository.GetZoneRepository().GetChange(ctx, changeRRSetEvent.ChangeID)
+	changes := append([]ZoneChange{{
+		ZoneName: changeRRSetEvent.ZoneName,
+		ChangeID: changeRRSetEvent.ChangeID,
+	}}, changeRRSetEvent.PTRChanges...)
+
+	for _, zoneChange := range changes {
+		if err := p.processZoneChange(ctx, zoneChange); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// processZoneChange applies a change to the DNS store and marks it done. Applying a change
+// again is harmless, so an event whose processing failed part way can be processed again.
+func (p *EventProcessor) processZoneChange(ctx context.Context, zoneChange ZoneChange) error {
+	change, err := p.repository.GetZoneRepository().GetChange(ctx, zoneChange.ChangeID)
 	if err != nil {
 		return err
 	}
 
-	tx := p.store.ZoneTxn(ctx, changeRRSetEvent.ZoneName)
+	tx := p.store.ZoneTxn(ctx, zoneChange.ZoneName)
 
 	for _, changeAction := range change.Actions {
 		if err = processChangeAction(tx, changeAction); err != nil {
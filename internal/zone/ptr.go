@@ -0,0 +1,246 @@
+package zone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/repository"
+)
+
+var ErrPTRConflict = errors.New("PTR record conflict")
+
+// ptrUpdate describes a PTR record that should point an address back to a forward name, or
+// stop doing so.
+type ptrUpdate struct {
+	// name is the owner name of the PTR record, e.g. 5.2.0.192.in-addr.arpa.
+	name   string
+	target string
+	ttl    uint32
+	remove bool
+}
+
+// syncPTRRecords keeps the PTR records in hosted reverse zones in line with the A and AAAA
+// record sets changed by actions, given the record sets of the forward zone before the
+// change. Every address of an upserted record set gets a PTR record pointing back to its
+// name, and addresses that are no longer in use lose theirs. The PTR records of each reverse
+// zone are updated through a change of its own, written with the given registry so that it
+// commits together with the forward change. The changes are returned rather than announced
+// with events of their own, so that they are applied along with the forward change.
+// Addresses without a hosted reverse zone are skipped. A PTR record set that already points
+// an address somewhere else is treated as a conflict and fails the whole change.
+func syncPTRRecords(
+	ctx context.Context,
+	r repository.Registry,
+	rrSets []model.ResourceRecordSet,
+	actions []model.ChangeAction,
+) ([]ZoneChange, error) {
+	updates := ptrUpdates(rrSets, actions)
+	if len(updates) == 0 {
+		return nil, nil
+	}
+
+	zones := make(map[string]*model.Zone)
+	zoneUpdates := make(map[string][]ptrUpdate)
+	var zoneNames []string
+	for _, update := range updates {
+		zone, name, err := findReverseZone(ctx, r, update.name)
+		if err != nil {
+			return nil, err
+		}
+		if zone == nil {
+			continue
+		}
+
+		if _, ok := zones[zone.Name]; !ok {
+			zones[zone.Name] = zone
+			zoneNames = append(zoneNames, zone.Name)
+		}
+
+		update.name = name
+		zoneUpdates[zone.Name] = append(zoneUpdates[zone.Name], update)
+	}
+
+	var changes []ZoneChange
+	for _, zoneName := range zoneNames {
+		zone := zones[zoneName]
+
+		projected, err := applyPTRUpdates(zone.ResourceRecordSets, zoneUpdates[zoneName])
+		if err != nil {
+			return nil, beaconerr.ErrPTRRecordConflict(err.Error())
+		}
+
+		ptrActions := diffToChangeActions(diffResourceRecordSets(zone.ResourceRecordSets, projected))
+		if len(ptrActions) == 0 {
+			continue
+		}
+
+		change := model.NewChange(zone.ID, model.ChangeStatusPending, ptrActions)
+		if err = validateChanges(zone, &change); err != nil {
+			return nil, invalidChangeError(err, "failed to update PTR records in reverse zone "+zone.Name)
+		}
+
+		if err = recordChange(ctx, r, model.AuditOperationSyncPTR, zone, &change); err != nil {
+			return nil, err
+		}
+		changes = append(changes, ZoneChange{ZoneName: zone.Name, ChangeID: change.ID})
+	}
+
+	return changes, nil
+}
+
+// findReverseZone returns the hosted zone that serves the PTR record for ptrName along with
+// the name the record lives at in that zone. When the enclosing zone aliases ptrName into an
+// RFC 2317 classless zone that is also hosted, the classless zone is returned instead. It
+// returns a nil zone if no hosted zone covers ptrName.
+func findReverseZone(ctx context.Context, r repository.Registry, ptrName string) (*model.Zone, string, error) {
+	zone, err := findParentZone(ctx, r, ptrName)
+	if err != nil || zone == nil {
+		return nil, "", err
+	}
+
+	for _, rrSet := range zone.ResourceRecordSets {
+		if rrSet.Type != model.RRTypeCNAME || !strings.EqualFold(dns.Fqdn(rrSet.Name), ptrName) ||
+			len(rrSet.ResourceRecords) != 1 {
+			continue
+		}
+
+		target := dns.Fqdn(rrSet.ResourceRecords[0].Value)
+		classless, findErr := findParentZone(ctx, r, target)
+		if findErr != nil {
+			return nil, "", findErr
+		}
+		if classless != nil && classless.Name != zone.Name {
+			return classless, target, nil
+		}
+	}
+
+	return zone, ptrName, nil
+}
+
+// ptrUpdates returns the PTR updates needed to follow the A and AAAA record sets changed by
+// actions. Upserts refresh the PTR record of every address they contain, so that existing
+// records pick up TTL changes, and both upserts and deletes remove the PTR records of
+// addresses that the record set no longer contains. Wildcard record sets are skipped, since a
+// PTR record cannot point back to a wildcard name.
+func ptrUpdates(rrSets []model.ResourceRecordSet, actions []model.ChangeAction) []ptrUpdate {
+	var updates []ptrUpdate
+	for _, action := range actions {
+		rrSet := action.ResourceRecordSet
+		if rrSet == nil || (rrSet.Type != model.RRTypeA && rrSet.Type != model.RRTypeAAAA) ||
+			strings.HasPrefix(rrSet.Name, "*") {
+			continue
+		}
+
+		name := dns.Fqdn(rrSet.Name)
+		key := rrSetKey(rrSet.Name, rrSet.Type)
+
+		var before []string
+		if idx := slices.IndexFunc(rrSets, func(existing model.ResourceRecordSet) bool {
+			return rrSetKey(existing.Name, existing.Type) == key
+		}); idx >= 0 {
+			before = recordAddresses(rrSets[idx].ResourceRecords)
+		}
+
+		var after []string
+		if action.ActionType == model.ChangeActionTypeUpsert {
+			after = recordAddresses(rrSet.ResourceRecords)
+		}
+
+		for _, addr := range before {
+			if !slices.Contains(after, addr) {
+				updates = append(updates, ptrUpdate{name: addr, target: name, remove: true})
+			}
+		}
+
+		for _, addr := range after {
+			updates = append(updates, ptrUpdate{name: addr, target: name, ttl: rrSet.TTL})
+		}
+	}
+
+	return updates
+}
+
+// recordAddresses returns the PTR owner names of the addresses in records, skipping any
+// value that does not parse as an IP address.
+func recordAddresses(records []model.ResourceRecord) []string {
+	names := make([]string, 0, len(records))
+	for _, rr := range records {
+		addr, err := netip.ParseAddr(rr.Value)
+		if err != nil {
+			continue
+		}
+
+		name, err := dns.ReverseAddr(addr.Unmap().String())
+		if err != nil {
+			continue
+		}
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// applyPTRUpdates returns rrSets with the updates applied. Adding a PTR record fails with
+// ErrPTRConflict if the name already holds a PTR record set pointing elsewhere, or a record
+// set of another type. Removing a PTR record only drops the value pointing at the update's
+// target, leaving records managed by hand alone.
+func applyPTRUpdates(rrSets []model.ResourceRecordSet, updates []ptrUpdate) ([]model.ResourceRecordSet, error) {
+	projected := slices.Clone(rrSets)
+	for _, update := range updates {
+		idx := slices.IndexFunc(projected, func(rrSet model.ResourceRecordSet) bool {
+			return rrSet.Type == model.RRTypePTR && strings.EqualFold(dns.Fqdn(rrSet.Name), update.name)
+		})
+
+		if update.remove {
+			if idx < 0 {
+				continue
+			}
+
+			rrSet := projected[idx]
+			rrSet.ResourceRecords = slices.DeleteFunc(slices.Clone(rrSet.ResourceRecords), func(rr model.ResourceRecord) bool {
+				return strings.EqualFold(dns.Fqdn(rr.Value), update.target)
+			})
+			if len(rrSet.ResourceRecords) == 0 {
+				projected = slices.Delete(projected, idx, idx+1)
+			} else {
+				projected[idx] = rrSet
+			}
+			continue
+		}
+
+		if other := slices.IndexFunc(projected, func(rrSet model.ResourceRecordSet) bool {
+			return rrSet.Type != model.RRTypePTR && strings.EqualFold(dns.Fqdn(rrSet.Name), update.name)
+		}); other >= 0 {
+			return nil, fmt.Errorf("%w: %s already has a %s record", ErrPTRConflict, update.name, projected[other].Type)
+		}
+
+		ptr := model.ResourceRecordSet{
+			Name:            update.name,
+			Type:            model.RRTypePTR,
+			TTL:             update.ttl,
+			ResourceRecords: []model.ResourceRecord{{Value: update.target}},
+		}
+
+		if idx < 0 {
+			projected = append(projected, ptr)
+			continue
+		}
+
+		for _, rr := range projected[idx].ResourceRecords {
+			if !strings.EqualFold(dns.Fqdn(rr.Value), update.target) {
+				return nil, fmt.Errorf("%w: %s already points to %s", ErrPTRConflict, update.name, rr.Value)
+			}
+		}
+		projected[idx] = ptr
+	}
+
+	return projected, nil
+}
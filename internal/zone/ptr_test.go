@@ -0,0 +1,180 @@
+package zone
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+func TestPTRUpdates(t *testing.T) {
+	current := []model.ResourceRecordSet{
+		{
+			Name: "www.example.com.",
+			Type: model.RRTypeA,
+			TTL:  300,
+			ResourceRecords: []model.ResourceRecord{
+				{Value: "192.0.2.1"},
+				{Value: "192.0.2.2"},
+			},
+		},
+		{
+			Name:            "www.example.com.",
+			Type:            model.RRTypeTXT,
+			TTL:             300,
+			ResourceRecords: []model.ResourceRecord{{Value: "\"hello\""}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		actions []model.ChangeAction
+		want    []ptrUpdate
+	}{
+		{
+			name: "upsert replaces an address",
+			actions: []model.ChangeAction{
+				model.NewChangeAction(model.ChangeActionTypeUpsert, &model.ResourceRecordSet{
+					Name: "www.example.com",
+					Type: model.RRTypeA,
+					TTL:  600,
+					ResourceRecords: []model.ResourceRecord{
+						{Value: "192.0.2.1"},
+						{Value: "192.0.2.3"},
+					},
+				}),
+			},
+			want: []ptrUpdate{
+				{name: "2.2.0.192.in-addr.arpa.", target: "www.example.com.", remove: true},
+				{name: "1.2.0.192.in-addr.arpa.", target: "www.example.com.", ttl: 600},
+				{name: "3.2.0.192.in-addr.arpa.", target: "www.example.com.", ttl: 600},
+			},
+		},
+		{
+			name: "delete removes every address",
+			actions: []model.ChangeAction{
+				model.NewChangeAction(model.ChangeActionTypeDelete, &current[0]),
+			},
+			want: []ptrUpdate{
+				{name: "1.2.0.192.in-addr.arpa.", target: "www.example.com.", remove: true},
+				{name: "2.2.0.192.in-addr.arpa.", target: "www.example.com.", remove: true},
+			},
+		},
+		{
+			name: "new AAAA record",
+			actions: []model.ChangeAction{
+				model.NewChangeAction(model.ChangeActionTypeUpsert, &model.ResourceRecordSet{
+					Name:            "www.example.com.",
+					Type:            model.RRTypeAAAA,
+					TTL:             300,
+					ResourceRecords: []model.ResourceRecord{{Value: "2001:db8::1"}},
+				}),
+			},
+			want: []ptrUpdate{
+				{
+					name:   "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.",
+					target: "www.example.com.",
+					ttl:    300,
+				},
+			},
+		},
+		{
+			name: "wildcards are ignored",
+			actions: []model.ChangeAction{
+				model.NewChangeAction(model.ChangeActionTypeUpsert, &model.ResourceRecordSet{
+					Name:            "*.example.com.",
+					Type:            model.RRTypeA,
+					TTL:             300,
+					ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.1"}},
+				}),
+			},
+			want: nil,
+		},
+		{
+			name: "other record types are ignored",
+			actions: []model.ChangeAction{
+				model.NewChangeAction(model.ChangeActionTypeDelete, &current[1]),
+			},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ptrUpdates(current, tt.actions))
+		})
+	}
+}
+
+func TestApplyPTRUpdates(t *testing.T) {
+	current := []model.ResourceRecordSet{
+		{
+			Name:            "1.2.0.192.in-addr.arpa.",
+			Type:            model.RRTypePTR,
+			TTL:             300,
+			ResourceRecords: []model.ResourceRecord{{Value: "www.example.com."}},
+		},
+		{
+			Name:            "2.2.0.192.in-addr.arpa.",
+			Type:            model.RRTypePTR,
+			TTL:             300,
+			ResourceRecords: []model.ResourceRecord{{Value: "mail.example.com."}},
+		},
+	}
+
+	t.Run("adds, updates and removes records", func(t *testing.T) {
+		got, err := applyPTRUpdates(current, []ptrUpdate{
+			{name: "1.2.0.192.in-addr.arpa.", target: "www.example.com.", ttl: 600},
+			{name: "3.2.0.192.in-addr.arpa.", target: "www.example.com.", ttl: 600},
+			{name: "2.2.0.192.in-addr.arpa.", target: "www.example.com.", remove: true},
+		})
+		require.NoError(t, err)
+
+		want := []model.ResourceRecordSet{
+			{
+				Name:            "1.2.0.192.in-addr.arpa.",
+				Type:            model.RRTypePTR,
+				TTL:             600,
+				ResourceRecords: []model.ResourceRecord{{Value: "www.example.com."}},
+			},
+			current[1],
+			{
+				Name:            "3.2.0.192.in-addr.arpa.",
+				Type:            model.RRTypePTR,
+				TTL:             600,
+				ResourceRecords: []model.ResourceRecord{{Value: "www.example.com."}},
+			},
+		}
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("removes own record", func(t *testing.T) {
+		got, err := applyPTRUpdates(current, []ptrUpdate{
+			{name: "1.2.0.192.in-addr.arpa.", target: "www.example.com.", remove: true},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, current[1:], got)
+	})
+
+	t.Run("conflicts with hand-made record", func(t *testing.T) {
+		_, err := applyPTRUpdates(current, []ptrUpdate{
+			{name: "2.2.0.192.in-addr.arpa.", target: "www.example.com.", ttl: 300},
+		})
+		assert.ErrorIs(t, err, ErrPTRConflict)
+	})
+
+	t.Run("conflicts with record of another type", func(t *testing.T) {
+		cname := []model.ResourceRecordSet{{
+			Name:            "4.2.0.192.in-addr.arpa.",
+			Type:            model.RRTypeCNAME,
+			TTL:             300,
+			ResourceRecords: []model.ResourceRecord{{Value: "4.0-63.2.0.192.in-addr.arpa."}},
+		}}
+		_, err := applyPTRUpdates(cname, []ptrUpdate{
+			{name: "4.2.0.192.in-addr.arpa.", target: "www.example.com.", ttl: 300},
+		})
+		assert.ErrorIs(t, err, ErrPTRConflict)
+	})
+}
@@ -0,0 +1,59 @@
+package zone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/repository"
+)
+
+// checkZoneQuota returns a quota exceeded error if more zones exist than the service allows.
+// It is called in the transaction that creates zones, after they are created, so that
+// they are counted along with the zones created by concurrent transactions that committed.
+func (d *DefaultService) checkZoneQuota(ctx context.Context, r repository.Registry) error {
+	if d.maxZones == 0 {
+		return nil
+	}
+
+	count, err := r.GetZoneRepository().CountZones(ctx)
+	if err != nil {
+		return err
+	}
+
+	if count > d.maxZones {
+		return beaconerr.ErrQuotaExceeded(fmt.Sprintf("the number of zones is limited to %d", d.maxZones))
+	}
+
+	return nil
+}
+
+// checkResourceRecordSetQuota returns a quota exceeded error if a change written to the zone
+// named zoneName grew it from previous record sets to more than the service allows. A zone
+// already over a lowered quota can still be changed as long as it does not grow.
+func (d *DefaultService) checkResourceRecordSetQuota(
+	ctx context.Context,
+	r repository.Registry,
+	zoneName string,
+	previous int,
+) error {
+	if d.maxResourceRecordSetsPerZone == 0 {
+		return nil
+	}
+
+	zoneInfo, err := r.GetZoneRepository().GetZoneInfo(ctx, zoneName)
+	if err != nil {
+		return err
+	}
+
+	count := zoneInfo.ResourceRecordSetCount
+	if count > d.maxResourceRecordSetsPerZone && count > previous {
+		return beaconerr.ErrQuotaExceeded(fmt.Sprintf(
+			"the number of record sets in zone %s is limited to %d",
+			zoneName,
+			d.maxResourceRecordSetsPerZone,
+		))
+	}
+
+	return nil
+}
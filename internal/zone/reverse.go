@@ -0,0 +1,120 @@
+package zone
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+const (
+	ipv4ReverseSuffix = "in-addr.arpa."
+	ipv6ReverseSuffix = "ip6.arpa."
+
+	ipv4LabelBits = 8
+	ipv6LabelBits = 4
+
+	// maxClassfulIPv4Prefix is the longest IPv4 prefix that can be served by a zone on an
+	// octet boundary. Longer prefixes need RFC 2317 classless delegation.
+	maxClassfulIPv4Prefix = 24
+
+	// maxReverseZones caps the number of zones a single prefix may expand into.
+	maxReverseZones = 256
+)
+
+var (
+	ErrReversePrefixHostBits = errors.New("prefix has host bits set")
+	ErrReversePrefixTooShort = errors.New("prefix is too short")
+)
+
+type reverseZone struct {
+	name string
+	// classless is the address block of an RFC 2317 classless zone. It is the zero value
+	// for zones on an octet or nibble boundary.
+	classless netip.Prefix
+}
+
+// reverseZonesForPrefix returns the reverse zones needed to serve PTR records for every
+// address in prefix. IPv4 prefixes are split on octet boundaries and IPv6 prefixes on nibble
+// boundaries, so a prefix that does not end on a boundary expands into several zones. This
+// includes prefixes shorter than a single label, such as 10.0.0.0/7, which expands into the
+// /8 zones it covers. IPv4 prefixes longer than /24 get a single RFC 2317 zone named after
+// the first and last address of the block, to be delegated from the enclosing /24 zone.
+func reverseZonesForPrefix(prefix netip.Prefix) ([]reverseZone, error) {
+	if prefix.Masked() != prefix {
+		return nil, fmt.Errorf("%w: %s", ErrReversePrefixHostBits, prefix)
+	}
+
+	addr := prefix.Addr()
+	bits := prefix.Bits()
+
+	labelBits, suffix, base := ipv6LabelBits, ipv6ReverseSuffix, 16
+	units := addrNibbles(addr)
+	if addr.Is4() {
+		labelBits, suffix, base = ipv4LabelBits, ipv4ReverseSuffix, 10
+		units = addr.AsSlice()
+	}
+
+	if addr.Is4() && bits > maxClassfulIPv4Prefix {
+		first := int(units[3])
+		last := first + 1<<(32-bits) - 1
+		name := fmt.Sprintf("%d-%d.%s", first, last, reverseLabels(units[:3], base)+suffix)
+		return []reverseZone{{name: name, classless: prefix}}, nil
+	}
+
+	boundary := max((bits+labelBits-1)/labelBits, 1)
+	count := 1 << (boundary*labelBits - bits)
+	if count > maxReverseZones {
+		return nil, fmt.Errorf("%w: %s expands into more than %d zones", ErrReversePrefixTooShort, prefix, maxReverseZones)
+	}
+
+	zones := make([]reverseZone, 0, count)
+	for i := range count {
+		labels := slices.Clone(units[:boundary])
+		labels[boundary-1] += byte(i)
+		zones = append(zones, reverseZone{name: reverseLabels(labels, base) + suffix})
+	}
+
+	return zones, nil
+}
+
+// classlessDelegationCNAMEs returns the CNAME record sets the enclosing /24 zone needs to
+// point each address of an RFC 2317 classless zone into it.
+func classlessDelegationCNAMEs(zone reverseZone, ttl uint32) []model.ResourceRecordSet {
+	parent := zone.name[strings.Index(zone.name, ".")+1:]
+
+	var rrSets []model.ResourceRecordSet
+	for addr := zone.classless.Addr(); zone.classless.Contains(addr); addr = addr.Next() {
+		host := strconv.Itoa(int(addr.As4()[3]))
+		rrSets = append(rrSets, model.ResourceRecordSet{
+			Name:            host + "." + parent,
+			Type:            model.RRTypeCNAME,
+			TTL:             ttl,
+			ResourceRecords: []model.ResourceRecord{{Value: host + "." + zone.name}},
+		})
+	}
+
+	return rrSets
+}
+
+func addrNibbles(addr netip.Addr) []byte {
+	b := addr.As16()
+	nibbles := make([]byte, 0, len(b)*2)
+	for _, octet := range b {
+		nibbles = append(nibbles, octet>>4, octet&0x0f)
+	}
+	return nibbles
+}
+
+func reverseLabels(units []byte, base int) string {
+	var sb strings.Builder
+	for i := len(units) - 1; i >= 0; i-- {
+		sb.WriteString(strconv.FormatInt(int64(units[i]), base))
+		sb.WriteByte('.')
+	}
+	return sb.String()
+}
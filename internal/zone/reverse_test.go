@@ -0,0 +1,130 @@
+package zone
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+func TestReverseZonesForPrefix(t *testing.T) {
+	tests := []struct {
+		name      string
+		prefix    string
+		want      []string
+		classless bool
+		err       error
+	}{
+		{
+			name:   "ipv4 /8",
+			prefix: "10.0.0.0/8",
+			want:   []string{"10.in-addr.arpa."},
+		},
+		{
+			name:   "ipv4 /16",
+			prefix: "10.20.0.0/16",
+			want:   []string{"20.10.in-addr.arpa."},
+		},
+		{
+			name:   "ipv4 /24",
+			prefix: "192.0.2.0/24",
+			want:   []string{"2.0.192.in-addr.arpa."},
+		},
+		{
+			name:   "ipv4 /23 splits into /24 zones",
+			prefix: "192.0.2.0/23",
+			want:   []string{"2.0.192.in-addr.arpa.", "3.0.192.in-addr.arpa."},
+		},
+		{
+			name:      "ipv4 /26 is classless",
+			prefix:    "192.0.2.64/26",
+			want:      []string{"64-127.2.0.192.in-addr.arpa."},
+			classless: true,
+		},
+		{
+			name:      "ipv4 /32 is classless",
+			prefix:    "192.0.2.5/32",
+			want:      []string{"5-5.2.0.192.in-addr.arpa."},
+			classless: true,
+		},
+		{
+			name:   "ipv6 /32",
+			prefix: "2001:db8::/32",
+			want:   []string{"8.b.d.0.1.0.0.2.ip6.arpa."},
+		},
+		{
+			name:   "ipv6 /48",
+			prefix: "2001:db8:abcd::/48",
+			want:   []string{"d.c.b.a.8.b.d.0.1.0.0.2.ip6.arpa."},
+		},
+		{
+			name:   "ipv6 /63 splits into nibble zones",
+			prefix: "2001:db8:0:10::/63",
+			want: []string{
+				"0.1.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.",
+				"1.1.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.",
+			},
+		},
+		{
+			name:   "host bits set",
+			prefix: "10.20.1.0/16",
+			err:    ErrReversePrefixHostBits,
+		},
+		{
+			name:   "ipv4 /7 splits into /8 zones",
+			prefix: "10.0.0.0/7",
+			want:   []string{"10.in-addr.arpa.", "11.in-addr.arpa."},
+		},
+		{
+			name:   "ipv6 /2 splits into nibble zones",
+			prefix: "4000::/2",
+			want:   []string{"4.ip6.arpa.", "5.ip6.arpa.", "6.ip6.arpa.", "7.ip6.arpa."},
+		},
+		{
+			name:   "ipv4 /15 splits into /16 zones",
+			prefix: "10.0.0.0/15",
+			want:   []string{"0.10.in-addr.arpa.", "1.10.in-addr.arpa."},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zones, err := reverseZonesForPrefix(netip.MustParsePrefix(tt.prefix))
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			names := make([]string, len(zones))
+			for i, zone := range zones {
+				names[i] = zone.name
+				assert.Equal(t, tt.classless, zone.classless.IsValid())
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestClasslessDelegationCNAMEs(t *testing.T) {
+	zones, err := reverseZonesForPrefix(netip.MustParsePrefix("192.0.2.252/30"))
+	require.NoError(t, err)
+	require.Len(t, zones, 1)
+
+	cnames := classlessDelegationCNAMEs(zones[0], 300)
+
+	want := make([]model.ResourceRecordSet, 0, 4)
+	for _, host := range []string{"252", "253", "254", "255"} {
+		want = append(want, model.ResourceRecordSet{
+			Name:            host + ".2.0.192.in-addr.arpa.",
+			Type:            model.RRTypeCNAME,
+			TTL:             300,
+			ResourceRecords: []model.ResourceRecord{{Value: host + ".252-255.2.0.192.in-addr.arpa."}},
+		})
+	}
+	assert.Equal(t, want, cnames)
+}
@@ -1,11 +1,20 @@
 package zone
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"slices"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/miekg/dns"
 
+	"github.com/davidseybold/beacondns/internal/audit"
+	"github.com/davidseybold/beacondns/internal/auth"
 	"github.com/davidseybold/beacondns/internal/beaconerr"
 	"github.com/davidseybold/beacondns/internal/model"
 	"github.com/davidseybold/beacondns/internal/repository"
@@ -27,13 +36,23 @@ const (
 
 type Service interface {
 	// Zone management
-	CreateZone(ctx context.Context, name string) (*model.ZoneInfo, error)
-	DeleteZone(ctx context.Context, name string) error
+	CreateZone(ctx context.Context, name string, opts CreateZoneOptions) (*model.ZoneInfo, error)
+	CreateReverseZones(ctx context.Context, cidr string, opts CreateZoneOptions) ([]model.ZoneInfo, error)
+	DeleteZone(ctx context.Context, name string, force bool) error
+	ListDeletedZones(ctx context.Context, opts model.ListOptions) (model.Page[model.DeletedZone], error)
+	RestoreZone(ctx context.Context, name string) (*model.ZoneInfo, error)
 	GetZoneInfo(ctx context.Context, name string) (*model.ZoneInfo, error)
-	ListZones(ctx context.Context) ([]model.ZoneInfo, error)
+	GetZoneInfoByID(ctx context.Context, id uuid.UUID) (*model.ZoneInfo, error)
+	ListZones(ctx context.Context, opts model.ListOptions) (model.Page[model.ZoneInfo], error)
+	UpdateZoneTags(ctx context.Context, name string, set model.Tags, remove []string) (model.Tags, error)
 
 	// Resource record management
-	ListResourceRecordSets(ctx context.Context, zoneName string) ([]model.ResourceRecordSet, error)
+	ListResourceRecordSets(
+		ctx context.Context,
+		zoneName string,
+		rrType model.RRType,
+		opts model.ListOptions,
+	) (model.Page[model.ResourceRecordSet], error)
 	GetResourceRecordSet(
 		ctx context.Context,
 		zoneName string,
@@ -44,25 +63,276 @@ type Service interface {
 		ctx context.Context,
 		zoneName string,
 		rrSet *model.ResourceRecordSet,
-	) (*model.ResourceRecordSet, error)
-	DeleteResourceRecordSet(ctx context.Context, zoneName string, name string, rrType model.RRType) error
+		opts ResourceRecordSetOptions,
+	) (*model.ResourceRecordSet, []model.LintFinding, error)
+	DeleteResourceRecordSet(
+		ctx context.Context,
+		zoneName string,
+		name string,
+		rrType model.RRType,
+		opts ResourceRecordSetOptions,
+	) error
+	ChangeResourceRecordSets(
+		ctx context.Context,
+		zoneName string,
+		batch []ChangeBatchAction,
+		opts ResourceRecordSetOptions,
+	) (*model.Change, error)
+	GetChange(ctx context.Context, id uuid.UUID) (*model.Change, error)
+	PlanUpsertResourceRecordSet(
+		ctx context.Context,
+		zoneName string,
+		rrSet *model.ResourceRecordSet,
+		opts ResourceRecordSetOptions,
+	) (*model.ZoneDiff, error)
+	PlanDeleteResourceRecordSet(
+		ctx context.Context,
+		zoneName string,
+		name string,
+		rrType model.RRType,
+	) (*model.ZoneDiff, error)
+
+	// Zone file management
+	ImportZone(
+		ctx context.Context,
+		zoneName string,
+		zoneFile io.Reader,
+		opts ResourceRecordSetOptions,
+	) (*model.ZoneImportResult, error)
+	ExportZone(ctx context.Context, zoneName string) ([]byte, error)
+
+	// Zone health
+	LintZone(ctx context.Context, zoneName string, opts LintOptions) ([]model.LintFinding, error)
+
+	// Zone version management
+	ListZoneVersions(
+		ctx context.Context,
+		zoneName string,
+		opts model.ListOptions,
+	) (model.Page[model.ZoneVersion], error)
+	DiffZoneVersions(ctx context.Context, zoneName string, fromVersion int, toVersion int) (*model.ZoneDiff, error)
+	RollbackZone(ctx context.Context, zoneName string, version int, opts ResourceRecordSetOptions) (*model.Change, error)
+
+	// Zone template management
+	CreateZoneTemplate(ctx context.Context, template *model.ZoneTemplate) (*model.ZoneTemplate, error)
+	UpdateZoneTemplate(ctx context.Context, template *model.ZoneTemplate) (*model.ZoneTemplate, error)
+	DeleteZoneTemplate(ctx context.Context, name string) error
+	GetZoneTemplate(ctx context.Context, name string) (*model.ZoneTemplate, error)
+	ListZoneTemplates(ctx context.Context, opts model.ListOptions) (model.Page[model.ZoneTemplate], error)
+	ApplyZoneTemplate(
+		ctx context.Context,
+		templateName string,
+		zoneNames []string,
+		dryRun bool,
+	) ([]model.ZoneTemplateDrift, error)
+}
+
+// ServiceConfig holds the tunable behaviour of the zone service.
+type ServiceConfig struct {
+	// TrashPeriod is how long a force-deleted zone can be restored for. Force-deleted
+	// zones are removed permanently when it is zero.
+	TrashPeriod time.Duration
+	// Resolver is used by LintZone to check names outside of the hosted zones. Resolution
+	// checks are unavailable when it is nil.
+	Resolver Resolver
+	// MaxZones limits the number of zones. There is no limit when it is zero.
+	MaxZones int
+	// MaxResourceRecordSetsPerZone limits the number of record sets in a zone, counting the
+	// SOA and NS record sets at its apex. There is no limit when it is zero.
+	MaxResourceRecordSetsPerZone int
+}
+
+// CreateZoneOptions controls how a new zone is created.
+type CreateZoneOptions struct {
+	// DelegateFromParent adds NS records for the new zone to its closest hosted parent zone,
+	// so that the new zone is reachable through normal resolution.
+	DelegateFromParent bool
+	// Template is the name of a zone template whose record sets are added to the new zone as
+	// part of the change that creates it.
+	Template string
+	// Tags are added to every zone created.
+	Tags model.Tags
+}
+
+// ResourceRecordSetOptions controls the side effects of changing a resource record set.
+type ResourceRecordSetOptions struct {
+	// SyncPTR maintains the PTR records for the addresses of A and AAAA record sets in the
+	// hosted reverse zones that cover them.
+	SyncPTR bool
+	// ValidatePolicies checks the SPF, DMARC, DKIM and MTA-STS policies published in TXT
+	// record sets against their specifications. Problems that break a policy reject the
+	// change, and lesser ones are returned as warnings.
+	ValidatePolicies bool
+}
+
+// LintOptions controls which checks LintZone runs.
+type LintOptions struct {
+	// Resolve also checks that the names outside the zone that record sets point at resolve.
+	Resolve bool
 }
 
 type DefaultService struct {
-	registry repository.TransactorRegistry
+	registry                     repository.TransactorRegistry
+	trashPeriod                  time.Duration
+	resolver                     Resolver
+	maxZones                     int
+	maxResourceRecordSetsPerZone int
 }
 
 var _ Service = (*DefaultService)(nil)
 
-func NewService(r repository.TransactorRegistry) *DefaultService {
+func NewService(r repository.TransactorRegistry, cfg ServiceConfig) *DefaultService {
 	return &DefaultService{
-		registry: r,
+		registry:                     r,
+		trashPeriod:                  cfg.TrashPeriod,
+		resolver:                     cfg.Resolver,
+		maxZones:                     cfg.MaxZones,
+		maxResourceRecordSetsPerZone: cfg.MaxResourceRecordSetsPerZone,
 	}
 }
 
-func (d *DefaultService) CreateZone(ctx context.Context, name string) (*model.ZoneInfo, error) {
+func (d *DefaultService) CreateZone(
+	ctx context.Context,
+	name string,
+	opts CreateZoneOptions,
+) (*model.ZoneInfo, error) {
 	zoneName := dns.Fqdn(name)
+	if err := auth.Authorize(ctx, model.ActionWrite, model.ZoneResource(zoneName)); err != nil {
+		return nil, err
+	}
+
+	if err := opts.Tags.Validate(); err != nil {
+		return nil, beaconerr.ErrInvalidArgument(err.Error(), "tags")
+	}
+
+	zone := newHostedZone(zoneName)
+	zone.Tags = opts.Tags
+
+	if opts.Template != "" {
+		template, err := d.getZoneTemplate(ctx, opts.Template)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = applyTemplateToNewZone(zone, template); err != nil {
+			return nil, err
+		}
+	}
+
+	var zoneInfo *model.ZoneInfo
+	err := d.registry.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		var createZoneErr error
+		zoneInfo, createZoneErr = createZone(ctx, r, model.AuditOperationCreateZone, zone, opts.DelegateFromParent)
+		if createZoneErr != nil {
+			return createZoneErr
+		}
+
+		if createZoneErr = d.checkZoneQuota(ctx, r); createZoneErr != nil {
+			return createZoneErr
+		}
+
+		return d.checkResourceRecordSetQuota(ctx, r, zone.Name, 0)
+	})
+	if err != nil && errors.Is(err, repository.ErrEntityAlreadyExists) {
+		return nil, beaconerr.ErrZoneAlreadyExists("zone already exists")
+	} else if err != nil && (beaconerr.IsBadRequestError(err) || beaconerr.IsAccessDeniedError(err)) {
+		return nil, err
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to create zone", err)
+	}
+
+	return zoneInfo, nil
+}
+
+// CreateReverseZones creates the in-addr.arpa or ip6.arpa zones that serve PTR records for
+// the addresses in cidr, all in one transaction. See reverseZonesForPrefix for how the
+// prefix maps onto zones. When delegating from a hosted parent, an RFC 2317 classless zone
+// also gets a CNAME in the enclosing /24 zone for each of its addresses.
+func (d *DefaultService) CreateReverseZones(
+	ctx context.Context,
+	cidr string,
+	opts CreateZoneOptions,
+) ([]model.ZoneInfo, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil, beaconerr.ErrInvalidArgument("invalid CIDR block", "cidr")
+	}
+
+	reverseZones, err := reverseZonesForPrefix(prefix)
+	if err != nil {
+		return nil, beaconerr.ErrInvalidArgument(err.Error(), "cidr")
+	}
+
+	for _, reverseZone := range reverseZones {
+		if err = auth.Authorize(ctx, model.ActionWrite, model.ZoneResource(reverseZone.name)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = opts.Tags.Validate(); err != nil {
+		return nil, beaconerr.ErrInvalidArgument(err.Error(), "tags")
+	}
+
+	var template *model.ZoneTemplate
+	if opts.Template != "" {
+		template, err = d.getZoneTemplate(ctx, opts.Template)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	zoneInfos := make([]model.ZoneInfo, 0, len(reverseZones))
+	err = d.registry.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		for _, reverseZone := range reverseZones {
+			zone := newHostedZone(reverseZone.name)
+			zone.Tags = opts.Tags
+			if template != nil {
+				if templateErr := applyTemplateToNewZone(zone, template); templateErr != nil {
+					return templateErr
+				}
+			}
+
+			zoneInfo, createZoneErr := createZone(ctx, r, model.AuditOperationCreateZone, zone, false)
+			if createZoneErr != nil {
+				return createZoneErr
+			}
+			zoneInfos = append(zoneInfos, *zoneInfo)
+
+			if createZoneErr = d.checkResourceRecordSetQuota(ctx, r, zone.Name, 0); createZoneErr != nil {
+				return createZoneErr
+			}
+
+			if !opts.DelegateFromParent {
+				continue
+			}
+
+			var cnames []model.ResourceRecordSet
+			if reverseZone.classless.IsValid() {
+				cnames = classlessDelegationCNAMEs(reverseZone, nsRRTTL)
+			}
+
+			createZoneErr = syncParentDelegation(ctx, r, zone.Name, delegationModeCreate, cnames...)
+			if createZoneErr != nil {
+				return createZoneErr
+			}
+		}
+
+		return d.checkZoneQuota(ctx, r)
+	})
+	if err != nil && errors.Is(err, repository.ErrEntityAlreadyExists) {
+		return nil, beaconerr.ErrZoneAlreadyExists("zone already exists")
+	} else if err != nil && (beaconerr.IsBadRequestError(err) || beaconerr.IsAccessDeniedError(err)) {
+		return nil, err
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to create reverse zones", err)
+	}
 
+	return zoneInfos, nil
+}
+
+// newHostedZone returns a zone with the SOA and NS record sets Beacon serves at the apex of
+// every hosted zone.
+func newHostedZone(zoneName string) *model.Zone {
 	zone := model.NewZone(zoneName)
 
 	nameServerNames := []string{"ns1.beacondns.org.", "ns2.beacondns.org."}
@@ -84,37 +354,70 @@ func (d *DefaultService) CreateZone(ctx context.Context, name string) (*model.Zo
 
 	zone.ResourceRecordSets = []model.ResourceRecordSet{soaRecord, nsRecord}
 
-	soaChangeAction := model.NewChangeAction(model.ChangeActionTypeUpsert, &soaRecord)
-	nsChangeAction := model.NewChangeAction(model.ChangeActionTypeUpsert, &nsRecord)
+	return zone
+}
 
-	change := model.NewChange(zone.ID, model.ChangeStatusPending, []model.ChangeAction{soaChangeAction, nsChangeAction})
+// applyTemplateToNewZone validates the record sets of the template rendered for zone and adds
+// them to the record sets the zone is created with.
+func applyTemplateToNewZone(zone *model.Zone, template *model.ZoneTemplate) error {
+	actions := templateChangeActions(renderZoneTemplate(template, zone.Name))
+	change := model.NewChange(zone.ID, model.ChangeStatusPending, actions)
 
-	event := NewCreateZoneEvent(zoneName, change.ID)
+	if err := validateChanges(zone, &change); err != nil {
+		return invalidChangeError(err, "failed to apply zone template "+template.Name)
+	}
 
-	var zoneInfo *model.ZoneInfo
-	err := d.registry.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
-		var createZoneErr error
-		zoneInfo, createZoneErr = r.GetZoneRepository().CreateZone(ctx, zone)
-		if createZoneErr != nil {
-			return createZoneErr
-		}
+	zone.ResourceRecordSets = projectChanges(zone.ResourceRecordSets, actions)
 
-		_, createZoneErr = r.GetZoneRepository().CreateChange(ctx, change)
-		if createZoneErr != nil {
-			return createZoneErr
-		}
+	return nil
+}
 
-		createZoneErr = r.GetEventRepository().CreateEvent(ctx, event)
-		if createZoneErr != nil {
-			return createZoneErr
-		}
+// createZone writes a new zone along with the change, version and event that publish it, and
+// records it in the audit log as operation. If delegate is set, the zone is also delegated
+// from its closest hosted parent zone.
+func createZone(
+	ctx context.Context,
+	r repository.Registry,
+	operation string,
+	zone *model.Zone,
+	delegate bool,
+) (*model.ZoneInfo, error) {
+	actions := make([]model.ChangeAction, 0, len(zone.ResourceRecordSets))
+	for i := range zone.ResourceRecordSets {
+		actions = append(actions, model.NewChangeAction(model.ChangeActionTypeUpsert, &zone.ResourceRecordSets[i]))
+	}
 
-		return nil
-	})
-	if err != nil && errors.Is(err, repository.ErrEntityAlreadyExists) {
-		return nil, beaconerr.ErrZoneAlreadyExists("zone already exists")
-	} else if err != nil {
-		return nil, beaconerr.ErrInternalError("failed to create zone", err)
+	change := model.NewChange(zone.ID, model.ChangeStatusPending, actions)
+
+	zoneInfo, err := r.GetZoneRepository().CreateZone(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.GetZoneRepository().CreateChange(ctx, change)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.GetZoneRepository().CreateZoneVersion(ctx, zone.Name, change.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.GetEventRepository().CreateEvent(ctx, NewCreateZoneEvent(zone.Name, change.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = audit.Record(ctx, r, operation, model.ZoneResource(zone.Name), nil, zone); err != nil {
+		return nil, err
+	}
+
+	if delegate {
+		err = syncParentDelegation(ctx, r, zone.Name, delegationModeCreate)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return zoneInfo, nil
@@ -122,6 +425,10 @@ func (d *DefaultService) CreateZone(ctx context.Context, name string) (*model.Zo
 
 func (d *DefaultService) GetZoneInfo(ctx context.Context, name string) (*model.ZoneInfo, error) {
 	zoneName := dns.Fqdn(name)
+	if err := auth.Authorize(ctx, model.ActionRead, model.ZoneResource(zoneName)); err != nil {
+		return nil, err
+	}
+
 	z, err := d.registry.GetZoneRepository().GetZoneInfo(ctx, zoneName)
 	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
 		return nil, beaconerr.ErrNoSuchZone("zone not found")
@@ -132,167 +439,597 @@ func (d *DefaultService) GetZoneInfo(ctx context.Context, name string) (*model.Z
 	return z, nil
 }
 
-func (d *DefaultService) ListZones(ctx context.Context) ([]model.ZoneInfo, error) {
-	zones, err := d.registry.GetZoneRepository().ListZoneInfos(ctx)
-	if err != nil {
-		return nil, beaconerr.ErrInternalError("failed to list zones", err)
+// GetZoneInfoByID returns the zone with the given ID.
+func (d *DefaultService) GetZoneInfoByID(ctx context.Context, id uuid.UUID) (*model.ZoneInfo, error) {
+	z, err := d.registry.GetZoneRepository().GetZoneInfoByID(ctx, id)
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchZone("zone not found")
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to get zone info", err)
+	}
+
+	if err = auth.Authorize(ctx, model.ActionRead, model.ZoneResource(z.Name)); err != nil {
+		return nil, err
 	}
 
-	return zones, nil
+	return z, nil
 }
 
-func (d *DefaultService) UpsertResourceRecordSet(
-	ctx context.Context,
-	zoneName string,
-	rrSet *model.ResourceRecordSet,
-) (*model.ResourceRecordSet, error) {
-	zoneName = dns.Fqdn(zoneName)
-	zone, err := d.registry.GetZoneRepository().GetZone(ctx, zoneName)
-	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
-		return nil, beaconerr.ErrNoSuchZone("zone not found")
-	} else if err != nil {
-		return nil, beaconerr.ErrInternalError("failed to upsert resource record set", err)
+// ListZones returns a page of the zones, sorted by name.
+func (d *DefaultService) ListZones(ctx context.Context, opts model.ListOptions) (model.Page[model.ZoneInfo], error) {
+	if err := opts.Normalize(model.SortAscending, model.SortByName); err != nil {
+		return model.Page[model.ZoneInfo]{}, beaconerr.ErrInvalidArgument(err.Error(), "options")
 	}
 
-	rrSet.Name = dns.Fqdn(rrSet.Name)
+	zones, err := d.registry.GetZoneRepository().ListZoneInfos(ctx, opts)
+	if err != nil {
+		return model.Page[model.ZoneInfo]{}, listError("failed to list zones", err)
+	}
 
-	changeAction := model.NewChangeAction(model.ChangeActionTypeUpsert, rrSet)
+	return auth.FilterPage(ctx, zones, func(zone *model.ZoneInfo) string {
+		return model.ZoneResource(zone.Name)
+	}), nil
+}
 
-	change := model.NewChange(zone.ID, model.ChangeStatusPending, []model.ChangeAction{changeAction})
+// listError maps the error of a list query. Whether a cursor fits the sort key is only known
+// once the query is built.
+func listError(message string, err error) error {
+	if errors.Is(err, model.ErrInvalidCursor) {
+		return beaconerr.ErrInvalidArgument(err.Error(), "cursor")
+	}
+	return beaconerr.ErrInternalError(message, err)
+}
 
-	changeEvent := NewChangeRRSetEvent(change.ID.String(), change.ID)
+// UpdateZoneTags adds or overwrites the tags in set on the zone and removes the keys in
+// remove. Tags are not part of the zone data served over DNS, so no change is recorded.
+func (d *DefaultService) UpdateZoneTags(
+	ctx context.Context,
+	name string,
+	set model.Tags,
+	remove []string,
+) (model.Tags, error) {
+	if err := auth.Authorize(ctx, model.ActionWrite, model.ZoneResource(dns.Fqdn(name))); err != nil {
+		return nil, err
+	}
 
-	err = validateChanges(zone, &change)
-	if err != nil {
-		return nil, beaconerr.ErrInvalidArgument(err.Error(), "")
+	if err := set.Validate(); err != nil {
+		return nil, beaconerr.ErrInvalidArgument(err.Error(), "set")
 	}
 
-	var newRRSet *model.ResourceRecordSet
-	err = d.registry.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
-		newRRSet, err = r.GetZoneRepository().UpsertResourceRecordSet(ctx, zoneName, rrSet)
-		if err != nil {
-			return err
+	var tags model.Tags
+	err := d.registry.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		zone, txErr := r.GetZoneRepository().GetZoneInfo(ctx, dns.Fqdn(name))
+		if txErr != nil {
+			return txErr
 		}
 
-		_, err = r.GetZoneRepository().CreateChange(ctx, change)
-		if err != nil {
-			return err
+		tags, txErr = r.GetZoneRepository().UpdateZoneTags(ctx, zone.Name, set, remove)
+		if txErr != nil {
+			return txErr
 		}
 
-		err = r.GetEventRepository().CreateEvent(ctx, changeEvent)
-		if err != nil {
-			return err
+		if txErr = tags.Validate(); txErr != nil {
+			return beaconerr.ErrInvalidArgument(txErr.Error(), "set")
 		}
 
-		return nil
+		return audit.Record(ctx, r, model.AuditOperationUpdateZoneTags, model.ZoneResource(zone.Name), zone.Tags, tags)
 	})
-	if err != nil {
-		return nil, beaconerr.ErrInternalError("failed to upsert resource record set", err)
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchZone("zone not found")
+	} else if err != nil && beaconerr.IsBadRequestError(err) {
+		return nil, err
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to update zone tags", err)
 	}
 
-	return newRRSet, nil
+	return tags, nil
 }
 
-func (d *DefaultService) GetResourceRecordSet(
+func (d *DefaultService) UpsertResourceRecordSet(
 	ctx context.Context,
 	zoneName string,
-	name string,
-	rrType model.RRType,
-) (*model.ResourceRecordSet, error) {
+	rrSet *model.ResourceRecordSet,
+	opts ResourceRecordSetOptions,
+) (*model.ResourceRecordSet, []model.LintFinding, error) {
 	zoneName = dns.Fqdn(zoneName)
-	rrSet, err := d.registry.GetZoneRepository().GetResourceRecordSet(ctx, zoneName, name, rrType)
-	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
-		return nil, beaconerr.ErrNoSuchResourceRecordSet("resource record set not found")
-	} else if err != nil {
-		return nil, beaconerr.ErrInternalError("failed to get resource record set", err)
+	rrSet.Name = dns.Fqdn(rrSet.Name)
+	err := auth.Authorize(ctx, model.ActionWrite, model.ResourceRecordSetResource(zoneName, rrSet.Name, rrSet.Type))
+	if err != nil {
+		return nil, nil, err
 	}
-	return rrSet, nil
-}
 
-func (d *DefaultService) DeleteResourceRecordSet(
-	ctx context.Context,
-	zoneName string,
-	name string,
-	rrType model.RRType,
-) error {
-	zoneName = dns.Fqdn(zoneName)
-	zone, err := d.registry.GetZoneRepository().GetZone(ctx, zoneName)
-	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
-		return beaconerr.ErrNoSuchZone("zone not found")
+	_, warnings, err := d.applyChange(ctx, model.AuditOperationUpsertRRSet, zoneName, opts,
+		func(*model.Zone) ([]model.ChangeAction, error) {
+			return []model.ChangeAction{model.NewChangeAction(model.ChangeActionTypeUpsert, rrSet)}, nil
+		})
+	if err != nil && (beaconerr.IsBadRequestError(err) || beaconerr.IsConflictError(err) ||
+		beaconerr.IsAccessDeniedError(err) || beaconerr.IsNoSuchError(err)) {
+		return nil, nil, err
 	} else if err != nil {
-		return beaconerr.ErrInternalError("failed to delete resource record set", err)
+		return nil, nil, beaconerr.ErrInternalError("failed to upsert resource record set", err)
 	}
 
-	changeAction := model.NewChangeAction(model.ChangeActionTypeDelete, nil)
-	change := model.NewChange(zone.ID, model.ChangeStatusPending, []model.ChangeAction{changeAction})
+	return rrSet, warnings, nil
+}
 
-	err = validateChanges(zone, &change)
-	if err != nil {
-		return beaconerr.ErrInvalidArgument(err.Error(), "")
-	}
+// changeBuilder returns the actions of a change to zone, failing with the error to report if
+// the change cannot be made.
+type changeBuilder func(zone *model.Zone) ([]model.ChangeAction, error)
 
-	changeEvent := NewChangeRRSetEvent(change.ID.String(), change.ID)
+// applyChange makes a change to the named zone in a single transaction, recorded in the audit
+// log as operation. The zone is locked for the transaction before the change is built from it
+// with build and checked with checkChange, so that changes checked against the zone cannot
+// race each other. The policy warnings checkChange finds are returned with the change.
+// If the change touches the apex NS or DNSKEY record sets of the zone, an existing delegation
+// to the zone in its hosted parent zone is updated in the same transaction. If syncPTR is
+// set in opts, the PTR records for any changed A and AAAA record sets are updated in the same
+// transaction too, and announced with the single event of the change.
+func (d *DefaultService) applyChange(
+	ctx context.Context,
+	operation string,
+	zoneName string,
+	opts ResourceRecordSetOptions,
+	build changeBuilder,
+) (*model.Change, []model.LintFinding, error) {
+	var change model.Change
+	var warnings []model.LintFinding
+	err := d.registry.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		zone, err := r.GetZoneRepository().GetZoneForUpdate(ctx, zoneName)
+		if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+			return beaconerr.ErrNoSuchZone("zone not found")
+		} else if err != nil {
+			return err
+		}
 
-	err = d.registry.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
-		deleteErr := r.GetZoneRepository().DeleteResourceRecordSet(ctx, zoneName, name, rrType)
-		if deleteErr != nil {
+		actions, err := build(zone)
+		if err != nil {
 			return err
 		}
 
-		_, deleteErr = r.GetZoneRepository().CreateChange(ctx, change)
-		if deleteErr != nil {
+		change = model.NewChange(zone.ID, model.ChangeStatusPending, actions)
+		if warnings, err = checkChange(zone, &change, opts); err != nil {
 			return err
 		}
 
-		deleteErr = r.GetEventRepository().CreateEvent(ctx, changeEvent)
-		if deleteErr != nil {
+		if err = recordChange(ctx, r, operation, zone, &change); err != nil {
 			return err
 		}
 
-		return nil
-	})
-	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
-		return beaconerr.ErrNoSuchResourceRecordSet("resource record set not found")
-	} else if err != nil {
-		return beaconerr.ErrInternalError("failed to delete zone", err)
-	}
+		err = d.checkResourceRecordSetQuota(ctx, r, zone.Name, len(zone.ResourceRecordSets))
+		if err != nil {
+			return err
+		}
 
-	return nil
-}
+		if changesDelegation(zone.Name, change.Actions) {
+			if err = syncParentDelegation(ctx, r, zone.Name, delegationModeSync); err != nil {
+				return err
+			}
+		}
 
-func (d *DefaultService) ListResourceRecordSets(
-	ctx context.Context,
-	zoneName string,
-) ([]model.ResourceRecordSet, error) {
-	zoneName = dns.Fqdn(zoneName)
-	rrSets, err := d.registry.GetZoneRepository().GetZoneResourceRecordSets(ctx, zoneName)
+		var ptrChanges []ZoneChange
+		if opts.SyncPTR {
+			ptrChanges, err = syncPTRRecords(ctx, r, zone.ResourceRecordSets, change.Actions)
+			if err != nil {
+				return err
+			}
+		}
+
+		return r.GetEventRepository().CreateEvent(ctx, NewChangeRRSetEvent(zone.Name, change.ID, ptrChanges...))
+	})
 	if err != nil {
-		return nil, beaconerr.ErrInternalError("failed to list resource record sets", err)
+		return nil, nil, err
 	}
 
-	return rrSets, nil
+	return &change, warnings, nil
 }
 
-func (d *DefaultService) DeleteZone(ctx context.Context, name string) error {
-	zoneName := dns.Fqdn(name)
+// checkChange validates change against zone. If opts asks for it, the policies in the TXT
+// record sets the change upserts are checked as well, and the warnings found returned.
+func checkChange(zone *model.Zone, change *model.Change, opts ResourceRecordSetOptions) ([]model.LintFinding, error) {
+	if err := validateChanges(zone, change); err != nil {
+		return nil, invalidChangeError(err, "")
+	}
 
-	zone, err := d.registry.GetZoneRepository().GetZoneInfo(ctx, zoneName)
-	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
-		return beaconerr.ErrNoSuchZone("zone not found")
-	} else if err != nil {
-		return beaconerr.ErrInternalError("failed to delete zone", err)
+	if !opts.ValidatePolicies {
+		return nil, nil
 	}
 
-	if zone.ResourceRecordSetCount > 2 {
-		return beaconerr.ErrHostedZoneNotEmpty("zone is not empty")
+	warnings, err := checkChangePolicies(zone, change.Actions)
+	if err != nil {
+		return nil, invalidChangeError(err, "")
 	}
 
-	event := NewDeleteZoneEvent(zoneName)
+	return warnings, nil
+}
 
-	err = d.registry.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
-		deleteErr := r.GetZoneRepository().DeleteZone(ctx, zoneName)
-		if deleteErr != nil {
-			return deleteErr
+// writeChange records a validated change with recordChange and emits a single change event
+// for it.
+func writeChange(
+	ctx context.Context,
+	r repository.Registry,
+	operation string,
+	zone *model.Zone,
+	change *model.Change,
+) error {
+	if err := recordChange(ctx, r, operation, zone, change); err != nil {
+		return err
+	}
+
+	return r.GetEventRepository().CreateEvent(ctx, NewChangeRRSetEvent(zone.Name, change.ID))
+}
+
+// recordChange writes the actions of a validated change to the repository, records the
+// change and snapshots the resulting zone as a new version, without emitting an event for
+// it. The change is recorded in the audit log as operation. Each action is authorized
+// against its record set, which also covers the changes made to other zones on the side,
+// such as delegations and PTR records.
+func recordChange(
+	ctx context.Context,
+	r repository.Registry,
+	operation string,
+	zone *model.Zone,
+	change *model.Change,
+) error {
+	zoneName := zone.Name
+	if err := authorizeChange(ctx, zoneName, change.Actions); err != nil {
+		return err
+	}
+
+	for _, action := range change.Actions {
+		var err error
+		switch action.ActionType {
+		case model.ChangeActionTypeUpsert:
+			_, err = r.GetZoneRepository().UpsertResourceRecordSet(ctx, zoneName, action.ResourceRecordSet)
+		case model.ChangeActionTypeDelete:
+			err = r.GetZoneRepository().DeleteResourceRecordSet(
+				ctx,
+				zoneName,
+				action.ResourceRecordSet.Name,
+				action.ResourceRecordSet.Type,
+			)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	created, err := r.GetZoneRepository().CreateChange(ctx, *change)
+	if err != nil {
+		return err
+	}
+	change.SubmittedAt = created.SubmittedAt
+
+	_, err = r.GetZoneRepository().CreateZoneVersion(ctx, zoneName, change.ID)
+	if err != nil {
+		return err
+	}
+
+	return auditChange(ctx, r, operation, zone, change)
+}
+
+// auditChange records a change to zone in the audit log, with the record sets it replaces or
+// deletes as before and the record sets it upserts as after. A change to a single record set
+// targets that record set, and any other change the zone.
+func auditChange(
+	ctx context.Context,
+	r repository.Registry,
+	operation string,
+	zone *model.Zone,
+	change *model.Change,
+) error {
+	current := make(map[string]model.ResourceRecordSet, len(zone.ResourceRecordSets))
+	for _, rrSet := range zone.ResourceRecordSets {
+		current[rrSetKey(rrSet.Name, rrSet.Type)] = rrSet
+	}
+
+	var before, after []model.ResourceRecordSet
+	for _, action := range change.Actions {
+		rrSet := action.ResourceRecordSet
+		if existing, ok := current[rrSetKey(rrSet.Name, rrSet.Type)]; ok {
+			before = append(before, existing)
+		}
+		if action.ActionType == model.ChangeActionTypeUpsert {
+			after = append(after, *rrSet)
+		}
+	}
+
+	target := model.ZoneResource(zone.Name)
+	if len(change.Actions) == 1 {
+		rrSet := change.Actions[0].ResourceRecordSet
+		target = model.ResourceRecordSetResource(zone.Name, dns.Fqdn(rrSet.Name), rrSet.Type)
+	}
+
+	return audit.Record(ctx, r, operation, target, before, after)
+}
+
+// authorizeChange checks that the principal carried by ctx may write the record sets the
+// actions upsert and delete the record sets the actions delete.
+func authorizeChange(ctx context.Context, zoneName string, actions []model.ChangeAction) error {
+	for _, action := range actions {
+		permission := model.ActionWrite
+		if action.ActionType == model.ChangeActionTypeDelete {
+			permission = model.ActionDelete
+		}
+
+		rrSet := action.ResourceRecordSet
+		resource := model.ResourceRecordSetResource(zoneName, rrSet.Name, rrSet.Type)
+		if err := auth.Authorize(ctx, permission, resource); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PlanUpsertResourceRecordSet validates an upsert of rrSet and returns the difference it
+// would make to the zone, without applying it. Policies are checked as the upsert would
+// check them with opts. The difference only covers the zone itself, leaving out the PTR
+// records the upsert would sync into reverse zones and the delegation it would update in
+// the parent zone.
+func (d *DefaultService) PlanUpsertResourceRecordSet(
+	ctx context.Context,
+	zoneName string,
+	rrSet *model.ResourceRecordSet,
+	opts ResourceRecordSetOptions,
+) (*model.ZoneDiff, error) {
+	zoneName = dns.Fqdn(zoneName)
+	rrSet.Name = dns.Fqdn(rrSet.Name)
+	err := auth.Authorize(ctx, model.ActionWrite, model.ResourceRecordSetResource(zoneName, rrSet.Name, rrSet.Type))
+	if err != nil {
+		return nil, err
+	}
+
+	zone, err := d.registry.GetZoneRepository().GetZone(ctx, zoneName)
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchZone("zone not found")
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to plan resource record set upsert", err)
+	}
+
+	changeAction := model.NewChangeAction(model.ChangeActionTypeUpsert, rrSet)
+	change := model.NewChange(zone.ID, model.ChangeStatusPending, []model.ChangeAction{changeAction})
+
+	return planChange(zone, &change, opts)
+}
+
+// PlanDeleteResourceRecordSet validates a delete of the named resource record set and
+// returns the difference it would make to the zone, without applying it. As with
+// PlanUpsertResourceRecordSet, changes to reverse and parent zones are left out.
+func (d *DefaultService) PlanDeleteResourceRecordSet(
+	ctx context.Context,
+	zoneName string,
+	name string,
+	rrType model.RRType,
+) (*model.ZoneDiff, error) {
+	zoneName = dns.Fqdn(zoneName)
+	err := auth.Authorize(ctx, model.ActionDelete, model.ResourceRecordSetResource(zoneName, dns.Fqdn(name), rrType))
+	if err != nil {
+		return nil, err
+	}
+
+	zone, err := d.registry.GetZoneRepository().GetZone(ctx, zoneName)
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchZone("zone not found")
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to plan resource record set delete", err)
+	}
+
+	key := rrSetKey(name, rrType)
+	idx := slices.IndexFunc(zone.ResourceRecordSets, func(rrSet model.ResourceRecordSet) bool {
+		return rrSetKey(rrSet.Name, rrSet.Type) == key
+	})
+	if idx < 0 {
+		return nil, beaconerr.ErrNoSuchResourceRecordSet("resource record set not found")
+	}
+
+	rrSet := zone.ResourceRecordSets[idx]
+	changeAction := model.NewChangeAction(model.ChangeActionTypeDelete, &rrSet)
+	change := model.NewChange(zone.ID, model.ChangeStatusPending, []model.ChangeAction{changeAction})
+
+	return planChange(zone, &change, ResourceRecordSetOptions{})
+}
+
+// planChange checks change against zone with checkChange and returns the difference between
+// the current state of the zone and the state it would be in once the change is applied.
+func planChange(zone *model.Zone, change *model.Change, opts ResourceRecordSetOptions) (*model.ZoneDiff, error) {
+	if _, err := checkChange(zone, change, opts); err != nil {
+		return nil, err
+	}
+
+	projected := projectChanges(zone.ResourceRecordSets, change.Actions)
+	diff := diffResourceRecordSets(zone.ResourceRecordSets, projected)
+
+	return &diff, nil
+}
+
+func (d *DefaultService) GetResourceRecordSet(
+	ctx context.Context,
+	zoneName string,
+	name string,
+	rrType model.RRType,
+) (*model.ResourceRecordSet, error) {
+	zoneName = dns.Fqdn(zoneName)
+	err := auth.Authorize(ctx, model.ActionRead, model.ResourceRecordSetResource(zoneName, dns.Fqdn(name), rrType))
+	if err != nil {
+		return nil, err
+	}
+
+	rrSet, err := d.registry.GetZoneRepository().GetResourceRecordSet(ctx, zoneName, name, rrType)
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchResourceRecordSet("resource record set not found")
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to get resource record set", err)
+	}
+	return rrSet, nil
+}
+
+func (d *DefaultService) DeleteResourceRecordSet(
+	ctx context.Context,
+	zoneName string,
+	name string,
+	rrType model.RRType,
+	opts ResourceRecordSetOptions,
+) error {
+	zoneName = dns.Fqdn(zoneName)
+	err := auth.Authorize(ctx, model.ActionDelete, model.ResourceRecordSetResource(zoneName, dns.Fqdn(name), rrType))
+	if err != nil {
+		return err
+	}
+
+	_, _, err = d.applyChange(ctx, model.AuditOperationDeleteRRSet, zoneName, opts,
+		func(zone *model.Zone) ([]model.ChangeAction, error) {
+			key := rrSetKey(name, rrType)
+			idx := slices.IndexFunc(zone.ResourceRecordSets, func(rrSet model.ResourceRecordSet) bool {
+				return rrSetKey(rrSet.Name, rrSet.Type) == key
+			})
+			if idx < 0 {
+				return nil, beaconerr.ErrNoSuchResourceRecordSet("resource record set not found")
+			}
+
+			rrSet := zone.ResourceRecordSets[idx]
+			return []model.ChangeAction{model.NewChangeAction(model.ChangeActionTypeDelete, &rrSet)}, nil
+		})
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return beaconerr.ErrNoSuchResourceRecordSet("resource record set not found")
+	} else if err != nil && (beaconerr.IsBadRequestError(err) || beaconerr.IsConflictError(err) ||
+		beaconerr.IsAccessDeniedError(err) || beaconerr.IsNoSuchError(err)) {
+		return err
+	} else if err != nil {
+		return beaconerr.ErrInternalError("failed to delete resource record set", err)
+	}
+
+	return nil
+}
+
+// ChangeResourceRecordSets applies a batch of creates, upserts and deletes to the record sets
+// of the zone as a single change, which is rejected as a whole if any of its actions is
+// invalid.
+func (d *DefaultService) ChangeResourceRecordSets(
+	ctx context.Context,
+	zoneName string,
+	batch []ChangeBatchAction,
+	opts ResourceRecordSetOptions,
+) (*model.Change, error) {
+	zoneName = dns.Fqdn(zoneName)
+	if len(batch) == 0 {
+		return nil, beaconerr.ErrInvalidArgument("change batch has no actions", "changes")
+	}
+
+	// The batch is checked against the zone as it is locked for the change, so that of two
+	// concurrent creates of the same record set, the second fails rather than replacing the
+	// first.
+	change, _, err := d.applyChange(ctx, model.AuditOperationChangeRRSets, zoneName, opts,
+		func(zone *model.Zone) ([]model.ChangeAction, error) {
+			actions, batchErr := changeBatchActions(zone, batch)
+			if batchErr != nil {
+				return nil, invalidChangeError(batchErr, "")
+			}
+
+			if batchErr = authorizeChange(ctx, zoneName, actions); batchErr != nil {
+				return nil, batchErr
+			}
+
+			return actions, nil
+		})
+	if err != nil && (beaconerr.IsBadRequestError(err) || beaconerr.IsConflictError(err) ||
+		beaconerr.IsAccessDeniedError(err) || beaconerr.IsNoSuchError(err)) {
+		return nil, err
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to change resource record sets", err)
+	}
+
+	return change, nil
+}
+
+// GetChange returns a change made to a zone the principal may read. Changes to zones that
+// have since been deleted are not found.
+func (d *DefaultService) GetChange(ctx context.Context, id uuid.UUID) (*model.Change, error) {
+	change, err := d.registry.GetZoneRepository().GetChange(ctx, id)
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchChange("change not found")
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to get change", err)
+	}
+
+	zone, err := d.registry.GetZoneRepository().GetZoneInfoByID(ctx, change.ZoneID)
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchChange("change not found")
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to get change", err)
+	}
+
+	if err = auth.Authorize(ctx, model.ActionRead, model.ZoneResource(zone.Name)); err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
+
+// ListResourceRecordSets returns a page of the record sets of the zone, sorted by name or
+// type. An empty rrType lists every type. Record sets the principal may not read are left
+// out.
+func (d *DefaultService) ListResourceRecordSets(
+	ctx context.Context,
+	zoneName string,
+	rrType model.RRType,
+	opts model.ListOptions,
+) (model.Page[model.ResourceRecordSet], error) {
+	if err := opts.Normalize(model.SortAscending, model.SortByName, model.SortByType); err != nil {
+		return model.Page[model.ResourceRecordSet]{}, beaconerr.ErrInvalidArgument(err.Error(), "options")
+	}
+
+	zoneName = dns.Fqdn(zoneName)
+	rrSets, err := d.registry.GetZoneRepository().ListResourceRecordSets(ctx, zoneName, rrType, opts)
+	if err != nil {
+		return model.Page[model.ResourceRecordSet]{}, listError("failed to list resource record sets", err)
+	}
+
+	return auth.FilterPage(ctx, rrSets, func(rrSet *model.ResourceRecordSet) string {
+		return model.ResourceRecordSetResource(zoneName, rrSet.Name, rrSet.Type)
+	}), nil
+}
+
+// DeleteZone deletes the zone. Unless force is set, the zone must not contain any record
+// sets other than its SOA and NS records. A forced delete removes every record set and the
+// change history of the zone along with it, and moves the zone to the trash when a trash
+// period is configured. Any delegation to the zone in its hosted parent zone is removed.
+func (d *DefaultService) DeleteZone(ctx context.Context, name string, force bool) error {
+	zoneName := dns.Fqdn(name)
+	if err := auth.Authorize(ctx, model.ActionDelete, model.ZoneResource(zoneName)); err != nil {
+		return err
+	}
+
+	trash := force && d.trashPeriod > 0
+
+	event := NewDeleteZoneEvent(zoneName)
+
+	err := d.registry.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		// The zone is locked so that no record set can be written between checking that it is
+		// empty, or storing it in the trash, and deleting it.
+		zone, deleteErr := r.GetZoneRepository().GetZoneForUpdate(ctx, zoneName)
+		if deleteErr != nil {
+			return deleteErr
+		}
+
+		if !force && len(zone.ResourceRecordSets) > 2 {
+			return beaconerr.ErrHostedZoneNotEmpty("zone is not empty")
+		}
+
+		if trash {
+			deleteErr = r.GetZoneRepository().PurgeDeletedZones(ctx)
+			if deleteErr != nil {
+				return deleteErr
+			}
+
+			deleteErr = r.GetZoneRepository().CreateDeletedZone(ctx, zone, time.Now().Add(d.trashPeriod))
+			if deleteErr != nil {
+				return deleteErr
+			}
+		}
+
+		deleteErr = r.GetZoneRepository().DeleteZone(ctx, zoneName)
+		if deleteErr != nil {
+			return deleteErr
 		}
 
 		deleteErr = r.GetEventRepository().CreateEvent(ctx, event)
@@ -300,13 +1037,587 @@ func (d *DefaultService) DeleteZone(ctx context.Context, name string) error {
 			return deleteErr
 		}
 
-		return nil
+		deleteErr = audit.Record(ctx, r, model.AuditOperationDeleteZone, model.ZoneResource(zoneName), zone, nil)
+		if deleteErr != nil {
+			return deleteErr
+		}
+
+		return syncParentDelegation(ctx, r, zoneName, delegationModeRemove)
 	})
 	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
 		return beaconerr.ErrNoSuchZone("zone not found")
+	} else if err != nil && (beaconerr.IsAccessDeniedError(err) || beaconerr.IsBadRequestError(err)) {
+		return err
 	} else if err != nil {
 		return beaconerr.ErrInternalError("failed to delete zone", err)
 	}
 
 	return nil
 }
+
+// ListDeletedZones returns a page of the zones in the trash, most recently deleted first
+// unless sorted otherwise. Zones whose trash period has expired are purged first.
+func (d *DefaultService) ListDeletedZones(
+	ctx context.Context,
+	opts model.ListOptions,
+) (model.Page[model.DeletedZone], error) {
+	if err := opts.Normalize(model.SortDescending, model.SortByDeletedAt, model.SortByName); err != nil {
+		return model.Page[model.DeletedZone]{}, beaconerr.ErrInvalidArgument(err.Error(), "options")
+	}
+
+	if err := d.registry.GetZoneRepository().PurgeDeletedZones(ctx); err != nil {
+		return model.Page[model.DeletedZone]{}, beaconerr.ErrInternalError("failed to purge deleted zones", err)
+	}
+
+	zones, err := d.registry.GetZoneRepository().ListDeletedZones(ctx, opts)
+	if err != nil {
+		return model.Page[model.DeletedZone]{}, listError("failed to list deleted zones", err)
+	}
+
+	return auth.FilterPage(ctx, zones, func(zone *model.DeletedZone) string {
+		return model.ZoneResource(zone.Name)
+	}), nil
+}
+
+// RestoreZone recreates a force-deleted zone from the trash with the record sets it had when
+// it was deleted. The change history of the zone is not restored, so its version history
+// starts over. Zones whose trash period has expired are purged first.
+func (d *DefaultService) RestoreZone(ctx context.Context, name string) (*model.ZoneInfo, error) {
+	zoneName := dns.Fqdn(name)
+	if err := auth.Authorize(ctx, model.ActionWrite, model.ZoneResource(zoneName)); err != nil {
+		return nil, err
+	}
+
+	if err := d.registry.GetZoneRepository().PurgeDeletedZones(ctx); err != nil {
+		return nil, beaconerr.ErrInternalError("failed to purge deleted zones", err)
+	}
+
+	deleted, err := d.registry.GetZoneRepository().GetDeletedZone(ctx, zoneName)
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchZone("deleted zone not found")
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to restore zone", err)
+	}
+
+	zone := &model.Zone{
+		ID:                 deleted.ID,
+		Name:               deleted.Name,
+		ResourceRecordSets: deleted.ResourceRecordSets,
+		Tags:               deleted.Tags,
+	}
+
+	var zoneInfo *model.ZoneInfo
+	err = d.registry.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		var restoreErr error
+		zoneInfo, restoreErr = createZone(ctx, r, model.AuditOperationRestoreZone, zone, false)
+		if restoreErr != nil {
+			return restoreErr
+		}
+
+		if restoreErr = d.checkZoneQuota(ctx, r); restoreErr != nil {
+			return restoreErr
+		}
+
+		if restoreErr = d.checkResourceRecordSetQuota(ctx, r, zone.Name, 0); restoreErr != nil {
+			return restoreErr
+		}
+
+		return r.GetZoneRepository().DeleteDeletedZone(ctx, deleted.ID)
+	})
+	if err != nil && errors.Is(err, repository.ErrEntityAlreadyExists) {
+		return nil, beaconerr.ErrZoneAlreadyExists("a zone with this name already exists")
+	} else if err != nil && beaconerr.IsBadRequestError(err) {
+		return nil, err
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to restore zone", err)
+	}
+
+	return zoneInfo, nil
+}
+
+// ImportZone upserts the record sets of a zone file into the zone as a single change. The
+// record sets are checked with opts like any other upsert.
+func (d *DefaultService) ImportZone(
+	ctx context.Context,
+	zoneName string,
+	zoneFile io.Reader,
+	opts ResourceRecordSetOptions,
+) (*model.ZoneImportResult, error) {
+	zoneName = dns.Fqdn(zoneName)
+	if err := auth.Authorize(ctx, model.ActionWrite, model.ZoneResource(zoneName)); err != nil {
+		return nil, err
+	}
+
+	if _, err := d.registry.GetZoneRepository().GetZoneInfo(ctx, zoneName); err != nil &&
+		errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchZone("zone not found")
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to import zone", err)
+	}
+
+	parsed, err := parseZoneFile(zoneName, zoneFile)
+	if err != nil {
+		return nil, beaconerr.ErrInvalidArgument(err.Error(), "zoneFile")
+	}
+
+	if len(parsed.resourceRecordSets) == 0 {
+		return nil, beaconerr.ErrInvalidArgument("zone file contains no importable records", "zoneFile")
+	}
+
+	actions := make([]model.ChangeAction, 0, len(parsed.resourceRecordSets))
+	for i := range parsed.resourceRecordSets {
+		actions = append(actions, model.NewChangeAction(model.ChangeActionTypeUpsert, &parsed.resourceRecordSets[i]))
+	}
+
+	change, warnings, err := d.applyChange(ctx, model.AuditOperationImportZone, zoneName, opts,
+		func(*model.Zone) ([]model.ChangeAction, error) {
+			return actions, nil
+		})
+	if err != nil && (beaconerr.IsBadRequestError(err) || beaconerr.IsAccessDeniedError(err) ||
+		beaconerr.IsNoSuchError(err)) {
+		return nil, err
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to import zone", err)
+	}
+
+	return &model.ZoneImportResult{
+		ChangeID:           change.ID,
+		ResourceRecordSets: parsed.resourceRecordSets,
+		SkippedRecords:     parsed.skippedRecords,
+		Warnings:           warnings,
+	}, nil
+}
+
+func (d *DefaultService) ExportZone(ctx context.Context, zoneName string) ([]byte, error) {
+	zoneName = dns.Fqdn(zoneName)
+	if err := auth.Authorize(ctx, model.ActionRead, model.ZoneResource(zoneName)); err != nil {
+		return nil, err
+	}
+
+	zone, err := d.registry.GetZoneRepository().GetZone(ctx, zoneName)
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchZone("zone not found")
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to export zone", err)
+	}
+
+	var buf bytes.Buffer
+	if err = writeZoneFile(&buf, zone); err != nil {
+		return nil, beaconerr.ErrInternalError("failed to export zone", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// LintZone analyses the stored record sets of the zone and returns the problems it finds,
+// such as dangling CNAMEs or name servers without glue. See lint.go for the rules.
+func (d *DefaultService) LintZone(
+	ctx context.Context,
+	zoneName string,
+	opts LintOptions,
+) ([]model.LintFinding, error) {
+	zoneName = dns.Fqdn(zoneName)
+	if err := auth.Authorize(ctx, model.ActionRead, model.ZoneResource(zoneName)); err != nil {
+		return nil, err
+	}
+
+	if opts.Resolve && d.resolver == nil {
+		return nil, beaconerr.ErrInvalidArgument("resolution checks are not available", "resolve")
+	}
+
+	zone, err := d.registry.GetZoneRepository().GetZone(ctx, zoneName)
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchZone("zone not found")
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to lint zone", err)
+	}
+
+	findings := lintZoneRecords(zone)
+	if opts.Resolve {
+		findings = append(findings, lintResolution(ctx, d.resolver, zone)...)
+	}
+
+	return findings, nil
+}
+
+// ListZoneVersions returns a page of the versions of the zone, newest first unless sorted
+// otherwise. A version is only listed once the change that produced it is done, and cannot
+// be diffed or rolled back to before then either.
+func (d *DefaultService) ListZoneVersions(
+	ctx context.Context,
+	zoneName string,
+	opts model.ListOptions,
+) (model.Page[model.ZoneVersion], error) {
+	zoneName = dns.Fqdn(zoneName)
+	if err := auth.Authorize(ctx, model.ActionRead, model.ZoneResource(zoneName)); err != nil {
+		return model.Page[model.ZoneVersion]{}, err
+	}
+
+	if err := opts.Normalize(model.SortDescending, model.SortByVersion); err != nil {
+		return model.Page[model.ZoneVersion]{}, beaconerr.ErrInvalidArgument(err.Error(), "options")
+	}
+	if opts.NamePrefix != "" || !opts.Tags.IsZero() {
+		return model.Page[model.ZoneVersion]{}, beaconerr.ErrInvalidArgument(
+			"zone versions cannot be filtered by name or tags",
+			"options",
+		)
+	}
+
+	_, err := d.registry.GetZoneRepository().GetZoneInfo(ctx, zoneName)
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return model.Page[model.ZoneVersion]{}, beaconerr.ErrNoSuchZone("zone not found")
+	} else if err != nil {
+		return model.Page[model.ZoneVersion]{}, beaconerr.ErrInternalError("failed to list zone versions", err)
+	}
+
+	versions, err := d.registry.GetZoneRepository().ListZoneVersions(ctx, zoneName, opts)
+	if err != nil {
+		return model.Page[model.ZoneVersion]{}, listError("failed to list zone versions", err)
+	}
+
+	return versions, nil
+}
+
+func (d *DefaultService) DiffZoneVersions(
+	ctx context.Context,
+	zoneName string,
+	fromVersion int,
+	toVersion int,
+) (*model.ZoneDiff, error) {
+	zoneName = dns.Fqdn(zoneName)
+	if err := auth.Authorize(ctx, model.ActionRead, model.ZoneResource(zoneName)); err != nil {
+		return nil, err
+	}
+
+	_, err := d.registry.GetZoneRepository().GetZoneInfo(ctx, zoneName)
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchZone("zone not found")
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to diff zone versions", err)
+	}
+
+	from, err := d.getZoneVersion(ctx, zoneName, fromVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := d.getZoneVersion(ctx, zoneName, toVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := diffResourceRecordSets(from.ResourceRecordSets, to.ResourceRecordSets)
+
+	return &diff, nil
+}
+
+// RollbackZone restores the resource record sets of the zone to the state captured in the
+// given version. The rollback is submitted as a regular change, so it produces a new
+// version of its own rather than discarding the versions that came after the target, and is
+// checked with opts like any other change.
+func (d *DefaultService) RollbackZone(
+	ctx context.Context,
+	zoneName string,
+	version int,
+	opts ResourceRecordSetOptions,
+) (*model.Change, error) {
+	zoneName = dns.Fqdn(zoneName)
+	if err := auth.Authorize(ctx, model.ActionWrite, model.ZoneResource(zoneName)); err != nil {
+		return nil, err
+	}
+
+	if _, err := d.registry.GetZoneRepository().GetZoneInfo(ctx, zoneName); err != nil &&
+		errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchZone("zone not found")
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to rollback zone", err)
+	}
+
+	target, err := d.getZoneVersion(ctx, zoneName, version)
+	if err != nil {
+		return nil, err
+	}
+
+	change, _, err := d.applyChange(ctx, model.AuditOperationRollbackZone, zoneName, opts,
+		func(zone *model.Zone) ([]model.ChangeAction, error) {
+			actions := diffToChangeActions(diffResourceRecordSets(zone.ResourceRecordSets, target.ResourceRecordSets))
+			if len(actions) == 0 {
+				return nil, beaconerr.ErrInvalidArgument("zone already matches the requested version", "version")
+			}
+			return actions, nil
+		})
+	if err != nil && (beaconerr.IsBadRequestError(err) || beaconerr.IsAccessDeniedError(err) ||
+		beaconerr.IsNoSuchError(err)) {
+		return nil, err
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to rollback zone", err)
+	}
+
+	return change, nil
+}
+
+func (d *DefaultService) getZoneVersion(ctx context.Context, zoneName string, version int) (*model.ZoneVersion, error) {
+	zoneVersion, err := d.registry.GetZoneRepository().GetZoneVersion(ctx, zoneName, version)
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchZoneVersion(fmt.Sprintf("zone version %d not found", version))
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to get zone version", err)
+	}
+
+	return zoneVersion, nil
+}
+
+func (d *DefaultService) CreateZoneTemplate(
+	ctx context.Context,
+	template *model.ZoneTemplate,
+) (*model.ZoneTemplate, error) {
+	if err := auth.Authorize(ctx, model.ActionWrite, model.ZoneTemplateResource(template.Name)); err != nil {
+		return nil, err
+	}
+
+	if err := validateZoneTemplate(template); err != nil {
+		return nil, invalidChangeError(err, "invalid zone template")
+	}
+
+	template.ID = uuid.New()
+
+	var created *model.ZoneTemplate
+	err := d.registry.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		var txErr error
+		if created, txErr = r.GetZoneRepository().CreateZoneTemplate(ctx, template); txErr != nil {
+			return txErr
+		}
+
+		return audit.Record(ctx, r, model.AuditOperationCreateZoneTemplate, model.ZoneTemplateResource(template.Name),
+			nil, created)
+	})
+	if err != nil && errors.Is(err, repository.ErrEntityAlreadyExists) {
+		return nil, beaconerr.ErrZoneTemplateAlreadyExists("zone template already exists")
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to create zone template", err)
+	}
+
+	return created, nil
+}
+
+// UpdateZoneTemplate replaces the description and record sets of the named template. Zones
+// created from the template are left as they are; use ApplyZoneTemplate to bring them in
+// line with it.
+func (d *DefaultService) UpdateZoneTemplate(
+	ctx context.Context,
+	template *model.ZoneTemplate,
+) (*model.ZoneTemplate, error) {
+	if err := auth.Authorize(ctx, model.ActionWrite, model.ZoneTemplateResource(template.Name)); err != nil {
+		return nil, err
+	}
+
+	if err := validateZoneTemplate(template); err != nil {
+		return nil, invalidChangeError(err, "invalid zone template")
+	}
+
+	var updated *model.ZoneTemplate
+	err := d.registry.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		existing, txErr := r.GetZoneRepository().GetZoneTemplate(ctx, template.Name)
+		if txErr != nil {
+			return txErr
+		}
+
+		if updated, txErr = r.GetZoneRepository().UpdateZoneTemplate(ctx, template); txErr != nil {
+			return txErr
+		}
+
+		return audit.Record(ctx, r, model.AuditOperationUpdateZoneTemplate, model.ZoneTemplateResource(template.Name),
+			existing, updated)
+	})
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchZoneTemplate("zone template not found")
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to update zone template", err)
+	}
+
+	return updated, nil
+}
+
+func (d *DefaultService) DeleteZoneTemplate(ctx context.Context, name string) error {
+	if err := auth.Authorize(ctx, model.ActionDelete, model.ZoneTemplateResource(name)); err != nil {
+		return err
+	}
+
+	err := d.registry.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		existing, txErr := r.GetZoneRepository().GetZoneTemplate(ctx, name)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = r.GetZoneRepository().DeleteZoneTemplate(ctx, name); txErr != nil {
+			return txErr
+		}
+
+		return audit.Record(ctx, r, model.AuditOperationDeleteZoneTemplate, model.ZoneTemplateResource(name),
+			existing, nil)
+	})
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return beaconerr.ErrNoSuchZoneTemplate("zone template not found")
+	} else if err != nil {
+		return beaconerr.ErrInternalError("failed to delete zone template", err)
+	}
+
+	return nil
+}
+
+func (d *DefaultService) GetZoneTemplate(ctx context.Context, name string) (*model.ZoneTemplate, error) {
+	return d.getZoneTemplate(ctx, name)
+}
+
+// ListZoneTemplates returns a page of the zone templates, sorted by name.
+func (d *DefaultService) ListZoneTemplates(
+	ctx context.Context,
+	opts model.ListOptions,
+) (model.Page[model.ZoneTemplate], error) {
+	if err := opts.Normalize(model.SortAscending, model.SortByName); err != nil {
+		return model.Page[model.ZoneTemplate]{}, beaconerr.ErrInvalidArgument(err.Error(), "options")
+	}
+	if !opts.Tags.IsZero() {
+		return model.Page[model.ZoneTemplate]{}, beaconerr.ErrInvalidArgument(
+			"zone templates cannot be filtered by tags",
+			"options",
+		)
+	}
+
+	templates, err := d.registry.GetZoneRepository().ListZoneTemplates(ctx, opts)
+	if err != nil {
+		return model.Page[model.ZoneTemplate]{}, listError("failed to list zone templates", err)
+	}
+
+	return auth.FilterPage(ctx, templates, func(template *model.ZoneTemplate) string {
+		return model.ZoneTemplateResource(template.Name)
+	}), nil
+}
+
+// ApplyZoneTemplate reports how each of the zones has drifted from the record sets of the
+// template and, unless dryRun is set, upserts the template record sets that are missing or
+// differ. Record sets the template does not cover are never touched. Every zone gets a
+// change of its own, and all of them are applied in a single transaction. A dry run only
+// needs read access to the zones.
+func (d *DefaultService) ApplyZoneTemplate(
+	ctx context.Context,
+	templateName string,
+	zoneNames []string,
+	dryRun bool,
+) ([]model.ZoneTemplateDrift, error) {
+	if len(zoneNames) == 0 {
+		return nil, beaconerr.ErrInvalidArgument("at least one zone is required", "zones")
+	}
+
+	template, err := d.getZoneTemplate(ctx, templateName)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneAction := model.ActionWrite
+	if dryRun {
+		zoneAction = model.ActionRead
+	}
+
+	names := make([]string, 0, len(zoneNames))
+	seen := make(map[string]struct{}, len(zoneNames))
+	for _, name := range zoneNames {
+		zoneName := dns.Fqdn(name)
+		if _, ok := seen[zoneName]; ok {
+			continue
+		}
+		seen[zoneName] = struct{}{}
+
+		if err = auth.Authorize(ctx, zoneAction, model.ZoneResource(zoneName)); err != nil {
+			return nil, err
+		}
+		names = append(names, zoneName)
+	}
+
+	// The zones are loaded, checked and changed in a single transaction, so that no other
+	// change can slip in between checking a zone and changing it. They are locked in name
+	// order, so that templates applied to overlapping zones at once cannot deadlock. A dry
+	// run only reads the zones.
+	var drifts []model.ZoneTemplateDrift
+	err = d.registry.InTx(ctx, func(ctx context.Context, r repository.Registry) error {
+		getZone := r.GetZoneRepository().GetZoneForUpdate
+		if dryRun {
+			getZone = r.GetZoneRepository().GetZone
+		}
+
+		zones := make(map[string]*model.Zone, len(names))
+		for _, zoneName := range slices.Sorted(slices.Values(names)) {
+			zone, getErr := getZone(ctx, zoneName)
+			if getErr != nil && errors.Is(getErr, repository.ErrEntityNotFound) {
+				return beaconerr.ErrNoSuchZone(fmt.Sprintf("zone %s not found", zoneName))
+			} else if getErr != nil {
+				return getErr
+			}
+			zones[zoneName] = zone
+		}
+
+		drifts = make([]model.ZoneTemplateDrift, 0, len(names))
+		for _, zoneName := range names {
+			zone := zones[zoneName]
+			drift := templateDrift(zone, renderZoneTemplate(template, zone.Name))
+			drifts = append(drifts, model.ZoneTemplateDrift{ZoneName: zone.Name, Drift: drift})
+
+			actions := diffToChangeActions(drift)
+			if len(actions) == 0 {
+				continue
+			}
+
+			change := model.NewChange(zone.ID, model.ChangeStatusPending, actions)
+			if txErr := validateChanges(zone, &change); txErr != nil {
+				return invalidChangeError(txErr, "failed to apply zone template to zone "+zone.Name)
+			}
+
+			if dryRun {
+				continue
+			}
+
+			if txErr := writeChange(ctx, r, model.AuditOperationApplyZoneTemplate, zone, &change); txErr != nil {
+				return txErr
+			}
+
+			txErr := d.checkResourceRecordSetQuota(ctx, r, zone.Name, len(zone.ResourceRecordSets))
+			if txErr != nil {
+				return txErr
+			}
+
+			if changesDelegation(zone.Name, change.Actions) {
+				if txErr = syncParentDelegation(ctx, r, zone.Name, delegationModeSync); txErr != nil {
+					return txErr
+				}
+			}
+
+			drifts[len(drifts)-1].ChangeID = &change.ID
+		}
+
+		return nil
+	})
+	if err != nil && (beaconerr.IsBadRequestError(err) || beaconerr.IsAccessDeniedError(err) ||
+		beaconerr.IsNoSuchError(err)) {
+		return nil, err
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to apply zone template", err)
+	}
+
+	return drifts, nil
+}
+
+// getZoneTemplate returns the named template if the principal may read it.
+func (d *DefaultService) getZoneTemplate(ctx context.Context, name string) (*model.ZoneTemplate, error) {
+	if err := auth.Authorize(ctx, model.ActionRead, model.ZoneTemplateResource(name)); err != nil {
+		return nil, err
+	}
+
+	template, err := d.registry.GetZoneRepository().GetZoneTemplate(ctx, name)
+	if err != nil && errors.Is(err, repository.ErrEntityNotFound) {
+		return nil, beaconerr.ErrNoSuchZoneTemplate(fmt.Sprintf("zone template %s not found", name))
+	} else if err != nil {
+		return nil, beaconerr.ErrInternalError("failed to get zone template", err)
+	}
+
+	return template, nil
+}
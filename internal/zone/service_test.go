@@ -0,0 +1,387 @@
+package zone
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davidseybold/beacondns/internal/auth"
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+	"github.com/davidseybold/beacondns/internal/repository"
+)
+
+// fakeRegistry keeps zones, changes and events in memory. Only the repository methods the
+// change path uses are implemented; calling any other panics.
+type fakeRegistry struct {
+	repository.TransactorRegistry
+
+	zones  *fakeZoneRepository
+	events *fakeEventRepository
+}
+
+func newFakeRegistry(zones ...*model.Zone) *fakeRegistry {
+	r := &fakeRegistry{
+		zones: &fakeZoneRepository{
+			zones:   make(map[string]*model.Zone),
+			changes: make(map[uuid.UUID]model.Change),
+		},
+		events: &fakeEventRepository{},
+	}
+	for _, zone := range zones {
+		r.zones.zones[zone.Name] = zone
+	}
+	return r
+}
+
+func (r *fakeRegistry) InTx(ctx context.Context, txFunc repository.TxFunc) error {
+	return txFunc(ctx, r)
+}
+
+func (r *fakeRegistry) GetZoneRepository() repository.ZoneRepository {
+	return r.zones
+}
+
+func (r *fakeRegistry) GetEventRepository() repository.EventRepository {
+	return r.events
+}
+
+func (r *fakeRegistry) GetAuditRepository() repository.AuditRepository {
+	return fakeAuditRepository{}
+}
+
+type fakeZoneRepository struct {
+	repository.ZoneRepository
+
+	zones     map[string]*model.Zone
+	changes   map[uuid.UUID]model.Change
+	versions  []model.ZoneVersion
+	templates []model.ZoneTemplate
+	// locked lists the zones locked with GetZoneForUpdate, in the order they were locked.
+	locked []string
+	// trash holds the zones moved to the trash, and purges counts the purges of the trash.
+	trash  []model.Zone
+	purges int
+}
+
+func (r *fakeZoneRepository) GetZone(_ context.Context, name string) (*model.Zone, error) {
+	zone, ok := r.zones[name]
+	if !ok {
+		return nil, repository.ErrEntityNotFound
+	}
+
+	clone := *zone
+	clone.ResourceRecordSets = slices.Clone(zone.ResourceRecordSets)
+	return &clone, nil
+}
+
+func (r *fakeZoneRepository) GetZoneForUpdate(ctx context.Context, name string) (*model.Zone, error) {
+	r.locked = append(r.locked, name)
+	return r.GetZone(ctx, name)
+}
+
+func (r *fakeZoneRepository) GetZoneTemplate(_ context.Context, name string) (*model.ZoneTemplate, error) {
+	for _, template := range r.templates {
+		if template.Name == name {
+			return &template, nil
+		}
+	}
+
+	return nil, repository.ErrEntityNotFound
+}
+
+func (r *fakeZoneRepository) GetZoneInfo(_ context.Context, name string) (*model.ZoneInfo, error) {
+	zone, ok := r.zones[name]
+	if !ok {
+		return nil, repository.ErrEntityNotFound
+	}
+
+	return &model.ZoneInfo{ID: zone.ID, Name: zone.Name, ResourceRecordSetCount: len(zone.ResourceRecordSets)}, nil
+}
+
+func (r *fakeZoneRepository) GetZoneVersion(_ context.Context, _ string, version int) (*model.ZoneVersion, error) {
+	for _, zoneVersion := range r.versions {
+		if zoneVersion.Version == version {
+			return &zoneVersion, nil
+		}
+	}
+
+	return nil, repository.ErrEntityNotFound
+}
+
+func (r *fakeZoneRepository) UpsertResourceRecordSet(
+	_ context.Context,
+	zoneName string,
+	recordSet *model.ResourceRecordSet,
+) (*model.ResourceRecordSet, error) {
+	zone := r.zones[zoneName]
+	zone.ResourceRecordSets = slices.DeleteFunc(zone.ResourceRecordSets, func(rrSet model.ResourceRecordSet) bool {
+		return rrSet.Type == recordSet.Type && strings.EqualFold(rrSet.Name, recordSet.Name)
+	})
+	zone.ResourceRecordSets = append(zone.ResourceRecordSets, *recordSet)
+	return recordSet, nil
+}
+
+func (r *fakeZoneRepository) DeleteResourceRecordSet(
+	_ context.Context,
+	zoneName string,
+	name string,
+	rrType model.RRType,
+) error {
+	zone := r.zones[zoneName]
+	zone.ResourceRecordSets = slices.DeleteFunc(zone.ResourceRecordSets, func(rrSet model.ResourceRecordSet) bool {
+		return rrSet.Type == rrType && strings.EqualFold(rrSet.Name, name)
+	})
+	return nil
+}
+
+func (r *fakeZoneRepository) CreateChange(_ context.Context, change model.Change) (*model.Change, error) {
+	r.changes[change.ID] = change
+	return &change, nil
+}
+
+func (r *fakeZoneRepository) CreateZoneVersion(_ context.Context, _ string, _ uuid.UUID) (int, error) {
+	return 1, nil
+}
+
+func (r *fakeZoneRepository) DeleteZone(_ context.Context, name string) error {
+	if _, ok := r.zones[name]; !ok {
+		return repository.ErrEntityNotFound
+	}
+
+	delete(r.zones, name)
+	return nil
+}
+
+func (r *fakeZoneRepository) CreateDeletedZone(_ context.Context, zone *model.Zone, _ time.Time) error {
+	r.trash = append(r.trash, *zone)
+	return nil
+}
+
+func (r *fakeZoneRepository) ListDeletedZones(
+	_ context.Context,
+	_ model.ListOptions,
+) (model.Page[model.DeletedZone], error) {
+	return model.Page[model.DeletedZone]{}, nil
+}
+
+func (r *fakeZoneRepository) PurgeDeletedZones(_ context.Context) error {
+	r.purges++
+	return nil
+}
+
+type fakeEventRepository struct {
+	repository.EventRepository
+
+	events []*model.Event
+}
+
+func (r *fakeEventRepository) CreateEvent(_ context.Context, event *model.Event) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+type fakeAuditRepository struct {
+	repository.AuditRepository
+}
+
+func (fakeAuditRepository) CreateAuditEntry(_ context.Context, entry *model.AuditEntry) (*model.AuditEntry, error) {
+	return entry, nil
+}
+
+func adminContext() context.Context {
+	return auth.WithPrincipal(context.Background(), &auth.Principal{
+		Name: "admin",
+		Permissions: []model.Permission{
+			{Actions: []model.Action{model.ActionAll}, Resources: []string{model.ResourceAll}},
+		},
+	})
+}
+
+func TestUpsertResourceRecordSetSyncPTR(t *testing.T) {
+	forward := newHostedZone("example.com.")
+	registry := newFakeRegistry(
+		forward,
+		newHostedZone("2.0.192.in-addr.arpa."),
+		newHostedZone("8.b.d.0.1.0.0.2.ip6.arpa."),
+	)
+	service := NewService(registry, ServiceConfig{})
+
+	for _, rrSet := range []*model.ResourceRecordSet{
+		{Name: "www.example.com.", Type: model.RRTypeA, TTL: 300,
+			ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.1"}}},
+		{Name: "www.example.com.", Type: model.RRTypeAAAA, TTL: 300,
+			ResourceRecords: []model.ResourceRecord{{Value: "2001:db8::1"}}},
+	} {
+		registry.events.events = nil
+
+		_, _, err := service.UpsertResourceRecordSet(adminContext(), "example.com.", rrSet,
+			ResourceRecordSetOptions{SyncPTR: true})
+		require.NoError(t, err)
+
+		require.Len(t, registry.events.events, 1, rrSet.Type)
+		var event ChangeRRSetEvent
+		require.NoError(t, json.Unmarshal(registry.events.events[0].Payload, &event))
+		assert.Equal(t, "example.com.", event.ZoneName)
+		assert.Equal(t, forward.ID, registry.zones.changes[event.ChangeID].ZoneID)
+		require.Len(t, event.PTRChanges, 1)
+
+		ptrChange := registry.zones.changes[event.PTRChanges[0].ChangeID]
+		require.Len(t, ptrChange.Actions, 1)
+		assert.Equal(t, model.RRTypePTR, ptrChange.Actions[0].ResourceRecordSet.Type)
+		assert.Equal(t, "www.example.com.", ptrChange.Actions[0].ResourceRecordSet.ResourceRecords[0].Value)
+	}
+}
+
+func TestValidatePolicies(t *testing.T) {
+	spf := "v=spf1 include:_spf1.example.com include:_spf2.example.com -all"
+	rrSet := txtRRSet("example.com.", spf)
+	zoneFile := `example.com. 300 IN TXT "` + spf + `"`
+
+	newService := func() (*DefaultService, *fakeRegistry) {
+		zone := newHostedZone("example.com.")
+		zone.ResourceRecordSets = append(zone.ResourceRecordSets,
+			txtRRSet("_spf1.example.com.", "v=spf1 a mx a:a.example.net a:b.example.net a:c.example.net -all"),
+			txtRRSet("_spf2.example.com.", "v=spf1 a mx a:a.example.net a:b.example.net a:c.example.net -all"),
+		)
+		registry := newFakeRegistry(zone)
+		registry.zones.versions = []model.ZoneVersion{{
+			Version:            1,
+			Status:             model.ChangeStatusDone,
+			ResourceRecordSets: append(slices.Clone(zone.ResourceRecordSets), txtRRSet("example.com.", spf)),
+		}}
+		return NewService(registry, ServiceConfig{}), registry
+	}
+
+	tests := []struct {
+		name   string
+		change func(service *DefaultService, opts ResourceRecordSetOptions) error
+	}{
+		{
+			name: "plan",
+			change: func(service *DefaultService, opts ResourceRecordSetOptions) error {
+				_, err := service.PlanUpsertResourceRecordSet(adminContext(), "example.com.", &rrSet, opts)
+				return err
+			},
+		},
+		{
+			name: "change batch",
+			change: func(service *DefaultService, opts ResourceRecordSetOptions) error {
+				_, err := service.ChangeResourceRecordSets(adminContext(), "example.com.", []ChangeBatchAction{
+					{ActionType: ChangeBatchActionUpsert, ResourceRecordSet: &rrSet},
+				}, opts)
+				return err
+			},
+		},
+		{
+			name: "rollback",
+			change: func(service *DefaultService, opts ResourceRecordSetOptions) error {
+				_, err := service.RollbackZone(adminContext(), "example.com.", 1, opts)
+				return err
+			},
+		},
+		{
+			name: "import",
+			change: func(service *DefaultService, opts ResourceRecordSetOptions) error {
+				_, err := service.ImportZone(adminContext(), "example.com.", strings.NewReader(zoneFile), opts)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, registry := newService()
+			err := tt.change(service, ResourceRecordSetOptions{ValidatePolicies: true})
+			assert.True(t, beaconerr.IsBadRequestError(err), err)
+			assert.ErrorContains(t, err, ErrInvalidPolicyRecord.Error())
+			assert.Empty(t, registry.events.events)
+
+			service, _ = newService()
+			require.NoError(t, tt.change(service, ResourceRecordSetOptions{}))
+		})
+	}
+}
+
+func TestApplyZoneTemplate(t *testing.T) {
+	registry := newFakeRegistry(newHostedZone("b.example."), newHostedZone("a.example."))
+	registry.zones.templates = []model.ZoneTemplate{*productTemplate()}
+	service := NewService(registry, ServiceConfig{})
+
+	drifts, err := service.ApplyZoneTemplate(adminContext(), "product", []string{"b.example", "a.example."}, true)
+	require.NoError(t, err)
+	require.Len(t, drifts, 2)
+	assert.Equal(t, "b.example.", drifts[0].ZoneName)
+	assert.Nil(t, drifts[0].ChangeID)
+	assert.Empty(t, registry.zones.locked)
+	assert.Empty(t, registry.events.events)
+
+	drifts, err = service.ApplyZoneTemplate(adminContext(), "product", []string{"b.example", "a.example."}, false)
+	require.NoError(t, err)
+	require.Len(t, drifts, 2)
+	assert.Equal(t, []string{"a.example.", "b.example."}, registry.zones.locked)
+	assert.Len(t, registry.events.events, 2)
+	for _, drift := range drifts {
+		require.NotNil(t, drift.ChangeID, drift.ZoneName)
+		assert.Len(t, registry.zones.changes[*drift.ChangeID].Actions, len(productTemplate().ResourceRecordSets))
+	}
+
+	_, err = service.ApplyZoneTemplate(adminContext(), "product", []string{"a.example.", "missing.example."}, false)
+	assert.True(t, beaconerr.IsNoSuchError(err), err)
+}
+
+func TestDeleteZone(t *testing.T) {
+	newZone := func() *model.Zone {
+		zone := newHostedZone("example.com.")
+		zone.ResourceRecordSets = append(zone.ResourceRecordSets, txtRRSet("example.com.", "hello"))
+		return zone
+	}
+
+	t.Run("not empty", func(t *testing.T) {
+		registry := newFakeRegistry(newZone())
+		service := NewService(registry, ServiceConfig{})
+
+		err := service.DeleteZone(adminContext(), "example.com", false)
+		assert.True(t, beaconerr.IsBadRequestError(err), err)
+		assert.Equal(t, []string{"example.com."}, registry.zones.locked)
+		assert.Contains(t, registry.zones.zones, "example.com.")
+		assert.Empty(t, registry.events.events)
+	})
+
+	t.Run("forced into the trash", func(t *testing.T) {
+		zone := newZone()
+		registry := newFakeRegistry(zone)
+		service := NewService(registry, ServiceConfig{TrashPeriod: time.Hour})
+
+		require.NoError(t, service.DeleteZone(adminContext(), "example.com", true))
+		assert.Equal(t, "example.com.", registry.zones.locked[0])
+		assert.NotContains(t, registry.zones.zones, "example.com.")
+		require.Len(t, registry.zones.trash, 1)
+		assert.Equal(t, zone.ResourceRecordSets, registry.zones.trash[0].ResourceRecordSets)
+		assert.Equal(t, 1, registry.zones.purges)
+		assert.Len(t, registry.events.events, 1)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		service := NewService(newFakeRegistry(), ServiceConfig{})
+
+		err := service.DeleteZone(adminContext(), "example.com", true)
+		assert.True(t, beaconerr.IsNoSuchError(err), err)
+	})
+}
+
+func TestListDeletedZonesPurges(t *testing.T) {
+	registry := newFakeRegistry()
+	service := NewService(registry, ServiceConfig{TrashPeriod: time.Hour})
+
+	_, err := service.ListDeletedZones(adminContext(), model.ListOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, registry.zones.purges)
+}
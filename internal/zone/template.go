@@ -0,0 +1,118 @@
+package zone
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+// templateValidationZone is the zone a template is rendered for to validate it. The .invalid
+// TLD is reserved by RFC 2606, so it cannot clash with anything in the template itself.
+const templateValidationZone = "zone-template.invalid."
+
+var (
+	ErrTemplateNameOutsideZone = fmt.Errorf(
+		"invalid template record set name: must be %s or end in .%s",
+		model.ZoneTemplatePlaceholder,
+		model.ZoneTemplatePlaceholder,
+	)
+	ErrTemplateNoRecordSets = errors.New("zone template must contain at least one resource record set")
+)
+
+// renderZoneTemplate returns the record sets of the template with every placeholder in their
+// names and values replaced by zoneName. Tags and comments are copied as they are.
+func renderZoneTemplate(template *model.ZoneTemplate, zoneName string) []model.ResourceRecordSet {
+	replacer := strings.NewReplacer(model.ZoneTemplatePlaceholder, strings.TrimSuffix(zoneName, "."))
+
+	rrSets := make([]model.ResourceRecordSet, len(template.ResourceRecordSets))
+	for i, rrSet := range template.ResourceRecordSets {
+		records := make([]model.ResourceRecord, len(rrSet.ResourceRecords))
+		for j, rr := range rrSet.ResourceRecords {
+			records[j] = model.ResourceRecord{Value: replacer.Replace(rr.Value)}
+		}
+
+		rrSets[i] = model.ResourceRecordSet{
+			Name:            dns.Fqdn(replacer.Replace(rrSet.Name)),
+			Type:            rrSet.Type,
+			TTL:             rrSet.TTL,
+			ResourceRecords: records,
+			Tags:            maps.Clone(rrSet.Tags),
+			Comment:         rrSet.Comment,
+		}
+	}
+
+	return rrSets
+}
+
+// templateChangeActions returns the upserts that apply the rendered record sets of a template.
+func templateChangeActions(rrSets []model.ResourceRecordSet) []model.ChangeAction {
+	actions := make([]model.ChangeAction, len(rrSets))
+	for i := range rrSets {
+		actions[i] = model.NewChangeAction(model.ChangeActionTypeUpsert, &rrSets[i])
+	}
+	return actions
+}
+
+// validateZoneTemplate checks that every record set of the template is named within the zone
+// it is applied to, and that the template renders into a valid change for a new zone. The
+// violations of the rendered change are reported under the names used in the template.
+func validateZoneTemplate(template *model.ZoneTemplate) error {
+	if len(template.ResourceRecordSets) == 0 {
+		return ErrTemplateNoRecordSets
+	}
+
+	var errs ValidationErrors
+	for i := range template.ResourceRecordSets {
+		rrSet := &template.ResourceRecordSets[i]
+		name := strings.TrimSuffix(rrSet.Name, ".")
+		if name != model.ZoneTemplatePlaceholder && !strings.HasSuffix(name, "."+model.ZoneTemplatePlaceholder) {
+			errs = append(errs, newValidationError(i, rrSet, ErrTemplateNameOutsideZone))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	zone := newHostedZone(templateValidationZone)
+	change := model.NewChange(
+		zone.ID,
+		model.ChangeStatusPending,
+		templateChangeActions(renderZoneTemplate(template, zone.Name)),
+	)
+
+	err := validateChanges(zone, &change)
+	if errors.As(err, &errs) {
+		for _, e := range errs {
+			if e.Action < len(template.ResourceRecordSets) {
+				e.Name = template.ResourceRecordSets[e.Action].Name
+			}
+		}
+		return errs
+	}
+
+	return err
+}
+
+// templateDrift returns the changes that bring the record sets of the zone that the template
+// covers in line with the rendered template. Record sets the template does not mention are
+// left out, so the drift never removes anything.
+func templateDrift(zone *model.Zone, rendered []model.ResourceRecordSet) model.ZoneDiff {
+	keys := make(map[string]struct{}, len(rendered))
+	for _, rrSet := range rendered {
+		keys[rrSetKey(rrSet.Name, rrSet.Type)] = struct{}{}
+	}
+
+	current := slices.DeleteFunc(slices.Clone(zone.ResourceRecordSets), func(rrSet model.ResourceRecordSet) bool {
+		_, ok := keys[rrSetKey(rrSet.Name, rrSet.Type)]
+		return !ok
+	})
+
+	return diffResourceRecordSets(current, rendered)
+}
@@ -0,0 +1,162 @@
+package zone
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davidseybold/beacondns/internal/beaconerr"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+func productTemplate() *model.ZoneTemplate {
+	return &model.ZoneTemplate{
+		Name: "product",
+		ResourceRecordSets: []model.ResourceRecordSet{
+			lintTestRRSet("{{zone}}", model.RRTypeMX, "10 mail.{{zone}}."),
+			lintTestRRSet("{{zone}}", model.RRTypeTXT, "\"v=spf1 mx -all\""),
+			lintTestRRSet("_dmarc.{{zone}}", model.RRTypeTXT, "\"v=DMARC1; p=reject; rua=mailto:dmarc@{{zone}}\""),
+			lintTestRRSet("{{zone}}", model.RRTypeCAA, "0 issue \"letsencrypt.org\""),
+			lintTestRRSet("www.{{zone}}.", model.RRTypeCNAME, "{{zone}}."),
+		},
+	}
+}
+
+func TestRenderZoneTemplate(t *testing.T) {
+	rendered := renderZoneTemplate(productTemplate(), "example.com.")
+
+	want := []model.ResourceRecordSet{
+		lintTestRRSet("example.com.", model.RRTypeMX, "10 mail.example.com."),
+		lintTestRRSet("example.com.", model.RRTypeTXT, "\"v=spf1 mx -all\""),
+		lintTestRRSet("_dmarc.example.com.", model.RRTypeTXT, "\"v=DMARC1; p=reject; rua=mailto:dmarc@example.com\""),
+		lintTestRRSet("example.com.", model.RRTypeCAA, "0 issue \"letsencrypt.org\""),
+		lintTestRRSet("www.example.com.", model.RRTypeCNAME, "example.com."),
+	}
+	assert.Equal(t, want, rendered)
+}
+
+func TestValidateZoneTemplate(t *testing.T) {
+	tests := []struct {
+		name   string
+		rrSets []model.ResourceRecordSet
+		// want maps the index of each record set expected to fail to the error it fails with.
+		want    map[int]error
+		wantErr error
+	}{
+		{
+			name:   "valid template",
+			rrSets: productTemplate().ResourceRecordSets,
+		},
+		{
+			name:    "no record sets",
+			wantErr: ErrTemplateNoRecordSets,
+		},
+		{
+			name: "name without placeholder",
+			rrSets: []model.ResourceRecordSet{
+				lintTestRRSet("www.example.com.", model.RRTypeA, "192.0.2.1"),
+				lintTestRRSet("{{zone}}.example.com.", model.RRTypeA, "192.0.2.1"),
+				lintTestRRSet("www.{{zone}}", model.RRTypeA, "192.0.2.1"),
+			},
+			want: map[int]error{0: ErrTemplateNameOutsideZone, 1: ErrTemplateNameOutsideZone},
+		},
+		{
+			name: "invalid rendered record sets",
+			rrSets: []model.ResourceRecordSet{
+				lintTestRRSet(
+					"{{zone}}",
+					model.RRTypeSOA,
+					"ns1.{{zone}}. hostmaster.{{zone}}. 1 7200 900 1209600 86400",
+				),
+				lintTestRRSet("www.{{zone}}", model.RRTypeA, "not-an-address"),
+			},
+			want: map[int]error{0: ErrSOAExists, 1: ErrInvalidRecordValue},
+		},
+		{
+			name: "CNAME at the apex",
+			rrSets: []model.ResourceRecordSet{
+				lintTestRRSet("{{zone}}", model.RRTypeCNAME, "example.net."),
+			},
+			want: map[int]error{0: ErrInvalidApexRecord},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateZoneTemplate(&model.ZoneTemplate{Name: "test", ResourceRecordSets: tt.rrSets})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			if len(tt.want) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			var errs ValidationErrors
+			require.ErrorAs(t, err, &errs)
+
+			got := make(map[int]error, len(errs))
+			for _, e := range errs {
+				got[e.Action] = e.Err
+				assert.Equal(t, tt.rrSets[e.Action].Name, e.Name)
+			}
+			require.Len(t, got, len(tt.want))
+			for action, wantErr := range tt.want {
+				assert.ErrorIs(t, got[action], wantErr, "action %d", action)
+			}
+		})
+	}
+}
+
+func TestApplyTemplateToNewZone(t *testing.T) {
+	zone := newHostedZone("example.com.")
+
+	require.NoError(t, applyTemplateToNewZone(zone, productTemplate()))
+
+	assert.Len(t, zone.ResourceRecordSets, 7)
+	assert.Equal(t, model.RRTypeSOA, zone.ResourceRecordSets[0].Type)
+	assert.Equal(t, model.RRTypeNS, zone.ResourceRecordSets[1].Type)
+	assert.Equal(t, lintTestRRSet("www.example.com.", model.RRTypeCNAME, "example.com."), zone.ResourceRecordSets[6])
+
+	invalid := &model.ZoneTemplate{
+		Name: "invalid",
+		ResourceRecordSets: []model.ResourceRecordSet{
+			lintTestRRSet("{{zone}}", model.RRTypeA, "not-an-address"),
+		},
+	}
+	err := applyTemplateToNewZone(newHostedZone("example.com."), invalid)
+
+	var batchErr *beaconerr.InvalidChangeBatchError
+	require.True(t, errors.As(err, &batchErr))
+	assert.Len(t, batchErr.Violations, 1)
+}
+
+func TestTemplateDrift(t *testing.T) {
+	zone := newHostedZone("example.com.")
+	zone.ResourceRecordSets = append(zone.ResourceRecordSets,
+		lintTestRRSet("example.com.", model.RRTypeMX, "10 mail.example.com."),
+		lintTestRRSet("example.com.", model.RRTypeTXT, "\"v=spf1 include:_spf.example.net -all\""),
+		lintTestRRSet("api.example.com.", model.RRTypeA, "192.0.2.10"),
+	)
+
+	drift := templateDrift(zone, renderZoneTemplate(productTemplate(), zone.Name))
+
+	assert.Empty(t, drift.Removed)
+	assert.Len(t, drift.Added, 3)
+	for _, rrSet := range drift.Added {
+		assert.NotEqual(t, model.RRTypeMX, rrSet.Type)
+	}
+
+	require.Len(t, drift.Modified, 1)
+	assert.Equal(t, model.RRTypeTXT, drift.Modified[0].After.Type)
+	assert.Equal(t, []model.ResourceRecord{{Value: "\"v=spf1 mx -all\""}}, drift.Modified[0].AddedRecords)
+
+	zone.ResourceRecordSets = projectChanges(zone.ResourceRecordSets, diffToChangeActions(drift))
+	drift = templateDrift(zone, renderZoneTemplate(productTemplate(), zone.Name))
+	assert.True(t, drift.IsEmpty())
+}
@@ -0,0 +1,816 @@
+package zone
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	bdns "github.com/davidseybold/beacondns/internal/dns"
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+const (
+	lintRuleSPF    = "spf"
+	lintRuleDMARC  = "dmarc"
+	lintRuleDKIM   = "dkim"
+	lintRuleMTASTS = "mta-sts"
+
+	// spfLookupLimit is the number of DNS lookups an SPF check may cause before it fails
+	// (RFC 7208 section 4.6.4).
+	spfLookupLimit = 10
+	// spfRecordLength is the length above which an SPF record risks not fitting in a UDP
+	// response along with the other TXT records of its name (RFC 7208 section 3.4).
+	spfRecordLength = 450
+
+	dkimMinRSABits         = 1024
+	dkimRecommendedRSABits = 2048
+	mtaSTSMaxIDLength      = 32
+)
+
+var ErrInvalidPolicyRecord = errors.New("invalid policy record")
+
+// checkChangePolicies checks the SPF, DMARC, DKIM and MTA-STS policies in the TXT record
+// sets upserted by actions as they would be once every action is applied to zone, so that
+// record sets that refer to each other can be changed together. Findings of error severity
+// are returned as ValidationErrors for the action that upserts the record set, covering every
+// action at once, and warnings are returned for the caller to pass on.
+func checkChangePolicies(zone *model.Zone, actions []model.ChangeAction) ([]model.LintFinding, error) {
+	projected := newLintZone(&model.Zone{
+		ID:                 zone.ID,
+		Name:               zone.Name,
+		ResourceRecordSets: projectChanges(zone.ResourceRecordSets, actions),
+	})
+
+	var warnings []model.LintFinding
+	var errs ValidationErrors
+	for i, action := range actions {
+		rrSet := action.ResourceRecordSet
+		if action.ActionType != model.ChangeActionTypeUpsert || rrSet.Type != model.RRTypeTXT {
+			continue
+		}
+
+		for _, finding := range txtPolicyFindings(projected, *rrSet) {
+			if finding.Severity != model.LintSeverityError {
+				warnings = append(warnings, finding)
+				continue
+			}
+
+			errs = append(errs, newValidationError(i, rrSet,
+				fmt.Errorf("%w: %s", ErrInvalidPolicyRecord, finding.Message)))
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return warnings, nil
+}
+
+// txtPolicyRule reports problems in the SPF, DMARC, DKIM and MTA-STS policies of the zone.
+func txtPolicyRule(zone *lintZone) []model.LintFinding {
+	var findings []model.LintFinding
+	for _, rrSet := range zone.rrSets {
+		findings = append(findings, txtPolicyFindings(zone, rrSet)...)
+	}
+
+	return findings
+}
+
+// txtPolicyFindings checks the TXT records of rrSet that publish a mail authentication
+// policy. SPF records may be at any name, while DMARC, DKIM and MTA-STS records are
+// recognised by the underscore label their name starts with.
+func txtPolicyFindings(zone *lintZone, rrSet model.ResourceRecordSet) []model.LintFinding {
+	if rrSet.Type != model.RRTypeTXT {
+		return nil
+	}
+
+	values := txtRecordValues(rrSet)
+	findings := spfFindings(zone, rrSet, values)
+
+	labels := dns.SplitDomainName(strings.ToLower(dns.Fqdn(rrSet.Name)))
+	switch {
+	case len(labels) == 0:
+	case labels[0] == "_dmarc":
+		findings = append(findings, dmarcFindings(rrSet, values)...)
+	case labels[0] == "_mta-sts":
+		findings = append(findings, mtaSTSFindings(zone, rrSet, values)...)
+	case slices.Index(labels, "_domainkey") > 0:
+		findings = append(findings, dkimFindings(rrSet, values)...)
+	}
+
+	return findings
+}
+
+// txtRecordValues returns the text of each TXT record in rrSet, with the character strings
+// of a record joined together as SPF, DMARC, DKIM and MTA-STS all require.
+func txtRecordValues(rrSet model.ResourceRecordSet) []string {
+	rrs, err := bdns.TXT(&rrSet)
+	if err != nil {
+		return nil
+	}
+
+	values := make([]string, 0, len(rrs))
+	for _, rr := range rrs {
+		if txt, ok := rr.(*dns.TXT); ok {
+			values = append(values, strings.Join(txt.Txt, ""))
+		}
+	}
+
+	return values
+}
+
+// policyRecords returns the values that start with the version tag of a policy, such as
+// "v=DMARC1". Receivers ignore the other TXT records at the name.
+func policyRecords(values []string, version string) []string {
+	var records []string
+	for _, value := range values {
+		if isPolicyRecord(value, version) {
+			records = append(records, value)
+		}
+	}
+
+	return records
+}
+
+func isPolicyRecord(value, version string) bool {
+	value = strings.TrimSpace(value)
+	if len(value) < len(version) || !strings.EqualFold(value[:len(version)], version) {
+		return false
+	}
+
+	rest := value[len(version):]
+	return rest == "" || rest[0] == ' ' || rest[0] == ';'
+}
+
+type policyTag struct {
+	name  string
+	value string
+}
+
+// parseTagList parses the tag=value list shared by DMARC, DKIM and MTA-STS records (RFC
+// 6376 section 3.2). Tag names are case-sensitive and may appear only once.
+func parseTagList(record string) ([]policyTag, error) {
+	var tags []policyTag
+	seen := make(map[string]struct{})
+	for _, spec := range strings.Split(record, ";") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+
+		name, value, ok := strings.Cut(spec, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("%q is not a tag=value pair", spec)
+		}
+
+		if _, dup := seen[name]; dup {
+			return nil, fmt.Errorf("tag %s appears more than once", name)
+		}
+		seen[name] = struct{}{}
+
+		tags = append(tags, policyTag{name: name, value: strings.TrimSpace(value)})
+	}
+
+	return tags, nil
+}
+
+// spfFindings checks the SPF record at the name of rrSet, counting the DNS lookups it
+// causes through the include and redirect terms that point at SPF records in the zone.
+func spfFindings(zone *lintZone, rrSet model.ResourceRecordSet, values []string) []model.LintFinding {
+	records := policyRecords(values, "v=spf1")
+	if len(records) == 0 {
+		return nil
+	}
+
+	if len(records) > 1 {
+		return []model.LintFinding{newLintFinding(lintRuleSPF, model.LintSeverityError, rrSet,
+			"%s has %d SPF records, but a name may only have one (RFC 7208 section 3.2)",
+			rrSet.Name, len(records))}
+	}
+
+	var findings []model.LintFinding
+	if len(records[0]) > spfRecordLength {
+		findings = append(findings, newLintFinding(lintRuleSPF, model.LintSeverityWarning, rrSet,
+			"SPF record is %d characters long; records over %d characters may not fit in a UDP response",
+			len(records[0]), spfRecordLength))
+	}
+
+	spf, err := parseSPF(records[0])
+	if err != nil {
+		return append(findings, newLintFinding(lintRuleSPF, model.LintSeverityError, rrSet,
+			"invalid SPF record: %s", err))
+	}
+
+	for _, warning := range spf.warnings {
+		findings = append(findings, newLintFinding(lintRuleSPF, model.LintSeverityWarning, rrSet, "%s", warning))
+	}
+
+	name := strings.ToLower(dns.Fqdn(rrSet.Name))
+	lookups, complete, err := spfLookups(zone, name, spf, []string{name})
+	if err != nil {
+		return append(findings, newLintFinding(lintRuleSPF, model.LintSeverityError, rrSet, "%s", err))
+	}
+
+	if lookups > spfLookupLimit {
+		atLeast := ""
+		if !complete {
+			atLeast = "at least "
+		}
+		findings = append(findings, newLintFinding(lintRuleSPF, model.LintSeverityError, rrSet,
+			"SPF record needs %s%d DNS lookups, more than the limit of %d (RFC 7208 section 4.6.4)",
+			atLeast, lookups, spfLookupLimit))
+	}
+
+	return findings
+}
+
+// spfLookups returns the number of DNS lookups evaluating spf causes, following the
+// include and redirect terms that point at names the zone is authoritative for. The
+// lookups of other names cannot be counted, and complete is false if there were any.
+// path holds the names already being evaluated, to detect include loops.
+func spfLookups(zone *lintZone, name string, spf *spfRecord, path []string) (int, bool, error) {
+	lookups := spf.lookups
+	complete := true
+	for _, ref := range spf.references {
+		if strings.Contains(ref, "%") || !zone.authoritative(ref) {
+			complete = false
+			continue
+		}
+
+		if slices.Contains(path, ref) {
+			return 0, false, fmt.Errorf("SPF include loop: %s -> %s", strings.Join(path, " -> "), ref)
+		}
+
+		records := policyRecords(zone.txt[ref], "v=spf1")
+		if len(records) != 1 {
+			return 0, false, fmt.Errorf("SPF record at %s refers to %s, which has %d SPF records instead of one",
+				name, ref, len(records))
+		}
+
+		included, err := parseSPF(records[0])
+		if err != nil {
+			return 0, false, fmt.Errorf("SPF record at %s refers to %s, which is invalid: %w", name, ref, err)
+		}
+
+		n, ok, err := spfLookups(zone, ref, included, append(path, ref))
+		if err != nil {
+			return 0, false, err
+		}
+		lookups += n
+		complete = complete && ok
+
+		// Include trees can be wide, so stop once the record is known to be over the limit.
+		if lookups > spfLookupLimit {
+			break
+		}
+	}
+
+	return lookups, complete, nil
+}
+
+type spfRecord struct {
+	// lookups is the number of terms in the record that cause a DNS lookup.
+	lookups int
+	// references are the domains of the include mechanisms and the redirect modifier,
+	// whose SPF records are evaluated in turn.
+	references []string
+	warnings   []string
+}
+
+// parseSPF parses an SPF record against the grammar of RFC 7208 section 12.
+func parseSPF(record string) (*spfRecord, error) {
+	terms := strings.Fields(record)
+	if len(terms) == 0 || !strings.EqualFold(terms[0], "v=spf1") {
+		return nil, errors.New("record must start with v=spf1")
+	}
+
+	spf := &spfRecord{}
+	modifiers := make(map[string]struct{})
+	all := false
+	for _, term := range terms[1:] {
+		if name, value, ok := spfModifier(term); ok {
+			if _, dup := modifiers[name]; dup && (name == "redirect" || name == "exp") {
+				return nil, fmt.Errorf("%s modifier appears more than once", name)
+			}
+			modifiers[name] = struct{}{}
+
+			switch name {
+			case "redirect":
+				if err := checkSPFDomainSpec(value); err != nil {
+					return nil, fmt.Errorf("redirect modifier: %w", err)
+				}
+				spf.lookups++
+				spf.references = append(spf.references, strings.ToLower(dns.Fqdn(value)))
+			case "exp":
+				if err := checkSPFDomainSpec(value); err != nil {
+					return nil, fmt.Errorf("exp modifier: %w", err)
+				}
+			default:
+				spf.warnings = append(spf.warnings, fmt.Sprintf("unknown SPF modifier %s is ignored", name))
+			}
+			continue
+		}
+
+		if all {
+			spf.warnings = append(spf.warnings,
+				fmt.Sprintf("SPF term %s after the all mechanism is never evaluated", term))
+		}
+
+		if err := spf.parseMechanism(term, &all); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := modifiers["redirect"]; ok && all {
+		spf.warnings = append(spf.warnings, "SPF redirect modifier is ignored because the record has an all mechanism")
+	}
+
+	return spf, nil
+}
+
+func (spf *spfRecord) parseMechanism(term string, all *bool) error {
+	mechanism := strings.TrimLeft(term, "+-~?")
+	if len(term)-len(mechanism) > 1 {
+		return fmt.Errorf("mechanism %s has more than one qualifier", term)
+	}
+
+	end := strings.IndexAny(mechanism, ":/")
+	if end < 0 {
+		end = len(mechanism)
+	}
+	name, rest := strings.ToLower(mechanism[:end]), mechanism[end:]
+
+	domain, cidr := "", rest
+	if strings.HasPrefix(rest, ":") {
+		domain, cidr = rest[1:], ""
+		if i := strings.Index(domain, "/"); i >= 0 && name != "ip4" && name != "ip6" {
+			domain, cidr = domain[:i], domain[i:]
+		}
+	}
+
+	switch name {
+	case "all":
+		if rest != "" {
+			return fmt.Errorf("all mechanism takes no arguments: %s", term)
+		}
+		*all = true
+	case "include", "exists":
+		if domain == "" || cidr != "" {
+			return fmt.Errorf("%s mechanism needs a domain: %s", name, term)
+		}
+		if err := checkSPFDomainSpec(domain); err != nil {
+			return fmt.Errorf("%s mechanism: %w", name, err)
+		}
+		spf.lookups++
+		if name == "include" {
+			spf.references = append(spf.references, strings.ToLower(dns.Fqdn(domain)))
+		}
+	case "a", "mx":
+		if err := checkSPFOptionalDomain(rest, domain); err != nil {
+			return fmt.Errorf("%s mechanism: %w", name, err)
+		}
+		if err := checkSPFDualCIDR(cidr); err != nil {
+			return fmt.Errorf("%s mechanism: %w", name, err)
+		}
+		spf.lookups++
+	case "ptr":
+		if err := checkSPFOptionalDomain(rest, domain); err != nil || cidr != "" {
+			return fmt.Errorf("invalid ptr mechanism: %s", term)
+		}
+		spf.lookups++
+		spf.warnings = append(spf.warnings,
+			"SPF ptr mechanism is slow and unreliable and should not be used (RFC 7208 section 5.5)")
+	case "ip4", "ip6":
+		if err := checkSPFNetwork(name, domain); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown SPF mechanism %s", term)
+	}
+
+	return nil
+}
+
+// spfModifier splits term into the name and value of a modifier. Modifier names start with
+// a letter and contain only letters, digits, "-", "_" and ".".
+func spfModifier(term string) (string, string, bool) {
+	name, value, ok := strings.Cut(term, "=")
+	if !ok || name == "" || !isASCIILetter(name[0]) {
+		return "", "", false
+	}
+
+	for i := range len(name) {
+		c := name[i]
+		if !isASCIILetter(c) && (c < '0' || c > '9') && c != '-' && c != '_' && c != '.' {
+			return "", "", false
+		}
+	}
+
+	return strings.ToLower(name), value, true
+}
+
+func isASCIILetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func checkSPFOptionalDomain(rest, domain string) error {
+	if !strings.HasPrefix(rest, ":") {
+		return nil
+	}
+
+	return checkSPFDomainSpec(domain)
+}
+
+// checkSPFDomainSpec checks a domain-spec, which is either a domain name or contains macros
+// that are expanded when the record is evaluated (RFC 7208 section 7).
+func checkSPFDomainSpec(spec string) error {
+	if spec == "" {
+		return errors.New("missing domain")
+	}
+
+	if !strings.Contains(spec, "%") {
+		if _, ok := dns.IsDomainName(spec); !ok {
+			return fmt.Errorf("%s is not a valid domain name", spec)
+		}
+		return nil
+	}
+
+	for i := 0; i < len(spec); i++ {
+		if spec[i] != '%' {
+			continue
+		}
+
+		if i+1 >= len(spec) {
+			return fmt.Errorf("%s has an incomplete macro", spec)
+		}
+
+		switch spec[i+1] {
+		case '%', '_', '-':
+			i++
+		case '{':
+			end := strings.IndexByte(spec[i:], '}')
+			if end < 0 || end < 3 || !strings.ContainsRune("slodiphcrtvSLODIPHCRTV", rune(spec[i+2])) {
+				return fmt.Errorf("%s has an invalid macro", spec)
+			}
+			i += end
+		default:
+			return fmt.Errorf("%s has an invalid macro", spec)
+		}
+	}
+
+	return nil
+}
+
+// checkSPFDualCIDR checks the optional "/prefix4//prefix6" suffix of the a and mx
+// mechanisms.
+func checkSPFDualCIDR(cidr string) error {
+	if cidr == "" {
+		return nil
+	}
+
+	v4, v6 := cidr, ""
+	if i := strings.Index(cidr, "//"); i >= 0 {
+		v4, v6 = cidr[:i], cidr[i+2:]
+		if bits, err := strconv.Atoi(v6); err != nil || bits < 0 || bits > 128 {
+			return fmt.Errorf("invalid IPv6 prefix length in %s", cidr)
+		}
+	}
+
+	if v4 == "" {
+		return nil
+	}
+
+	if bits, err := strconv.Atoi(strings.TrimPrefix(v4, "/")); err != nil || !strings.HasPrefix(v4, "/") ||
+		bits < 0 || bits > 32 {
+		return fmt.Errorf("invalid IPv4 prefix length in %s", cidr)
+	}
+
+	return nil
+}
+
+func checkSPFNetwork(mechanism, value string) error {
+	var addr netip.Addr
+	if strings.Contains(value, "/") {
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return fmt.Errorf("%s mechanism: %s is not a valid network", mechanism, value)
+		}
+		addr = prefix.Addr()
+	} else {
+		var err error
+		if addr, err = netip.ParseAddr(value); err != nil {
+			return fmt.Errorf("%s mechanism: %s is not a valid address", mechanism, value)
+		}
+	}
+
+	if mechanism == "ip4" && !addr.Is4() {
+		return fmt.Errorf("ip4 mechanism: %s is not an IPv4 address", value)
+	}
+	if mechanism == "ip6" && !addr.Is6() {
+		return fmt.Errorf("ip6 mechanism: %s is not an IPv6 address", value)
+	}
+
+	return nil
+}
+
+// dmarcFindings checks the DMARC policy record at a _dmarc name against RFC 7489 section
+// 6.3.
+func dmarcFindings(rrSet model.ResourceRecordSet, values []string) []model.LintFinding {
+	records := policyRecords(values, "v=DMARC1")
+	if len(records) == 0 {
+		return []model.LintFinding{newLintFinding(lintRuleDMARC, model.LintSeverityWarning, rrSet,
+			"%s has no TXT record starting with v=DMARC1, so receivers find no DMARC policy", rrSet.Name)}
+	}
+
+	if len(records) > 1 {
+		return []model.LintFinding{newLintFinding(lintRuleDMARC, model.LintSeverityError, rrSet,
+			"%s has %d DMARC records; receivers ignore all of them when there is more than one "+
+				"(RFC 7489 section 6.6.3)", rrSet.Name, len(records))}
+	}
+
+	tags, err := parseTagList(records[0])
+	if err != nil {
+		return []model.LintFinding{newLintFinding(lintRuleDMARC, model.LintSeverityError, rrSet,
+			"invalid DMARC record: %s", err)}
+	}
+
+	var findings []model.LintFinding
+	fail := func(format string, args ...any) {
+		findings = append(findings, newLintFinding(lintRuleDMARC, model.LintSeverityError, rrSet,
+			"invalid DMARC record: "+format, args...))
+	}
+	warn := func(format string, args ...any) {
+		findings = append(findings, newLintFinding(lintRuleDMARC, model.LintSeverityWarning, rrSet,
+			"DMARC record: "+format, args...))
+	}
+
+	if tags[0].name != "v" || tags[0].value != "DMARC1" {
+		fail("the first tag must be v=DMARC1")
+	}
+
+	hasPolicy := false
+	for _, tag := range tags[1:] {
+		switch tag.name {
+		case "p", "sp", "np":
+			hasPolicy = hasPolicy || tag.name == "p"
+			if !slices.Contains([]string{"none", "quarantine", "reject"}, strings.ToLower(tag.value)) {
+				fail("%s must be none, quarantine or reject, not %q", tag.name, tag.value)
+			}
+		case "adkim", "aspf":
+			if !slices.Contains([]string{"r", "s"}, strings.ToLower(tag.value)) {
+				fail("%s must be r or s, not %q", tag.name, tag.value)
+			}
+		case "pct":
+			if pct, pctErr := strconv.Atoi(tag.value); pctErr != nil || pct < 0 || pct > 100 {
+				fail("pct must be a number between 0 and 100, not %q", tag.value)
+			}
+		case "ri":
+			if _, riErr := strconv.ParseUint(tag.value, 10, 32); riErr != nil {
+				fail("ri must be a number of seconds, not %q", tag.value)
+			}
+		case "fo":
+			for _, option := range strings.Split(tag.value, ":") {
+				if !slices.Contains([]string{"0", "1", "d", "s"}, strings.TrimSpace(option)) {
+					fail("fo options must be 0, 1, d or s, not %q", option)
+				}
+			}
+		case "rf":
+			for _, format := range strings.Split(tag.value, ":") {
+				if !strings.EqualFold(strings.TrimSpace(format), "afrf") {
+					warn("unknown report format %q in rf", format)
+				}
+			}
+		case "rua", "ruf":
+			for _, uri := range strings.Split(tag.value, ",") {
+				uri = strings.TrimSpace(uri)
+				// A report URI may end with a size limit such as "!10m".
+				if i := strings.LastIndex(uri, "!"); i >= 0 {
+					uri = uri[:i]
+				}
+
+				parsed, uriErr := url.Parse(uri)
+				if uriErr != nil || parsed.Scheme == "" {
+					fail("%s contains %q, which is not a URI", tag.name, uri)
+					continue
+				}
+				if !strings.EqualFold(parsed.Scheme, "mailto") {
+					warn("%s URI %s does not use mailto, which is the only scheme receivers widely support",
+						tag.name, uri)
+				}
+			}
+		default:
+			warn("unknown tag %s is ignored", tag.name)
+		}
+	}
+
+	if !hasPolicy {
+		fail("the p tag is required")
+	}
+
+	return findings
+}
+
+// dkimFindings checks the DKIM key records at a selector's _domainkey name against RFC 6376
+// section 3.6.1 and the key requirements of RFC 8301 and RFC 8463.
+func dkimFindings(rrSet model.ResourceRecordSet, values []string) []model.LintFinding {
+	var findings []model.LintFinding
+	fail := func(format string, args ...any) {
+		findings = append(findings, newLintFinding(lintRuleDKIM, model.LintSeverityError, rrSet,
+			"invalid DKIM key record: "+format, args...))
+	}
+	warn := func(format string, args ...any) {
+		findings = append(findings, newLintFinding(lintRuleDKIM, model.LintSeverityWarning, rrSet,
+			"DKIM key record: "+format, args...))
+	}
+
+	if len(values) > 1 {
+		warn("%s has %d TXT records; verifiers may use any of them", rrSet.Name, len(values))
+	}
+
+	for _, value := range values {
+		tags, err := parseTagList(value)
+		if err != nil {
+			fail("%s", err)
+			continue
+		}
+
+		keyType := "rsa"
+		publicKey, hasKey := "", false
+		for i, tag := range tags {
+			switch tag.name {
+			case "v":
+				if i != 0 || tag.value != "DKIM1" {
+					fail("v must be the first tag and have the value DKIM1")
+				}
+			case "k":
+				keyType = strings.ToLower(tag.value)
+			case "p":
+				publicKey, hasKey = tag.value, true
+			case "h":
+				for _, alg := range strings.Split(tag.value, ":") {
+					switch strings.ToLower(strings.TrimSpace(alg)) {
+					case "sha256":
+					case "sha1":
+						warn("sha1 in h is no longer accepted by verifiers (RFC 8301 section 3.1)")
+					default:
+						warn("unknown hash algorithm %q in h", alg)
+					}
+				}
+			case "s":
+				for _, service := range strings.Split(tag.value, ":") {
+					if service = strings.TrimSpace(service); service != "*" && service != "email" {
+						warn("unknown service type %q in s", service)
+					}
+				}
+			case "t":
+				for _, flag := range strings.Split(tag.value, ":") {
+					if flag = strings.TrimSpace(flag); flag != "y" && flag != "s" {
+						warn("unknown flag %q in t", flag)
+					}
+				}
+			case "n":
+			default:
+				warn("unknown tag %s is ignored", tag.name)
+			}
+		}
+
+		if !hasKey {
+			fail("the p tag is required")
+			continue
+		}
+
+		if severity, message := checkDKIMKey(keyType, publicKey); message != "" {
+			if severity == model.LintSeverityError {
+				fail("%s", message)
+			} else {
+				warn("%s", message)
+			}
+		}
+	}
+
+	return findings
+}
+
+// checkDKIMKey checks the public key of a DKIM key record, returning the severity and
+// message of the problem found, if any.
+func checkDKIMKey(keyType, value string) (model.LintSeverity, string) {
+	value = strings.Join(strings.Fields(value), "")
+	if value == "" {
+		return model.LintSeverityWarning, "the key has been revoked (empty p tag)"
+	}
+
+	der, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return model.LintSeverityError, "p is not valid base64"
+	}
+
+	switch keyType {
+	case "rsa":
+		var key *rsa.PublicKey
+		if parsed, parseErr := x509.ParsePKIXPublicKey(der); parseErr == nil {
+			key, _ = parsed.(*rsa.PublicKey)
+		} else if pkcs1, pkcs1Err := x509.ParsePKCS1PublicKey(der); pkcs1Err == nil {
+			key = pkcs1
+		}
+
+		switch {
+		case key == nil:
+			return model.LintSeverityError, "p is not an RSA public key"
+		case key.N.BitLen() < dkimMinRSABits:
+			return model.LintSeverityError, fmt.Sprintf(
+				"the RSA key has %d bits, but verifiers require at least %d (RFC 8301 section 3.2)",
+				key.N.BitLen(), dkimMinRSABits)
+		case key.N.BitLen() < dkimRecommendedRSABits:
+			return model.LintSeverityWarning, fmt.Sprintf(
+				"the RSA key has %d bits; keys of at least %d bits are recommended",
+				key.N.BitLen(), dkimRecommendedRSABits)
+		}
+	case "ed25519":
+		if len(der) != ed25519.PublicKeySize {
+			return model.LintSeverityError, fmt.Sprintf("an ed25519 key must be %d bytes long, not %d",
+				ed25519.PublicKeySize, len(der))
+		}
+	default:
+		return model.LintSeverityWarning, fmt.Sprintf("unknown key type %q, verifiers will ignore this key", keyType)
+	}
+
+	return "", ""
+}
+
+// mtaSTSFindings checks the MTA-STS record at a _mta-sts name against RFC 8461 section 3.1,
+// and that the zone lets senders reach the host the policy is fetched from.
+func mtaSTSFindings(zone *lintZone, rrSet model.ResourceRecordSet, values []string) []model.LintFinding {
+	records := policyRecords(values, "v=STSv1")
+	if len(records) == 0 {
+		return []model.LintFinding{newLintFinding(lintRuleMTASTS, model.LintSeverityWarning, rrSet,
+			"%s has no TXT record starting with v=STSv1, so senders find no MTA-STS policy", rrSet.Name)}
+	}
+
+	if len(records) > 1 {
+		return []model.LintFinding{newLintFinding(lintRuleMTASTS, model.LintSeverityError, rrSet,
+			"%s has %d MTA-STS records; senders treat the domain as having no policy when there is more "+
+				"than one (RFC 8461 section 3.1)", rrSet.Name, len(records))}
+	}
+
+	var findings []model.LintFinding
+	fail := func(format string, args ...any) {
+		findings = append(findings, newLintFinding(lintRuleMTASTS, model.LintSeverityError, rrSet,
+			"invalid MTA-STS record: "+format, args...))
+	}
+
+	tags, err := parseTagList(records[0])
+	if err != nil {
+		fail("%s", err)
+		return findings
+	}
+
+	if tags[0].name != "v" || tags[0].value != "STSv1" {
+		fail("the first tag must be v=STSv1")
+	}
+
+	idx := slices.IndexFunc(tags, func(tag policyTag) bool { return tag.name == "id" })
+	switch {
+	case idx < 0:
+		fail("the id tag is required")
+	case !isMTASTSID(tags[idx].value):
+		fail("id must be 1 to %d letters and digits, not %q", mtaSTSMaxIDLength, tags[idx].value)
+	}
+
+	name := strings.ToLower(dns.Fqdn(rrSet.Name))
+	domainOff, _ := dns.NextLabel(name, 0)
+	policyHost := "mta-sts." + name[domainOff:]
+	if zone.authoritative(policyHost) && !zone.has(policyHost, model.RRTypeCNAME) &&
+		!zone.hasAddress(policyHost, true) {
+		findings = append(findings, newLintFinding(lintRuleMTASTS, model.LintSeverityWarning, rrSet,
+			"policy host %s has no A, AAAA or CNAME records in the zone, so senders cannot fetch the policy",
+			policyHost))
+	}
+
+	return findings
+}
+
+func isMTASTSID(id string) bool {
+	if id == "" || len(id) > mtaSTSMaxIDLength {
+		return false
+	}
+
+	for i := range len(id) {
+		if c := id[i]; !isASCIILetter(c) && (c < '0' || c > '9') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,411 @@
+package zone
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/davidseybold/beacondns/internal/model"
+)
+
+// wantFinding matches a finding by severity and a part of its message.
+type wantFinding struct {
+	severity model.LintSeverity
+	message  string
+}
+
+func assertFindings(t *testing.T, want []wantFinding, got []model.LintFinding) {
+	t.Helper()
+
+	require.Len(t, got, len(want), "findings: %v", got)
+	for i, w := range want {
+		assert.Equal(t, w.severity, got[i].Severity, got[i].Message)
+		assert.Contains(t, got[i].Message, w.message)
+	}
+}
+
+func txtRRSet(name string, values ...string) model.ResourceRecordSet {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = `"` + value + `"`
+	}
+
+	return lintTestRRSet(name, model.RRTypeTXT, quoted...)
+}
+
+func rsaTestKey(t *testing.T, bits int) string {
+	t.Helper()
+
+	n := new(big.Int).Lsh(big.NewInt(1), uint(bits-1))
+	n.Add(n, big.NewInt(1))
+	der, err := x509.MarshalPKIXPublicKey(&rsa.PublicKey{N: n, E: 65537})
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(der)
+}
+
+func TestParseSPF(t *testing.T) {
+	tests := []struct {
+		name           string
+		record         string
+		wantLookups    int
+		wantReferences []string
+		wantWarnings   int
+		wantErr        string
+	}{
+		{
+			name: "every mechanism",
+			record: "v=spf1 +a -mx:mail.example.com/24//64 ~ip4:192.0.2.0/24 ?ip6:2001:db8::/32 " +
+				"include:_spf.example.net exists:%{i}._ip.%{h}._ehlo.%{d}._spf.example.com a//64 -all",
+			wantLookups:    5,
+			wantReferences: []string{"_spf.example.net."},
+		},
+		{
+			name:           "redirect and exp modifiers",
+			record:         "v=spf1 redirect=_spf.Example.com exp=explain._spf.%{d}",
+			wantLookups:    1,
+			wantReferences: []string{"_spf.example.com."},
+		},
+		{
+			name:         "ptr mechanism and terms after all",
+			record:       "v=spf1 ptr -all ip4:192.0.2.1",
+			wantLookups:  1,
+			wantWarnings: 2,
+		},
+		{
+			name:         "unknown modifier",
+			record:       "v=spf1 custom=value -all",
+			wantWarnings: 1,
+		},
+		{
+			name:    "missing version",
+			record:  "a mx -all",
+			wantErr: "must start with v=spf1",
+		},
+		{
+			name:    "unknown mechanism",
+			record:  "v=spf1 ip:192.0.2.1 -all",
+			wantErr: "unknown SPF mechanism",
+		},
+		{
+			name:    "IPv6 address in ip4",
+			record:  "v=spf1 ip4:2001:db8::1 -all",
+			wantErr: "not an IPv4 address",
+		},
+		{
+			name:    "invalid prefix length",
+			record:  "v=spf1 a/33 -all",
+			wantErr: "invalid IPv4 prefix length",
+		},
+		{
+			name:    "two redirects",
+			record:  "v=spf1 redirect=a.example.com redirect=b.example.com",
+			wantErr: "redirect modifier appears more than once",
+		},
+		{
+			name:    "two qualifiers",
+			record:  "v=spf1 +-all",
+			wantErr: "more than one qualifier",
+		},
+		{
+			name:    "include without domain",
+			record:  "v=spf1 include -all",
+			wantErr: "include mechanism needs a domain",
+		},
+		{
+			name:    "invalid macro",
+			record:  "v=spf1 exists:%{x}.example.com -all",
+			wantErr: "invalid macro",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spf, err := parseSPF(tt.record)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantLookups, spf.lookups)
+			assert.Equal(t, tt.wantReferences, spf.references)
+			assert.Len(t, spf.warnings, tt.wantWarnings)
+		})
+	}
+}
+
+func TestTXTPolicyFindings(t *testing.T) {
+	fiveLookups := "v=spf1 a mx a:a.example.net a:b.example.net a:c.example.net -all"
+
+	tests := []struct {
+		name   string
+		rrSets []model.ResourceRecordSet
+		want   []wantFinding
+	}{
+		{
+			name: "SPF within the lookup limit",
+			rrSets: []model.ResourceRecordSet{
+				txtRRSet(
+					"example.com.",
+					"v=spf1 include:_spf.example.com ip4:192.0.2.0/24 -all",
+					"google-site-verification=abc",
+				),
+				txtRRSet("_spf.example.com.", fiveLookups),
+			},
+		},
+		{
+			name: "SPF over the lookup limit through includes in the zone",
+			rrSets: []model.ResourceRecordSet{
+				txtRRSet("example.com.", "v=spf1 include:_spf1.example.com include:_spf2.example.com -all"),
+				txtRRSet("_spf1.example.com.", fiveLookups),
+				txtRRSet("_spf2.example.com.", fiveLookups),
+			},
+			want: []wantFinding{{model.LintSeverityError, "needs 12 DNS lookups"}},
+		},
+		{
+			name: "SPF over the lookup limit with includes outside the zone",
+			rrSets: []model.ResourceRecordSet{
+				txtRRSet("example.com.", "v=spf1 include:spf.example.net include:_spf.example.com -all"),
+				txtRRSet("_spf.example.com.", fiveLookups+" "+strings.Repeat("a ", 5)),
+			},
+			want: []wantFinding{
+				{model.LintSeverityError, "needs at least 12 DNS lookups"},
+			},
+		},
+		{
+			name: "SPF include loop",
+			rrSets: []model.ResourceRecordSet{
+				txtRRSet("example.com.", "v=spf1 include:_spf.example.com -all"),
+				txtRRSet("_spf.example.com.", "v=spf1 redirect=example.com"),
+			},
+			want: []wantFinding{{model.LintSeverityError, "SPF include loop"}},
+		},
+		{
+			name: "SPF include of a name without an SPF record",
+			rrSets: []model.ResourceRecordSet{
+				txtRRSet("example.com.", "v=spf1 include:_spf.example.com -all"),
+			},
+			want: []wantFinding{{model.LintSeverityError, "has 0 SPF records"}},
+		},
+		{
+			name: "two SPF records",
+			rrSets: []model.ResourceRecordSet{
+				txtRRSet("example.com.", "v=spf1 -all", "v=spf1 mx -all"),
+			},
+			want: []wantFinding{{model.LintSeverityError, "has 2 SPF records"}},
+		},
+		{
+			name: "valid DMARC record",
+			rrSets: []model.ResourceRecordSet{
+				txtRRSet("_dmarc.example.com.", "v=DMARC1; p=reject; sp=quarantine; pct=50; adkim=s; aspf=r; fo=1:d; "+
+					"rua=mailto:dmarc@example.com,mailto:ext@example.net!10m; ri=86400;"),
+			},
+		},
+		{
+			name: "broken DMARC record",
+			rrSets: []model.ResourceRecordSet{
+				txtRRSet("_dmarc.example.com.", "v=DMARC1; sp=block; pct=150; rua=https://example.com/reports; foo=bar"),
+			},
+			want: []wantFinding{
+				{model.LintSeverityError, "sp must be none, quarantine or reject"},
+				{model.LintSeverityError, "pct must be a number between 0 and 100"},
+				{model.LintSeverityWarning, "does not use mailto"},
+				{model.LintSeverityWarning, "unknown tag foo"},
+				{model.LintSeverityError, "the p tag is required"},
+			},
+		},
+		{
+			name: "DMARC version not first",
+			rrSets: []model.ResourceRecordSet{
+				txtRRSet("_dmarc.example.com.", "v=DMARC1; p=none; v=DMARC1"),
+			},
+			want: []wantFinding{{model.LintSeverityError, "tag v appears more than once"}},
+		},
+		{
+			name: "no DMARC record at a DMARC name",
+			rrSets: []model.ResourceRecordSet{
+				txtRRSet("_dmarc.example.com.", "p=reject"),
+			},
+			want: []wantFinding{{model.LintSeverityWarning, "no TXT record starting with v=DMARC1"}},
+		},
+		{
+			name: "valid MTA-STS record",
+			rrSets: []model.ResourceRecordSet{
+				txtRRSet("_mta-sts.example.com.", "v=STSv1; id=20240101T000000"),
+				lintTestRRSet("mta-sts.example.com.", model.RRTypeCNAME, "policy.example.net."),
+			},
+		},
+		{
+			name: "broken MTA-STS record",
+			rrSets: []model.ResourceRecordSet{
+				txtRRSet("_mta-sts.example.com.", "v=STSv1; id=2024-01-01"),
+			},
+			want: []wantFinding{
+				{model.LintSeverityError, "id must be 1 to 32 letters and digits"},
+				{model.LintSeverityWarning, "policy host mta-sts.example.com. has no A, AAAA or CNAME records"},
+			},
+		},
+		{
+			name: "other TXT records",
+			rrSets: []model.ResourceRecordSet{
+				txtRRSet("www.example.com.", "hello world"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zone := newLintZone(&model.Zone{Name: "example.com.", ResourceRecordSets: tt.rrSets})
+			assertFindings(t, tt.want, txtPolicyFindings(zone, tt.rrSets[0]))
+		})
+	}
+}
+
+func TestDKIMFindings(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []wantFinding
+	}{
+		{
+			name:  "2048-bit RSA key",
+			value: "v=DKIM1; k=rsa; h=sha256; t=s; p=" + rsaTestKey(t, 2048),
+		},
+		{
+			name:  "ed25519 key",
+			value: "v=DKIM1; k=ed25519; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo=",
+		},
+		{
+			name:  "1024-bit RSA key",
+			value: "v=DKIM1; p=" + rsaTestKey(t, 1024),
+			want:  []wantFinding{{model.LintSeverityWarning, "the RSA key has 1024 bits"}},
+		},
+		{
+			name:  "512-bit RSA key",
+			value: "v=DKIM1; h=sha1; p=" + rsaTestKey(t, 512),
+			want: []wantFinding{
+				{model.LintSeverityWarning, "sha1 in h is no longer accepted"},
+				{model.LintSeverityError, "the RSA key has 512 bits"},
+			},
+		},
+		{
+			name:  "revoked key",
+			value: "v=DKIM1; p=",
+			want:  []wantFinding{{model.LintSeverityWarning, "the key has been revoked"}},
+		},
+		{
+			name:  "missing key",
+			value: "v=DKIM1; k=rsa",
+			want:  []wantFinding{{model.LintSeverityError, "the p tag is required"}},
+		},
+		{
+			name:  "key that is not base64",
+			value: "k=rsa; p=not*base64",
+			want:  []wantFinding{{model.LintSeverityError, "p is not valid base64"}},
+		},
+		{
+			name:  "version not first",
+			value: "k=ed25519; v=DKIM1; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo=",
+			want:  []wantFinding{{model.LintSeverityError, "v must be the first tag"}},
+		},
+		{
+			name:  "unknown key type",
+			value: "v=DKIM1; k=dsa; p=AAAA",
+			want:  []wantFinding{{model.LintSeverityWarning, `unknown key type "dsa"`}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rrSet := txtRRSet("mail._domainkey.example.com.", tt.value)
+			zone := newLintZone(&model.Zone{Name: "example.com.", ResourceRecordSets: []model.ResourceRecordSet{rrSet}})
+			assertFindings(t, tt.want, txtPolicyFindings(zone, rrSet))
+		})
+	}
+}
+
+func TestCheckChangePolicies(t *testing.T) {
+	zone := newHostedZone("example.com.")
+	zone.ResourceRecordSets = append(zone.ResourceRecordSets,
+		txtRRSet("_spf1.example.com.", "v=spf1 a mx a:a.example.net a:b.example.net a:c.example.net -all"),
+		txtRRSet("_spf2.example.com.", "v=spf1 a mx a:a.example.net a:b.example.net a:c.example.net -all"),
+	)
+
+	upsert := func(rrSet model.ResourceRecordSet) model.ChangeAction {
+		return model.NewChangeAction(model.ChangeActionTypeUpsert, &rrSet)
+	}
+	del := func(rrSet model.ResourceRecordSet) model.ChangeAction {
+		return model.NewChangeAction(model.ChangeActionTypeDelete, &rrSet)
+	}
+
+	t.Run("errors reject the change", func(t *testing.T) {
+		rrSet := txtRRSet("example.com.", "v=spf1 include:_spf1.example.com include:_spf2.example.com -all")
+
+		warnings, err := checkChangePolicies(zone, []model.ChangeAction{
+			upsert(txtRRSet("www.example.com.", "hello")),
+			upsert(txtRRSet("_spf3.example.com.", "v=spf1 -all")),
+			upsert(rrSet),
+		})
+		assert.Nil(t, warnings)
+
+		var errs ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 1)
+		assert.Equal(t, 2, errs[0].Action)
+		assert.ErrorIs(t, errs[0], ErrInvalidPolicyRecord)
+	})
+
+	t.Run("warnings are returned", func(t *testing.T) {
+		rrSet := txtRRSet("example.com.", "v=spf1 ptr include:_spf1.example.com -all")
+
+		warnings, err := checkChangePolicies(zone, []model.ChangeAction{upsert(rrSet)})
+		require.NoError(t, err)
+		assertFindings(t, []wantFinding{{model.LintSeverityWarning, "ptr mechanism"}}, warnings)
+	})
+
+	t.Run("the upserted record set replaces the stored one", func(t *testing.T) {
+		zone := newHostedZone("example.com.")
+		zone.ResourceRecordSets = append(zone.ResourceRecordSets,
+			txtRRSet("example.com.", "v=spf1 -all", "v=spf1 mx -all"))
+		rrSet := txtRRSet("example.com.", "v=spf1 mx -all")
+
+		warnings, err := checkChangePolicies(zone, []model.ChangeAction{upsert(rrSet)})
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("record sets upserted together see each other", func(t *testing.T) {
+		zone := newHostedZone("example.com.")
+
+		warnings, err := checkChangePolicies(zone, []model.ChangeAction{
+			upsert(txtRRSet("example.com.", "v=spf1 include:_spf.example.com -all")),
+			upsert(txtRRSet("_spf.example.com.", "v=spf1 ip4:192.0.2.0/24 -all")),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("record sets deleted in the change are gone", func(t *testing.T) {
+		zone := newHostedZone("example.com.")
+		spf := txtRRSet("_spf.example.com.", "v=spf1 ip4:192.0.2.0/24 -all")
+		zone.ResourceRecordSets = append(zone.ResourceRecordSets, spf)
+
+		_, err := checkChangePolicies(zone, []model.ChangeAction{
+			del(spf),
+			upsert(txtRRSet("example.com.", "v=spf1 include:_spf.example.com -all")),
+		})
+
+		var errs ValidationErrors
+		require.ErrorAs(t, err, &errs)
+		require.Len(t, errs, 1)
+		assert.Equal(t, 1, errs[0].Action)
+	})
+}
@@ -3,200 +3,552 @@ package zone
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/miekg/dns"
 
+	"github.com/davidseybold/beacondns/internal/beaconerr"
 	bdns "github.com/davidseybold/beacondns/internal/dns"
 	"github.com/davidseybold/beacondns/internal/model"
 )
 
+const (
+	// maxTTL is the largest TTL allowed by RFC 2181 section 8.
+	maxTTL = 1<<31 - 1
+	// maxNameLength and maxLabelLength are the limits on the wire format of a domain name
+	// set by RFC 1035 section 2.3.4.
+	maxNameLength  = 255
+	maxLabelLength = 63
+)
+
 var (
-	ErrCNAMESelfReference = errors.New("CNAME record cannot point to itself")
-	ErrCNAMEConflict      = errors.New("cannot add CNAME record: record of different type already exists")
-	ErrSOADeletion        = errors.New("cannot delete SOA record: it is required for zone")
-	ErrSOAExists          = errors.New("cannot add SOA record: zone already has an SOA record")
-	ErrSOAMultipleRecords = errors.New("SOA record can only have one resource record")
-	ErrNSDeletion         = errors.New("cannot delete all NS records: at least one NS record is required")
-	ErrNSRequired         = errors.New("zone must have at least one NS record")
-	ErrInvalidApexRecord  = errors.New("invalid apex record type: only SOA, NS, and A records are allowed at zone apex")
-	ErrUnsupportedRRType  = errors.New("invalid record type: not supported")
-	ErrInvalidRecordValue = errors.New("invalid record value")
-	ErrOutsideZone        = errors.New("invalid domain name: not within zone")
-	ErrInvalidWildcard    = errors.New("invalid wildcard record: wildcard must be at the leftmost label")
+	ErrMissingRecordSet     = errors.New("change action has no resource record set")
+	ErrDuplicateAction      = errors.New("resource record set is changed more than once in the same change")
+	ErrNoSuchRecordSet      = errors.New("cannot delete resource record set: it does not exist")
+	ErrNoRecords            = errors.New("resource record set must contain at least one record")
+	ErrCNAMESelfReference   = errors.New("CNAME record cannot point to itself")
+	ErrCNAMEMultipleRecords = errors.New("CNAME record set can only have one resource record")
+	ErrCNAMEConflict        = errors.New("CNAME record cannot coexist with records of other types")
+	ErrDNAMEMultipleRecords = errors.New("DNAME record set can only have one resource record")
+	ErrDNAMESelfReference   = errors.New("DNAME record cannot point into its own subtree")
+	ErrSOADeletion          = errors.New("cannot delete SOA record: it is required for zone")
+	ErrSOAExists            = errors.New("cannot add SOA record: zone already has an SOA record")
+	ErrSOAMultipleRecords   = errors.New("SOA record can only have one resource record")
+	ErrSOANotAtApex         = errors.New("SOA record is only allowed at the zone apex")
+	ErrNSDeletion           = errors.New("cannot delete all NS records: at least one NS record is required")
+	ErrInvalidApexRecord    = errors.New("invalid apex record type: not allowed at the zone apex")
+	ErrDSWithoutDelegation  = errors.New("DS record is only allowed at a delegation point with NS records")
+	ErrOccludedName         = errors.New("record is occluded")
+	ErrUnsupportedRRType    = errors.New("invalid record type: not supported")
+	ErrInvalidRecordValue   = errors.New("invalid record value")
+	ErrOutsideZone          = errors.New("invalid domain name: not within zone")
+	ErrInvalidWildcard      = errors.New("invalid wildcard record: wildcard must be at the leftmost label")
+	ErrNameTooLong          = errors.New("invalid domain name: longer than 255 octets")
+	ErrLabelTooLong         = errors.New("invalid domain name: label longer than 63 octets")
+	ErrInvalidDomainName    = errors.New("invalid domain name")
+	ErrTTLOutOfRange        = fmt.Errorf("invalid TTL: must be between 0 and %d", maxTTL)
+	ErrInvalidTags          = errors.New("invalid tags")
+	ErrCommentTooLong       = fmt.Errorf(
+		"invalid comment: longer than %d characters",
+		model.MaxResourceRecordSetCommentLength,
+	)
 )
 
-type rule func(zone *model.Zone, changes []model.ChangeAction) error
+// ValidationError is a rule violation found in a change. Action is the index of the action
+// in the change that caused it.
+type ValidationError struct {
+	Action int
+	Name   string
+	Type   model.RRType
+	Err    error
+}
+
+func (e *ValidationError) Error() string {
+	if e.Name == "" {
+		return fmt.Sprintf("action %d: %s", e.Action, e.Err)
+	}
+	return fmt.Sprintf("action %d (%s %s): %s", e.Action, e.Name, e.Type, e.Err)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors holds every rule violation found in a change.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// actionRule checks a single change action on its own.
+type actionRule func(zone *model.Zone, action model.ChangeAction) error
 
-var rules = []rule{
+var actionRules = []actionRule{
 	supportedRRTypeRule,
-	cnameRule,
+	domainNameRule,
+	deleteRule,
+	ttlRule,
+	metadataRule,
+	recordValueRule,
+	cnameRecordRule,
+	dnameRecordRule,
 	soaRule,
+}
+
+// stateRule checks the state the zone would be in once the change is applied.
+type stateRule func(state *changeState) ValidationErrors
+
+var stateRules = []stateRule{
+	cnameExclusivityRule,
+	apexRule,
 	nsRule,
-	apexRecordRule,
-	recordValueRule,
-	domainNameRule,
+	delegationRule,
+	occlusionRule,
 }
 
+// validateChanges checks the change against the zone. Each action is first checked on its
+// own, and if all of them pass, the zone as it would be once the change is applied is
+// checked as a whole. Rules on the resulting state only report problems involving a record
+// set the change touches, so that a change is not rejected for problems the zone already
+// had. All violations found are returned as ValidationErrors.
 func validateChanges(zone *model.Zone, change *model.Change) error {
-	for _, rule := range rules {
-		if err := rule(zone, change.Actions); err != nil {
-			return err
+	var errs ValidationErrors
+
+	seen := make(map[string]int, len(change.Actions))
+	for i, action := range change.Actions {
+		rrSet := action.ResourceRecordSet
+		if rrSet == nil {
+			errs = append(errs, &ValidationError{Action: i, Err: ErrMissingRecordSet})
+			continue
+		}
+
+		key := rrSetKey(rrSet.Name, rrSet.Type)
+		if first, ok := seen[key]; ok {
+			errs = append(errs, newValidationError(i, rrSet, fmt.Errorf("%w (see action %d)", ErrDuplicateAction, first)))
+			continue
 		}
+		seen[key] = i
+
+		for _, rule := range actionRules {
+			if err := rule(zone, action); err != nil {
+				errs = append(errs, newValidationError(i, rrSet, err))
+				break
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	state := newChangeState(zone, change.Actions)
+	for _, rule := range stateRules {
+		errs = append(errs, rule(state)...)
+	}
+
+	if len(errs) > 0 {
+		return errs
 	}
 
 	return nil
 }
 
-func cnameRule(zone *model.Zone, changes []model.ChangeAction) error {
-	cnameChangeNames := make(map[string]struct{})
-	for _, change := range changes {
-		if change.ResourceRecordSet.Type != model.RRTypeCNAME {
-			continue
+// invalidChangeError converts an error returned by validateChanges into the error reported
+// to the caller, keeping each violation so that clients can tell which action failed.
+func invalidChangeError(err error, prefix string) error {
+	message := err.Error()
+	if prefix != "" {
+		message = prefix + ": " + message
+	}
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		return beaconerr.ErrInvalidArgument(message, "")
+	}
+
+	violations := make([]beaconerr.ChangeViolation, len(errs))
+	for i, e := range errs {
+		violations[i] = beaconerr.ChangeViolation{
+			Action:  e.Action,
+			Name:    e.Name,
+			Type:    string(e.Type),
+			Message: e.Err.Error(),
 		}
+	}
 
-		cnameChangeNames[change.ResourceRecordSet.Name] = struct{}{}
+	return beaconerr.ErrInvalidChangeBatch(message, violations)
+}
 
-		for _, rr := range change.ResourceRecordSet.ResourceRecords {
-			if rr.Value == change.ResourceRecordSet.Name {
-				return fmt.Errorf("%w: %s", ErrCNAMESelfReference, change.ResourceRecordSet.Name)
-			}
+func newValidationError(action int, rrSet *model.ResourceRecordSet, err error) *ValidationError {
+	return &ValidationError{Action: action, Name: rrSet.Name, Type: rrSet.Type, Err: err}
+}
+
+func supportedRRTypeRule(_ *model.Zone, action model.ChangeAction) error {
+	if _, ok := model.SupportedRRTypes[action.ResourceRecordSet.Type]; !ok {
+		return fmt.Errorf("%w: %s (supported types are %v)",
+			ErrUnsupportedRRType,
+			action.ResourceRecordSet.Type,
+			model.SupportedRRTypes,
+		)
+	}
+
+	return nil
+}
+
+func domainNameRule(zone *model.Zone, action model.ChangeAction) error {
+	name := dns.Fqdn(action.ResourceRecordSet.Name)
+
+	// Check if the record name is within the zone.
+	if !dns.IsSubDomain(dns.Fqdn(zone.Name), name) {
+		return fmt.Errorf("%w: %s is not within zone %s", ErrOutsideZone, action.ResourceRecordSet.Name, zone.Name)
+	}
+
+	// Check for wildcard records.
+	numAsterisks := strings.Count(name, "*")
+	if numAsterisks > 0 {
+		labels := dns.SplitDomainName(name)
+		if labels[0] != "*" || numAsterisks > 1 {
+			return fmt.Errorf("%w: %s", ErrInvalidWildcard, action.ResourceRecordSet.Name)
 		}
 	}
 
-	if len(cnameChangeNames) == 0 {
-		return nil
+	// Check the length limits of the name in wire format, which takes one octet more than
+	// the presentation format of a fully qualified name.
+	if len(name)+1 > maxNameLength {
+		return fmt.Errorf("%w: %s", ErrNameTooLong, action.ResourceRecordSet.Name)
 	}
 
-	for _, rrset := range zone.ResourceRecordSets {
-		if _, ok := cnameChangeNames[rrset.Name]; ok && rrset.Type != model.RRTypeCNAME {
-			return fmt.Errorf("%w at %s: record of type %s already exists", ErrCNAMEConflict, rrset.Name, rrset.Type)
+	for _, label := range dns.SplitDomainName(name) {
+		if len(label) > maxLabelLength {
+			return fmt.Errorf("%w: %s", ErrLabelTooLong, label)
 		}
 	}
 
+	if _, ok := dns.IsDomainName(name); !ok {
+		return fmt.Errorf("%w: %s", ErrInvalidDomainName, action.ResourceRecordSet.Name)
+	}
+
 	return nil
 }
 
-func soaRule(zone *model.Zone, changes []model.ChangeAction) error {
-	zoneHasSOA := false
-	for _, rrset := range zone.ResourceRecordSets {
-		if rrset.Type == model.RRTypeSOA {
-			zoneHasSOA = true
-			break
-		}
+func deleteRule(zone *model.Zone, action model.ChangeAction) error {
+	if action.ActionType != model.ChangeActionTypeDelete {
+		return nil
 	}
 
-	for _, change := range changes {
-		if change.ResourceRecordSet.Type != model.RRTypeSOA {
-			continue
-		}
+	key := rrSetKey(action.ResourceRecordSet.Name, action.ResourceRecordSet.Type)
+	if !slices.ContainsFunc(zone.ResourceRecordSets, func(rrSet model.ResourceRecordSet) bool {
+		return rrSetKey(rrSet.Name, rrSet.Type) == key
+	}) {
+		return ErrNoSuchRecordSet
+	}
 
-		if change.ActionType == model.ChangeActionTypeDelete {
-			return fmt.Errorf("%w %s", ErrSOADeletion, zone.Name)
-		}
+	return nil
+}
 
-		if zoneHasSOA {
-			return fmt.Errorf("%w %s", ErrSOAExists, zone.Name)
-		}
-		if len(change.ResourceRecordSet.ResourceRecords) > 1 {
-			return ErrSOAMultipleRecords
-		}
+func ttlRule(_ *model.Zone, action model.ChangeAction) error {
+	if action.ActionType == model.ChangeActionTypeUpsert && action.ResourceRecordSet.TTL > maxTTL {
+		return fmt.Errorf("%w: %d", ErrTTLOutOfRange, action.ResourceRecordSet.TTL)
 	}
 
 	return nil
 }
 
-func nsRule(zone *model.Zone, changes []model.ChangeAction) error {
-	hasNS := false
-	for _, rrset := range zone.ResourceRecordSets {
-		if rrset.Type == model.RRTypeNS {
-			hasNS = true
-			break
-		}
+// metadataRule checks the tags and comment of the record set, which are not part of its DNS
+// data but are stored alongside it.
+func metadataRule(_ *model.Zone, action model.ChangeAction) error {
+	if action.ActionType != model.ChangeActionTypeUpsert {
+		return nil
+	}
+
+	if err := action.ResourceRecordSet.Tags.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidTags, err)
+	}
+
+	if len(action.ResourceRecordSet.Comment) > model.MaxResourceRecordSetCommentLength {
+		return ErrCommentTooLong
+	}
+
+	return nil
+}
+
+func recordValueRule(_ *model.Zone, action model.ChangeAction) error {
+	if action.ActionType != model.ChangeActionTypeUpsert {
+		return nil
+	}
+
+	if len(action.ResourceRecordSet.ResourceRecords) == 0 {
+		return ErrNoRecords
+	}
+
+	if _, err := bdns.ParseRRs(action.ResourceRecordSet); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidRecordValue, err)
 	}
 
-	nsDeletions := 0
-	for _, change := range changes {
-		if change.ResourceRecordSet.Type == model.RRTypeNS && change.ActionType == model.ChangeActionTypeDelete {
-			nsDeletions++
+	return nil
+}
+
+func cnameRecordRule(_ *model.Zone, action model.ChangeAction) error {
+	rrSet := action.ResourceRecordSet
+	if rrSet.Type != model.RRTypeCNAME || action.ActionType != model.ChangeActionTypeUpsert {
+		return nil
+	}
+
+	if len(rrSet.ResourceRecords) > 1 {
+		return ErrCNAMEMultipleRecords
+	}
+
+	for _, rr := range rrSet.ResourceRecords {
+		if strings.EqualFold(dns.Fqdn(rr.Value), dns.Fqdn(rrSet.Name)) {
+			return fmt.Errorf("%w: %s", ErrCNAMESelfReference, rrSet.Name)
 		}
 	}
 
-	if hasNS && nsDeletions == len(zone.ResourceRecordSets) {
-		return fmt.Errorf("%w %s", ErrNSDeletion, zone.Name)
+	return nil
+}
+
+// dnameRecordRule rejects DNAME record sets with more than one record and DNAME records that
+// redirect a subtree into itself, which would make every lookup below it loop (RFC 6672
+// section 2.4).
+func dnameRecordRule(_ *model.Zone, action model.ChangeAction) error {
+	rrSet := action.ResourceRecordSet
+	if rrSet.Type != model.RRTypeDNAME || action.ActionType != model.ChangeActionTypeUpsert {
+		return nil
+	}
+
+	if len(rrSet.ResourceRecords) > 1 {
+		return ErrDNAMEMultipleRecords
 	}
 
-	if !hasNS {
-		addingNS := false
-		for _, change := range changes {
-			if change.ResourceRecordSet.Type == model.RRTypeNS && change.ActionType == model.ChangeActionTypeUpsert {
-				addingNS = true
-				break
-			}
+	for _, rr := range rrSet.ResourceRecords {
+		if dns.IsSubDomain(dns.Fqdn(rrSet.Name), dns.Fqdn(rr.Value)) {
+			return fmt.Errorf("%w: %s", ErrDNAMESelfReference, rr.Value)
 		}
-		if !addingNS {
-			return fmt.Errorf("%w %s", ErrNSRequired, zone.Name)
+	}
+
+	return nil
+}
+
+func soaRule(zone *model.Zone, action model.ChangeAction) error {
+	if action.ResourceRecordSet.Type != model.RRTypeSOA {
+		return nil
+	}
+
+	if action.ActionType == model.ChangeActionTypeDelete {
+		return fmt.Errorf("%w %s", ErrSOADeletion, zone.Name)
+	}
+
+	if !strings.EqualFold(dns.Fqdn(action.ResourceRecordSet.Name), dns.Fqdn(zone.Name)) {
+		return ErrSOANotAtApex
+	}
+
+	for _, rrSet := range zone.ResourceRecordSets {
+		if rrSet.Type == model.RRTypeSOA {
+			return fmt.Errorf("%w %s", ErrSOAExists, zone.Name)
 		}
 	}
 
+	if len(action.ResourceRecordSet.ResourceRecords) > 1 {
+		return ErrSOAMultipleRecords
+	}
+
 	return nil
 }
 
-func apexRecordRule(zone *model.Zone, changes []model.ChangeAction) error {
-	apexName := dns.Fqdn(zone.Name)
+// cnameExclusivityRule rejects names that would hold a CNAME next to records of another
+// type (RFC 1034 section 3.6.2), whether the other records already exist or are added in the
+// same change.
+func cnameExclusivityRule(state *changeState) ValidationErrors {
+	var errs ValidationErrors
+	for _, cname := range state.rrSets {
+		// A CNAME at the apex is reported by apexRule.
+		if cname.Type != model.RRTypeCNAME || state.isApex(cname.Name) {
+			continue
+		}
+
+		for _, rrSet := range state.rrSets {
+			if rrSet.Type == model.RRTypeCNAME || !strings.EqualFold(dns.Fqdn(rrSet.Name), dns.Fqdn(cname.Name)) {
+				continue
+			}
 
-	for _, change := range changes {
-		if dns.Fqdn(change.ResourceRecordSet.Name) == apexName {
-			if change.ResourceRecordSet.Type != model.RRTypeSOA &&
-				change.ResourceRecordSet.Type != model.RRTypeNS &&
-				change.ResourceRecordSet.Type != model.RRTypeA {
-				return fmt.Errorf("%w: %s", ErrInvalidApexRecord, change.ResourceRecordSet.Type)
+			culprit := cname
+			if !state.touched(culprit) {
+				culprit = rrSet
 			}
+			if !state.touched(culprit) {
+				continue
+			}
+
+			errs = append(errs, state.errorFor(culprit, fmt.Errorf(
+				"%w at %s: record of type %s exists", ErrCNAMEConflict, cname.Name, rrSet.Type)))
+			break
 		}
 	}
 
-	return nil
+	return errs
 }
 
-func supportedRRTypeRule(_ *model.Zone, changes []model.ChangeAction) error {
-	for _, change := range changes {
-		if _, ok := model.SupportedRRTypes[change.ResourceRecordSet.Type]; !ok {
-			return fmt.Errorf("%w: %s (supported types are %v)",
-				ErrUnsupportedRRType,
-				change.ResourceRecordSet.Type,
-				model.SupportedRRTypes,
-			)
+// apexRule rejects CNAME records at the zone apex, which would hide the SOA and NS records
+// the apex must hold, and DS records, which belong in the parent zone.
+func apexRule(state *changeState) ValidationErrors {
+	var errs ValidationErrors
+	for _, rrSet := range state.rrSets {
+		if !state.touched(rrSet) || !state.isApex(rrSet.Name) {
+			continue
+		}
+
+		if rrSet.Type == model.RRTypeCNAME || rrSet.Type == model.RRTypeDS {
+			errs = append(errs, state.errorFor(rrSet, fmt.Errorf("%w: %s", ErrInvalidApexRecord, rrSet.Type)))
 		}
 	}
 
-	return nil
+	return errs
 }
 
-func recordValueRule(_ *model.Zone, changes []model.ChangeAction) error {
-	for _, change := range changes {
-		_, err := bdns.ParseRRs(change.ResourceRecordSet)
-		if err != nil {
-			return fmt.Errorf("%w: %w", ErrInvalidRecordValue, err)
+// nsRule requires the zone apex to keep at least one NS record. The action that removes the
+// last of them is blamed. A zone that already had none can still be changed, since no action
+// of the change is to blame for it.
+func nsRule(state *changeState) ValidationErrors {
+	for _, rrSet := range state.rrSets {
+		if rrSet.Type == model.RRTypeNS && state.isApex(rrSet.Name) && len(rrSet.ResourceRecords) > 0 {
+			return nil
 		}
 	}
+
+	for i, action := range state.actions {
+		rrSet := action.ResourceRecordSet
+		if rrSet.Type == model.RRTypeNS && state.isApex(rrSet.Name) {
+			return ValidationErrors{newValidationError(i, rrSet, fmt.Errorf("%w %s", ErrNSDeletion, state.zone.Name))}
+		}
+	}
+
 	return nil
 }
 
-func domainNameRule(zone *model.Zone, changes []model.ChangeAction) error {
-	for _, change := range changes {
-		// Check if the record name is within the zone.
-		if !dns.IsSubDomain(dns.Fqdn(zone.Name), dns.Fqdn(change.ResourceRecordSet.Name)) {
-			return fmt.Errorf("%w: %s is not within zone %s", ErrOutsideZone, change.ResourceRecordSet.Name, zone.Name)
+// delegationRule only allows DS records at a delegation point, where they are served by the
+// parent side of the zone cut (RFC 4035 section 2.4).
+func delegationRule(state *changeState) ValidationErrors {
+	var errs ValidationErrors
+	for _, rrSet := range state.rrSets {
+		if rrSet.Type != model.RRTypeDS || !state.touched(rrSet) || state.isApex(rrSet.Name) {
+			continue
+		}
+
+		if !state.has(rrSet.Name, model.RRTypeNS) {
+			errs = append(errs, state.errorFor(rrSet, ErrDSWithoutDelegation))
+		}
+	}
+
+	return errs
+}
+
+// occlusionRule rejects records that resolvers would never see because they are below a
+// DNAME, or at or below a delegation to another zone (RFC 6672 section 2.4, RFC 1034
+// section 4.2.1). Address records below a delegation are allowed, since they serve as glue.
+func occlusionRule(state *changeState) ValidationErrors {
+	var errs ValidationErrors
+	for _, rrSet := range state.rrSets {
+		if !state.touched(rrSet) {
+			continue
 		}
 
-		// Check for wildcard records.
-		numAsterisks := strings.Count(change.ResourceRecordSet.Name, "*")
-		if numAsterisks > 0 {
-			labels := dns.SplitDomainName(change.ResourceRecordSet.Name)
-			if labels[0] != "*" || numAsterisks > 1 {
-				return fmt.Errorf("%w: %s", ErrInvalidWildcard, change.ResourceRecordSet.Name)
+		name := strings.ToLower(dns.Fqdn(rrSet.Name))
+		for off, end := 0, false; !end; off, end = dns.NextLabel(name, off) {
+			ancestor := name[off:]
+			if state.isApex(ancestor) {
+				break
+			}
+
+			below := off > 0
+			if below && state.has(ancestor, model.RRTypeDNAME) {
+				errs = append(errs, state.errorFor(rrSet, fmt.Errorf(
+					"%w: %s is below the DNAME at %s", ErrOccludedName, rrSet.Name, ancestor)))
+				break
+			}
+
+			if !state.has(ancestor, model.RRTypeNS) {
+				continue
+			}
+
+			glue := below && (rrSet.Type == model.RRTypeA || rrSet.Type == model.RRTypeAAAA)
+			atCut := !below && (rrSet.Type == model.RRTypeNS || rrSet.Type == model.RRTypeDS)
+			if !glue && !atCut {
+				errs = append(errs, state.errorFor(rrSet, fmt.Errorf(
+					"%w: %s is at or below the delegation at %s", ErrOccludedName, rrSet.Name, ancestor)))
+				break
 			}
 		}
 	}
-	return nil
+
+	return errs
+}
+
+// changeState is the state a zone would be in once a change is applied, along with which
+// action touched each record set.
+type changeState struct {
+	zone    *model.Zone
+	apex    string
+	actions []model.ChangeAction
+	rrSets  []model.ResourceRecordSet
+	types   map[string]map[model.RRType]struct{}
+	origin  map[string]int
+}
+
+func newChangeState(zone *model.Zone, actions []model.ChangeAction) *changeState {
+	state := &changeState{
+		zone:    zone,
+		apex:    strings.ToLower(dns.Fqdn(zone.Name)),
+		actions: actions,
+		rrSets:  projectChanges(zone.ResourceRecordSets, actions),
+		types:   make(map[string]map[model.RRType]struct{}),
+		origin:  make(map[string]int, len(actions)),
+	}
+
+	for _, rrSet := range state.rrSets {
+		name := strings.ToLower(dns.Fqdn(rrSet.Name))
+		if state.types[name] == nil {
+			state.types[name] = make(map[model.RRType]struct{})
+		}
+		state.types[name][rrSet.Type] = struct{}{}
+	}
+
+	for i, action := range actions {
+		state.origin[rrSetKey(action.ResourceRecordSet.Name, action.ResourceRecordSet.Type)] = i
+	}
+
+	return state
+}
+
+func (s *changeState) touched(rrSet model.ResourceRecordSet) bool {
+	_, ok := s.origin[rrSetKey(rrSet.Name, rrSet.Type)]
+	return ok
+}
+
+func (s *changeState) has(name string, rrType model.RRType) bool {
+	_, ok := s.types[strings.ToLower(dns.Fqdn(name))][rrType]
+	return ok
+}
+
+func (s *changeState) isApex(name string) bool {
+	return strings.ToLower(dns.Fqdn(name)) == s.apex
+}
+
+// errorFor attributes err to the action that touched rrSet.
+func (s *changeState) errorFor(rrSet model.ResourceRecordSet, err error) *ValidationError {
+	action := s.origin[rrSetKey(rrSet.Name, rrSet.Type)]
+	return newValidationError(action, s.actions[action].ResourceRecordSet, err)
 }
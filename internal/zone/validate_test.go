@@ -1,10 +1,13 @@
 package zone
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/davidseybold/beacondns/internal/beaconerr"
 	"github.com/davidseybold/beacondns/internal/model"
 )
 
@@ -160,7 +163,7 @@ func TestDomainNameRule(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := domainNameRule(tt.zone, tt.changes)
+			err := domainNameRule(tt.zone, tt.changes[0])
 			if tt.wantErr {
 				assert.Error(t, err)
 				assert.ErrorAs(t, err, &tt.err)
@@ -170,3 +173,273 @@ func TestDomainNameRule(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateChanges(t *testing.T) {
+	newZone := func(rrSets ...model.ResourceRecordSet) *model.Zone {
+		zone := newHostedZone("example.com.")
+		zone.ResourceRecordSets = append(zone.ResourceRecordSets, rrSets...)
+		return zone
+	}
+
+	rrSet := func(name string, rrType model.RRType, values ...string) *model.ResourceRecordSet {
+		records := make([]model.ResourceRecord, len(values))
+		for i, value := range values {
+			records[i] = model.ResourceRecord{Value: value}
+		}
+		return &model.ResourceRecordSet{Name: name, Type: rrType, TTL: 300, ResourceRecords: records}
+	}
+
+	upsert := func(rrSet *model.ResourceRecordSet) model.ChangeAction {
+		return model.NewChangeAction(model.ChangeActionTypeUpsert, rrSet)
+	}
+
+	remove := func(rrSet *model.ResourceRecordSet) model.ChangeAction {
+		return model.NewChangeAction(model.ChangeActionTypeDelete, rrSet)
+	}
+
+	longLabel := strings.Repeat("a", 64)
+	longName := strings.Repeat(strings.Repeat("a", 60)+".", 4) + "example.com."
+
+	tests := []struct {
+		name    string
+		zone    *model.Zone
+		actions []model.ChangeAction
+		// want maps the index of each action expected to fail to the error it fails with.
+		want map[int]error
+	}{
+		{
+			name: "MX, TXT and CAA at the apex",
+			zone: newZone(),
+			actions: []model.ChangeAction{
+				upsert(rrSet("example.com.", model.RRTypeMX, "10 mail.example.com.")),
+				upsert(rrSet("example.com.", model.RRTypeTXT, "\"v=spf1 -all\"")),
+				upsert(rrSet("example.com.", model.RRTypeCAA, "0 issue \"letsencrypt.org\"")),
+			},
+		},
+		{
+			name:    "CNAME at the apex",
+			zone:    newZone(),
+			actions: []model.ChangeAction{upsert(rrSet("example.com.", model.RRTypeCNAME, "other.net."))},
+			want:    map[int]error{0: ErrInvalidApexRecord},
+		},
+		{
+			name: "CNAME and other data added in the same change",
+			zone: newZone(),
+			actions: []model.ChangeAction{
+				upsert(rrSet("www.example.com.", model.RRTypeA, "192.0.2.1")),
+				upsert(rrSet("www.example.com.", model.RRTypeCNAME, "other.net.")),
+			},
+			want: map[int]error{1: ErrCNAMEConflict},
+		},
+		{
+			name:    "data added next to an existing CNAME",
+			zone:    newZone(*rrSet("www.example.com.", model.RRTypeCNAME, "other.net.")),
+			actions: []model.ChangeAction{upsert(rrSet("www.example.com.", model.RRTypeTXT, "\"hello\""))},
+			want:    map[int]error{0: ErrCNAMEConflict},
+		},
+		{
+			name: "CNAME replacing data deleted in the same change",
+			zone: newZone(*rrSet("www.example.com.", model.RRTypeA, "192.0.2.1")),
+			actions: []model.ChangeAction{
+				remove(rrSet("www.example.com.", model.RRTypeA, "192.0.2.1")),
+				upsert(rrSet("www.example.com.", model.RRTypeCNAME, "other.net.")),
+			},
+		},
+		{
+			name:    "CNAME with several records",
+			zone:    newZone(),
+			actions: []model.ChangeAction{upsert(rrSet("www.example.com.", model.RRTypeCNAME, "a.net.", "b.net."))},
+			want:    map[int]error{0: ErrCNAMEMultipleRecords},
+		},
+		{
+			name: "deleting the apex NS records",
+			zone: newZone(*rrSet("sub.example.com.", model.RRTypeNS, "ns.other.net.")),
+			actions: []model.ChangeAction{
+				remove(rrSet("example.com.", model.RRTypeNS, "ns1.beacondns.org.", "ns2.beacondns.org.")),
+			},
+			want: map[int]error{0: ErrNSDeletion},
+		},
+		{
+			name: "deleting the apex NS records later in a change",
+			zone: newZone(),
+			actions: []model.ChangeAction{
+				upsert(rrSet("www.example.com.", model.RRTypeA, "192.0.2.1")),
+				remove(rrSet("example.com.", model.RRTypeNS, "ns1.beacondns.org.", "ns2.beacondns.org.")),
+			},
+			want: map[int]error{1: ErrNSDeletion},
+		},
+		{
+			name: "changing a zone that has no apex NS records",
+			zone: &model.Zone{
+				Name: "example.com.",
+				ResourceRecordSets: []model.ResourceRecordSet{
+					*rrSet("example.com.", model.RRTypeSOA,
+						"ns1.beacondns.org. hostmaster.beacondns.org. 1 7200 900 1209600 86400"),
+				},
+			},
+			actions: []model.ChangeAction{upsert(rrSet("www.example.com.", model.RRTypeA, "192.0.2.1"))},
+		},
+		{
+			name:    "deleting a delegation",
+			zone:    newZone(*rrSet("sub.example.com.", model.RRTypeNS, "ns.other.net.")),
+			actions: []model.ChangeAction{remove(rrSet("sub.example.com.", model.RRTypeNS, "ns.other.net."))},
+		},
+		{
+			name:    "deleting a missing record set",
+			zone:    newZone(),
+			actions: []model.ChangeAction{remove(rrSet("www.example.com.", model.RRTypeA))},
+			want:    map[int]error{0: ErrNoSuchRecordSet},
+		},
+		{
+			name:    "delete without a record set",
+			zone:    newZone(),
+			actions: []model.ChangeAction{model.NewChangeAction(model.ChangeActionTypeDelete, nil)},
+			want:    map[int]error{0: ErrMissingRecordSet},
+		},
+		{
+			name: "the same record set changed twice",
+			zone: newZone(),
+			actions: []model.ChangeAction{
+				upsert(rrSet("www.example.com.", model.RRTypeA, "192.0.2.1")),
+				upsert(rrSet("WWW.example.com", model.RRTypeA, "192.0.2.2")),
+			},
+			want: map[int]error{1: ErrDuplicateAction},
+		},
+		{
+			name: "TTL out of range",
+			zone: newZone(),
+			actions: []model.ChangeAction{upsert(&model.ResourceRecordSet{
+				Name:            "www.example.com.",
+				Type:            model.RRTypeA,
+				TTL:             1 << 31,
+				ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.1"}},
+			})},
+			want: map[int]error{0: ErrTTLOutOfRange},
+		},
+		{
+			name: "invalid tags and comment",
+			zone: newZone(),
+			actions: []model.ChangeAction{
+				upsert(&model.ResourceRecordSet{
+					Name:            "www.example.com.",
+					Type:            model.RRTypeA,
+					TTL:             300,
+					ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.1"}},
+					Tags:            model.Tags{"team:payments": "yes"},
+				}),
+				upsert(&model.ResourceRecordSet{
+					Name:            "api.example.com.",
+					Type:            model.RRTypeA,
+					TTL:             300,
+					ResourceRecords: []model.ResourceRecord{{Value: "192.0.2.2"}},
+					Tags:            model.Tags{"team": "payments"},
+					Comment:         strings.Repeat("x", model.MaxResourceRecordSetCommentLength+1),
+				}),
+			},
+			want: map[int]error{0: ErrInvalidTags, 1: ErrCommentTooLong},
+		},
+		{
+			name: "name and label length limits",
+			zone: newZone(),
+			actions: []model.ChangeAction{
+				upsert(rrSet(longLabel+".example.com.", model.RRTypeA, "192.0.2.1")),
+				upsert(rrSet(longName, model.RRTypeA, "192.0.2.1")),
+			},
+			want: map[int]error{0: ErrLabelTooLong, 1: ErrNameTooLong},
+		},
+		{
+			name:    "empty record set",
+			zone:    newZone(),
+			actions: []model.ChangeAction{upsert(rrSet("www.example.com.", model.RRTypeA))},
+			want:    map[int]error{0: ErrNoRecords},
+		},
+		{
+			name: "data below a delegation",
+			zone: newZone(*rrSet("sub.example.com.", model.RRTypeNS, "ns.sub.example.com.")),
+			actions: []model.ChangeAction{
+				upsert(rrSet("ns.sub.example.com.", model.RRTypeA, "192.0.2.53")),
+				upsert(rrSet("www.sub.example.com.", model.RRTypeTXT, "\"hidden\"")),
+				upsert(rrSet("sub.example.com.", model.RRTypeMX, "10 mail.example.com.")),
+			},
+			want: map[int]error{1: ErrOccludedName, 2: ErrOccludedName},
+		},
+		{
+			name: "data below a DNAME",
+			zone: newZone(*rrSet("old.example.com.", model.RRTypeDNAME, "new.example.net.")),
+			actions: []model.ChangeAction{
+				upsert(rrSet("www.old.example.com.", model.RRTypeA, "192.0.2.1")),
+			},
+			want: map[int]error{0: ErrOccludedName},
+		},
+		{
+			name: "DNAME records",
+			zone: newZone(),
+			actions: []model.ChangeAction{
+				upsert(rrSet("old.example.com.", model.RRTypeDNAME, "new.example.net.")),
+				upsert(rrSet("loop.example.com.", model.RRTypeDNAME, "sub.loop.example.com.")),
+				upsert(rrSet("many.example.com.", model.RRTypeDNAME, "a.example.net.", "b.example.net.")),
+			},
+			want: map[int]error{1: ErrDNAMESelfReference, 2: ErrDNAMEMultipleRecords},
+		},
+		{
+			name: "DS records",
+			zone: newZone(*rrSet("sub.example.com.", model.RRTypeNS, "ns.other.net.")),
+			actions: []model.ChangeAction{
+				upsert(rrSet("sub.example.com.", model.RRTypeDS, "12345 13 2 "+strings.Repeat("ab", 32))),
+				upsert(rrSet("www.example.com.", model.RRTypeDS, "12345 13 2 "+strings.Repeat("ab", 32))),
+				upsert(rrSet("example.com.", model.RRTypeDS, "12345 13 2 "+strings.Repeat("ab", 32))),
+			},
+			want: map[int]error{1: ErrDSWithoutDelegation, 2: ErrInvalidApexRecord},
+		},
+		{
+			name:    "deleting the SOA record",
+			zone:    newZone(),
+			actions: []model.ChangeAction{remove(&newZone().ResourceRecordSets[0])},
+			want:    map[int]error{0: ErrSOADeletion},
+		},
+		{
+			name: "existing problems are not reported",
+			zone: newZone(
+				*rrSet("www.example.com.", model.RRTypeCNAME, "other.net."),
+				*rrSet("www.example.com.", model.RRTypeTXT, "\"legacy\""),
+			),
+			actions: []model.ChangeAction{upsert(rrSet("mail.example.com.", model.RRTypeA, "192.0.2.25"))},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change := model.NewChange(tt.zone.ID, model.ChangeStatusPending, tt.actions)
+			err := validateChanges(tt.zone, &change)
+			if len(tt.want) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			var errs ValidationErrors
+			require.ErrorAs(t, err, &errs)
+			require.Len(t, errs, len(tt.want))
+			for _, e := range errs {
+				want, ok := tt.want[e.Action]
+				require.True(t, ok, "unexpected error for action %d: %s", e.Action, e)
+				assert.ErrorIs(t, e, want)
+			}
+		})
+	}
+}
+
+func TestInvalidChangeError(t *testing.T) {
+	err := invalidChangeError(ValidationErrors{
+		{Action: 1, Name: "www.example.com.", Type: model.RRTypeCNAME, Err: ErrCNAMEConflict},
+	}, "")
+
+	var batchErr *beaconerr.InvalidChangeBatchError
+	require.ErrorAs(t, err, &batchErr)
+	assert.True(t, beaconerr.IsBadRequestError(err))
+	assert.Equal(t, []beaconerr.ChangeViolation{{
+		Action:  1,
+		Name:    "www.example.com.",
+		Type:    "CNAME",
+		Message: ErrCNAMEConflict.Error(),
+	}}, batchErr.Violations)
+}
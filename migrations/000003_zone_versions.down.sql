@@ -0,0 +1 @@
+DROP TABLE IF EXISTS zone_versions;
\ No newline at end of file
@@ -0,0 +1,11 @@
+CREATE TABLE
+    zone_versions (
+        zone_id UUID NOT NULL,
+        version INT NOT NULL,
+        change_id UUID NOT NULL,
+        resource_record_sets JSONB NOT NULL,
+        created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        PRIMARY KEY (zone_id, version),
+        FOREIGN KEY (zone_id) REFERENCES zones (id) ON DELETE CASCADE,
+        FOREIGN KEY (change_id) REFERENCES changes (id) ON DELETE CASCADE
+    );
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS deleted_zones;
\ No newline at end of file
@@ -0,0 +1,10 @@
+CREATE TABLE
+    deleted_zones (
+        zone_id UUID PRIMARY KEY,
+        name VARCHAR(255) NOT NULL,
+        resource_record_sets JSONB NOT NULL,
+        deleted_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+        purge_after TIMESTAMPTZ NOT NULL
+    );
+
+CREATE INDEX deleted_zones_name_idx ON deleted_zones (name);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS zone_templates;
\ No newline at end of file
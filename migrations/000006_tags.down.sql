@@ -0,0 +1,16 @@
+DROP INDEX IF EXISTS firewall_rules_tags_idx;
+
+DROP INDEX IF EXISTS domain_lists_tags_idx;
+
+DROP INDEX IF EXISTS zones_tags_idx;
+
+ALTER TABLE firewall_rules DROP COLUMN IF EXISTS tags;
+
+ALTER TABLE domain_lists DROP COLUMN IF EXISTS tags;
+
+ALTER TABLE deleted_zones DROP COLUMN IF EXISTS tags;
+
+ALTER TABLE resource_record_sets DROP COLUMN IF EXISTS comment,
+DROP COLUMN IF EXISTS tags;
+
+ALTER TABLE zones DROP COLUMN IF EXISTS tags;
\ No newline at end of file
@@ -0,0 +1,21 @@
+ALTER TABLE zones
+ADD COLUMN tags JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE resource_record_sets
+ADD COLUMN tags JSONB NOT NULL DEFAULT '{}',
+ADD COLUMN comment TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE deleted_zones
+ADD COLUMN tags JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE domain_lists
+ADD COLUMN tags JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE firewall_rules
+ADD COLUMN tags JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX zones_tags_idx ON zones USING GIN (tags);
+
+CREATE INDEX domain_lists_tags_idx ON domain_lists USING GIN (tags);
+
+CREATE INDEX firewall_rules_tags_idx ON firewall_rules USING GIN (tags);
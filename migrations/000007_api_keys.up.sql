@@ -0,0 +1,11 @@
+CREATE TABLE
+    api_keys (
+        id UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+        name TEXT NOT NULL,
+        prefix TEXT NOT NULL,
+        key_hash BYTEA NOT NULL UNIQUE,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT now (),
+        expires_at TIMESTAMPTZ,
+        last_used_at TIMESTAMPTZ,
+        revoked_at TIMESTAMPTZ
+    );
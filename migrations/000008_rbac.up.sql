@@ -0,0 +1,48 @@
+CREATE TABLE
+    roles (
+        name TEXT PRIMARY KEY,
+        description TEXT NOT NULL DEFAULT '',
+        permissions JSONB NOT NULL DEFAULT '[]',
+        built_in BOOLEAN NOT NULL DEFAULT FALSE,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT now (),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT now ()
+    );
+
+CREATE TABLE
+    role_bindings (
+        id UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+        role_name TEXT NOT NULL,
+        subject_type TEXT NOT NULL,
+        subject_id TEXT NOT NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT now (),
+        UNIQUE (role_name, subject_type, subject_id),
+        FOREIGN KEY (role_name) REFERENCES roles (name) ON DELETE CASCADE
+    );
+
+CREATE INDEX role_bindings_subject_idx ON role_bindings (subject_type, subject_id);
+
+INSERT INTO
+    roles (name, description, permissions, built_in)
+VALUES
+    (
+        'admin',
+        'Full access to every resource',
+        '[{"actions": ["*"], "resources": ["*"]}]',
+        TRUE
+    ),
+    (
+        'viewer',
+        'Read access to every resource',
+        '[{"actions": ["read"], "resources": ["*"]}]',
+        TRUE
+    );
+
+-- API keys created before access control had full access, which they keep.
+INSERT INTO
+    role_bindings (role_name, subject_type, subject_id)
+SELECT
+    'admin',
+    'apiKey',
+    id::text
+FROM
+    api_keys;
@@ -0,0 +1,34 @@
+CREATE TABLE
+    audit_log (
+        id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT now (),
+        actor_type TEXT NOT NULL,
+        actor_id TEXT NOT NULL,
+        actor_name TEXT NOT NULL DEFAULT '',
+        source_ip TEXT NOT NULL DEFAULT '',
+        operation TEXT NOT NULL,
+        target TEXT NOT NULL,
+        before JSONB,
+        after JSONB
+    );
+
+CREATE INDEX audit_log_created_at_idx ON audit_log (created_at, id);
+
+CREATE INDEX audit_log_target_idx ON audit_log (target text_pattern_ops);
+
+CREATE INDEX audit_log_actor_idx ON audit_log (actor_type, actor_id);
+
+-- The audit log is append-only: entries can be added but never changed or removed.
+CREATE FUNCTION audit_log_append_only () RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_append_only BEFORE
+UPDATE
+OR DELETE ON audit_log FOR EACH STATEMENT
+EXECUTE FUNCTION audit_log_append_only ();
+
+CREATE TRIGGER audit_log_no_truncate BEFORE TRUNCATE ON audit_log FOR EACH STATEMENT
+EXECUTE FUNCTION audit_log_append_only ();
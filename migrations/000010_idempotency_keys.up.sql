@@ -0,0 +1,16 @@
+-- Responses to POST requests made with an Idempotency-Key header, replayed when a request is
+-- retried with the same key. The response is null while the request is being handled.
+CREATE TABLE
+    idempotency_keys (
+        subject_type TEXT NOT NULL,
+        subject_id TEXT NOT NULL,
+        key TEXT NOT NULL,
+        request_hash BYTEA NOT NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT now (),
+        status_code INTEGER,
+        content_type TEXT,
+        body BYTEA,
+        PRIMARY KEY (subject_type, subject_id, key)
+    );
+
+CREATE INDEX idempotency_keys_created_at_idx ON idempotency_keys (created_at);
@@ -0,0 +1,33 @@
+CREATE TABLE
+    webhooks (
+        id UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+        name TEXT NOT NULL,
+        url TEXT NOT NULL,
+        events TEXT[] NOT NULL,
+        secret TEXT NOT NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT now ()
+    );
+
+-- Deliveries of events to webhooks, kept as the delivery log of each webhook. Pending
+-- deliveries are attempted once next_attempt_at has passed.
+CREATE TABLE
+    webhook_deliveries (
+        id UUID PRIMARY KEY DEFAULT gen_random_uuid (),
+        webhook_id UUID NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+        event_id UUID NOT NULL,
+        event_type TEXT NOT NULL,
+        payload JSONB NOT NULL,
+        status TEXT NOT NULL DEFAULT 'pending',
+        attempts INTEGER NOT NULL DEFAULT 0,
+        next_attempt_at TIMESTAMPTZ,
+        last_attempt_at TIMESTAMPTZ,
+        response_status INTEGER,
+        last_error TEXT NOT NULL DEFAULT '',
+        created_at TIMESTAMPTZ NOT NULL DEFAULT now ()
+    );
+
+CREATE INDEX webhook_deliveries_webhook_idx ON webhook_deliveries (webhook_id, created_at, id);
+
+CREATE INDEX webhook_deliveries_pending_idx ON webhook_deliveries (next_attempt_at)
+WHERE
+    status = 'pending';
@@ -0,0 +1,12 @@
+-- Notifications of the changes the worker applied, streamed to watchers. Ids increase in
+-- commit order, so that a watcher resuming after an id never skips a change.
+CREATE TABLE
+    change_log (
+        id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+        type TEXT NOT NULL,
+        resource TEXT NOT NULL,
+        data JSONB,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT now ()
+    );
+
+CREATE INDEX change_log_created_at_idx ON change_log (created_at);
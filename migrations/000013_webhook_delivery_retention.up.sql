@@ -0,0 +1,4 @@
+-- Finished deliveries are purged once they are older than the delivery retention.
+CREATE INDEX webhook_deliveries_finished_idx ON webhook_deliveries (created_at)
+WHERE
+    status <> 'pending';
@@ -0,0 +1,380 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: beacondns/v1/common.proto
+
+package beacondnsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SortOrder is the order of a list. Unspecified uses the default order of the list.
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_UNSPECIFIED SortOrder = 0
+	SortOrder_SORT_ORDER_ASCENDING   SortOrder = 1
+	SortOrder_SORT_ORDER_DESCENDING  SortOrder = 2
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_UNSPECIFIED",
+		1: "SORT_ORDER_ASCENDING",
+		2: "SORT_ORDER_DESCENDING",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_UNSPECIFIED": 0,
+		"SORT_ORDER_ASCENDING":   1,
+		"SORT_ORDER_DESCENDING":  2,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_beacondns_v1_common_proto_enumTypes[0].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_beacondns_v1_common_proto_enumTypes[0]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_beacondns_v1_common_proto_rawDescGZIP(), []int{0}
+}
+
+// ListOptions selects a page of a list, like the paging, filtering and sorting query
+// parameters of the REST API.
+type ListOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of items on the page. Zero uses the default limit.
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// The next_cursor of the previous page. It is only valid with the sort and order it was
+	// issued with.
+	Cursor     string    `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	NamePrefix string    `protobuf:"bytes,3,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`
+	SortBy     string    `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	Order      SortOrder `protobuf:"varint,5,opt,name=order,proto3,enum=beacondns.v1.SortOrder" json:"order,omitempty"`
+	// Tag filters of the form key:value, which match a tag exactly, or key, which matches any
+	// value of the key. An item must match every filter.
+	Tags          []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOptions) Reset() {
+	*x = ListOptions{}
+	mi := &file_beacondns_v1_common_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOptions) ProtoMessage() {}
+
+func (x *ListOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_common_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOptions.ProtoReflect.Descriptor instead.
+func (*ListOptions) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_common_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListOptions) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListOptions) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListOptions) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *ListOptions) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListOptions) GetOrder() SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *ListOptions) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ResourceRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceRecord) Reset() {
+	*x = ResourceRecord{}
+	mi := &file_beacondns_v1_common_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceRecord) ProtoMessage() {}
+
+func (x *ResourceRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_common_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceRecord.ProtoReflect.Descriptor instead.
+func (*ResourceRecord) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_common_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResourceRecord) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ResourceRecordSet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Fully qualified name of the record set.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Record type, such as A or MX.
+	Type            string            `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Ttl             uint32            `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	ResourceRecords []*ResourceRecord `protobuf:"bytes,4,rep,name=resource_records,json=resourceRecords,proto3" json:"resource_records,omitempty"`
+	Tags            map[string]string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Comment         string            `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ResourceRecordSet) Reset() {
+	*x = ResourceRecordSet{}
+	mi := &file_beacondns_v1_common_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceRecordSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceRecordSet) ProtoMessage() {}
+
+func (x *ResourceRecordSet) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_common_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceRecordSet.ProtoReflect.Descriptor instead.
+func (*ResourceRecordSet) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_common_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ResourceRecordSet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceRecordSet) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ResourceRecordSet) GetTtl() uint32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *ResourceRecordSet) GetResourceRecords() []*ResourceRecord {
+	if x != nil {
+		return x.ResourceRecords
+	}
+	return nil
+}
+
+func (x *ResourceRecordSet) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ResourceRecordSet) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+var File_beacondns_v1_common_proto protoreflect.FileDescriptor
+
+const file_beacondns_v1_common_proto_rawDesc = "" +
+	"\n" +
+	"\x19beacondns/v1/common.proto\x12\fbeacondns.v1\"\xb8\x01\n" +
+	"\vListOptions\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x1f\n" +
+	"\vname_prefix\x18\x03 \x01(\tR\n" +
+	"namePrefix\x12\x17\n" +
+	"\asort_by\x18\x04 \x01(\tR\x06sortBy\x12-\n" +
+	"\x05order\x18\x05 \x01(\x0e2\x17.beacondns.v1.SortOrderR\x05order\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\"&\n" +
+	"\x0eResourceRecord\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"\xa8\x02\n" +
+	"\x11ResourceRecordSet\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x10\n" +
+	"\x03ttl\x18\x03 \x01(\rR\x03ttl\x12G\n" +
+	"\x10resource_records\x18\x04 \x03(\v2\x1c.beacondns.v1.ResourceRecordR\x0fresourceRecords\x12=\n" +
+	"\x04tags\x18\x05 \x03(\v2).beacondns.v1.ResourceRecordSet.TagsEntryR\x04tags\x12\x18\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*\\\n" +
+	"\tSortOrder\x12\x1a\n" +
+	"\x16SORT_ORDER_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14SORT_ORDER_ASCENDING\x10\x01\x12\x19\n" +
+	"\x15SORT_ORDER_DESCENDING\x10\x02BBZ@github.com/davidseybold/beacondns/proto/beacondns/v1;beacondnsv1b\x06proto3"
+
+var (
+	file_beacondns_v1_common_proto_rawDescOnce sync.Once
+	file_beacondns_v1_common_proto_rawDescData []byte
+)
+
+func file_beacondns_v1_common_proto_rawDescGZIP() []byte {
+	file_beacondns_v1_common_proto_rawDescOnce.Do(func() {
+		file_beacondns_v1_common_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_beacondns_v1_common_proto_rawDesc), len(file_beacondns_v1_common_proto_rawDesc)))
+	})
+	return file_beacondns_v1_common_proto_rawDescData
+}
+
+var file_beacondns_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_beacondns_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_beacondns_v1_common_proto_goTypes = []any{
+	(SortOrder)(0),            // 0: beacondns.v1.SortOrder
+	(*ListOptions)(nil),       // 1: beacondns.v1.ListOptions
+	(*ResourceRecord)(nil),    // 2: beacondns.v1.ResourceRecord
+	(*ResourceRecordSet)(nil), // 3: beacondns.v1.ResourceRecordSet
+	nil,                       // 4: beacondns.v1.ResourceRecordSet.TagsEntry
+}
+var file_beacondns_v1_common_proto_depIdxs = []int32{
+	0, // 0: beacondns.v1.ListOptions.order:type_name -> beacondns.v1.SortOrder
+	2, // 1: beacondns.v1.ResourceRecordSet.resource_records:type_name -> beacondns.v1.ResourceRecord
+	4, // 2: beacondns.v1.ResourceRecordSet.tags:type_name -> beacondns.v1.ResourceRecordSet.TagsEntry
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_beacondns_v1_common_proto_init() }
+func file_beacondns_v1_common_proto_init() {
+	if File_beacondns_v1_common_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_beacondns_v1_common_proto_rawDesc), len(file_beacondns_v1_common_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_beacondns_v1_common_proto_goTypes,
+		DependencyIndexes: file_beacondns_v1_common_proto_depIdxs,
+		EnumInfos:         file_beacondns_v1_common_proto_enumTypes,
+		MessageInfos:      file_beacondns_v1_common_proto_msgTypes,
+	}.Build()
+	File_beacondns_v1_common_proto = out.File
+	file_beacondns_v1_common_proto_goTypes = nil
+	file_beacondns_v1_common_proto_depIdxs = nil
+}
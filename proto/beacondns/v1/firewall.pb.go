@@ -0,0 +1,2153 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: beacondns/v1/firewall.proto
+
+package beacondnsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FirewallRuleAction int32
+
+const (
+	FirewallRuleAction_FIREWALL_RULE_ACTION_UNSPECIFIED FirewallRuleAction = 0
+	FirewallRuleAction_FIREWALL_RULE_ACTION_ALLOW       FirewallRuleAction = 1
+	FirewallRuleAction_FIREWALL_RULE_ACTION_ALERT       FirewallRuleAction = 2
+	FirewallRuleAction_FIREWALL_RULE_ACTION_BLOCK       FirewallRuleAction = 3
+)
+
+// Enum value maps for FirewallRuleAction.
+var (
+	FirewallRuleAction_name = map[int32]string{
+		0: "FIREWALL_RULE_ACTION_UNSPECIFIED",
+		1: "FIREWALL_RULE_ACTION_ALLOW",
+		2: "FIREWALL_RULE_ACTION_ALERT",
+		3: "FIREWALL_RULE_ACTION_BLOCK",
+	}
+	FirewallRuleAction_value = map[string]int32{
+		"FIREWALL_RULE_ACTION_UNSPECIFIED": 0,
+		"FIREWALL_RULE_ACTION_ALLOW":       1,
+		"FIREWALL_RULE_ACTION_ALERT":       2,
+		"FIREWALL_RULE_ACTION_BLOCK":       3,
+	}
+)
+
+func (x FirewallRuleAction) Enum() *FirewallRuleAction {
+	p := new(FirewallRuleAction)
+	*p = x
+	return p
+}
+
+func (x FirewallRuleAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FirewallRuleAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_beacondns_v1_firewall_proto_enumTypes[0].Descriptor()
+}
+
+func (FirewallRuleAction) Type() protoreflect.EnumType {
+	return &file_beacondns_v1_firewall_proto_enumTypes[0]
+}
+
+func (x FirewallRuleAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FirewallRuleAction.Descriptor instead.
+func (FirewallRuleAction) EnumDescriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{0}
+}
+
+type BlockResponseType int32
+
+const (
+	BlockResponseType_BLOCK_RESPONSE_TYPE_UNSPECIFIED BlockResponseType = 0
+	BlockResponseType_BLOCK_RESPONSE_TYPE_NXDOMAIN    BlockResponseType = 1
+	BlockResponseType_BLOCK_RESPONSE_TYPE_NODATA      BlockResponseType = 2
+	BlockResponseType_BLOCK_RESPONSE_TYPE_OVERRIDE    BlockResponseType = 3
+)
+
+// Enum value maps for BlockResponseType.
+var (
+	BlockResponseType_name = map[int32]string{
+		0: "BLOCK_RESPONSE_TYPE_UNSPECIFIED",
+		1: "BLOCK_RESPONSE_TYPE_NXDOMAIN",
+		2: "BLOCK_RESPONSE_TYPE_NODATA",
+		3: "BLOCK_RESPONSE_TYPE_OVERRIDE",
+	}
+	BlockResponseType_value = map[string]int32{
+		"BLOCK_RESPONSE_TYPE_UNSPECIFIED": 0,
+		"BLOCK_RESPONSE_TYPE_NXDOMAIN":    1,
+		"BLOCK_RESPONSE_TYPE_NODATA":      2,
+		"BLOCK_RESPONSE_TYPE_OVERRIDE":    3,
+	}
+)
+
+func (x BlockResponseType) Enum() *BlockResponseType {
+	p := new(BlockResponseType)
+	*p = x
+	return p
+}
+
+func (x BlockResponseType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BlockResponseType) Descriptor() protoreflect.EnumDescriptor {
+	return file_beacondns_v1_firewall_proto_enumTypes[1].Descriptor()
+}
+
+func (BlockResponseType) Type() protoreflect.EnumType {
+	return &file_beacondns_v1_firewall_proto_enumTypes[1]
+}
+
+func (x BlockResponseType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlockResponseType.Descriptor instead.
+func (BlockResponseType) EnumDescriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{1}
+}
+
+type FirewallRule struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DomainListId string                 `protobuf:"bytes,3,opt,name=domain_list_id,json=domainListId,proto3" json:"domain_list_id,omitempty"`
+	Action       FirewallRuleAction     `protobuf:"varint,4,opt,name=action,proto3,enum=beacondns.v1.FirewallRuleAction" json:"action,omitempty"`
+	// Required for the BLOCK action.
+	BlockResponseType BlockResponseType `protobuf:"varint,5,opt,name=block_response_type,json=blockResponseType,proto3,enum=beacondns.v1.BlockResponseType" json:"block_response_type,omitempty"`
+	// Required for the OVERRIDE block response type.
+	BlockResponse *ResourceRecordSet `protobuf:"bytes,6,opt,name=block_response,json=blockResponse,proto3" json:"block_response,omitempty"`
+	Priority      uint32             `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	Tags          map[string]string  `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FirewallRule) Reset() {
+	*x = FirewallRule{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FirewallRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirewallRule) ProtoMessage() {}
+
+func (x *FirewallRule) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirewallRule.ProtoReflect.Descriptor instead.
+func (*FirewallRule) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FirewallRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FirewallRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FirewallRule) GetDomainListId() string {
+	if x != nil {
+		return x.DomainListId
+	}
+	return ""
+}
+
+func (x *FirewallRule) GetAction() FirewallRuleAction {
+	if x != nil {
+		return x.Action
+	}
+	return FirewallRuleAction_FIREWALL_RULE_ACTION_UNSPECIFIED
+}
+
+func (x *FirewallRule) GetBlockResponseType() BlockResponseType {
+	if x != nil {
+		return x.BlockResponseType
+	}
+	return BlockResponseType_BLOCK_RESPONSE_TYPE_UNSPECIFIED
+}
+
+func (x *FirewallRule) GetBlockResponse() *ResourceRecordSet {
+	if x != nil {
+		return x.BlockResponse
+	}
+	return nil
+}
+
+func (x *FirewallRule) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *FirewallRule) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type DomainList struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IsManaged bool                   `protobuf:"varint,3,opt,name=is_managed,json=isManaged,proto3" json:"is_managed,omitempty"`
+	// Only set on managed lists.
+	SourceUrl   string `protobuf:"bytes,4,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`
+	DomainCount int32  `protobuf:"varint,5,opt,name=domain_count,json=domainCount,proto3" json:"domain_count,omitempty"`
+	// IDs of the rules that match the list.
+	LinkedRules   []string               `protobuf:"bytes,6,rep,name=linked_rules,json=linkedRules,proto3" json:"linked_rules,omitempty"`
+	LastUpdated   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	Tags          map[string]string      `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DomainList) Reset() {
+	*x = DomainList{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DomainList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainList) ProtoMessage() {}
+
+func (x *DomainList) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainList.ProtoReflect.Descriptor instead.
+func (*DomainList) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DomainList) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DomainList) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DomainList) GetIsManaged() bool {
+	if x != nil {
+		return x.IsManaged
+	}
+	return false
+}
+
+func (x *DomainList) GetSourceUrl() string {
+	if x != nil {
+		return x.SourceUrl
+	}
+	return ""
+}
+
+func (x *DomainList) GetDomainCount() int32 {
+	if x != nil {
+		return x.DomainCount
+	}
+	return 0
+}
+
+func (x *DomainList) GetLinkedRules() []string {
+	if x != nil {
+		return x.LinkedRules
+	}
+	return nil
+}
+
+func (x *DomainList) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+func (x *DomainList) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CreateFirewallRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id is ignored.
+	Rule          *FirewallRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFirewallRuleRequest) Reset() {
+	*x = CreateFirewallRuleRequest{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFirewallRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFirewallRuleRequest) ProtoMessage() {}
+
+func (x *CreateFirewallRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFirewallRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateFirewallRuleRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateFirewallRuleRequest) GetRule() *FirewallRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type CreateFirewallRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *FirewallRule          `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFirewallRuleResponse) Reset() {
+	*x = CreateFirewallRuleResponse{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFirewallRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFirewallRuleResponse) ProtoMessage() {}
+
+func (x *CreateFirewallRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFirewallRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateFirewallRuleResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateFirewallRuleResponse) GetRule() *FirewallRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateFirewallRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The rule with the id is updated. Its tags are ignored.
+	Rule          *FirewallRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFirewallRuleRequest) Reset() {
+	*x = UpdateFirewallRuleRequest{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFirewallRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFirewallRuleRequest) ProtoMessage() {}
+
+func (x *UpdateFirewallRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFirewallRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFirewallRuleRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateFirewallRuleRequest) GetRule() *FirewallRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateFirewallRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *FirewallRule          `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFirewallRuleResponse) Reset() {
+	*x = UpdateFirewallRuleResponse{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFirewallRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFirewallRuleResponse) ProtoMessage() {}
+
+func (x *UpdateFirewallRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFirewallRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFirewallRuleResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateFirewallRuleResponse) GetRule() *FirewallRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteFirewallRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFirewallRuleRequest) Reset() {
+	*x = DeleteFirewallRuleRequest{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFirewallRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFirewallRuleRequest) ProtoMessage() {}
+
+func (x *DeleteFirewallRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFirewallRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFirewallRuleRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteFirewallRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteFirewallRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFirewallRuleResponse) Reset() {
+	*x = DeleteFirewallRuleResponse{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFirewallRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFirewallRuleResponse) ProtoMessage() {}
+
+func (x *DeleteFirewallRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFirewallRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFirewallRuleResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{7}
+}
+
+type GetFirewallRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFirewallRuleRequest) Reset() {
+	*x = GetFirewallRuleRequest{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFirewallRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFirewallRuleRequest) ProtoMessage() {}
+
+func (x *GetFirewallRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFirewallRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetFirewallRuleRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetFirewallRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetFirewallRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *FirewallRule          `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFirewallRuleResponse) Reset() {
+	*x = GetFirewallRuleResponse{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFirewallRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFirewallRuleResponse) ProtoMessage() {}
+
+func (x *GetFirewallRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFirewallRuleResponse.ProtoReflect.Descriptor instead.
+func (*GetFirewallRuleResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetFirewallRuleResponse) GetRule() *FirewallRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type ListFirewallRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Options       *ListOptions           `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFirewallRulesRequest) Reset() {
+	*x = ListFirewallRulesRequest{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFirewallRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFirewallRulesRequest) ProtoMessage() {}
+
+func (x *ListFirewallRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFirewallRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListFirewallRulesRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListFirewallRulesRequest) GetOptions() *ListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ListFirewallRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*FirewallRule        `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFirewallRulesResponse) Reset() {
+	*x = ListFirewallRulesResponse{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFirewallRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFirewallRulesResponse) ProtoMessage() {}
+
+func (x *ListFirewallRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFirewallRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListFirewallRulesResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListFirewallRulesResponse) GetRules() []*FirewallRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ListFirewallRulesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type UpdateFirewallRuleTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Set           map[string]string      `protobuf:"bytes,2,rep,name=set,proto3" json:"set,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Remove        []string               `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFirewallRuleTagsRequest) Reset() {
+	*x = UpdateFirewallRuleTagsRequest{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFirewallRuleTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFirewallRuleTagsRequest) ProtoMessage() {}
+
+func (x *UpdateFirewallRuleTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFirewallRuleTagsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFirewallRuleTagsRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateFirewallRuleTagsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateFirewallRuleTagsRequest) GetSet() map[string]string {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *UpdateFirewallRuleTagsRequest) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+type UpdateFirewallRuleTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          map[string]string      `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFirewallRuleTagsResponse) Reset() {
+	*x = UpdateFirewallRuleTagsResponse{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFirewallRuleTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFirewallRuleTagsResponse) ProtoMessage() {}
+
+func (x *UpdateFirewallRuleTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFirewallRuleTagsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFirewallRuleTagsResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateFirewallRuleTagsResponse) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CreateDomainListRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsManaged bool                   `protobuf:"varint,2,opt,name=is_managed,json=isManaged,proto3" json:"is_managed,omitempty"`
+	// Domains of an unmanaged list.
+	Domains []string `protobuf:"bytes,3,rep,name=domains,proto3" json:"domains,omitempty"`
+	// Source of the domains of a managed list.
+	SourceUrl     string            `protobuf:"bytes,4,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`
+	Tags          map[string]string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDomainListRequest) Reset() {
+	*x = CreateDomainListRequest{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDomainListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDomainListRequest) ProtoMessage() {}
+
+func (x *CreateDomainListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDomainListRequest.ProtoReflect.Descriptor instead.
+func (*CreateDomainListRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateDomainListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDomainListRequest) GetIsManaged() bool {
+	if x != nil {
+		return x.IsManaged
+	}
+	return false
+}
+
+func (x *CreateDomainListRequest) GetDomains() []string {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+func (x *CreateDomainListRequest) GetSourceUrl() string {
+	if x != nil {
+		return x.SourceUrl
+	}
+	return ""
+}
+
+func (x *CreateDomainListRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CreateDomainListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainList    *DomainList            `protobuf:"bytes,1,opt,name=domain_list,json=domainList,proto3" json:"domain_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDomainListResponse) Reset() {
+	*x = CreateDomainListResponse{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDomainListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDomainListResponse) ProtoMessage() {}
+
+func (x *CreateDomainListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDomainListResponse.ProtoReflect.Descriptor instead.
+func (*CreateDomainListResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateDomainListResponse) GetDomainList() *DomainList {
+	if x != nil {
+		return x.DomainList
+	}
+	return nil
+}
+
+type DeleteDomainListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDomainListRequest) Reset() {
+	*x = DeleteDomainListRequest{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDomainListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDomainListRequest) ProtoMessage() {}
+
+func (x *DeleteDomainListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDomainListRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDomainListRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteDomainListRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteDomainListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDomainListResponse) Reset() {
+	*x = DeleteDomainListResponse{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDomainListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDomainListResponse) ProtoMessage() {}
+
+func (x *DeleteDomainListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDomainListResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDomainListResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{17}
+}
+
+type RefreshDomainListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshDomainListRequest) Reset() {
+	*x = RefreshDomainListRequest{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshDomainListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshDomainListRequest) ProtoMessage() {}
+
+func (x *RefreshDomainListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshDomainListRequest.ProtoReflect.Descriptor instead.
+func (*RefreshDomainListRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RefreshDomainListRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RefreshDomainListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainList    *DomainList            `protobuf:"bytes,1,opt,name=domain_list,json=domainList,proto3" json:"domain_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshDomainListResponse) Reset() {
+	*x = RefreshDomainListResponse{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshDomainListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshDomainListResponse) ProtoMessage() {}
+
+func (x *RefreshDomainListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshDomainListResponse.ProtoReflect.Descriptor instead.
+func (*RefreshDomainListResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RefreshDomainListResponse) GetDomainList() *DomainList {
+	if x != nil {
+		return x.DomainList
+	}
+	return nil
+}
+
+type AddDomainListDomainsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Domains       []string               `protobuf:"bytes,2,rep,name=domains,proto3" json:"domains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDomainListDomainsRequest) Reset() {
+	*x = AddDomainListDomainsRequest{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDomainListDomainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDomainListDomainsRequest) ProtoMessage() {}
+
+func (x *AddDomainListDomainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDomainListDomainsRequest.ProtoReflect.Descriptor instead.
+func (*AddDomainListDomainsRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AddDomainListDomainsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddDomainListDomainsRequest) GetDomains() []string {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+type AddDomainListDomainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDomainListDomainsResponse) Reset() {
+	*x = AddDomainListDomainsResponse{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDomainListDomainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDomainListDomainsResponse) ProtoMessage() {}
+
+func (x *AddDomainListDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDomainListDomainsResponse.ProtoReflect.Descriptor instead.
+func (*AddDomainListDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{21}
+}
+
+type RemoveDomainListDomainsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Domains       []string               `protobuf:"bytes,2,rep,name=domains,proto3" json:"domains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDomainListDomainsRequest) Reset() {
+	*x = RemoveDomainListDomainsRequest{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDomainListDomainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDomainListDomainsRequest) ProtoMessage() {}
+
+func (x *RemoveDomainListDomainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDomainListDomainsRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDomainListDomainsRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RemoveDomainListDomainsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoveDomainListDomainsRequest) GetDomains() []string {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+type RemoveDomainListDomainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDomainListDomainsResponse) Reset() {
+	*x = RemoveDomainListDomainsResponse{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDomainListDomainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDomainListDomainsResponse) ProtoMessage() {}
+
+func (x *RemoveDomainListDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDomainListDomainsResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDomainListDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{23}
+}
+
+type GetDomainListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDomainListRequest) Reset() {
+	*x = GetDomainListRequest{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDomainListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDomainListRequest) ProtoMessage() {}
+
+func (x *GetDomainListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDomainListRequest.ProtoReflect.Descriptor instead.
+func (*GetDomainListRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetDomainListRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetDomainListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainList    *DomainList            `protobuf:"bytes,1,opt,name=domain_list,json=domainList,proto3" json:"domain_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDomainListResponse) Reset() {
+	*x = GetDomainListResponse{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDomainListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDomainListResponse) ProtoMessage() {}
+
+func (x *GetDomainListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDomainListResponse.ProtoReflect.Descriptor instead.
+func (*GetDomainListResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetDomainListResponse) GetDomainList() *DomainList {
+	if x != nil {
+		return x.DomainList
+	}
+	return nil
+}
+
+type ListDomainListsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Options       *ListOptions           `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDomainListsRequest) Reset() {
+	*x = ListDomainListsRequest{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDomainListsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDomainListsRequest) ProtoMessage() {}
+
+func (x *ListDomainListsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDomainListsRequest.ProtoReflect.Descriptor instead.
+func (*ListDomainListsRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListDomainListsRequest) GetOptions() *ListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ListDomainListsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainLists   []*DomainList          `protobuf:"bytes,1,rep,name=domain_lists,json=domainLists,proto3" json:"domain_lists,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDomainListsResponse) Reset() {
+	*x = ListDomainListsResponse{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDomainListsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDomainListsResponse) ProtoMessage() {}
+
+func (x *ListDomainListsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDomainListsResponse.ProtoReflect.Descriptor instead.
+func (*ListDomainListsResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListDomainListsResponse) GetDomainLists() []*DomainList {
+	if x != nil {
+		return x.DomainLists
+	}
+	return nil
+}
+
+func (x *ListDomainListsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type UpdateDomainListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Set           map[string]string      `protobuf:"bytes,2,rep,name=set,proto3" json:"set,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Remove        []string               `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDomainListTagsRequest) Reset() {
+	*x = UpdateDomainListTagsRequest{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDomainListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDomainListTagsRequest) ProtoMessage() {}
+
+func (x *UpdateDomainListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDomainListTagsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDomainListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateDomainListTagsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateDomainListTagsRequest) GetSet() map[string]string {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *UpdateDomainListTagsRequest) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+type UpdateDomainListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          map[string]string      `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDomainListTagsResponse) Reset() {
+	*x = UpdateDomainListTagsResponse{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDomainListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDomainListTagsResponse) ProtoMessage() {}
+
+func (x *UpdateDomainListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDomainListTagsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDomainListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateDomainListTagsResponse) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListDomainListDomainsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Options       *ListOptions           `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDomainListDomainsRequest) Reset() {
+	*x = ListDomainListDomainsRequest{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDomainListDomainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDomainListDomainsRequest) ProtoMessage() {}
+
+func (x *ListDomainListDomainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDomainListDomainsRequest.ProtoReflect.Descriptor instead.
+func (*ListDomainListDomainsRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListDomainListDomainsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListDomainListDomainsRequest) GetOptions() *ListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ListDomainListDomainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domains       []string               `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDomainListDomainsResponse) Reset() {
+	*x = ListDomainListDomainsResponse{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDomainListDomainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDomainListDomainsResponse) ProtoMessage() {}
+
+func (x *ListDomainListDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDomainListDomainsResponse.ProtoReflect.Descriptor instead.
+func (*ListDomainListDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListDomainListDomainsResponse) GetDomains() []string {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+func (x *ListDomainListDomainsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type StreamDomainListDomainsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Options       *ListOptions           `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamDomainListDomainsRequest) Reset() {
+	*x = StreamDomainListDomainsRequest{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamDomainListDomainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamDomainListDomainsRequest) ProtoMessage() {}
+
+func (x *StreamDomainListDomainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamDomainListDomainsRequest.ProtoReflect.Descriptor instead.
+func (*StreamDomainListDomainsRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *StreamDomainListDomainsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StreamDomainListDomainsRequest) GetOptions() *ListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type StreamDomainListDomainsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Domains []string               `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+	// Cursor to resume the stream after this batch with, should it break.
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamDomainListDomainsResponse) Reset() {
+	*x = StreamDomainListDomainsResponse{}
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamDomainListDomainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamDomainListDomainsResponse) ProtoMessage() {}
+
+func (x *StreamDomainListDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_firewall_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamDomainListDomainsResponse.ProtoReflect.Descriptor instead.
+func (*StreamDomainListDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_firewall_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StreamDomainListDomainsResponse) GetDomains() []string {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+func (x *StreamDomainListDomainsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+var File_beacondns_v1_firewall_proto protoreflect.FileDescriptor
+
+const file_beacondns_v1_firewall_proto_rawDesc = "" +
+	"\n" +
+	"\x1bbeacondns/v1/firewall.proto\x12\fbeacondns.v1\x1a\x19beacondns/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xba\x03\n" +
+	"\fFirewallRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12$\n" +
+	"\x0edomain_list_id\x18\x03 \x01(\tR\fdomainListId\x128\n" +
+	"\x06action\x18\x04 \x01(\x0e2 .beacondns.v1.FirewallRuleActionR\x06action\x12O\n" +
+	"\x13block_response_type\x18\x05 \x01(\x0e2\x1f.beacondns.v1.BlockResponseTypeR\x11blockResponseType\x12F\n" +
+	"\x0eblock_response\x18\x06 \x01(\v2\x1f.beacondns.v1.ResourceRecordSetR\rblockResponse\x12\x1a\n" +
+	"\bpriority\x18\a \x01(\rR\bpriority\x128\n" +
+	"\x04tags\x18\b \x03(\v2$.beacondns.v1.FirewallRule.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe4\x02\n" +
+	"\n" +
+	"DomainList\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"is_managed\x18\x03 \x01(\bR\tisManaged\x12\x1d\n" +
+	"\n" +
+	"source_url\x18\x04 \x01(\tR\tsourceUrl\x12!\n" +
+	"\fdomain_count\x18\x05 \x01(\x05R\vdomainCount\x12!\n" +
+	"\flinked_rules\x18\x06 \x03(\tR\vlinkedRules\x12=\n" +
+	"\flast_updated\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vlastUpdated\x126\n" +
+	"\x04tags\x18\b \x03(\v2\".beacondns.v1.DomainList.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"K\n" +
+	"\x19CreateFirewallRuleRequest\x12.\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1a.beacondns.v1.FirewallRuleR\x04rule\"L\n" +
+	"\x1aCreateFirewallRuleResponse\x12.\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1a.beacondns.v1.FirewallRuleR\x04rule\"K\n" +
+	"\x19UpdateFirewallRuleRequest\x12.\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1a.beacondns.v1.FirewallRuleR\x04rule\"L\n" +
+	"\x1aUpdateFirewallRuleResponse\x12.\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1a.beacondns.v1.FirewallRuleR\x04rule\"+\n" +
+	"\x19DeleteFirewallRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1c\n" +
+	"\x1aDeleteFirewallRuleResponse\"(\n" +
+	"\x16GetFirewallRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"I\n" +
+	"\x17GetFirewallRuleResponse\x12.\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1a.beacondns.v1.FirewallRuleR\x04rule\"O\n" +
+	"\x18ListFirewallRulesRequest\x123\n" +
+	"\aoptions\x18\x01 \x01(\v2\x19.beacondns.v1.ListOptionsR\aoptions\"n\n" +
+	"\x19ListFirewallRulesResponse\x120\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1a.beacondns.v1.FirewallRuleR\x05rules\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xc7\x01\n" +
+	"\x1dUpdateFirewallRuleTagsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12F\n" +
+	"\x03set\x18\x02 \x03(\v24.beacondns.v1.UpdateFirewallRuleTagsRequest.SetEntryR\x03set\x12\x16\n" +
+	"\x06remove\x18\x03 \x03(\tR\x06remove\x1a6\n" +
+	"\bSetEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa5\x01\n" +
+	"\x1eUpdateFirewallRuleTagsResponse\x12J\n" +
+	"\x04tags\x18\x01 \x03(\v26.beacondns.v1.UpdateFirewallRuleTagsResponse.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x83\x02\n" +
+	"\x17CreateDomainListRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"is_managed\x18\x02 \x01(\bR\tisManaged\x12\x18\n" +
+	"\adomains\x18\x03 \x03(\tR\adomains\x12\x1d\n" +
+	"\n" +
+	"source_url\x18\x04 \x01(\tR\tsourceUrl\x12C\n" +
+	"\x04tags\x18\x05 \x03(\v2/.beacondns.v1.CreateDomainListRequest.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"U\n" +
+	"\x18CreateDomainListResponse\x129\n" +
+	"\vdomain_list\x18\x01 \x01(\v2\x18.beacondns.v1.DomainListR\n" +
+	"domainList\")\n" +
+	"\x17DeleteDomainListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1a\n" +
+	"\x18DeleteDomainListResponse\"*\n" +
+	"\x18RefreshDomainListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"V\n" +
+	"\x19RefreshDomainListResponse\x129\n" +
+	"\vdomain_list\x18\x01 \x01(\v2\x18.beacondns.v1.DomainListR\n" +
+	"domainList\"G\n" +
+	"\x1bAddDomainListDomainsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\adomains\x18\x02 \x03(\tR\adomains\"\x1e\n" +
+	"\x1cAddDomainListDomainsResponse\"J\n" +
+	"\x1eRemoveDomainListDomainsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\adomains\x18\x02 \x03(\tR\adomains\"!\n" +
+	"\x1fRemoveDomainListDomainsResponse\"&\n" +
+	"\x14GetDomainListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"R\n" +
+	"\x15GetDomainListResponse\x129\n" +
+	"\vdomain_list\x18\x01 \x01(\v2\x18.beacondns.v1.DomainListR\n" +
+	"domainList\"M\n" +
+	"\x16ListDomainListsRequest\x123\n" +
+	"\aoptions\x18\x01 \x01(\v2\x19.beacondns.v1.ListOptionsR\aoptions\"w\n" +
+	"\x17ListDomainListsResponse\x12;\n" +
+	"\fdomain_lists\x18\x01 \x03(\v2\x18.beacondns.v1.DomainListR\vdomainLists\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xc3\x01\n" +
+	"\x1bUpdateDomainListTagsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12D\n" +
+	"\x03set\x18\x02 \x03(\v22.beacondns.v1.UpdateDomainListTagsRequest.SetEntryR\x03set\x12\x16\n" +
+	"\x06remove\x18\x03 \x03(\tR\x06remove\x1a6\n" +
+	"\bSetEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa1\x01\n" +
+	"\x1cUpdateDomainListTagsResponse\x12H\n" +
+	"\x04tags\x18\x01 \x03(\v24.beacondns.v1.UpdateDomainListTagsResponse.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"c\n" +
+	"\x1cListDomainListDomainsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x123\n" +
+	"\aoptions\x18\x02 \x01(\v2\x19.beacondns.v1.ListOptionsR\aoptions\"Z\n" +
+	"\x1dListDomainListDomainsResponse\x12\x18\n" +
+	"\adomains\x18\x01 \x03(\tR\adomains\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"e\n" +
+	"\x1eStreamDomainListDomainsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x123\n" +
+	"\aoptions\x18\x02 \x01(\v2\x19.beacondns.v1.ListOptionsR\aoptions\"\\\n" +
+	"\x1fStreamDomainListDomainsResponse\x12\x18\n" +
+	"\adomains\x18\x01 \x03(\tR\adomains\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor*\x9a\x01\n" +
+	"\x12FirewallRuleAction\x12$\n" +
+	" FIREWALL_RULE_ACTION_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aFIREWALL_RULE_ACTION_ALLOW\x10\x01\x12\x1e\n" +
+	"\x1aFIREWALL_RULE_ACTION_ALERT\x10\x02\x12\x1e\n" +
+	"\x1aFIREWALL_RULE_ACTION_BLOCK\x10\x03*\x9c\x01\n" +
+	"\x11BlockResponseType\x12#\n" +
+	"\x1fBLOCK_RESPONSE_TYPE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cBLOCK_RESPONSE_TYPE_NXDOMAIN\x10\x01\x12\x1e\n" +
+	"\x1aBLOCK_RESPONSE_TYPE_NODATA\x10\x02\x12 \n" +
+	"\x1cBLOCK_RESPONSE_TYPE_OVERRIDE\x10\x032\xaf\r\n" +
+	"\x0fFirewallService\x12g\n" +
+	"\x12CreateFirewallRule\x12'.beacondns.v1.CreateFirewallRuleRequest\x1a(.beacondns.v1.CreateFirewallRuleResponse\x12g\n" +
+	"\x12UpdateFirewallRule\x12'.beacondns.v1.UpdateFirewallRuleRequest\x1a(.beacondns.v1.UpdateFirewallRuleResponse\x12g\n" +
+	"\x12DeleteFirewallRule\x12'.beacondns.v1.DeleteFirewallRuleRequest\x1a(.beacondns.v1.DeleteFirewallRuleResponse\x12^\n" +
+	"\x0fGetFirewallRule\x12$.beacondns.v1.GetFirewallRuleRequest\x1a%.beacondns.v1.GetFirewallRuleResponse\x12d\n" +
+	"\x11ListFirewallRules\x12&.beacondns.v1.ListFirewallRulesRequest\x1a'.beacondns.v1.ListFirewallRulesResponse\x12s\n" +
+	"\x16UpdateFirewallRuleTags\x12+.beacondns.v1.UpdateFirewallRuleTagsRequest\x1a,.beacondns.v1.UpdateFirewallRuleTagsResponse\x12a\n" +
+	"\x10CreateDomainList\x12%.beacondns.v1.CreateDomainListRequest\x1a&.beacondns.v1.CreateDomainListResponse\x12a\n" +
+	"\x10DeleteDomainList\x12%.beacondns.v1.DeleteDomainListRequest\x1a&.beacondns.v1.DeleteDomainListResponse\x12d\n" +
+	"\x11RefreshDomainList\x12&.beacondns.v1.RefreshDomainListRequest\x1a'.beacondns.v1.RefreshDomainListResponse\x12m\n" +
+	"\x14AddDomainListDomains\x12).beacondns.v1.AddDomainListDomainsRequest\x1a*.beacondns.v1.AddDomainListDomainsResponse\x12v\n" +
+	"\x17RemoveDomainListDomains\x12,.beacondns.v1.RemoveDomainListDomainsRequest\x1a-.beacondns.v1.RemoveDomainListDomainsResponse\x12X\n" +
+	"\rGetDomainList\x12\".beacondns.v1.GetDomainListRequest\x1a#.beacondns.v1.GetDomainListResponse\x12^\n" +
+	"\x0fListDomainLists\x12$.beacondns.v1.ListDomainListsRequest\x1a%.beacondns.v1.ListDomainListsResponse\x12m\n" +
+	"\x14UpdateDomainListTags\x12).beacondns.v1.UpdateDomainListTagsRequest\x1a*.beacondns.v1.UpdateDomainListTagsResponse\x12p\n" +
+	"\x15ListDomainListDomains\x12*.beacondns.v1.ListDomainListDomainsRequest\x1a+.beacondns.v1.ListDomainListDomainsResponse\x12x\n" +
+	"\x17StreamDomainListDomains\x12,.beacondns.v1.StreamDomainListDomainsRequest\x1a-.beacondns.v1.StreamDomainListDomainsResponse0\x01BBZ@github.com/davidseybold/beacondns/proto/beacondns/v1;beacondnsv1b\x06proto3"
+
+var (
+	file_beacondns_v1_firewall_proto_rawDescOnce sync.Once
+	file_beacondns_v1_firewall_proto_rawDescData []byte
+)
+
+func file_beacondns_v1_firewall_proto_rawDescGZIP() []byte {
+	file_beacondns_v1_firewall_proto_rawDescOnce.Do(func() {
+		file_beacondns_v1_firewall_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_beacondns_v1_firewall_proto_rawDesc), len(file_beacondns_v1_firewall_proto_rawDesc)))
+	})
+	return file_beacondns_v1_firewall_proto_rawDescData
+}
+
+var file_beacondns_v1_firewall_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_beacondns_v1_firewall_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_beacondns_v1_firewall_proto_goTypes = []any{
+	(FirewallRuleAction)(0),                 // 0: beacondns.v1.FirewallRuleAction
+	(BlockResponseType)(0),                  // 1: beacondns.v1.BlockResponseType
+	(*FirewallRule)(nil),                    // 2: beacondns.v1.FirewallRule
+	(*DomainList)(nil),                      // 3: beacondns.v1.DomainList
+	(*CreateFirewallRuleRequest)(nil),       // 4: beacondns.v1.CreateFirewallRuleRequest
+	(*CreateFirewallRuleResponse)(nil),      // 5: beacondns.v1.CreateFirewallRuleResponse
+	(*UpdateFirewallRuleRequest)(nil),       // 6: beacondns.v1.UpdateFirewallRuleRequest
+	(*UpdateFirewallRuleResponse)(nil),      // 7: beacondns.v1.UpdateFirewallRuleResponse
+	(*DeleteFirewallRuleRequest)(nil),       // 8: beacondns.v1.DeleteFirewallRuleRequest
+	(*DeleteFirewallRuleResponse)(nil),      // 9: beacondns.v1.DeleteFirewallRuleResponse
+	(*GetFirewallRuleRequest)(nil),          // 10: beacondns.v1.GetFirewallRuleRequest
+	(*GetFirewallRuleResponse)(nil),         // 11: beacondns.v1.GetFirewallRuleResponse
+	(*ListFirewallRulesRequest)(nil),        // 12: beacondns.v1.ListFirewallRulesRequest
+	(*ListFirewallRulesResponse)(nil),       // 13: beacondns.v1.ListFirewallRulesResponse
+	(*UpdateFirewallRuleTagsRequest)(nil),   // 14: beacondns.v1.UpdateFirewallRuleTagsRequest
+	(*UpdateFirewallRuleTagsResponse)(nil),  // 15: beacondns.v1.UpdateFirewallRuleTagsResponse
+	(*CreateDomainListRequest)(nil),         // 16: beacondns.v1.CreateDomainListRequest
+	(*CreateDomainListResponse)(nil),        // 17: beacondns.v1.CreateDomainListResponse
+	(*DeleteDomainListRequest)(nil),         // 18: beacondns.v1.DeleteDomainListRequest
+	(*DeleteDomainListResponse)(nil),        // 19: beacondns.v1.DeleteDomainListResponse
+	(*RefreshDomainListRequest)(nil),        // 20: beacondns.v1.RefreshDomainListRequest
+	(*RefreshDomainListResponse)(nil),       // 21: beacondns.v1.RefreshDomainListResponse
+	(*AddDomainListDomainsRequest)(nil),     // 22: beacondns.v1.AddDomainListDomainsRequest
+	(*AddDomainListDomainsResponse)(nil),    // 23: beacondns.v1.AddDomainListDomainsResponse
+	(*RemoveDomainListDomainsRequest)(nil),  // 24: beacondns.v1.RemoveDomainListDomainsRequest
+	(*RemoveDomainListDomainsResponse)(nil), // 25: beacondns.v1.RemoveDomainListDomainsResponse
+	(*GetDomainListRequest)(nil),            // 26: beacondns.v1.GetDomainListRequest
+	(*GetDomainListResponse)(nil),           // 27: beacondns.v1.GetDomainListResponse
+	(*ListDomainListsRequest)(nil),          // 28: beacondns.v1.ListDomainListsRequest
+	(*ListDomainListsResponse)(nil),         // 29: beacondns.v1.ListDomainListsResponse
+	(*UpdateDomainListTagsRequest)(nil),     // 30: beacondns.v1.UpdateDomainListTagsRequest
+	(*UpdateDomainListTagsResponse)(nil),    // 31: beacondns.v1.UpdateDomainListTagsResponse
+	(*ListDomainListDomainsRequest)(nil),    // 32: beacondns.v1.ListDomainListDomainsRequest
+	(*ListDomainListDomainsResponse)(nil),   // 33: beacondns.v1.ListDomainListDomainsResponse
+	(*StreamDomainListDomainsRequest)(nil),  // 34: beacondns.v1.StreamDomainListDomainsRequest
+	(*StreamDomainListDomainsResponse)(nil), // 35: beacondns.v1.StreamDomainListDomainsResponse
+	nil,                                     // 36: beacondns.v1.FirewallRule.TagsEntry
+	nil,                                     // 37: beacondns.v1.DomainList.TagsEntry
+	nil,                                     // 38: beacondns.v1.UpdateFirewallRuleTagsRequest.SetEntry
+	nil,                                     // 39: beacondns.v1.UpdateFirewallRuleTagsResponse.TagsEntry
+	nil,                                     // 40: beacondns.v1.CreateDomainListRequest.TagsEntry
+	nil,                                     // 41: beacondns.v1.UpdateDomainListTagsRequest.SetEntry
+	nil,                                     // 42: beacondns.v1.UpdateDomainListTagsResponse.TagsEntry
+	(*ResourceRecordSet)(nil),               // 43: beacondns.v1.ResourceRecordSet
+	(*timestamppb.Timestamp)(nil),           // 44: google.protobuf.Timestamp
+	(*ListOptions)(nil),                     // 45: beacondns.v1.ListOptions
+}
+var file_beacondns_v1_firewall_proto_depIdxs = []int32{
+	0,  // 0: beacondns.v1.FirewallRule.action:type_name -> beacondns.v1.FirewallRuleAction
+	1,  // 1: beacondns.v1.FirewallRule.block_response_type:type_name -> beacondns.v1.BlockResponseType
+	43, // 2: beacondns.v1.FirewallRule.block_response:type_name -> beacondns.v1.ResourceRecordSet
+	36, // 3: beacondns.v1.FirewallRule.tags:type_name -> beacondns.v1.FirewallRule.TagsEntry
+	44, // 4: beacondns.v1.DomainList.last_updated:type_name -> google.protobuf.Timestamp
+	37, // 5: beacondns.v1.DomainList.tags:type_name -> beacondns.v1.DomainList.TagsEntry
+	2,  // 6: beacondns.v1.CreateFirewallRuleRequest.rule:type_name -> beacondns.v1.FirewallRule
+	2,  // 7: beacondns.v1.CreateFirewallRuleResponse.rule:type_name -> beacondns.v1.FirewallRule
+	2,  // 8: beacondns.v1.UpdateFirewallRuleRequest.rule:type_name -> beacondns.v1.FirewallRule
+	2,  // 9: beacondns.v1.UpdateFirewallRuleResponse.rule:type_name -> beacondns.v1.FirewallRule
+	2,  // 10: beacondns.v1.GetFirewallRuleResponse.rule:type_name -> beacondns.v1.FirewallRule
+	45, // 11: beacondns.v1.ListFirewallRulesRequest.options:type_name -> beacondns.v1.ListOptions
+	2,  // 12: beacondns.v1.ListFirewallRulesResponse.rules:type_name -> beacondns.v1.FirewallRule
+	38, // 13: beacondns.v1.UpdateFirewallRuleTagsRequest.set:type_name -> beacondns.v1.UpdateFirewallRuleTagsRequest.SetEntry
+	39, // 14: beacondns.v1.UpdateFirewallRuleTagsResponse.tags:type_name -> beacondns.v1.UpdateFirewallRuleTagsResponse.TagsEntry
+	40, // 15: beacondns.v1.CreateDomainListRequest.tags:type_name -> beacondns.v1.CreateDomainListRequest.TagsEntry
+	3,  // 16: beacondns.v1.CreateDomainListResponse.domain_list:type_name -> beacondns.v1.DomainList
+	3,  // 17: beacondns.v1.RefreshDomainListResponse.domain_list:type_name -> beacondns.v1.DomainList
+	3,  // 18: beacondns.v1.GetDomainListResponse.domain_list:type_name -> beacondns.v1.DomainList
+	45, // 19: beacondns.v1.ListDomainListsRequest.options:type_name -> beacondns.v1.ListOptions
+	3,  // 20: beacondns.v1.ListDomainListsResponse.domain_lists:type_name -> beacondns.v1.DomainList
+	41, // 21: beacondns.v1.UpdateDomainListTagsRequest.set:type_name -> beacondns.v1.UpdateDomainListTagsRequest.SetEntry
+	42, // 22: beacondns.v1.UpdateDomainListTagsResponse.tags:type_name -> beacondns.v1.UpdateDomainListTagsResponse.TagsEntry
+	45, // 23: beacondns.v1.ListDomainListDomainsRequest.options:type_name -> beacondns.v1.ListOptions
+	45, // 24: beacondns.v1.StreamDomainListDomainsRequest.options:type_name -> beacondns.v1.ListOptions
+	4,  // 25: beacondns.v1.FirewallService.CreateFirewallRule:input_type -> beacondns.v1.CreateFirewallRuleRequest
+	6,  // 26: beacondns.v1.FirewallService.UpdateFirewallRule:input_type -> beacondns.v1.UpdateFirewallRuleRequest
+	8,  // 27: beacondns.v1.FirewallService.DeleteFirewallRule:input_type -> beacondns.v1.DeleteFirewallRuleRequest
+	10, // 28: beacondns.v1.FirewallService.GetFirewallRule:input_type -> beacondns.v1.GetFirewallRuleRequest
+	12, // 29: beacondns.v1.FirewallService.ListFirewallRules:input_type -> beacondns.v1.ListFirewallRulesRequest
+	14, // 30: beacondns.v1.FirewallService.UpdateFirewallRuleTags:input_type -> beacondns.v1.UpdateFirewallRuleTagsRequest
+	16, // 31: beacondns.v1.FirewallService.CreateDomainList:input_type -> beacondns.v1.CreateDomainListRequest
+	18, // 32: beacondns.v1.FirewallService.DeleteDomainList:input_type -> beacondns.v1.DeleteDomainListRequest
+	20, // 33: beacondns.v1.FirewallService.RefreshDomainList:input_type -> beacondns.v1.RefreshDomainListRequest
+	22, // 34: beacondns.v1.FirewallService.AddDomainListDomains:input_type -> beacondns.v1.AddDomainListDomainsRequest
+	24, // 35: beacondns.v1.FirewallService.RemoveDomainListDomains:input_type -> beacondns.v1.RemoveDomainListDomainsRequest
+	26, // 36: beacondns.v1.FirewallService.GetDomainList:input_type -> beacondns.v1.GetDomainListRequest
+	28, // 37: beacondns.v1.FirewallService.ListDomainLists:input_type -> beacondns.v1.ListDomainListsRequest
+	30, // 38: beacondns.v1.FirewallService.UpdateDomainListTags:input_type -> beacondns.v1.UpdateDomainListTagsRequest
+	32, // 39: beacondns.v1.FirewallService.ListDomainListDomains:input_type -> beacondns.v1.ListDomainListDomainsRequest
+	34, // 40: beacondns.v1.FirewallService.StreamDomainListDomains:input_type -> beacondns.v1.StreamDomainListDomainsRequest
+	5,  // 41: beacondns.v1.FirewallService.CreateFirewallRule:output_type -> beacondns.v1.CreateFirewallRuleResponse
+	7,  // 42: beacondns.v1.FirewallService.UpdateFirewallRule:output_type -> beacondns.v1.UpdateFirewallRuleResponse
+	9,  // 43: beacondns.v1.FirewallService.DeleteFirewallRule:output_type -> beacondns.v1.DeleteFirewallRuleResponse
+	11, // 44: beacondns.v1.FirewallService.GetFirewallRule:output_type -> beacondns.v1.GetFirewallRuleResponse
+	13, // 45: beacondns.v1.FirewallService.ListFirewallRules:output_type -> beacondns.v1.ListFirewallRulesResponse
+	15, // 46: beacondns.v1.FirewallService.UpdateFirewallRuleTags:output_type -> beacondns.v1.UpdateFirewallRuleTagsResponse
+	17, // 47: beacondns.v1.FirewallService.CreateDomainList:output_type -> beacondns.v1.CreateDomainListResponse
+	19, // 48: beacondns.v1.FirewallService.DeleteDomainList:output_type -> beacondns.v1.DeleteDomainListResponse
+	21, // 49: beacondns.v1.FirewallService.RefreshDomainList:output_type -> beacondns.v1.RefreshDomainListResponse
+	23, // 50: beacondns.v1.FirewallService.AddDomainListDomains:output_type -> beacondns.v1.AddDomainListDomainsResponse
+	25, // 51: beacondns.v1.FirewallService.RemoveDomainListDomains:output_type -> beacondns.v1.RemoveDomainListDomainsResponse
+	27, // 52: beacondns.v1.FirewallService.GetDomainList:output_type -> beacondns.v1.GetDomainListResponse
+	29, // 53: beacondns.v1.FirewallService.ListDomainLists:output_type -> beacondns.v1.ListDomainListsResponse
+	31, // 54: beacondns.v1.FirewallService.UpdateDomainListTags:output_type -> beacondns.v1.UpdateDomainListTagsResponse
+	33, // 55: beacondns.v1.FirewallService.ListDomainListDomains:output_type -> beacondns.v1.ListDomainListDomainsResponse
+	35, // 56: beacondns.v1.FirewallService.StreamDomainListDomains:output_type -> beacondns.v1.StreamDomainListDomainsResponse
+	41, // [41:57] is the sub-list for method output_type
+	25, // [25:41] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
+}
+
+func init() { file_beacondns_v1_firewall_proto_init() }
+func file_beacondns_v1_firewall_proto_init() {
+	if File_beacondns_v1_firewall_proto != nil {
+		return
+	}
+	file_beacondns_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_beacondns_v1_firewall_proto_rawDesc), len(file_beacondns_v1_firewall_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   41,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_beacondns_v1_firewall_proto_goTypes,
+		DependencyIndexes: file_beacondns_v1_firewall_proto_depIdxs,
+		EnumInfos:         file_beacondns_v1_firewall_proto_enumTypes,
+		MessageInfos:      file_beacondns_v1_firewall_proto_msgTypes,
+	}.Build()
+	File_beacondns_v1_firewall_proto = out.File
+	file_beacondns_v1_firewall_proto_goTypes = nil
+	file_beacondns_v1_firewall_proto_depIdxs = nil
+}
@@ -0,0 +1,715 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: beacondns/v1/firewall.proto
+
+package beacondnsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FirewallService_CreateFirewallRule_FullMethodName      = "/beacondns.v1.FirewallService/CreateFirewallRule"
+	FirewallService_UpdateFirewallRule_FullMethodName      = "/beacondns.v1.FirewallService/UpdateFirewallRule"
+	FirewallService_DeleteFirewallRule_FullMethodName      = "/beacondns.v1.FirewallService/DeleteFirewallRule"
+	FirewallService_GetFirewallRule_FullMethodName         = "/beacondns.v1.FirewallService/GetFirewallRule"
+	FirewallService_ListFirewallRules_FullMethodName       = "/beacondns.v1.FirewallService/ListFirewallRules"
+	FirewallService_UpdateFirewallRuleTags_FullMethodName  = "/beacondns.v1.FirewallService/UpdateFirewallRuleTags"
+	FirewallService_CreateDomainList_FullMethodName        = "/beacondns.v1.FirewallService/CreateDomainList"
+	FirewallService_DeleteDomainList_FullMethodName        = "/beacondns.v1.FirewallService/DeleteDomainList"
+	FirewallService_RefreshDomainList_FullMethodName       = "/beacondns.v1.FirewallService/RefreshDomainList"
+	FirewallService_AddDomainListDomains_FullMethodName    = "/beacondns.v1.FirewallService/AddDomainListDomains"
+	FirewallService_RemoveDomainListDomains_FullMethodName = "/beacondns.v1.FirewallService/RemoveDomainListDomains"
+	FirewallService_GetDomainList_FullMethodName           = "/beacondns.v1.FirewallService/GetDomainList"
+	FirewallService_ListDomainLists_FullMethodName         = "/beacondns.v1.FirewallService/ListDomainLists"
+	FirewallService_UpdateDomainListTags_FullMethodName    = "/beacondns.v1.FirewallService/UpdateDomainListTags"
+	FirewallService_ListDomainListDomains_FullMethodName   = "/beacondns.v1.FirewallService/ListDomainListDomains"
+	FirewallService_StreamDomainListDomains_FullMethodName = "/beacondns.v1.FirewallService/StreamDomainListDomains"
+)
+
+// FirewallServiceClient is the client API for FirewallService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// FirewallService manages the firewall rules and the domain lists they match. It mirrors the
+// firewall endpoints of the REST API.
+type FirewallServiceClient interface {
+	CreateFirewallRule(ctx context.Context, in *CreateFirewallRuleRequest, opts ...grpc.CallOption) (*CreateFirewallRuleResponse, error)
+	// UpdateFirewallRule replaces a rule, apart from its tags.
+	UpdateFirewallRule(ctx context.Context, in *UpdateFirewallRuleRequest, opts ...grpc.CallOption) (*UpdateFirewallRuleResponse, error)
+	DeleteFirewallRule(ctx context.Context, in *DeleteFirewallRuleRequest, opts ...grpc.CallOption) (*DeleteFirewallRuleResponse, error)
+	GetFirewallRule(ctx context.Context, in *GetFirewallRuleRequest, opts ...grpc.CallOption) (*GetFirewallRuleResponse, error)
+	ListFirewallRules(ctx context.Context, in *ListFirewallRulesRequest, opts ...grpc.CallOption) (*ListFirewallRulesResponse, error)
+	UpdateFirewallRuleTags(ctx context.Context, in *UpdateFirewallRuleTagsRequest, opts ...grpc.CallOption) (*UpdateFirewallRuleTagsResponse, error)
+	// CreateDomainList creates a managed list, whose domains are fetched from source_url, or
+	// an unmanaged one holding the domains given.
+	CreateDomainList(ctx context.Context, in *CreateDomainListRequest, opts ...grpc.CallOption) (*CreateDomainListResponse, error)
+	DeleteDomainList(ctx context.Context, in *DeleteDomainListRequest, opts ...grpc.CallOption) (*DeleteDomainListResponse, error)
+	// RefreshDomainList fetches the domains of a managed list from its source again.
+	RefreshDomainList(ctx context.Context, in *RefreshDomainListRequest, opts ...grpc.CallOption) (*RefreshDomainListResponse, error)
+	AddDomainListDomains(ctx context.Context, in *AddDomainListDomainsRequest, opts ...grpc.CallOption) (*AddDomainListDomainsResponse, error)
+	RemoveDomainListDomains(ctx context.Context, in *RemoveDomainListDomainsRequest, opts ...grpc.CallOption) (*RemoveDomainListDomainsResponse, error)
+	GetDomainList(ctx context.Context, in *GetDomainListRequest, opts ...grpc.CallOption) (*GetDomainListResponse, error)
+	ListDomainLists(ctx context.Context, in *ListDomainListsRequest, opts ...grpc.CallOption) (*ListDomainListsResponse, error)
+	UpdateDomainListTags(ctx context.Context, in *UpdateDomainListTagsRequest, opts ...grpc.CallOption) (*UpdateDomainListTagsResponse, error)
+	// ListDomainListDomains returns a page of the domains of a list.
+	ListDomainListDomains(ctx context.Context, in *ListDomainListDomainsRequest, opts ...grpc.CallOption) (*ListDomainListDomainsResponse, error)
+	// StreamDomainListDomains streams every domain of a list after the cursor of the options,
+	// in batches of up to their limit, so that large lists can be read in a single call.
+	StreamDomainListDomains(ctx context.Context, in *StreamDomainListDomainsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamDomainListDomainsResponse], error)
+}
+
+type firewallServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFirewallServiceClient(cc grpc.ClientConnInterface) FirewallServiceClient {
+	return &firewallServiceClient{cc}
+}
+
+func (c *firewallServiceClient) CreateFirewallRule(ctx context.Context, in *CreateFirewallRuleRequest, opts ...grpc.CallOption) (*CreateFirewallRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFirewallRuleResponse)
+	err := c.cc.Invoke(ctx, FirewallService_CreateFirewallRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallServiceClient) UpdateFirewallRule(ctx context.Context, in *UpdateFirewallRuleRequest, opts ...grpc.CallOption) (*UpdateFirewallRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateFirewallRuleResponse)
+	err := c.cc.Invoke(ctx, FirewallService_UpdateFirewallRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallServiceClient) DeleteFirewallRule(ctx context.Context, in *DeleteFirewallRuleRequest, opts ...grpc.CallOption) (*DeleteFirewallRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFirewallRuleResponse)
+	err := c.cc.Invoke(ctx, FirewallService_DeleteFirewallRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallServiceClient) GetFirewallRule(ctx context.Context, in *GetFirewallRuleRequest, opts ...grpc.CallOption) (*GetFirewallRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFirewallRuleResponse)
+	err := c.cc.Invoke(ctx, FirewallService_GetFirewallRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallServiceClient) ListFirewallRules(ctx context.Context, in *ListFirewallRulesRequest, opts ...grpc.CallOption) (*ListFirewallRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFirewallRulesResponse)
+	err := c.cc.Invoke(ctx, FirewallService_ListFirewallRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallServiceClient) UpdateFirewallRuleTags(ctx context.Context, in *UpdateFirewallRuleTagsRequest, opts ...grpc.CallOption) (*UpdateFirewallRuleTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateFirewallRuleTagsResponse)
+	err := c.cc.Invoke(ctx, FirewallService_UpdateFirewallRuleTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallServiceClient) CreateDomainList(ctx context.Context, in *CreateDomainListRequest, opts ...grpc.CallOption) (*CreateDomainListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDomainListResponse)
+	err := c.cc.Invoke(ctx, FirewallService_CreateDomainList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallServiceClient) DeleteDomainList(ctx context.Context, in *DeleteDomainListRequest, opts ...grpc.CallOption) (*DeleteDomainListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDomainListResponse)
+	err := c.cc.Invoke(ctx, FirewallService_DeleteDomainList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallServiceClient) RefreshDomainList(ctx context.Context, in *RefreshDomainListRequest, opts ...grpc.CallOption) (*RefreshDomainListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshDomainListResponse)
+	err := c.cc.Invoke(ctx, FirewallService_RefreshDomainList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallServiceClient) AddDomainListDomains(ctx context.Context, in *AddDomainListDomainsRequest, opts ...grpc.CallOption) (*AddDomainListDomainsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddDomainListDomainsResponse)
+	err := c.cc.Invoke(ctx, FirewallService_AddDomainListDomains_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallServiceClient) RemoveDomainListDomains(ctx context.Context, in *RemoveDomainListDomainsRequest, opts ...grpc.CallOption) (*RemoveDomainListDomainsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveDomainListDomainsResponse)
+	err := c.cc.Invoke(ctx, FirewallService_RemoveDomainListDomains_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallServiceClient) GetDomainList(ctx context.Context, in *GetDomainListRequest, opts ...grpc.CallOption) (*GetDomainListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDomainListResponse)
+	err := c.cc.Invoke(ctx, FirewallService_GetDomainList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallServiceClient) ListDomainLists(ctx context.Context, in *ListDomainListsRequest, opts ...grpc.CallOption) (*ListDomainListsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDomainListsResponse)
+	err := c.cc.Invoke(ctx, FirewallService_ListDomainLists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallServiceClient) UpdateDomainListTags(ctx context.Context, in *UpdateDomainListTagsRequest, opts ...grpc.CallOption) (*UpdateDomainListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDomainListTagsResponse)
+	err := c.cc.Invoke(ctx, FirewallService_UpdateDomainListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallServiceClient) ListDomainListDomains(ctx context.Context, in *ListDomainListDomainsRequest, opts ...grpc.CallOption) (*ListDomainListDomainsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDomainListDomainsResponse)
+	err := c.cc.Invoke(ctx, FirewallService_ListDomainListDomains_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallServiceClient) StreamDomainListDomains(ctx context.Context, in *StreamDomainListDomainsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamDomainListDomainsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FirewallService_ServiceDesc.Streams[0], FirewallService_StreamDomainListDomains_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamDomainListDomainsRequest, StreamDomainListDomainsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FirewallService_StreamDomainListDomainsClient = grpc.ServerStreamingClient[StreamDomainListDomainsResponse]
+
+// FirewallServiceServer is the server API for FirewallService service.
+// All implementations must embed UnimplementedFirewallServiceServer
+// for forward compatibility.
+//
+// FirewallService manages the firewall rules and the domain lists they match. It mirrors the
+// firewall endpoints of the REST API.
+type FirewallServiceServer interface {
+	CreateFirewallRule(context.Context, *CreateFirewallRuleRequest) (*CreateFirewallRuleResponse, error)
+	// UpdateFirewallRule replaces a rule, apart from its tags.
+	UpdateFirewallRule(context.Context, *UpdateFirewallRuleRequest) (*UpdateFirewallRuleResponse, error)
+	DeleteFirewallRule(context.Context, *DeleteFirewallRuleRequest) (*DeleteFirewallRuleResponse, error)
+	GetFirewallRule(context.Context, *GetFirewallRuleRequest) (*GetFirewallRuleResponse, error)
+	ListFirewallRules(context.Context, *ListFirewallRulesRequest) (*ListFirewallRulesResponse, error)
+	UpdateFirewallRuleTags(context.Context, *UpdateFirewallRuleTagsRequest) (*UpdateFirewallRuleTagsResponse, error)
+	// CreateDomainList creates a managed list, whose domains are fetched from source_url, or
+	// an unmanaged one holding the domains given.
+	CreateDomainList(context.Context, *CreateDomainListRequest) (*CreateDomainListResponse, error)
+	DeleteDomainList(context.Context, *DeleteDomainListRequest) (*DeleteDomainListResponse, error)
+	// RefreshDomainList fetches the domains of a managed list from its source again.
+	RefreshDomainList(context.Context, *RefreshDomainListRequest) (*RefreshDomainListResponse, error)
+	AddDomainListDomains(context.Context, *AddDomainListDomainsRequest) (*AddDomainListDomainsResponse, error)
+	RemoveDomainListDomains(context.Context, *RemoveDomainListDomainsRequest) (*RemoveDomainListDomainsResponse, error)
+	GetDomainList(context.Context, *GetDomainListRequest) (*GetDomainListResponse, error)
+	ListDomainLists(context.Context, *ListDomainListsRequest) (*ListDomainListsResponse, error)
+	UpdateDomainListTags(context.Context, *UpdateDomainListTagsRequest) (*UpdateDomainListTagsResponse, error)
+	// ListDomainListDomains returns a page of the domains of a list.
+	ListDomainListDomains(context.Context, *ListDomainListDomainsRequest) (*ListDomainListDomainsResponse, error)
+	// StreamDomainListDomains streams every domain of a list after the cursor of the options,
+	// in batches of up to their limit, so that large lists can be read in a single call.
+	StreamDomainListDomains(*StreamDomainListDomainsRequest, grpc.ServerStreamingServer[StreamDomainListDomainsResponse]) error
+	mustEmbedUnimplementedFirewallServiceServer()
+}
+
+// UnimplementedFirewallServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFirewallServiceServer struct{}
+
+func (UnimplementedFirewallServiceServer) CreateFirewallRule(context.Context, *CreateFirewallRuleRequest) (*CreateFirewallRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFirewallRule not implemented")
+}
+func (UnimplementedFirewallServiceServer) UpdateFirewallRule(context.Context, *UpdateFirewallRuleRequest) (*UpdateFirewallRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFirewallRule not implemented")
+}
+func (UnimplementedFirewallServiceServer) DeleteFirewallRule(context.Context, *DeleteFirewallRuleRequest) (*DeleteFirewallRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFirewallRule not implemented")
+}
+func (UnimplementedFirewallServiceServer) GetFirewallRule(context.Context, *GetFirewallRuleRequest) (*GetFirewallRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFirewallRule not implemented")
+}
+func (UnimplementedFirewallServiceServer) ListFirewallRules(context.Context, *ListFirewallRulesRequest) (*ListFirewallRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFirewallRules not implemented")
+}
+func (UnimplementedFirewallServiceServer) UpdateFirewallRuleTags(context.Context, *UpdateFirewallRuleTagsRequest) (*UpdateFirewallRuleTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFirewallRuleTags not implemented")
+}
+func (UnimplementedFirewallServiceServer) CreateDomainList(context.Context, *CreateDomainListRequest) (*CreateDomainListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDomainList not implemented")
+}
+func (UnimplementedFirewallServiceServer) DeleteDomainList(context.Context, *DeleteDomainListRequest) (*DeleteDomainListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDomainList not implemented")
+}
+func (UnimplementedFirewallServiceServer) RefreshDomainList(context.Context, *RefreshDomainListRequest) (*RefreshDomainListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshDomainList not implemented")
+}
+func (UnimplementedFirewallServiceServer) AddDomainListDomains(context.Context, *AddDomainListDomainsRequest) (*AddDomainListDomainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDomainListDomains not implemented")
+}
+func (UnimplementedFirewallServiceServer) RemoveDomainListDomains(context.Context, *RemoveDomainListDomainsRequest) (*RemoveDomainListDomainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDomainListDomains not implemented")
+}
+func (UnimplementedFirewallServiceServer) GetDomainList(context.Context, *GetDomainListRequest) (*GetDomainListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDomainList not implemented")
+}
+func (UnimplementedFirewallServiceServer) ListDomainLists(context.Context, *ListDomainListsRequest) (*ListDomainListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDomainLists not implemented")
+}
+func (UnimplementedFirewallServiceServer) UpdateDomainListTags(context.Context, *UpdateDomainListTagsRequest) (*UpdateDomainListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDomainListTags not implemented")
+}
+func (UnimplementedFirewallServiceServer) ListDomainListDomains(context.Context, *ListDomainListDomainsRequest) (*ListDomainListDomainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDomainListDomains not implemented")
+}
+func (UnimplementedFirewallServiceServer) StreamDomainListDomains(*StreamDomainListDomainsRequest, grpc.ServerStreamingServer[StreamDomainListDomainsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamDomainListDomains not implemented")
+}
+func (UnimplementedFirewallServiceServer) mustEmbedUnimplementedFirewallServiceServer() {}
+func (UnimplementedFirewallServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeFirewallServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FirewallServiceServer will
+// result in compilation errors.
+type UnsafeFirewallServiceServer interface {
+	mustEmbedUnimplementedFirewallServiceServer()
+}
+
+func RegisterFirewallServiceServer(s grpc.ServiceRegistrar, srv FirewallServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFirewallServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FirewallService_ServiceDesc, srv)
+}
+
+func _FirewallService_CreateFirewallRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFirewallRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServiceServer).CreateFirewallRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallService_CreateFirewallRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServiceServer).CreateFirewallRule(ctx, req.(*CreateFirewallRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallService_UpdateFirewallRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFirewallRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServiceServer).UpdateFirewallRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallService_UpdateFirewallRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServiceServer).UpdateFirewallRule(ctx, req.(*UpdateFirewallRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallService_DeleteFirewallRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFirewallRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServiceServer).DeleteFirewallRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallService_DeleteFirewallRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServiceServer).DeleteFirewallRule(ctx, req.(*DeleteFirewallRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallService_GetFirewallRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFirewallRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServiceServer).GetFirewallRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallService_GetFirewallRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServiceServer).GetFirewallRule(ctx, req.(*GetFirewallRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallService_ListFirewallRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFirewallRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServiceServer).ListFirewallRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallService_ListFirewallRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServiceServer).ListFirewallRules(ctx, req.(*ListFirewallRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallService_UpdateFirewallRuleTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFirewallRuleTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServiceServer).UpdateFirewallRuleTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallService_UpdateFirewallRuleTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServiceServer).UpdateFirewallRuleTags(ctx, req.(*UpdateFirewallRuleTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallService_CreateDomainList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDomainListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServiceServer).CreateDomainList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallService_CreateDomainList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServiceServer).CreateDomainList(ctx, req.(*CreateDomainListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallService_DeleteDomainList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDomainListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServiceServer).DeleteDomainList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallService_DeleteDomainList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServiceServer).DeleteDomainList(ctx, req.(*DeleteDomainListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallService_RefreshDomainList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshDomainListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServiceServer).RefreshDomainList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallService_RefreshDomainList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServiceServer).RefreshDomainList(ctx, req.(*RefreshDomainListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallService_AddDomainListDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDomainListDomainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServiceServer).AddDomainListDomains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallService_AddDomainListDomains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServiceServer).AddDomainListDomains(ctx, req.(*AddDomainListDomainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallService_RemoveDomainListDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDomainListDomainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServiceServer).RemoveDomainListDomains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallService_RemoveDomainListDomains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServiceServer).RemoveDomainListDomains(ctx, req.(*RemoveDomainListDomainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallService_GetDomainList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDomainListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServiceServer).GetDomainList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallService_GetDomainList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServiceServer).GetDomainList(ctx, req.(*GetDomainListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallService_ListDomainLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDomainListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServiceServer).ListDomainLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallService_ListDomainLists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServiceServer).ListDomainLists(ctx, req.(*ListDomainListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallService_UpdateDomainListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDomainListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServiceServer).UpdateDomainListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallService_UpdateDomainListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServiceServer).UpdateDomainListTags(ctx, req.(*UpdateDomainListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallService_ListDomainListDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDomainListDomainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServiceServer).ListDomainListDomains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FirewallService_ListDomainListDomains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServiceServer).ListDomainListDomains(ctx, req.(*ListDomainListDomainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirewallService_StreamDomainListDomains_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamDomainListDomainsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FirewallServiceServer).StreamDomainListDomains(m, &grpc.GenericServerStream[StreamDomainListDomainsRequest, StreamDomainListDomainsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FirewallService_StreamDomainListDomainsServer = grpc.ServerStreamingServer[StreamDomainListDomainsResponse]
+
+// FirewallService_ServiceDesc is the grpc.ServiceDesc for FirewallService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FirewallService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "beacondns.v1.FirewallService",
+	HandlerType: (*FirewallServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFirewallRule",
+			Handler:    _FirewallService_CreateFirewallRule_Handler,
+		},
+		{
+			MethodName: "UpdateFirewallRule",
+			Handler:    _FirewallService_UpdateFirewallRule_Handler,
+		},
+		{
+			MethodName: "DeleteFirewallRule",
+			Handler:    _FirewallService_DeleteFirewallRule_Handler,
+		},
+		{
+			MethodName: "GetFirewallRule",
+			Handler:    _FirewallService_GetFirewallRule_Handler,
+		},
+		{
+			MethodName: "ListFirewallRules",
+			Handler:    _FirewallService_ListFirewallRules_Handler,
+		},
+		{
+			MethodName: "UpdateFirewallRuleTags",
+			Handler:    _FirewallService_UpdateFirewallRuleTags_Handler,
+		},
+		{
+			MethodName: "CreateDomainList",
+			Handler:    _FirewallService_CreateDomainList_Handler,
+		},
+		{
+			MethodName: "DeleteDomainList",
+			Handler:    _FirewallService_DeleteDomainList_Handler,
+		},
+		{
+			MethodName: "RefreshDomainList",
+			Handler:    _FirewallService_RefreshDomainList_Handler,
+		},
+		{
+			MethodName: "AddDomainListDomains",
+			Handler:    _FirewallService_AddDomainListDomains_Handler,
+		},
+		{
+			MethodName: "RemoveDomainListDomains",
+			Handler:    _FirewallService_RemoveDomainListDomains_Handler,
+		},
+		{
+			MethodName: "GetDomainList",
+			Handler:    _FirewallService_GetDomainList_Handler,
+		},
+		{
+			MethodName: "ListDomainLists",
+			Handler:    _FirewallService_ListDomainLists_Handler,
+		},
+		{
+			MethodName: "UpdateDomainListTags",
+			Handler:    _FirewallService_UpdateDomainListTags_Handler,
+		},
+		{
+			MethodName: "ListDomainListDomains",
+			Handler:    _FirewallService_ListDomainListDomains_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamDomainListDomains",
+			Handler:       _FirewallService_StreamDomainListDomains_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "beacondns/v1/firewall.proto",
+}
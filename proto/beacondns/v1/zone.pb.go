@@ -0,0 +1,3727 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: beacondns/v1/zone.proto
+
+package beacondnsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LintSeverity int32
+
+const (
+	LintSeverity_LINT_SEVERITY_UNSPECIFIED LintSeverity = 0
+	LintSeverity_LINT_SEVERITY_ERROR       LintSeverity = 1
+	LintSeverity_LINT_SEVERITY_WARNING     LintSeverity = 2
+	LintSeverity_LINT_SEVERITY_INFO        LintSeverity = 3
+)
+
+// Enum value maps for LintSeverity.
+var (
+	LintSeverity_name = map[int32]string{
+		0: "LINT_SEVERITY_UNSPECIFIED",
+		1: "LINT_SEVERITY_ERROR",
+		2: "LINT_SEVERITY_WARNING",
+		3: "LINT_SEVERITY_INFO",
+	}
+	LintSeverity_value = map[string]int32{
+		"LINT_SEVERITY_UNSPECIFIED": 0,
+		"LINT_SEVERITY_ERROR":       1,
+		"LINT_SEVERITY_WARNING":     2,
+		"LINT_SEVERITY_INFO":        3,
+	}
+)
+
+func (x LintSeverity) Enum() *LintSeverity {
+	p := new(LintSeverity)
+	*p = x
+	return p
+}
+
+func (x LintSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LintSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_beacondns_v1_zone_proto_enumTypes[0].Descriptor()
+}
+
+func (LintSeverity) Type() protoreflect.EnumType {
+	return &file_beacondns_v1_zone_proto_enumTypes[0]
+}
+
+func (x LintSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LintSeverity.Descriptor instead.
+func (LintSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{0}
+}
+
+type ChangeStatus int32
+
+const (
+	ChangeStatus_CHANGE_STATUS_UNSPECIFIED ChangeStatus = 0
+	ChangeStatus_CHANGE_STATUS_PENDING     ChangeStatus = 1
+	ChangeStatus_CHANGE_STATUS_DONE        ChangeStatus = 2
+)
+
+// Enum value maps for ChangeStatus.
+var (
+	ChangeStatus_name = map[int32]string{
+		0: "CHANGE_STATUS_UNSPECIFIED",
+		1: "CHANGE_STATUS_PENDING",
+		2: "CHANGE_STATUS_DONE",
+	}
+	ChangeStatus_value = map[string]int32{
+		"CHANGE_STATUS_UNSPECIFIED": 0,
+		"CHANGE_STATUS_PENDING":     1,
+		"CHANGE_STATUS_DONE":        2,
+	}
+)
+
+func (x ChangeStatus) Enum() *ChangeStatus {
+	p := new(ChangeStatus)
+	*p = x
+	return p
+}
+
+func (x ChangeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_beacondns_v1_zone_proto_enumTypes[1].Descriptor()
+}
+
+func (ChangeStatus) Type() protoreflect.EnumType {
+	return &file_beacondns_v1_zone_proto_enumTypes[1]
+}
+
+func (x ChangeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeStatus.Descriptor instead.
+func (ChangeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{1}
+}
+
+type Zone struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ResourceRecordSetCount int32                  `protobuf:"varint,3,opt,name=resource_record_set_count,json=resourceRecordSetCount,proto3" json:"resource_record_set_count,omitempty"`
+	Tags                   map[string]string      `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Zone) Reset() {
+	*x = Zone{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Zone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Zone) ProtoMessage() {}
+
+func (x *Zone) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Zone.ProtoReflect.Descriptor instead.
+func (*Zone) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Zone) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Zone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Zone) GetResourceRecordSetCount() int32 {
+	if x != nil {
+		return x.ResourceRecordSetCount
+	}
+	return 0
+}
+
+func (x *Zone) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// DeletedZone is a force-deleted zone that can be restored until purge_after.
+type DeletedZone struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	PurgeAfter    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=purge_after,json=purgeAfter,proto3" json:"purge_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletedZone) Reset() {
+	*x = DeletedZone{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletedZone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedZone) ProtoMessage() {}
+
+func (x *DeletedZone) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedZone.ProtoReflect.Descriptor instead.
+func (*DeletedZone) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeletedZone) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeletedZone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeletedZone) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *DeletedZone) GetPurgeAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAfter
+	}
+	return nil
+}
+
+// LintFinding is a problem found in a record set, which name and type identify.
+type LintFinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Severity      LintSeverity           `protobuf:"varint,2,opt,name=severity,proto3,enum=beacondns.v1.LintSeverity" json:"severity,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LintFinding) Reset() {
+	*x = LintFinding{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LintFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintFinding) ProtoMessage() {}
+
+func (x *LintFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintFinding.ProtoReflect.Descriptor instead.
+func (*LintFinding) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LintFinding) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *LintFinding) GetSeverity() LintSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return LintSeverity_LINT_SEVERITY_UNSPECIFIED
+}
+
+func (x *LintFinding) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LintFinding) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LintFinding) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ChangeInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        ChangeStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=beacondns.v1.ChangeStatus" json:"status,omitempty"`
+	SubmittedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeInfo) Reset() {
+	*x = ChangeInfo{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeInfo) ProtoMessage() {}
+
+func (x *ChangeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeInfo.ProtoReflect.Descriptor instead.
+func (*ChangeInfo) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ChangeInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChangeInfo) GetStatus() ChangeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ChangeStatus_CHANGE_STATUS_UNSPECIFIED
+}
+
+func (x *ChangeInfo) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+type ZoneVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ChangeId      string                 `protobuf:"bytes,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	Status        ChangeStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=beacondns.v1.ChangeStatus" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneVersion) Reset() {
+	*x = ZoneVersion{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneVersion) ProtoMessage() {}
+
+func (x *ZoneVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneVersion.ProtoReflect.Descriptor instead.
+func (*ZoneVersion) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ZoneVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ZoneVersion) GetChangeId() string {
+	if x != nil {
+		return x.ChangeId
+	}
+	return ""
+}
+
+func (x *ZoneVersion) GetStatus() ChangeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ChangeStatus_CHANGE_STATUS_UNSPECIFIED
+}
+
+func (x *ZoneVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ZoneDiff struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Added         []*ResourceRecordSet             `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
+	Removed       []*ResourceRecordSet             `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
+	Modified      []*ResourceRecordSetModification `protobuf:"bytes,3,rep,name=modified,proto3" json:"modified,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneDiff) Reset() {
+	*x = ZoneDiff{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneDiff) ProtoMessage() {}
+
+func (x *ZoneDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneDiff.ProtoReflect.Descriptor instead.
+func (*ZoneDiff) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ZoneDiff) GetAdded() []*ResourceRecordSet {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *ZoneDiff) GetRemoved() []*ResourceRecordSet {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *ZoneDiff) GetModified() []*ResourceRecordSetModification {
+	if x != nil {
+		return x.Modified
+	}
+	return nil
+}
+
+type ResourceRecordSetModification struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Before         *ResourceRecordSet     `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
+	After          *ResourceRecordSet     `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	AddedRecords   []*ResourceRecord      `protobuf:"bytes,3,rep,name=added_records,json=addedRecords,proto3" json:"added_records,omitempty"`
+	RemovedRecords []*ResourceRecord      `protobuf:"bytes,4,rep,name=removed_records,json=removedRecords,proto3" json:"removed_records,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResourceRecordSetModification) Reset() {
+	*x = ResourceRecordSetModification{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceRecordSetModification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceRecordSetModification) ProtoMessage() {}
+
+func (x *ResourceRecordSetModification) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceRecordSetModification.ProtoReflect.Descriptor instead.
+func (*ResourceRecordSetModification) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ResourceRecordSetModification) GetBefore() *ResourceRecordSet {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ResourceRecordSetModification) GetAfter() *ResourceRecordSet {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ResourceRecordSetModification) GetAddedRecords() []*ResourceRecord {
+	if x != nil {
+		return x.AddedRecords
+	}
+	return nil
+}
+
+func (x *ResourceRecordSetModification) GetRemovedRecords() []*ResourceRecord {
+	if x != nil {
+		return x.RemovedRecords
+	}
+	return nil
+}
+
+type SkippedRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkippedRecord) Reset() {
+	*x = SkippedRecord{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkippedRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkippedRecord) ProtoMessage() {}
+
+func (x *SkippedRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkippedRecord.ProtoReflect.Descriptor instead.
+func (*SkippedRecord) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SkippedRecord) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SkippedRecord) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SkippedRecord) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ZoneTemplate is a blueprint of record sets for new zones. Their names and values may
+// contain {{zone}}, which stands for the name of the zone the template is applied to.
+type ZoneTemplate struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ResourceRecordSets []*ResourceRecordSet   `protobuf:"bytes,4,rep,name=resource_record_sets,json=resourceRecordSets,proto3" json:"resource_record_sets,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ZoneTemplate) Reset() {
+	*x = ZoneTemplate{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneTemplate) ProtoMessage() {}
+
+func (x *ZoneTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneTemplate.ProtoReflect.Descriptor instead.
+func (*ZoneTemplate) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ZoneTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ZoneTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ZoneTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ZoneTemplate) GetResourceRecordSets() []*ResourceRecordSet {
+	if x != nil {
+		return x.ResourceRecordSets
+	}
+	return nil
+}
+
+func (x *ZoneTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ZoneTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ZoneTemplateDrift holds the changes applying a template makes to a zone. change_id is set
+// once they have been applied.
+type ZoneTemplateDrift struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneName      string                 `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	Drift         *ZoneDiff              `protobuf:"bytes,2,opt,name=drift,proto3" json:"drift,omitempty"`
+	ChangeId      string                 `protobuf:"bytes,3,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneTemplateDrift) Reset() {
+	*x = ZoneTemplateDrift{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneTemplateDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneTemplateDrift) ProtoMessage() {}
+
+func (x *ZoneTemplateDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneTemplateDrift.ProtoReflect.Descriptor instead.
+func (*ZoneTemplateDrift) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ZoneTemplateDrift) GetZoneName() string {
+	if x != nil {
+		return x.ZoneName
+	}
+	return ""
+}
+
+func (x *ZoneTemplateDrift) GetDrift() *ZoneDiff {
+	if x != nil {
+		return x.Drift
+	}
+	return nil
+}
+
+func (x *ZoneTemplateDrift) GetChangeId() string {
+	if x != nil {
+		return x.ChangeId
+	}
+	return ""
+}
+
+type CreateZoneRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Add NS records for the zone to its closest hosted parent zone.
+	DelegateFromParent bool `protobuf:"varint,2,opt,name=delegate_from_parent,json=delegateFromParent,proto3" json:"delegate_from_parent,omitempty"`
+	// Name of a zone template whose record sets are added to the zone.
+	Template      string            `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	Tags          map[string]string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateZoneRequest) Reset() {
+	*x = CreateZoneRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateZoneRequest) ProtoMessage() {}
+
+func (x *CreateZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateZoneRequest.ProtoReflect.Descriptor instead.
+func (*CreateZoneRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateZoneRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateZoneRequest) GetDelegateFromParent() bool {
+	if x != nil {
+		return x.DelegateFromParent
+	}
+	return false
+}
+
+func (x *CreateZoneRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *CreateZoneRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CreateZoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Zone          *Zone                  `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateZoneResponse) Reset() {
+	*x = CreateZoneResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateZoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateZoneResponse) ProtoMessage() {}
+
+func (x *CreateZoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateZoneResponse.ProtoReflect.Descriptor instead.
+func (*CreateZoneResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateZoneResponse) GetZone() *Zone {
+	if x != nil {
+		return x.Zone
+	}
+	return nil
+}
+
+type CreateReverseZonesRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Cidr               string                 `protobuf:"bytes,1,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	DelegateFromParent bool                   `protobuf:"varint,2,opt,name=delegate_from_parent,json=delegateFromParent,proto3" json:"delegate_from_parent,omitempty"`
+	Template           string                 `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	Tags               map[string]string      `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateReverseZonesRequest) Reset() {
+	*x = CreateReverseZonesRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReverseZonesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReverseZonesRequest) ProtoMessage() {}
+
+func (x *CreateReverseZonesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReverseZonesRequest.ProtoReflect.Descriptor instead.
+func (*CreateReverseZonesRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateReverseZonesRequest) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *CreateReverseZonesRequest) GetDelegateFromParent() bool {
+	if x != nil {
+		return x.DelegateFromParent
+	}
+	return false
+}
+
+func (x *CreateReverseZonesRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *CreateReverseZonesRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CreateReverseZonesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Zones         []*Zone                `protobuf:"bytes,1,rep,name=zones,proto3" json:"zones,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReverseZonesResponse) Reset() {
+	*x = CreateReverseZonesResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReverseZonesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReverseZonesResponse) ProtoMessage() {}
+
+func (x *CreateReverseZonesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReverseZonesResponse.ProtoReflect.Descriptor instead.
+func (*CreateReverseZonesResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateReverseZonesResponse) GetZones() []*Zone {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+type DeleteZoneRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Delete the zone along with its record sets, keeping it in the trash if the controller
+	// has a trash period.
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteZoneRequest) Reset() {
+	*x = DeleteZoneRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteZoneRequest) ProtoMessage() {}
+
+func (x *DeleteZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteZoneRequest.ProtoReflect.Descriptor instead.
+func (*DeleteZoneRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteZoneRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteZoneRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DeleteZoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteZoneResponse) Reset() {
+	*x = DeleteZoneResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteZoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteZoneResponse) ProtoMessage() {}
+
+func (x *DeleteZoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteZoneResponse.ProtoReflect.Descriptor instead.
+func (*DeleteZoneResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{15}
+}
+
+type ListDeletedZonesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Options       *ListOptions           `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedZonesRequest) Reset() {
+	*x = ListDeletedZonesRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedZonesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedZonesRequest) ProtoMessage() {}
+
+func (x *ListDeletedZonesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedZonesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedZonesRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListDeletedZonesRequest) GetOptions() *ListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ListDeletedZonesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeletedZones  []*DeletedZone         `protobuf:"bytes,1,rep,name=deleted_zones,json=deletedZones,proto3" json:"deleted_zones,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedZonesResponse) Reset() {
+	*x = ListDeletedZonesResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedZonesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedZonesResponse) ProtoMessage() {}
+
+func (x *ListDeletedZonesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedZonesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedZonesResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListDeletedZonesResponse) GetDeletedZones() []*DeletedZone {
+	if x != nil {
+		return x.DeletedZones
+	}
+	return nil
+}
+
+func (x *ListDeletedZonesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type RestoreZoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreZoneRequest) Reset() {
+	*x = RestoreZoneRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreZoneRequest) ProtoMessage() {}
+
+func (x *RestoreZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreZoneRequest.ProtoReflect.Descriptor instead.
+func (*RestoreZoneRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RestoreZoneRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RestoreZoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Zone          *Zone                  `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreZoneResponse) Reset() {
+	*x = RestoreZoneResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreZoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreZoneResponse) ProtoMessage() {}
+
+func (x *RestoreZoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreZoneResponse.ProtoReflect.Descriptor instead.
+func (*RestoreZoneResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RestoreZoneResponse) GetZone() *Zone {
+	if x != nil {
+		return x.Zone
+	}
+	return nil
+}
+
+type GetZoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetZoneRequest) Reset() {
+	*x = GetZoneRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetZoneRequest) ProtoMessage() {}
+
+func (x *GetZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetZoneRequest.ProtoReflect.Descriptor instead.
+func (*GetZoneRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetZoneRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetZoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Zone          *Zone                  `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetZoneResponse) Reset() {
+	*x = GetZoneResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetZoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetZoneResponse) ProtoMessage() {}
+
+func (x *GetZoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetZoneResponse.ProtoReflect.Descriptor instead.
+func (*GetZoneResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetZoneResponse) GetZone() *Zone {
+	if x != nil {
+		return x.Zone
+	}
+	return nil
+}
+
+type ListZonesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Options       *ListOptions           `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListZonesRequest) Reset() {
+	*x = ListZonesRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListZonesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListZonesRequest) ProtoMessage() {}
+
+func (x *ListZonesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListZonesRequest.ProtoReflect.Descriptor instead.
+func (*ListZonesRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListZonesRequest) GetOptions() *ListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ListZonesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Zones         []*Zone                `protobuf:"bytes,1,rep,name=zones,proto3" json:"zones,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListZonesResponse) Reset() {
+	*x = ListZonesResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListZonesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListZonesResponse) ProtoMessage() {}
+
+func (x *ListZonesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListZonesResponse.ProtoReflect.Descriptor instead.
+func (*ListZonesResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListZonesResponse) GetZones() []*Zone {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+func (x *ListZonesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type UpdateZoneTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Set           map[string]string      `protobuf:"bytes,2,rep,name=set,proto3" json:"set,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Remove        []string               `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateZoneTagsRequest) Reset() {
+	*x = UpdateZoneTagsRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateZoneTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateZoneTagsRequest) ProtoMessage() {}
+
+func (x *UpdateZoneTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateZoneTagsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateZoneTagsRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateZoneTagsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateZoneTagsRequest) GetSet() map[string]string {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *UpdateZoneTagsRequest) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+type UpdateZoneTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          map[string]string      `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateZoneTagsResponse) Reset() {
+	*x = UpdateZoneTagsResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateZoneTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateZoneTagsResponse) ProtoMessage() {}
+
+func (x *UpdateZoneTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateZoneTagsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateZoneTagsResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateZoneTagsResponse) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListResourceRecordSetsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ZoneName string                 `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	// Only list the record sets of this type.
+	Type          string       `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Options       *ListOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResourceRecordSetsRequest) Reset() {
+	*x = ListResourceRecordSetsRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourceRecordSetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourceRecordSetsRequest) ProtoMessage() {}
+
+func (x *ListResourceRecordSetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourceRecordSetsRequest.ProtoReflect.Descriptor instead.
+func (*ListResourceRecordSetsRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListResourceRecordSetsRequest) GetZoneName() string {
+	if x != nil {
+		return x.ZoneName
+	}
+	return ""
+}
+
+func (x *ListResourceRecordSetsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListResourceRecordSetsRequest) GetOptions() *ListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ListResourceRecordSetsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ResourceRecordSets []*ResourceRecordSet   `protobuf:"bytes,1,rep,name=resource_record_sets,json=resourceRecordSets,proto3" json:"resource_record_sets,omitempty"`
+	NextCursor         string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListResourceRecordSetsResponse) Reset() {
+	*x = ListResourceRecordSetsResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourceRecordSetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourceRecordSetsResponse) ProtoMessage() {}
+
+func (x *ListResourceRecordSetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourceRecordSetsResponse.ProtoReflect.Descriptor instead.
+func (*ListResourceRecordSetsResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListResourceRecordSetsResponse) GetResourceRecordSets() []*ResourceRecordSet {
+	if x != nil {
+		return x.ResourceRecordSets
+	}
+	return nil
+}
+
+func (x *ListResourceRecordSetsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetResourceRecordSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneName      string                 `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceRecordSetRequest) Reset() {
+	*x = GetResourceRecordSetRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceRecordSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceRecordSetRequest) ProtoMessage() {}
+
+func (x *GetResourceRecordSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceRecordSetRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceRecordSetRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetResourceRecordSetRequest) GetZoneName() string {
+	if x != nil {
+		return x.ZoneName
+	}
+	return ""
+}
+
+func (x *GetResourceRecordSetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetResourceRecordSetRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type GetResourceRecordSetResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ResourceRecordSet *ResourceRecordSet     `protobuf:"bytes,1,opt,name=resource_record_set,json=resourceRecordSet,proto3" json:"resource_record_set,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetResourceRecordSetResponse) Reset() {
+	*x = GetResourceRecordSetResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceRecordSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceRecordSetResponse) ProtoMessage() {}
+
+func (x *GetResourceRecordSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceRecordSetResponse.ProtoReflect.Descriptor instead.
+func (*GetResourceRecordSetResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetResourceRecordSetResponse) GetResourceRecordSet() *ResourceRecordSet {
+	if x != nil {
+		return x.ResourceRecordSet
+	}
+	return nil
+}
+
+type UpsertResourceRecordSetRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ZoneName          string                 `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	ResourceRecordSet *ResourceRecordSet     `protobuf:"bytes,2,opt,name=resource_record_set,json=resourceRecordSet,proto3" json:"resource_record_set,omitempty"`
+	// Return the changes the upsert would make without making them.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Maintain the PTR records for the addresses of A and AAAA record sets.
+	SyncPtr bool `protobuf:"varint,4,opt,name=sync_ptr,json=syncPtr,proto3" json:"sync_ptr,omitempty"`
+	// Check the mail policies published in TXT record sets.
+	ValidatePolicies bool `protobuf:"varint,5,opt,name=validate_policies,json=validatePolicies,proto3" json:"validate_policies,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpsertResourceRecordSetRequest) Reset() {
+	*x = UpsertResourceRecordSetRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertResourceRecordSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertResourceRecordSetRequest) ProtoMessage() {}
+
+func (x *UpsertResourceRecordSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertResourceRecordSetRequest.ProtoReflect.Descriptor instead.
+func (*UpsertResourceRecordSetRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpsertResourceRecordSetRequest) GetZoneName() string {
+	if x != nil {
+		return x.ZoneName
+	}
+	return ""
+}
+
+func (x *UpsertResourceRecordSetRequest) GetResourceRecordSet() *ResourceRecordSet {
+	if x != nil {
+		return x.ResourceRecordSet
+	}
+	return nil
+}
+
+func (x *UpsertResourceRecordSetRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *UpsertResourceRecordSetRequest) GetSyncPtr() bool {
+	if x != nil {
+		return x.SyncPtr
+	}
+	return false
+}
+
+func (x *UpsertResourceRecordSetRequest) GetValidatePolicies() bool {
+	if x != nil {
+		return x.ValidatePolicies
+	}
+	return false
+}
+
+type UpsertResourceRecordSetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unset on a dry run.
+	ResourceRecordSet *ResourceRecordSet `protobuf:"bytes,1,opt,name=resource_record_set,json=resourceRecordSet,proto3" json:"resource_record_set,omitempty"`
+	Warnings          []*LintFinding     `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// Only set on a dry run. Covers the zone itself, not the PTR records synced into reverse
+	// zones or the delegation updated in the parent zone.
+	Diff          *ZoneDiff `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertResourceRecordSetResponse) Reset() {
+	*x = UpsertResourceRecordSetResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertResourceRecordSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertResourceRecordSetResponse) ProtoMessage() {}
+
+func (x *UpsertResourceRecordSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertResourceRecordSetResponse.ProtoReflect.Descriptor instead.
+func (*UpsertResourceRecordSetResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpsertResourceRecordSetResponse) GetResourceRecordSet() *ResourceRecordSet {
+	if x != nil {
+		return x.ResourceRecordSet
+	}
+	return nil
+}
+
+func (x *UpsertResourceRecordSetResponse) GetWarnings() []*LintFinding {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *UpsertResourceRecordSetResponse) GetDiff() *ZoneDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type DeleteResourceRecordSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneName      string                 `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	SyncPtr       bool                   `protobuf:"varint,5,opt,name=sync_ptr,json=syncPtr,proto3" json:"sync_ptr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResourceRecordSetRequest) Reset() {
+	*x = DeleteResourceRecordSetRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResourceRecordSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourceRecordSetRequest) ProtoMessage() {}
+
+func (x *DeleteResourceRecordSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourceRecordSetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteResourceRecordSetRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteResourceRecordSetRequest) GetZoneName() string {
+	if x != nil {
+		return x.ZoneName
+	}
+	return ""
+}
+
+func (x *DeleteResourceRecordSetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteResourceRecordSetRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DeleteResourceRecordSetRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *DeleteResourceRecordSetRequest) GetSyncPtr() bool {
+	if x != nil {
+		return x.SyncPtr
+	}
+	return false
+}
+
+type DeleteResourceRecordSetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only set on a dry run. Covers the zone itself, not the PTR records synced into reverse
+	// zones or the delegation updated in the parent zone.
+	Diff          *ZoneDiff `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResourceRecordSetResponse) Reset() {
+	*x = DeleteResourceRecordSetResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResourceRecordSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourceRecordSetResponse) ProtoMessage() {}
+
+func (x *DeleteResourceRecordSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourceRecordSetResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResourceRecordSetResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteResourceRecordSetResponse) GetDiff() *ZoneDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type ImportZoneRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ZoneName string                 `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	// Zone file in the RFC 1035 master file format.
+	ZoneFile      string `protobuf:"bytes,2,opt,name=zone_file,json=zoneFile,proto3" json:"zone_file,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportZoneRequest) Reset() {
+	*x = ImportZoneRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportZoneRequest) ProtoMessage() {}
+
+func (x *ImportZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportZoneRequest.ProtoReflect.Descriptor instead.
+func (*ImportZoneRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ImportZoneRequest) GetZoneName() string {
+	if x != nil {
+		return x.ZoneName
+	}
+	return ""
+}
+
+func (x *ImportZoneRequest) GetZoneFile() string {
+	if x != nil {
+		return x.ZoneFile
+	}
+	return ""
+}
+
+type ImportZoneResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ChangeId           string                 `protobuf:"bytes,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	ResourceRecordSets []*ResourceRecordSet   `protobuf:"bytes,2,rep,name=resource_record_sets,json=resourceRecordSets,proto3" json:"resource_record_sets,omitempty"`
+	SkippedRecords     []*SkippedRecord       `protobuf:"bytes,3,rep,name=skipped_records,json=skippedRecords,proto3" json:"skipped_records,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ImportZoneResponse) Reset() {
+	*x = ImportZoneResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportZoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportZoneResponse) ProtoMessage() {}
+
+func (x *ImportZoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportZoneResponse.ProtoReflect.Descriptor instead.
+func (*ImportZoneResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ImportZoneResponse) GetChangeId() string {
+	if x != nil {
+		return x.ChangeId
+	}
+	return ""
+}
+
+func (x *ImportZoneResponse) GetResourceRecordSets() []*ResourceRecordSet {
+	if x != nil {
+		return x.ResourceRecordSets
+	}
+	return nil
+}
+
+func (x *ImportZoneResponse) GetSkippedRecords() []*SkippedRecord {
+	if x != nil {
+		return x.SkippedRecords
+	}
+	return nil
+}
+
+type ExportZoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneName      string                 `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportZoneRequest) Reset() {
+	*x = ExportZoneRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportZoneRequest) ProtoMessage() {}
+
+func (x *ExportZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportZoneRequest.ProtoReflect.Descriptor instead.
+func (*ExportZoneRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ExportZoneRequest) GetZoneName() string {
+	if x != nil {
+		return x.ZoneName
+	}
+	return ""
+}
+
+type ExportZoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneFile      string                 `protobuf:"bytes,1,opt,name=zone_file,json=zoneFile,proto3" json:"zone_file,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportZoneResponse) Reset() {
+	*x = ExportZoneResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportZoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportZoneResponse) ProtoMessage() {}
+
+func (x *ExportZoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportZoneResponse.ProtoReflect.Descriptor instead.
+func (*ExportZoneResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ExportZoneResponse) GetZoneFile() string {
+	if x != nil {
+		return x.ZoneFile
+	}
+	return ""
+}
+
+type LintZoneRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ZoneName string                 `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	// Also check that the names outside the zone that record sets point at resolve.
+	Resolve       bool `protobuf:"varint,2,opt,name=resolve,proto3" json:"resolve,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LintZoneRequest) Reset() {
+	*x = LintZoneRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LintZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintZoneRequest) ProtoMessage() {}
+
+func (x *LintZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintZoneRequest.ProtoReflect.Descriptor instead.
+func (*LintZoneRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LintZoneRequest) GetZoneName() string {
+	if x != nil {
+		return x.ZoneName
+	}
+	return ""
+}
+
+func (x *LintZoneRequest) GetResolve() bool {
+	if x != nil {
+		return x.Resolve
+	}
+	return false
+}
+
+type LintZoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Findings      []*LintFinding         `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LintZoneResponse) Reset() {
+	*x = LintZoneResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LintZoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintZoneResponse) ProtoMessage() {}
+
+func (x *LintZoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintZoneResponse.ProtoReflect.Descriptor instead.
+func (*LintZoneResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LintZoneResponse) GetFindings() []*LintFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+type ListZoneVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneName      string                 `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	Options       *ListOptions           `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListZoneVersionsRequest) Reset() {
+	*x = ListZoneVersionsRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListZoneVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListZoneVersionsRequest) ProtoMessage() {}
+
+func (x *ListZoneVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListZoneVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListZoneVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListZoneVersionsRequest) GetZoneName() string {
+	if x != nil {
+		return x.ZoneName
+	}
+	return ""
+}
+
+func (x *ListZoneVersionsRequest) GetOptions() *ListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ListZoneVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*ZoneVersion         `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListZoneVersionsResponse) Reset() {
+	*x = ListZoneVersionsResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListZoneVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListZoneVersionsResponse) ProtoMessage() {}
+
+func (x *ListZoneVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListZoneVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListZoneVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListZoneVersionsResponse) GetVersions() []*ZoneVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *ListZoneVersionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type DiffZoneVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneName      string                 `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	From          int32                  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            int32                  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffZoneVersionsRequest) Reset() {
+	*x = DiffZoneVersionsRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffZoneVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffZoneVersionsRequest) ProtoMessage() {}
+
+func (x *DiffZoneVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffZoneVersionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffZoneVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DiffZoneVersionsRequest) GetZoneName() string {
+	if x != nil {
+		return x.ZoneName
+	}
+	return ""
+}
+
+func (x *DiffZoneVersionsRequest) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *DiffZoneVersionsRequest) GetTo() int32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type DiffZoneVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Diff          *ZoneDiff              `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffZoneVersionsResponse) Reset() {
+	*x = DiffZoneVersionsResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffZoneVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffZoneVersionsResponse) ProtoMessage() {}
+
+func (x *DiffZoneVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffZoneVersionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffZoneVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DiffZoneVersionsResponse) GetDiff() *ZoneDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type RollbackZoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneName      string                 `protobuf:"bytes,1,opt,name=zone_name,json=zoneName,proto3" json:"zone_name,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackZoneRequest) Reset() {
+	*x = RollbackZoneRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackZoneRequest) ProtoMessage() {}
+
+func (x *RollbackZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackZoneRequest.ProtoReflect.Descriptor instead.
+func (*RollbackZoneRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RollbackZoneRequest) GetZoneName() string {
+	if x != nil {
+		return x.ZoneName
+	}
+	return ""
+}
+
+func (x *RollbackZoneRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RollbackZoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *ChangeInfo            `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackZoneResponse) Reset() {
+	*x = RollbackZoneResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackZoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackZoneResponse) ProtoMessage() {}
+
+func (x *RollbackZoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackZoneResponse.ProtoReflect.Descriptor instead.
+func (*RollbackZoneResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RollbackZoneResponse) GetChange() *ChangeInfo {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+type CreateZoneTemplateRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ResourceRecordSets []*ResourceRecordSet   `protobuf:"bytes,3,rep,name=resource_record_sets,json=resourceRecordSets,proto3" json:"resource_record_sets,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateZoneTemplateRequest) Reset() {
+	*x = CreateZoneTemplateRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateZoneTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateZoneTemplateRequest) ProtoMessage() {}
+
+func (x *CreateZoneTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateZoneTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateZoneTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateZoneTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateZoneTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateZoneTemplateRequest) GetResourceRecordSets() []*ResourceRecordSet {
+	if x != nil {
+		return x.ResourceRecordSets
+	}
+	return nil
+}
+
+type CreateZoneTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneTemplate  *ZoneTemplate          `protobuf:"bytes,1,opt,name=zone_template,json=zoneTemplate,proto3" json:"zone_template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateZoneTemplateResponse) Reset() {
+	*x = CreateZoneTemplateResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateZoneTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateZoneTemplateResponse) ProtoMessage() {}
+
+func (x *CreateZoneTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateZoneTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateZoneTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateZoneTemplateResponse) GetZoneTemplate() *ZoneTemplate {
+	if x != nil {
+		return x.ZoneTemplate
+	}
+	return nil
+}
+
+// UpdateZoneTemplateRequest replaces the description and record sets of a template.
+type UpdateZoneTemplateRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ResourceRecordSets []*ResourceRecordSet   `protobuf:"bytes,3,rep,name=resource_record_sets,json=resourceRecordSets,proto3" json:"resource_record_sets,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateZoneTemplateRequest) Reset() {
+	*x = UpdateZoneTemplateRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateZoneTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateZoneTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateZoneTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateZoneTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateZoneTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateZoneTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateZoneTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateZoneTemplateRequest) GetResourceRecordSets() []*ResourceRecordSet {
+	if x != nil {
+		return x.ResourceRecordSets
+	}
+	return nil
+}
+
+type UpdateZoneTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneTemplate  *ZoneTemplate          `protobuf:"bytes,1,opt,name=zone_template,json=zoneTemplate,proto3" json:"zone_template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateZoneTemplateResponse) Reset() {
+	*x = UpdateZoneTemplateResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateZoneTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateZoneTemplateResponse) ProtoMessage() {}
+
+func (x *UpdateZoneTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateZoneTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateZoneTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateZoneTemplateResponse) GetZoneTemplate() *ZoneTemplate {
+	if x != nil {
+		return x.ZoneTemplate
+	}
+	return nil
+}
+
+type DeleteZoneTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteZoneTemplateRequest) Reset() {
+	*x = DeleteZoneTemplateRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteZoneTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteZoneTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteZoneTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteZoneTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteZoneTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteZoneTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteZoneTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteZoneTemplateResponse) Reset() {
+	*x = DeleteZoneTemplateResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteZoneTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteZoneTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteZoneTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteZoneTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteZoneTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{51}
+}
+
+type GetZoneTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetZoneTemplateRequest) Reset() {
+	*x = GetZoneTemplateRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetZoneTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetZoneTemplateRequest) ProtoMessage() {}
+
+func (x *GetZoneTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetZoneTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetZoneTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetZoneTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetZoneTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneTemplate  *ZoneTemplate          `protobuf:"bytes,1,opt,name=zone_template,json=zoneTemplate,proto3" json:"zone_template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetZoneTemplateResponse) Reset() {
+	*x = GetZoneTemplateResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetZoneTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetZoneTemplateResponse) ProtoMessage() {}
+
+func (x *GetZoneTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetZoneTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetZoneTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetZoneTemplateResponse) GetZoneTemplate() *ZoneTemplate {
+	if x != nil {
+		return x.ZoneTemplate
+	}
+	return nil
+}
+
+type ListZoneTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Options       *ListOptions           `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListZoneTemplatesRequest) Reset() {
+	*x = ListZoneTemplatesRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListZoneTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListZoneTemplatesRequest) ProtoMessage() {}
+
+func (x *ListZoneTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListZoneTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListZoneTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListZoneTemplatesRequest) GetOptions() *ListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ListZoneTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ZoneTemplates []*ZoneTemplate        `protobuf:"bytes,1,rep,name=zone_templates,json=zoneTemplates,proto3" json:"zone_templates,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListZoneTemplatesResponse) Reset() {
+	*x = ListZoneTemplatesResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListZoneTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListZoneTemplatesResponse) ProtoMessage() {}
+
+func (x *ListZoneTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListZoneTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListZoneTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListZoneTemplatesResponse) GetZoneTemplates() []*ZoneTemplate {
+	if x != nil {
+		return x.ZoneTemplates
+	}
+	return nil
+}
+
+func (x *ListZoneTemplatesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ApplyZoneTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Zones []string               `protobuf:"bytes,2,rep,name=zones,proto3" json:"zones,omitempty"`
+	// Return the drift of each zone without applying the template.
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyZoneTemplateRequest) Reset() {
+	*x = ApplyZoneTemplateRequest{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyZoneTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyZoneTemplateRequest) ProtoMessage() {}
+
+func (x *ApplyZoneTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyZoneTemplateRequest.ProtoReflect.Descriptor instead.
+func (*ApplyZoneTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ApplyZoneTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApplyZoneTemplateRequest) GetZones() []string {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+func (x *ApplyZoneTemplateRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ApplyZoneTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Zones         []*ZoneTemplateDrift   `protobuf:"bytes,1,rep,name=zones,proto3" json:"zones,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyZoneTemplateResponse) Reset() {
+	*x = ApplyZoneTemplateResponse{}
+	mi := &file_beacondns_v1_zone_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyZoneTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyZoneTemplateResponse) ProtoMessage() {}
+
+func (x *ApplyZoneTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_beacondns_v1_zone_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyZoneTemplateResponse.ProtoReflect.Descriptor instead.
+func (*ApplyZoneTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_beacondns_v1_zone_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ApplyZoneTemplateResponse) GetZones() []*ZoneTemplateDrift {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+var File_beacondns_v1_zone_proto protoreflect.FileDescriptor
+
+const file_beacondns_v1_zone_proto_rawDesc = "" +
+	"\n" +
+	"\x17beacondns/v1/zone.proto\x12\fbeacondns.v1\x1a\x19beacondns/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd0\x01\n" +
+	"\x04Zone\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
+	"\x19resource_record_set_count\x18\x03 \x01(\x05R\x16resourceRecordSetCount\x120\n" +
+	"\x04tags\x18\x04 \x03(\v2\x1c.beacondns.v1.Zone.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa9\x01\n" +
+	"\vDeletedZone\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"deleted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12;\n" +
+	"\vpurge_after\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"purgeAfter\"\x9b\x01\n" +
+	"\vLintFinding\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x126\n" +
+	"\bseverity\x18\x02 \x01(\x0e2\x1a.beacondns.v1.LintSeverityR\bseverity\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"\x8f\x01\n" +
+	"\n" +
+	"ChangeInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1a.beacondns.v1.ChangeStatusR\x06status\x12=\n" +
+	"\fsubmitted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\"\xb3\x01\n" +
+	"\vZoneVersion\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x1b\n" +
+	"\tchange_id\x18\x02 \x01(\tR\bchangeId\x122\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1a.beacondns.v1.ChangeStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc5\x01\n" +
+	"\bZoneDiff\x125\n" +
+	"\x05added\x18\x01 \x03(\v2\x1f.beacondns.v1.ResourceRecordSetR\x05added\x129\n" +
+	"\aremoved\x18\x02 \x03(\v2\x1f.beacondns.v1.ResourceRecordSetR\aremoved\x12G\n" +
+	"\bmodified\x18\x03 \x03(\v2+.beacondns.v1.ResourceRecordSetModificationR\bmodified\"\x99\x02\n" +
+	"\x1dResourceRecordSetModification\x127\n" +
+	"\x06before\x18\x01 \x01(\v2\x1f.beacondns.v1.ResourceRecordSetR\x06before\x125\n" +
+	"\x05after\x18\x02 \x01(\v2\x1f.beacondns.v1.ResourceRecordSetR\x05after\x12A\n" +
+	"\radded_records\x18\x03 \x03(\v2\x1c.beacondns.v1.ResourceRecordR\faddedRecords\x12E\n" +
+	"\x0fremoved_records\x18\x04 \x03(\v2\x1c.beacondns.v1.ResourceRecordR\x0eremovedRecords\"O\n" +
+	"\rSkippedRecord\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x9d\x02\n" +
+	"\fZoneTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12Q\n" +
+	"\x14resource_record_sets\x18\x04 \x03(\v2\x1f.beacondns.v1.ResourceRecordSetR\x12resourceRecordSets\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"{\n" +
+	"\x11ZoneTemplateDrift\x12\x1b\n" +
+	"\tzone_name\x18\x01 \x01(\tR\bzoneName\x12,\n" +
+	"\x05drift\x18\x02 \x01(\v2\x16.beacondns.v1.ZoneDiffR\x05drift\x12\x1b\n" +
+	"\tchange_id\x18\x03 \x01(\tR\bchangeId\"\xed\x01\n" +
+	"\x11CreateZoneRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x120\n" +
+	"\x14delegate_from_parent\x18\x02 \x01(\bR\x12delegateFromParent\x12\x1a\n" +
+	"\btemplate\x18\x03 \x01(\tR\btemplate\x12=\n" +
+	"\x04tags\x18\x04 \x03(\v2).beacondns.v1.CreateZoneRequest.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"<\n" +
+	"\x12CreateZoneResponse\x12&\n" +
+	"\x04zone\x18\x01 \x01(\v2\x12.beacondns.v1.ZoneR\x04zone\"\xfd\x01\n" +
+	"\x19CreateReverseZonesRequest\x12\x12\n" +
+	"\x04cidr\x18\x01 \x01(\tR\x04cidr\x120\n" +
+	"\x14delegate_from_parent\x18\x02 \x01(\bR\x12delegateFromParent\x12\x1a\n" +
+	"\btemplate\x18\x03 \x01(\tR\btemplate\x12E\n" +
+	"\x04tags\x18\x04 \x03(\v21.beacondns.v1.CreateReverseZonesRequest.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"F\n" +
+	"\x1aCreateReverseZonesResponse\x12(\n" +
+	"\x05zones\x18\x01 \x03(\v2\x12.beacondns.v1.ZoneR\x05zones\"=\n" +
+	"\x11DeleteZoneRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"\x14\n" +
+	"\x12DeleteZoneResponse\"N\n" +
+	"\x17ListDeletedZonesRequest\x123\n" +
+	"\aoptions\x18\x01 \x01(\v2\x19.beacondns.v1.ListOptionsR\aoptions\"{\n" +
+	"\x18ListDeletedZonesResponse\x12>\n" +
+	"\rdeleted_zones\x18\x01 \x03(\v2\x19.beacondns.v1.DeletedZoneR\fdeletedZones\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"(\n" +
+	"\x12RestoreZoneRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"=\n" +
+	"\x13RestoreZoneResponse\x12&\n" +
+	"\x04zone\x18\x01 \x01(\v2\x12.beacondns.v1.ZoneR\x04zone\"$\n" +
+	"\x0eGetZoneRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"9\n" +
+	"\x0fGetZoneResponse\x12&\n" +
+	"\x04zone\x18\x01 \x01(\v2\x12.beacondns.v1.ZoneR\x04zone\"G\n" +
+	"\x10ListZonesRequest\x123\n" +
+	"\aoptions\x18\x01 \x01(\v2\x19.beacondns.v1.ListOptionsR\aoptions\"^\n" +
+	"\x11ListZonesResponse\x12(\n" +
+	"\x05zones\x18\x01 \x03(\v2\x12.beacondns.v1.ZoneR\x05zones\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xbb\x01\n" +
+	"\x15UpdateZoneTagsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12>\n" +
+	"\x03set\x18\x02 \x03(\v2,.beacondns.v1.UpdateZoneTagsRequest.SetEntryR\x03set\x12\x16\n" +
+	"\x06remove\x18\x03 \x03(\tR\x06remove\x1a6\n" +
+	"\bSetEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x95\x01\n" +
+	"\x16UpdateZoneTagsResponse\x12B\n" +
+	"\x04tags\x18\x01 \x03(\v2..beacondns.v1.UpdateZoneTagsResponse.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x85\x01\n" +
+	"\x1dListResourceRecordSetsRequest\x12\x1b\n" +
+	"\tzone_name\x18\x01 \x01(\tR\bzoneName\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x123\n" +
+	"\aoptions\x18\x03 \x01(\v2\x19.beacondns.v1.ListOptionsR\aoptions\"\x94\x01\n" +
+	"\x1eListResourceRecordSetsResponse\x12Q\n" +
+	"\x14resource_record_sets\x18\x01 \x03(\v2\x1f.beacondns.v1.ResourceRecordSetR\x12resourceRecordSets\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"b\n" +
+	"\x1bGetResourceRecordSetRequest\x12\x1b\n" +
+	"\tzone_name\x18\x01 \x01(\tR\bzoneName\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\"o\n" +
+	"\x1cGetResourceRecordSetResponse\x12O\n" +
+	"\x13resource_record_set\x18\x01 \x01(\v2\x1f.beacondns.v1.ResourceRecordSetR\x11resourceRecordSet\"\xef\x01\n" +
+	"\x1eUpsertResourceRecordSetRequest\x12\x1b\n" +
+	"\tzone_name\x18\x01 \x01(\tR\bzoneName\x12O\n" +
+	"\x13resource_record_set\x18\x02 \x01(\v2\x1f.beacondns.v1.ResourceRecordSetR\x11resourceRecordSet\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x19\n" +
+	"\bsync_ptr\x18\x04 \x01(\bR\asyncPtr\x12+\n" +
+	"\x11validate_policies\x18\x05 \x01(\bR\x10validatePolicies\"\xd5\x01\n" +
+	"\x1fUpsertResourceRecordSetResponse\x12O\n" +
+	"\x13resource_record_set\x18\x01 \x01(\v2\x1f.beacondns.v1.ResourceRecordSetR\x11resourceRecordSet\x125\n" +
+	"\bwarnings\x18\x02 \x03(\v2\x19.beacondns.v1.LintFindingR\bwarnings\x12*\n" +
+	"\x04diff\x18\x03 \x01(\v2\x16.beacondns.v1.ZoneDiffR\x04diff\"\x99\x01\n" +
+	"\x1eDeleteResourceRecordSetRequest\x12\x1b\n" +
+	"\tzone_name\x18\x01 \x01(\tR\bzoneName\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12\x19\n" +
+	"\bsync_ptr\x18\x05 \x01(\bR\asyncPtr\"M\n" +
+	"\x1fDeleteResourceRecordSetResponse\x12*\n" +
+	"\x04diff\x18\x01 \x01(\v2\x16.beacondns.v1.ZoneDiffR\x04diff\"M\n" +
+	"\x11ImportZoneRequest\x12\x1b\n" +
+	"\tzone_name\x18\x01 \x01(\tR\bzoneName\x12\x1b\n" +
+	"\tzone_file\x18\x02 \x01(\tR\bzoneFile\"\xca\x01\n" +
+	"\x12ImportZoneResponse\x12\x1b\n" +
+	"\tchange_id\x18\x01 \x01(\tR\bchangeId\x12Q\n" +
+	"\x14resource_record_sets\x18\x02 \x03(\v2\x1f.beacondns.v1.ResourceRecordSetR\x12resourceRecordSets\x12D\n" +
+	"\x0fskipped_records\x18\x03 \x03(\v2\x1b.beacondns.v1.SkippedRecordR\x0eskippedRecords\"0\n" +
+	"\x11ExportZoneRequest\x12\x1b\n" +
+	"\tzone_name\x18\x01 \x01(\tR\bzoneName\"1\n" +
+	"\x12ExportZoneResponse\x12\x1b\n" +
+	"\tzone_file\x18\x01 \x01(\tR\bzoneFile\"H\n" +
+	"\x0fLintZoneRequest\x12\x1b\n" +
+	"\tzone_name\x18\x01 \x01(\tR\bzoneName\x12\x18\n" +
+	"\aresolve\x18\x02 \x01(\bR\aresolve\"I\n" +
+	"\x10LintZoneResponse\x125\n" +
+	"\bfindings\x18\x01 \x03(\v2\x19.beacondns.v1.LintFindingR\bfindings\"k\n" +
+	"\x17ListZoneVersionsRequest\x12\x1b\n" +
+	"\tzone_name\x18\x01 \x01(\tR\bzoneName\x123\n" +
+	"\aoptions\x18\x02 \x01(\v2\x19.beacondns.v1.ListOptionsR\aoptions\"r\n" +
+	"\x18ListZoneVersionsResponse\x125\n" +
+	"\bversions\x18\x01 \x03(\v2\x19.beacondns.v1.ZoneVersionR\bversions\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"Z\n" +
+	"\x17DiffZoneVersionsRequest\x12\x1b\n" +
+	"\tzone_name\x18\x01 \x01(\tR\bzoneName\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x05R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x05R\x02to\"F\n" +
+	"\x18DiffZoneVersionsResponse\x12*\n" +
+	"\x04diff\x18\x01 \x01(\v2\x16.beacondns.v1.ZoneDiffR\x04diff\"L\n" +
+	"\x13RollbackZoneRequest\x12\x1b\n" +
+	"\tzone_name\x18\x01 \x01(\tR\bzoneName\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"H\n" +
+	"\x14RollbackZoneResponse\x120\n" +
+	"\x06change\x18\x01 \x01(\v2\x18.beacondns.v1.ChangeInfoR\x06change\"\xa4\x01\n" +
+	"\x19CreateZoneTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12Q\n" +
+	"\x14resource_record_sets\x18\x03 \x03(\v2\x1f.beacondns.v1.ResourceRecordSetR\x12resourceRecordSets\"]\n" +
+	"\x1aCreateZoneTemplateResponse\x12?\n" +
+	"\rzone_template\x18\x01 \x01(\v2\x1a.beacondns.v1.ZoneTemplateR\fzoneTemplate\"\xa4\x01\n" +
+	"\x19UpdateZoneTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12Q\n" +
+	"\x14resource_record_sets\x18\x03 \x03(\v2\x1f.beacondns.v1.ResourceRecordSetR\x12resourceRecordSets\"]\n" +
+	"\x1aUpdateZoneTemplateResponse\x12?\n" +
+	"\rzone_template\x18\x01 \x01(\v2\x1a.beacondns.v1.ZoneTemplateR\fzoneTemplate\"/\n" +
+	"\x19DeleteZoneTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x1c\n" +
+	"\x1aDeleteZoneTemplateResponse\",\n" +
+	"\x16GetZoneTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"Z\n" +
+	"\x17GetZoneTemplateResponse\x12?\n" +
+	"\rzone_template\x18\x01 \x01(\v2\x1a.beacondns.v1.ZoneTemplateR\fzoneTemplate\"O\n" +
+	"\x18ListZoneTemplatesRequest\x123\n" +
+	"\aoptions\x18\x01 \x01(\v2\x19.beacondns.v1.ListOptionsR\aoptions\"\x7f\n" +
+	"\x19ListZoneTemplatesResponse\x12A\n" +
+	"\x0ezone_templates\x18\x01 \x03(\v2\x1a.beacondns.v1.ZoneTemplateR\rzoneTemplates\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"]\n" +
+	"\x18ApplyZoneTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05zones\x18\x02 \x03(\tR\x05zones\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"R\n" +
+	"\x19ApplyZoneTemplateResponse\x125\n" +
+	"\x05zones\x18\x01 \x03(\v2\x1f.beacondns.v1.ZoneTemplateDriftR\x05zones*y\n" +
+	"\fLintSeverity\x12\x1d\n" +
+	"\x19LINT_SEVERITY_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13LINT_SEVERITY_ERROR\x10\x01\x12\x19\n" +
+	"\x15LINT_SEVERITY_WARNING\x10\x02\x12\x16\n" +
+	"\x12LINT_SEVERITY_INFO\x10\x03*`\n" +
+	"\fChangeStatus\x12\x1d\n" +
+	"\x19CHANGE_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15CHANGE_STATUS_PENDING\x10\x01\x12\x16\n" +
+	"\x12CHANGE_STATUS_DONE\x10\x022\x87\x12\n" +
+	"\vZoneService\x12O\n" +
+	"\n" +
+	"CreateZone\x12\x1f.beacondns.v1.CreateZoneRequest\x1a .beacondns.v1.CreateZoneResponse\x12g\n" +
+	"\x12CreateReverseZones\x12'.beacondns.v1.CreateReverseZonesRequest\x1a(.beacondns.v1.CreateReverseZonesResponse\x12O\n" +
+	"\n" +
+	"DeleteZone\x12\x1f.beacondns.v1.DeleteZoneRequest\x1a .beacondns.v1.DeleteZoneResponse\x12a\n" +
+	"\x10ListDeletedZones\x12%.beacondns.v1.ListDeletedZonesRequest\x1a&.beacondns.v1.ListDeletedZonesResponse\x12R\n" +
+	"\vRestoreZone\x12 .beacondns.v1.RestoreZoneRequest\x1a!.beacondns.v1.RestoreZoneResponse\x12F\n" +
+	"\aGetZone\x12\x1c.beacondns.v1.GetZoneRequest\x1a\x1d.beacondns.v1.GetZoneResponse\x12L\n" +
+	"\tListZones\x12\x1e.beacondns.v1.ListZonesRequest\x1a\x1f.beacondns.v1.ListZonesResponse\x12[\n" +
+	"\x0eUpdateZoneTags\x12#.beacondns.v1.UpdateZoneTagsRequest\x1a$.beacondns.v1.UpdateZoneTagsResponse\x12s\n" +
+	"\x16ListResourceRecordSets\x12+.beacondns.v1.ListResourceRecordSetsRequest\x1a,.beacondns.v1.ListResourceRecordSetsResponse\x12m\n" +
+	"\x14GetResourceRecordSet\x12).beacondns.v1.GetResourceRecordSetRequest\x1a*.beacondns.v1.GetResourceRecordSetResponse\x12v\n" +
+	"\x17UpsertResourceRecordSet\x12,.beacondns.v1.UpsertResourceRecordSetRequest\x1a-.beacondns.v1.UpsertResourceRecordSetResponse\x12v\n" +
+	"\x17DeleteResourceRecordSet\x12,.beacondns.v1.DeleteResourceRecordSetRequest\x1a-.beacondns.v1.DeleteResourceRecordSetResponse\x12O\n" +
+	"\n" +
+	"ImportZone\x12\x1f.beacondns.v1.ImportZoneRequest\x1a .beacondns.v1.ImportZoneResponse\x12O\n" +
+	"\n" +
+	"ExportZone\x12\x1f.beacondns.v1.ExportZoneRequest\x1a .beacondns.v1.ExportZoneResponse\x12I\n" +
+	"\bLintZone\x12\x1d.beacondns.v1.LintZoneRequest\x1a\x1e.beacondns.v1.LintZoneResponse\x12a\n" +
+	"\x10ListZoneVersions\x12%.beacondns.v1.ListZoneVersionsRequest\x1a&.beacondns.v1.ListZoneVersionsResponse\x12a\n" +
+	"\x10DiffZoneVersions\x12%.beacondns.v1.DiffZoneVersionsRequest\x1a&.beacondns.v1.DiffZoneVersionsResponse\x12U\n" +
+	"\fRollbackZone\x12!.beacondns.v1.RollbackZoneRequest\x1a\".beacondns.v1.RollbackZoneResponse\x12g\n" +
+	"\x12CreateZoneTemplate\x12'.beacondns.v1.CreateZoneTemplateRequest\x1a(.beacondns.v1.CreateZoneTemplateResponse\x12g\n" +
+	"\x12UpdateZoneTemplate\x12'.beacondns.v1.UpdateZoneTemplateRequest\x1a(.beacondns.v1.UpdateZoneTemplateResponse\x12g\n" +
+	"\x12DeleteZoneTemplate\x12'.beacondns.v1.DeleteZoneTemplateRequest\x1a(.beacondns.v1.DeleteZoneTemplateResponse\x12^\n" +
+	"\x0fGetZoneTemplate\x12$.beacondns.v1.GetZoneTemplateRequest\x1a%.beacondns.v1.GetZoneTemplateResponse\x12d\n" +
+	"\x11ListZoneTemplates\x12&.beacondns.v1.ListZoneTemplatesRequest\x1a'.beacondns.v1.ListZoneTemplatesResponse\x12d\n" +
+	"\x11ApplyZoneTemplate\x12&.beacondns.v1.ApplyZoneTemplateRequest\x1a'.beacondns.v1.ApplyZoneTemplateResponseBBZ@github.com/davidseybold/beacondns/proto/beacondns/v1;beacondnsv1b\x06proto3"
+
+var (
+	file_beacondns_v1_zone_proto_rawDescOnce sync.Once
+	file_beacondns_v1_zone_proto_rawDescData []byte
+)
+
+func file_beacondns_v1_zone_proto_rawDescGZIP() []byte {
+	file_beacondns_v1_zone_proto_rawDescOnce.Do(func() {
+		file_beacondns_v1_zone_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_beacondns_v1_zone_proto_rawDesc), len(file_beacondns_v1_zone_proto_rawDesc)))
+	})
+	return file_beacondns_v1_zone_proto_rawDescData
+}
+
+var file_beacondns_v1_zone_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_beacondns_v1_zone_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
+var file_beacondns_v1_zone_proto_goTypes = []any{
+	(LintSeverity)(0),                       // 0: beacondns.v1.LintSeverity
+	(ChangeStatus)(0),                       // 1: beacondns.v1.ChangeStatus
+	(*Zone)(nil),                            // 2: beacondns.v1.Zone
+	(*DeletedZone)(nil),                     // 3: beacondns.v1.DeletedZone
+	(*LintFinding)(nil),                     // 4: beacondns.v1.LintFinding
+	(*ChangeInfo)(nil),                      // 5: beacondns.v1.ChangeInfo
+	(*ZoneVersion)(nil),                     // 6: beacondns.v1.ZoneVersion
+	(*ZoneDiff)(nil),                        // 7: beacondns.v1.ZoneDiff
+	(*ResourceRecordSetModification)(nil),   // 8: beacondns.v1.ResourceRecordSetModification
+	(*SkippedRecord)(nil),                   // 9: beacondns.v1.SkippedRecord
+	(*ZoneTemplate)(nil),                    // 10: beacondns.v1.ZoneTemplate
+	(*ZoneTemplateDrift)(nil),               // 11: beacondns.v1.ZoneTemplateDrift
+	(*CreateZoneRequest)(nil),               // 12: beacondns.v1.CreateZoneRequest
+	(*CreateZoneResponse)(nil),              // 13: beacondns.v1.CreateZoneResponse
+	(*CreateReverseZonesRequest)(nil),       // 14: beacondns.v1.CreateReverseZonesRequest
+	(*CreateReverseZonesResponse)(nil),      // 15: beacondns.v1.CreateReverseZonesResponse
+	(*DeleteZoneRequest)(nil),               // 16: beacondns.v1.DeleteZoneRequest
+	(*DeleteZoneResponse)(nil),              // 17: beacondns.v1.DeleteZoneResponse
+	(*ListDeletedZonesRequest)(nil),         // 18: beacondns.v1.ListDeletedZonesRequest
+	(*ListDeletedZonesResponse)(nil),        // 19: beacondns.v1.ListDeletedZonesResponse
+	(*RestoreZoneRequest)(nil),              // 20: beacondns.v1.RestoreZoneRequest
+	(*RestoreZoneResponse)(nil),             // 21: beacondns.v1.RestoreZoneResponse
+	(*GetZoneRequest)(nil),                  // 22: beacondns.v1.GetZoneRequest
+	(*GetZoneResponse)(nil),                 // 23: beacondns.v1.GetZoneResponse
+	(*ListZonesRequest)(nil),                // 24: beacondns.v1.ListZonesRequest
+	(*ListZonesResponse)(nil),               // 25: beacondns.v1.ListZonesResponse
+	(*UpdateZoneTagsRequest)(nil),           // 26: beacondns.v1.UpdateZoneTagsRequest
+	(*UpdateZoneTagsResponse)(nil),          // 27: beacondns.v1.UpdateZoneTagsResponse
+	(*ListResourceRecordSetsRequest)(nil),   // 28: beacondns.v1.ListResourceRecordSetsRequest
+	(*ListResourceRecordSetsResponse)(nil),  // 29: beacondns.v1.ListResourceRecordSetsResponse
+	(*GetResourceRecordSetRequest)(nil),     // 30: beacondns.v1.GetResourceRecordSetRequest
+	(*GetResourceRecordSetResponse)(nil),    // 31: beacondns.v1.GetResourceRecordSetResponse
+	(*UpsertResourceRecordSetRequest)(nil),  // 32: beacondns.v1.UpsertResourceRecordSetRequest
+	(*UpsertResourceRecordSetResponse)(nil), // 33: beacondns.v1.UpsertResourceRecordSetResponse
+	(*DeleteResourceRecordSetRequest)(nil),  // 34: beacondns.v1.DeleteResourceRecordSetRequest
+	(*DeleteResourceRecordSetResponse)(nil), // 35: beacondns.v1.DeleteResourceRecordSetResponse
+	(*ImportZoneRequest)(nil),               // 36: beacondns.v1.ImportZoneRequest
+	(*ImportZoneResponse)(nil),              // 37: beacondns.v1.ImportZoneResponse
+	(*ExportZoneRequest)(nil),               // 38: beacondns.v1.ExportZoneRequest
+	(*ExportZoneResponse)(nil),              // 39: beacondns.v1.ExportZoneResponse
+	(*LintZoneRequest)(nil),                 // 40: beacondns.v1.LintZoneRequest
+	(*LintZoneResponse)(nil),                // 41: beacondns.v1.LintZoneResponse
+	(*ListZoneVersionsRequest)(nil),         // 42: beacondns.v1.ListZoneVersionsRequest
+	(*ListZoneVersionsResponse)(nil),        // 43: beacondns.v1.ListZoneVersionsResponse
+	(*DiffZoneVersionsRequest)(nil),         // 44: beacondns.v1.DiffZoneVersionsRequest
+	(*DiffZoneVersionsResponse)(nil),        // 45: beacondns.v1.DiffZoneVersionsResponse
+	(*RollbackZoneRequest)(nil),             // 46: beacondns.v1.RollbackZoneRequest
+	(*RollbackZoneResponse)(nil),            // 47: beacondns.v1.RollbackZoneResponse
+	(*CreateZoneTemplateRequest)(nil),       // 48: beacondns.v1.CreateZoneTemplateRequest
+	(*CreateZoneTemplateResponse)(nil),      // 49: beacondns.v1.CreateZoneTemplateResponse
+	(*UpdateZoneTemplateRequest)(nil),       // 50: beacondns.v1.UpdateZoneTemplateRequest
+	(*UpdateZoneTemplateResponse)(nil),      // 51: beacondns.v1.UpdateZoneTemplateResponse
+	(*DeleteZoneTemplateRequest)(nil),       // 52: beacondns.v1.DeleteZoneTemplateRequest
+	(*DeleteZoneTemplateResponse)(nil),      // 53: beacondns.v1.DeleteZoneTemplateResponse
+	(*GetZoneTemplateRequest)(nil),          // 54: beacondns.v1.GetZoneTemplateRequest
+	(*GetZoneTemplateResponse)(nil),         // 55: beacondns.v1.GetZoneTemplateResponse
+	(*ListZoneTemplatesRequest)(nil),        // 56: beacondns.v1.ListZoneTemplatesRequest
+	(*ListZoneTemplatesResponse)(nil),       // 57: beacondns.v1.ListZoneTemplatesResponse
+	(*ApplyZoneTemplateRequest)(nil),        // 58: beacondns.v1.ApplyZoneTemplateRequest
+	(*ApplyZoneTemplateResponse)(nil),       // 59: beacondns.v1.ApplyZoneTemplateResponse
+	nil,                                     // 60: beacondns.v1.Zone.TagsEntry
+	nil,                                     // 61: beacondns.v1.CreateZoneRequest.TagsEntry
+	nil,                                     // 62: beacondns.v1.CreateReverseZonesRequest.TagsEntry
+	nil,                                     // 63: beacondns.v1.UpdateZoneTagsRequest.SetEntry
+	nil,                                     // 64: beacondns.v1.UpdateZoneTagsResponse.TagsEntry
+	(*timestamppb.Timestamp)(nil),           // 65: google.protobuf.Timestamp
+	(*ResourceRecordSet)(nil),               // 66: beacondns.v1.ResourceRecordSet
+	(*ResourceRecord)(nil),                  // 67: beacondns.v1.ResourceRecord
+	(*ListOptions)(nil),                     // 68: beacondns.v1.ListOptions
+}
+var file_beacondns_v1_zone_proto_depIdxs = []int32{
+	60, // 0: beacondns.v1.Zone.tags:type_name -> beacondns.v1.Zone.TagsEntry
+	65, // 1: beacondns.v1.DeletedZone.deleted_at:type_name -> google.protobuf.Timestamp
+	65, // 2: beacondns.v1.DeletedZone.purge_after:type_name -> google.protobuf.Timestamp
+	0,  // 3: beacondns.v1.LintFinding.severity:type_name -> beacondns.v1.LintSeverity
+	1,  // 4: beacondns.v1.ChangeInfo.status:type_name -> beacondns.v1.ChangeStatus
+	65, // 5: beacondns.v1.ChangeInfo.submitted_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: beacondns.v1.ZoneVersion.status:type_name -> beacondns.v1.ChangeStatus
+	65, // 7: beacondns.v1.ZoneVersion.created_at:type_name -> google.protobuf.Timestamp
+	66, // 8: beacondns.v1.ZoneDiff.added:type_name -> beacondns.v1.ResourceRecordSet
+	66, // 9: beacondns.v1.ZoneDiff.removed:type_name -> beacondns.v1.ResourceRecordSet
+	8,  // 10: beacondns.v1.ZoneDiff.modified:type_name -> beacondns.v1.ResourceRecordSetModification
+	66, // 11: beacondns.v1.ResourceRecordSetModification.before:type_name -> beacondns.v1.ResourceRecordSet
+	66, // 12: beacondns.v1.ResourceRecordSetModification.after:type_name -> beacondns.v1.ResourceRecordSet
+	67, // 13: beacondns.v1.ResourceRecordSetModification.added_records:type_name -> beacondns.v1.ResourceRecord
+	67, // 14: beacondns.v1.ResourceRecordSetModification.removed_records:type_name -> beacondns.v1.ResourceRecord
+	66, // 15: beacondns.v1.ZoneTemplate.resource_record_sets:type_name -> beacondns.v1.ResourceRecordSet
+	65, // 16: beacondns.v1.ZoneTemplate.created_at:type_name -> google.protobuf.Timestamp
+	65, // 17: beacondns.v1.ZoneTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 18: beacondns.v1.ZoneTemplateDrift.drift:type_name -> beacondns.v1.ZoneDiff
+	61, // 19: beacondns.v1.CreateZoneRequest.tags:type_name -> beacondns.v1.CreateZoneRequest.TagsEntry
+	2,  // 20: beacondns.v1.CreateZoneResponse.zone:type_name -> beacondns.v1.Zone
+	62, // 21: beacondns.v1.CreateReverseZonesRequest.tags:type_name -> beacondns.v1.CreateReverseZonesRequest.TagsEntry
+	2,  // 22: beacondns.v1.CreateReverseZonesResponse.zones:type_name -> beacondns.v1.Zone
+	68, // 23: beacondns.v1.ListDeletedZonesRequest.options:type_name -> beacondns.v1.ListOptions
+	3,  // 24: beacondns.v1.ListDeletedZonesResponse.deleted_zones:type_name -> beacondns.v1.DeletedZone
+	2,  // 25: beacondns.v1.RestoreZoneResponse.zone:type_name -> beacondns.v1.Zone
+	2,  // 26: beacondns.v1.GetZoneResponse.zone:type_name -> beacondns.v1.Zone
+	68, // 27: beacondns.v1.ListZonesRequest.options:type_name -> beacondns.v1.ListOptions
+	2,  // 28: beacondns.v1.ListZonesResponse.zones:type_name -> beacondns.v1.Zone
+	63, // 29: beacondns.v1.UpdateZoneTagsRequest.set:type_name -> beacondns.v1.UpdateZoneTagsRequest.SetEntry
+	64, // 30: beacondns.v1.UpdateZoneTagsResponse.tags:type_name -> beacondns.v1.UpdateZoneTagsResponse.TagsEntry
+	68, // 31: beacondns.v1.ListResourceRecordSetsRequest.options:type_name -> beacondns.v1.ListOptions
+	66, // 32: beacondns.v1.ListResourceRecordSetsResponse.resource_record_sets:type_name -> beacondns.v1.ResourceRecordSet
+	66, // 33: beacondns.v1.GetResourceRecordSetResponse.resource_record_set:type_name -> beacondns.v1.ResourceRecordSet
+	66, // 34: beacondns.v1.UpsertResourceRecordSetRequest.resource_record_set:type_name -> beacondns.v1.ResourceRecordSet
+	66, // 35: beacondns.v1.UpsertResourceRecordSetResponse.resource_record_set:type_name -> beacondns.v1.ResourceRecordSet
+	4,  // 36: beacondns.v1.UpsertResourceRecordSetResponse.warnings:type_name -> beacondns.v1.LintFinding
+	7,  // 37: beacondns.v1.UpsertResourceRecordSetResponse.diff:type_name -> beacondns.v1.ZoneDiff
+	7,  // 38: beacondns.v1.DeleteResourceRecordSetResponse.diff:type_name -> beacondns.v1.ZoneDiff
+	66, // 39: beacondns.v1.ImportZoneResponse.resource_record_sets:type_name -> beacondns.v1.ResourceRecordSet
+	9,  // 40: beacondns.v1.ImportZoneResponse.skipped_records:type_name -> beacondns.v1.SkippedRecord
+	4,  // 41: beacondns.v1.LintZoneResponse.findings:type_name -> beacondns.v1.LintFinding
+	68, // 42: beacondns.v1.ListZoneVersionsRequest.options:type_name -> beacondns.v1.ListOptions
+	6,  // 43: beacondns.v1.ListZoneVersionsResponse.versions:type_name -> beacondns.v1.ZoneVersion
+	7,  // 44: beacondns.v1.DiffZoneVersionsResponse.diff:type_name -> beacondns.v1.ZoneDiff
+	5,  // 45: beacondns.v1.RollbackZoneResponse.change:type_name -> beacondns.v1.ChangeInfo
+	66, // 46: beacondns.v1.CreateZoneTemplateRequest.resource_record_sets:type_name -> beacondns.v1.ResourceRecordSet
+	10, // 47: beacondns.v1.CreateZoneTemplateResponse.zone_template:type_name -> beacondns.v1.ZoneTemplate
+	66, // 48: beacondns.v1.UpdateZoneTemplateRequest.resource_record_sets:type_name -> beacondns.v1.ResourceRecordSet
+	10, // 49: beacondns.v1.UpdateZoneTemplateResponse.zone_template:type_name -> beacondns.v1.ZoneTemplate
+	10, // 50: beacondns.v1.GetZoneTemplateResponse.zone_template:type_name -> beacondns.v1.ZoneTemplate
+	68, // 51: beacondns.v1.ListZoneTemplatesRequest.options:type_name -> beacondns.v1.ListOptions
+	10, // 52: beacondns.v1.ListZoneTemplatesResponse.zone_templates:type_name -> beacondns.v1.ZoneTemplate
+	11, // 53: beacondns.v1.ApplyZoneTemplateResponse.zones:type_name -> beacondns.v1.ZoneTemplateDrift
+	12, // 54: beacondns.v1.ZoneService.CreateZone:input_type -> beacondns.v1.CreateZoneRequest
+	14, // 55: beacondns.v1.ZoneService.CreateReverseZones:input_type -> beacondns.v1.CreateReverseZonesRequest
+	16, // 56: beacondns.v1.ZoneService.DeleteZone:input_type -> beacondns.v1.DeleteZoneRequest
+	18, // 57: beacondns.v1.ZoneService.ListDeletedZones:input_type -> beacondns.v1.ListDeletedZonesRequest
+	20, // 58: beacondns.v1.ZoneService.RestoreZone:input_type -> beacondns.v1.RestoreZoneRequest
+	22, // 59: beacondns.v1.ZoneService.GetZone:input_type -> beacondns.v1.GetZoneRequest
+	24, // 60: beacondns.v1.ZoneService.ListZones:input_type -> beacondns.v1.ListZonesRequest
+	26, // 61: beacondns.v1.ZoneService.UpdateZoneTags:input_type -> beacondns.v1.UpdateZoneTagsRequest
+	28, // 62: beacondns.v1.ZoneService.ListResourceRecordSets:input_type -> beacondns.v1.ListResourceRecordSetsRequest
+	30, // 63: beacondns.v1.ZoneService.GetResourceRecordSet:input_type -> beacondns.v1.GetResourceRecordSetRequest
+	32, // 64: beacondns.v1.ZoneService.UpsertResourceRecordSet:input_type -> beacondns.v1.UpsertResourceRecordSetRequest
+	34, // 65: beacondns.v1.ZoneService.DeleteResourceRecordSet:input_type -> beacondns.v1.DeleteResourceRecordSetRequest
+	36, // 66: beacondns.v1.ZoneService.ImportZone:input_type -> beacondns.v1.ImportZoneRequest
+	38, // 67: beacondns.v1.ZoneService.ExportZone:input_type -> beacondns.v1.ExportZoneRequest
+	40, // 68: beacondns.v1.ZoneService.LintZone:input_type -> beacondns.v1.LintZoneRequest
+	42, // 69: beacondns.v1.ZoneService.ListZoneVersions:input_type -> beacondns.v1.ListZoneVersionsRequest
+	44, // 70: beacondns.v1.ZoneService.DiffZoneVersions:input_type -> beacondns.v1.DiffZoneVersionsRequest
+	46, // 71: beacondns.v1.ZoneService.RollbackZone:input_type -> beacondns.v1.RollbackZoneRequest
+	48, // 72: beacondns.v1.ZoneService.CreateZoneTemplate:input_type -> beacondns.v1.CreateZoneTemplateRequest
+	50, // 73: beacondns.v1.ZoneService.UpdateZoneTemplate:input_type -> beacondns.v1.UpdateZoneTemplateRequest
+	52, // 74: beacondns.v1.ZoneService.DeleteZoneTemplate:input_type -> beacondns.v1.DeleteZoneTemplateRequest
+	54, // 75: beacondns.v1.ZoneService.GetZoneTemplate:input_type -> beacondns.v1.GetZoneTemplateRequest
+	56, // 76: beacondns.v1.ZoneService.ListZoneTemplates:input_type -> beacondns.v1.ListZoneTemplatesRequest
+	58, // 77: beacondns.v1.ZoneService.ApplyZoneTemplate:input_type -> beacondns.v1.ApplyZoneTemplateRequest
+	13, // 78: beacondns.v1.ZoneService.CreateZone:output_type -> beacondns.v1.CreateZoneResponse
+	15, // 79: beacondns.v1.ZoneService.CreateReverseZones:output_type -> beacondns.v1.CreateReverseZonesResponse
+	17, // 80: beacondns.v1.ZoneService.DeleteZone:output_type -> beacondns.v1.DeleteZoneResponse
+	19, // 81: beacondns.v1.ZoneService.ListDeletedZones:output_type -> beacondns.v1.ListDeletedZonesResponse
+	21, // 82: beacondns.v1.ZoneService.RestoreZone:output_type -> beacondns.v1.RestoreZoneResponse
+	23, // 83: beacondns.v1.ZoneService.GetZone:output_type -> beacondns.v1.GetZoneResponse
+	25, // 84: beacondns.v1.ZoneService.ListZones:output_type -> beacondns.v1.ListZonesResponse
+	27, // 85: beacondns.v1.ZoneService.UpdateZoneTags:output_type -> beacondns.v1.UpdateZoneTagsResponse
+	29, // 86: beacondns.v1.ZoneService.ListResourceRecordSets:output_type -> beacondns.v1.ListResourceRecordSetsResponse
+	31, // 87: beacondns.v1.ZoneService.GetResourceRecordSet:output_type -> beacondns.v1.GetResourceRecordSetResponse
+	33, // 88: beacondns.v1.ZoneService.UpsertResourceRecordSet:output_type -> beacondns.v1.UpsertResourceRecordSetResponse
+	35, // 89: beacondns.v1.ZoneService.DeleteResourceRecordSet:output_type -> beacondns.v1.DeleteResourceRecordSetResponse
+	37, // 90: beacondns.v1.ZoneService.ImportZone:output_type -> beacondns.v1.ImportZoneResponse
+	39, // 91: beacondns.v1.ZoneService.ExportZone:output_type -> beacondns.v1.ExportZoneResponse
+	41, // 92: beacondns.v1.ZoneService.LintZone:output_type -> beacondns.v1.LintZoneResponse
+	43, // 93: beacondns.v1.ZoneService.ListZoneVersions:output_type -> beacondns.v1.ListZoneVersionsResponse
+	45, // 94: beacondns.v1.ZoneService.DiffZoneVersions:output_type -> beacondns.v1.DiffZoneVersionsResponse
+	47, // 95: beacondns.v1.ZoneService.RollbackZone:output_type -> beacondns.v1.RollbackZoneResponse
+	49, // 96: beacondns.v1.ZoneService.CreateZoneTemplate:output_type -> beacondns.v1.CreateZoneTemplateResponse
+	51, // 97: beacondns.v1.ZoneService.UpdateZoneTemplate:output_type -> beacondns.v1.UpdateZoneTemplateResponse
+	53, // 98: beacondns.v1.ZoneService.DeleteZoneTemplate:output_type -> beacondns.v1.DeleteZoneTemplateResponse
+	55, // 99: beacondns.v1.ZoneService.GetZoneTemplate:output_type -> beacondns.v1.GetZoneTemplateResponse
+	57, // 100: beacondns.v1.ZoneService.ListZoneTemplates:output_type -> beacondns.v1.ListZoneTemplatesResponse
+	59, // 101: beacondns.v1.ZoneService.ApplyZoneTemplate:output_type -> beacondns.v1.ApplyZoneTemplateResponse
+	78, // [78:102] is the sub-list for method output_type
+	54, // [54:78] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
+}
+
+func init() { file_beacondns_v1_zone_proto_init() }
+func file_beacondns_v1_zone_proto_init() {
+	if File_beacondns_v1_zone_proto != nil {
+		return
+	}
+	file_beacondns_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_beacondns_v1_zone_proto_rawDesc), len(file_beacondns_v1_zone_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   63,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_beacondns_v1_zone_proto_goTypes,
+		DependencyIndexes: file_beacondns_v1_zone_proto_depIdxs,
+		EnumInfos:         file_beacondns_v1_zone_proto_enumTypes,
+		MessageInfos:      file_beacondns_v1_zone_proto_msgTypes,
+	}.Build()
+	File_beacondns_v1_zone_proto = out.File
+	file_beacondns_v1_zone_proto_goTypes = nil
+	file_beacondns_v1_zone_proto_depIdxs = nil
+}
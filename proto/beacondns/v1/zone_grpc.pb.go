@@ -0,0 +1,1003 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: beacondns/v1/zone.proto
+
+package beacondnsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ZoneService_CreateZone_FullMethodName              = "/beacondns.v1.ZoneService/CreateZone"
+	ZoneService_CreateReverseZones_FullMethodName      = "/beacondns.v1.ZoneService/CreateReverseZones"
+	ZoneService_DeleteZone_FullMethodName              = "/beacondns.v1.ZoneService/DeleteZone"
+	ZoneService_ListDeletedZones_FullMethodName        = "/beacondns.v1.ZoneService/ListDeletedZones"
+	ZoneService_RestoreZone_FullMethodName             = "/beacondns.v1.ZoneService/RestoreZone"
+	ZoneService_GetZone_FullMethodName                 = "/beacondns.v1.ZoneService/GetZone"
+	ZoneService_ListZones_FullMethodName               = "/beacondns.v1.ZoneService/ListZones"
+	ZoneService_UpdateZoneTags_FullMethodName          = "/beacondns.v1.ZoneService/UpdateZoneTags"
+	ZoneService_ListResourceRecordSets_FullMethodName  = "/beacondns.v1.ZoneService/ListResourceRecordSets"
+	ZoneService_GetResourceRecordSet_FullMethodName    = "/beacondns.v1.ZoneService/GetResourceRecordSet"
+	ZoneService_UpsertResourceRecordSet_FullMethodName = "/beacondns.v1.ZoneService/UpsertResourceRecordSet"
+	ZoneService_DeleteResourceRecordSet_FullMethodName = "/beacondns.v1.ZoneService/DeleteResourceRecordSet"
+	ZoneService_ImportZone_FullMethodName              = "/beacondns.v1.ZoneService/ImportZone"
+	ZoneService_ExportZone_FullMethodName              = "/beacondns.v1.ZoneService/ExportZone"
+	ZoneService_LintZone_FullMethodName                = "/beacondns.v1.ZoneService/LintZone"
+	ZoneService_ListZoneVersions_FullMethodName        = "/beacondns.v1.ZoneService/ListZoneVersions"
+	ZoneService_DiffZoneVersions_FullMethodName        = "/beacondns.v1.ZoneService/DiffZoneVersions"
+	ZoneService_RollbackZone_FullMethodName            = "/beacondns.v1.ZoneService/RollbackZone"
+	ZoneService_CreateZoneTemplate_FullMethodName      = "/beacondns.v1.ZoneService/CreateZoneTemplate"
+	ZoneService_UpdateZoneTemplate_FullMethodName      = "/beacondns.v1.ZoneService/UpdateZoneTemplate"
+	ZoneService_DeleteZoneTemplate_FullMethodName      = "/beacondns.v1.ZoneService/DeleteZoneTemplate"
+	ZoneService_GetZoneTemplate_FullMethodName         = "/beacondns.v1.ZoneService/GetZoneTemplate"
+	ZoneService_ListZoneTemplates_FullMethodName       = "/beacondns.v1.ZoneService/ListZoneTemplates"
+	ZoneService_ApplyZoneTemplate_FullMethodName       = "/beacondns.v1.ZoneService/ApplyZoneTemplate"
+)
+
+// ZoneServiceClient is the client API for ZoneService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ZoneService manages hosted zones, their record sets, versions and templates. It mirrors
+// the zone endpoints of the REST API.
+type ZoneServiceClient interface {
+	CreateZone(ctx context.Context, in *CreateZoneRequest, opts ...grpc.CallOption) (*CreateZoneResponse, error)
+	// CreateReverseZones creates the reverse zones that cover a CIDR block.
+	CreateReverseZones(ctx context.Context, in *CreateReverseZonesRequest, opts ...grpc.CallOption) (*CreateReverseZonesResponse, error)
+	DeleteZone(ctx context.Context, in *DeleteZoneRequest, opts ...grpc.CallOption) (*DeleteZoneResponse, error)
+	ListDeletedZones(ctx context.Context, in *ListDeletedZonesRequest, opts ...grpc.CallOption) (*ListDeletedZonesResponse, error)
+	RestoreZone(ctx context.Context, in *RestoreZoneRequest, opts ...grpc.CallOption) (*RestoreZoneResponse, error)
+	GetZone(ctx context.Context, in *GetZoneRequest, opts ...grpc.CallOption) (*GetZoneResponse, error)
+	ListZones(ctx context.Context, in *ListZonesRequest, opts ...grpc.CallOption) (*ListZonesResponse, error)
+	UpdateZoneTags(ctx context.Context, in *UpdateZoneTagsRequest, opts ...grpc.CallOption) (*UpdateZoneTagsResponse, error)
+	ListResourceRecordSets(ctx context.Context, in *ListResourceRecordSetsRequest, opts ...grpc.CallOption) (*ListResourceRecordSetsResponse, error)
+	GetResourceRecordSet(ctx context.Context, in *GetResourceRecordSetRequest, opts ...grpc.CallOption) (*GetResourceRecordSetResponse, error)
+	UpsertResourceRecordSet(ctx context.Context, in *UpsertResourceRecordSetRequest, opts ...grpc.CallOption) (*UpsertResourceRecordSetResponse, error)
+	DeleteResourceRecordSet(ctx context.Context, in *DeleteResourceRecordSetRequest, opts ...grpc.CallOption) (*DeleteResourceRecordSetResponse, error)
+	ImportZone(ctx context.Context, in *ImportZoneRequest, opts ...grpc.CallOption) (*ImportZoneResponse, error)
+	ExportZone(ctx context.Context, in *ExportZoneRequest, opts ...grpc.CallOption) (*ExportZoneResponse, error)
+	LintZone(ctx context.Context, in *LintZoneRequest, opts ...grpc.CallOption) (*LintZoneResponse, error)
+	ListZoneVersions(ctx context.Context, in *ListZoneVersionsRequest, opts ...grpc.CallOption) (*ListZoneVersionsResponse, error)
+	DiffZoneVersions(ctx context.Context, in *DiffZoneVersionsRequest, opts ...grpc.CallOption) (*DiffZoneVersionsResponse, error)
+	RollbackZone(ctx context.Context, in *RollbackZoneRequest, opts ...grpc.CallOption) (*RollbackZoneResponse, error)
+	CreateZoneTemplate(ctx context.Context, in *CreateZoneTemplateRequest, opts ...grpc.CallOption) (*CreateZoneTemplateResponse, error)
+	UpdateZoneTemplate(ctx context.Context, in *UpdateZoneTemplateRequest, opts ...grpc.CallOption) (*UpdateZoneTemplateResponse, error)
+	DeleteZoneTemplate(ctx context.Context, in *DeleteZoneTemplateRequest, opts ...grpc.CallOption) (*DeleteZoneTemplateResponse, error)
+	GetZoneTemplate(ctx context.Context, in *GetZoneTemplateRequest, opts ...grpc.CallOption) (*GetZoneTemplateResponse, error)
+	ListZoneTemplates(ctx context.Context, in *ListZoneTemplatesRequest, opts ...grpc.CallOption) (*ListZoneTemplatesResponse, error)
+	ApplyZoneTemplate(ctx context.Context, in *ApplyZoneTemplateRequest, opts ...grpc.CallOption) (*ApplyZoneTemplateResponse, error)
+}
+
+type zoneServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewZoneServiceClient(cc grpc.ClientConnInterface) ZoneServiceClient {
+	return &zoneServiceClient{cc}
+}
+
+func (c *zoneServiceClient) CreateZone(ctx context.Context, in *CreateZoneRequest, opts ...grpc.CallOption) (*CreateZoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateZoneResponse)
+	err := c.cc.Invoke(ctx, ZoneService_CreateZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) CreateReverseZones(ctx context.Context, in *CreateReverseZonesRequest, opts ...grpc.CallOption) (*CreateReverseZonesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReverseZonesResponse)
+	err := c.cc.Invoke(ctx, ZoneService_CreateReverseZones_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) DeleteZone(ctx context.Context, in *DeleteZoneRequest, opts ...grpc.CallOption) (*DeleteZoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteZoneResponse)
+	err := c.cc.Invoke(ctx, ZoneService_DeleteZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) ListDeletedZones(ctx context.Context, in *ListDeletedZonesRequest, opts ...grpc.CallOption) (*ListDeletedZonesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedZonesResponse)
+	err := c.cc.Invoke(ctx, ZoneService_ListDeletedZones_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) RestoreZone(ctx context.Context, in *RestoreZoneRequest, opts ...grpc.CallOption) (*RestoreZoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreZoneResponse)
+	err := c.cc.Invoke(ctx, ZoneService_RestoreZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) GetZone(ctx context.Context, in *GetZoneRequest, opts ...grpc.CallOption) (*GetZoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetZoneResponse)
+	err := c.cc.Invoke(ctx, ZoneService_GetZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) ListZones(ctx context.Context, in *ListZonesRequest, opts ...grpc.CallOption) (*ListZonesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListZonesResponse)
+	err := c.cc.Invoke(ctx, ZoneService_ListZones_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) UpdateZoneTags(ctx context.Context, in *UpdateZoneTagsRequest, opts ...grpc.CallOption) (*UpdateZoneTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateZoneTagsResponse)
+	err := c.cc.Invoke(ctx, ZoneService_UpdateZoneTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) ListResourceRecordSets(ctx context.Context, in *ListResourceRecordSetsRequest, opts ...grpc.CallOption) (*ListResourceRecordSetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResourceRecordSetsResponse)
+	err := c.cc.Invoke(ctx, ZoneService_ListResourceRecordSets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) GetResourceRecordSet(ctx context.Context, in *GetResourceRecordSetRequest, opts ...grpc.CallOption) (*GetResourceRecordSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResourceRecordSetResponse)
+	err := c.cc.Invoke(ctx, ZoneService_GetResourceRecordSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) UpsertResourceRecordSet(ctx context.Context, in *UpsertResourceRecordSetRequest, opts ...grpc.CallOption) (*UpsertResourceRecordSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertResourceRecordSetResponse)
+	err := c.cc.Invoke(ctx, ZoneService_UpsertResourceRecordSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) DeleteResourceRecordSet(ctx context.Context, in *DeleteResourceRecordSetRequest, opts ...grpc.CallOption) (*DeleteResourceRecordSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResourceRecordSetResponse)
+	err := c.cc.Invoke(ctx, ZoneService_DeleteResourceRecordSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) ImportZone(ctx context.Context, in *ImportZoneRequest, opts ...grpc.CallOption) (*ImportZoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportZoneResponse)
+	err := c.cc.Invoke(ctx, ZoneService_ImportZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) ExportZone(ctx context.Context, in *ExportZoneRequest, opts ...grpc.CallOption) (*ExportZoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportZoneResponse)
+	err := c.cc.Invoke(ctx, ZoneService_ExportZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) LintZone(ctx context.Context, in *LintZoneRequest, opts ...grpc.CallOption) (*LintZoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LintZoneResponse)
+	err := c.cc.Invoke(ctx, ZoneService_LintZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) ListZoneVersions(ctx context.Context, in *ListZoneVersionsRequest, opts ...grpc.CallOption) (*ListZoneVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListZoneVersionsResponse)
+	err := c.cc.Invoke(ctx, ZoneService_ListZoneVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) DiffZoneVersions(ctx context.Context, in *DiffZoneVersionsRequest, opts ...grpc.CallOption) (*DiffZoneVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffZoneVersionsResponse)
+	err := c.cc.Invoke(ctx, ZoneService_DiffZoneVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) RollbackZone(ctx context.Context, in *RollbackZoneRequest, opts ...grpc.CallOption) (*RollbackZoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackZoneResponse)
+	err := c.cc.Invoke(ctx, ZoneService_RollbackZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) CreateZoneTemplate(ctx context.Context, in *CreateZoneTemplateRequest, opts ...grpc.CallOption) (*CreateZoneTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateZoneTemplateResponse)
+	err := c.cc.Invoke(ctx, ZoneService_CreateZoneTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) UpdateZoneTemplate(ctx context.Context, in *UpdateZoneTemplateRequest, opts ...grpc.CallOption) (*UpdateZoneTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateZoneTemplateResponse)
+	err := c.cc.Invoke(ctx, ZoneService_UpdateZoneTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) DeleteZoneTemplate(ctx context.Context, in *DeleteZoneTemplateRequest, opts ...grpc.CallOption) (*DeleteZoneTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteZoneTemplateResponse)
+	err := c.cc.Invoke(ctx, ZoneService_DeleteZoneTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) GetZoneTemplate(ctx context.Context, in *GetZoneTemplateRequest, opts ...grpc.CallOption) (*GetZoneTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetZoneTemplateResponse)
+	err := c.cc.Invoke(ctx, ZoneService_GetZoneTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) ListZoneTemplates(ctx context.Context, in *ListZoneTemplatesRequest, opts ...grpc.CallOption) (*ListZoneTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListZoneTemplatesResponse)
+	err := c.cc.Invoke(ctx, ZoneService_ListZoneTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneServiceClient) ApplyZoneTemplate(ctx context.Context, in *ApplyZoneTemplateRequest, opts ...grpc.CallOption) (*ApplyZoneTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyZoneTemplateResponse)
+	err := c.cc.Invoke(ctx, ZoneService_ApplyZoneTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ZoneServiceServer is the server API for ZoneService service.
+// All implementations must embed UnimplementedZoneServiceServer
+// for forward compatibility.
+//
+// ZoneService manages hosted zones, their record sets, versions and templates. It mirrors
+// the zone endpoints of the REST API.
+type ZoneServiceServer interface {
+	CreateZone(context.Context, *CreateZoneRequest) (*CreateZoneResponse, error)
+	// CreateReverseZones creates the reverse zones that cover a CIDR block.
+	CreateReverseZones(context.Context, *CreateReverseZonesRequest) (*CreateReverseZonesResponse, error)
+	DeleteZone(context.Context, *DeleteZoneRequest) (*DeleteZoneResponse, error)
+	ListDeletedZones(context.Context, *ListDeletedZonesRequest) (*ListDeletedZonesResponse, error)
+	RestoreZone(context.Context, *RestoreZoneRequest) (*RestoreZoneResponse, error)
+	GetZone(context.Context, *GetZoneRequest) (*GetZoneResponse, error)
+	ListZones(context.Context, *ListZonesRequest) (*ListZonesResponse, error)
+	UpdateZoneTags(context.Context, *UpdateZoneTagsRequest) (*UpdateZoneTagsResponse, error)
+	ListResourceRecordSets(context.Context, *ListResourceRecordSetsRequest) (*ListResourceRecordSetsResponse, error)
+	GetResourceRecordSet(context.Context, *GetResourceRecordSetRequest) (*GetResourceRecordSetResponse, error)
+	UpsertResourceRecordSet(context.Context, *UpsertResourceRecordSetRequest) (*UpsertResourceRecordSetResponse, error)
+	DeleteResourceRecordSet(context.Context, *DeleteResourceRecordSetRequest) (*DeleteResourceRecordSetResponse, error)
+	ImportZone(context.Context, *ImportZoneRequest) (*ImportZoneResponse, error)
+	ExportZone(context.Context, *ExportZoneRequest) (*ExportZoneResponse, error)
+	LintZone(context.Context, *LintZoneRequest) (*LintZoneResponse, error)
+	ListZoneVersions(context.Context, *ListZoneVersionsRequest) (*ListZoneVersionsResponse, error)
+	DiffZoneVersions(context.Context, *DiffZoneVersionsRequest) (*DiffZoneVersionsResponse, error)
+	RollbackZone(context.Context, *RollbackZoneRequest) (*RollbackZoneResponse, error)
+	CreateZoneTemplate(context.Context, *CreateZoneTemplateRequest) (*CreateZoneTemplateResponse, error)
+	UpdateZoneTemplate(context.Context, *UpdateZoneTemplateRequest) (*UpdateZoneTemplateResponse, error)
+	DeleteZoneTemplate(context.Context, *DeleteZoneTemplateRequest) (*DeleteZoneTemplateResponse, error)
+	GetZoneTemplate(context.Context, *GetZoneTemplateRequest) (*GetZoneTemplateResponse, error)
+	ListZoneTemplates(context.Context, *ListZoneTemplatesRequest) (*ListZoneTemplatesResponse, error)
+	ApplyZoneTemplate(context.Context, *ApplyZoneTemplateRequest) (*ApplyZoneTemplateResponse, error)
+	mustEmbedUnimplementedZoneServiceServer()
+}
+
+// UnimplementedZoneServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedZoneServiceServer struct{}
+
+func (UnimplementedZoneServiceServer) CreateZone(context.Context, *CreateZoneRequest) (*CreateZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateZone not implemented")
+}
+func (UnimplementedZoneServiceServer) CreateReverseZones(context.Context, *CreateReverseZonesRequest) (*CreateReverseZonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReverseZones not implemented")
+}
+func (UnimplementedZoneServiceServer) DeleteZone(context.Context, *DeleteZoneRequest) (*DeleteZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteZone not implemented")
+}
+func (UnimplementedZoneServiceServer) ListDeletedZones(context.Context, *ListDeletedZonesRequest) (*ListDeletedZonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedZones not implemented")
+}
+func (UnimplementedZoneServiceServer) RestoreZone(context.Context, *RestoreZoneRequest) (*RestoreZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreZone not implemented")
+}
+func (UnimplementedZoneServiceServer) GetZone(context.Context, *GetZoneRequest) (*GetZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetZone not implemented")
+}
+func (UnimplementedZoneServiceServer) ListZones(context.Context, *ListZonesRequest) (*ListZonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListZones not implemented")
+}
+func (UnimplementedZoneServiceServer) UpdateZoneTags(context.Context, *UpdateZoneTagsRequest) (*UpdateZoneTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateZoneTags not implemented")
+}
+func (UnimplementedZoneServiceServer) ListResourceRecordSets(context.Context, *ListResourceRecordSetsRequest) (*ListResourceRecordSetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResourceRecordSets not implemented")
+}
+func (UnimplementedZoneServiceServer) GetResourceRecordSet(context.Context, *GetResourceRecordSetRequest) (*GetResourceRecordSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceRecordSet not implemented")
+}
+func (UnimplementedZoneServiceServer) UpsertResourceRecordSet(context.Context, *UpsertResourceRecordSetRequest) (*UpsertResourceRecordSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertResourceRecordSet not implemented")
+}
+func (UnimplementedZoneServiceServer) DeleteResourceRecordSet(context.Context, *DeleteResourceRecordSetRequest) (*DeleteResourceRecordSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteResourceRecordSet not implemented")
+}
+func (UnimplementedZoneServiceServer) ImportZone(context.Context, *ImportZoneRequest) (*ImportZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportZone not implemented")
+}
+func (UnimplementedZoneServiceServer) ExportZone(context.Context, *ExportZoneRequest) (*ExportZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportZone not implemented")
+}
+func (UnimplementedZoneServiceServer) LintZone(context.Context, *LintZoneRequest) (*LintZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LintZone not implemented")
+}
+func (UnimplementedZoneServiceServer) ListZoneVersions(context.Context, *ListZoneVersionsRequest) (*ListZoneVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListZoneVersions not implemented")
+}
+func (UnimplementedZoneServiceServer) DiffZoneVersions(context.Context, *DiffZoneVersionsRequest) (*DiffZoneVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffZoneVersions not implemented")
+}
+func (UnimplementedZoneServiceServer) RollbackZone(context.Context, *RollbackZoneRequest) (*RollbackZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackZone not implemented")
+}
+func (UnimplementedZoneServiceServer) CreateZoneTemplate(context.Context, *CreateZoneTemplateRequest) (*CreateZoneTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateZoneTemplate not implemented")
+}
+func (UnimplementedZoneServiceServer) UpdateZoneTemplate(context.Context, *UpdateZoneTemplateRequest) (*UpdateZoneTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateZoneTemplate not implemented")
+}
+func (UnimplementedZoneServiceServer) DeleteZoneTemplate(context.Context, *DeleteZoneTemplateRequest) (*DeleteZoneTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteZoneTemplate not implemented")
+}
+func (UnimplementedZoneServiceServer) GetZoneTemplate(context.Context, *GetZoneTemplateRequest) (*GetZoneTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetZoneTemplate not implemented")
+}
+func (UnimplementedZoneServiceServer) ListZoneTemplates(context.Context, *ListZoneTemplatesRequest) (*ListZoneTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListZoneTemplates not implemented")
+}
+func (UnimplementedZoneServiceServer) ApplyZoneTemplate(context.Context, *ApplyZoneTemplateRequest) (*ApplyZoneTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyZoneTemplate not implemented")
+}
+func (UnimplementedZoneServiceServer) mustEmbedUnimplementedZoneServiceServer() {}
+func (UnimplementedZoneServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeZoneServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ZoneServiceServer will
+// result in compilation errors.
+type UnsafeZoneServiceServer interface {
+	mustEmbedUnimplementedZoneServiceServer()
+}
+
+func RegisterZoneServiceServer(s grpc.ServiceRegistrar, srv ZoneServiceServer) {
+	// If the following call pancis, it indicates UnimplementedZoneServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ZoneService_ServiceDesc, srv)
+}
+
+func _ZoneService_CreateZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).CreateZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_CreateZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).CreateZone(ctx, req.(*CreateZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_CreateReverseZones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReverseZonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).CreateReverseZones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_CreateReverseZones_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).CreateReverseZones(ctx, req.(*CreateReverseZonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_DeleteZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).DeleteZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_DeleteZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).DeleteZone(ctx, req.(*DeleteZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_ListDeletedZones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedZonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).ListDeletedZones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_ListDeletedZones_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).ListDeletedZones(ctx, req.(*ListDeletedZonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_RestoreZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).RestoreZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_RestoreZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).RestoreZone(ctx, req.(*RestoreZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_GetZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).GetZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_GetZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).GetZone(ctx, req.(*GetZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_ListZones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListZonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).ListZones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_ListZones_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).ListZones(ctx, req.(*ListZonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_UpdateZoneTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateZoneTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).UpdateZoneTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_UpdateZoneTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).UpdateZoneTags(ctx, req.(*UpdateZoneTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_ListResourceRecordSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourceRecordSetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).ListResourceRecordSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_ListResourceRecordSets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).ListResourceRecordSets(ctx, req.(*ListResourceRecordSetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_GetResourceRecordSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceRecordSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).GetResourceRecordSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_GetResourceRecordSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).GetResourceRecordSet(ctx, req.(*GetResourceRecordSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_UpsertResourceRecordSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertResourceRecordSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).UpsertResourceRecordSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_UpsertResourceRecordSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).UpsertResourceRecordSet(ctx, req.(*UpsertResourceRecordSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_DeleteResourceRecordSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteResourceRecordSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).DeleteResourceRecordSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_DeleteResourceRecordSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).DeleteResourceRecordSet(ctx, req.(*DeleteResourceRecordSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_ImportZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).ImportZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_ImportZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).ImportZone(ctx, req.(*ImportZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_ExportZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).ExportZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_ExportZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).ExportZone(ctx, req.(*ExportZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_LintZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LintZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).LintZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_LintZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).LintZone(ctx, req.(*LintZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_ListZoneVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListZoneVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).ListZoneVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_ListZoneVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).ListZoneVersions(ctx, req.(*ListZoneVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_DiffZoneVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffZoneVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).DiffZoneVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_DiffZoneVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).DiffZoneVersions(ctx, req.(*DiffZoneVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_RollbackZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).RollbackZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_RollbackZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).RollbackZone(ctx, req.(*RollbackZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_CreateZoneTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateZoneTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).CreateZoneTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_CreateZoneTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).CreateZoneTemplate(ctx, req.(*CreateZoneTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_UpdateZoneTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateZoneTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).UpdateZoneTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_UpdateZoneTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).UpdateZoneTemplate(ctx, req.(*UpdateZoneTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_DeleteZoneTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteZoneTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).DeleteZoneTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_DeleteZoneTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).DeleteZoneTemplate(ctx, req.(*DeleteZoneTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_GetZoneTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetZoneTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).GetZoneTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_GetZoneTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).GetZoneTemplate(ctx, req.(*GetZoneTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_ListZoneTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListZoneTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).ListZoneTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_ListZoneTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).ListZoneTemplates(ctx, req.(*ListZoneTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneService_ApplyZoneTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyZoneTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneServiceServer).ApplyZoneTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneService_ApplyZoneTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneServiceServer).ApplyZoneTemplate(ctx, req.(*ApplyZoneTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ZoneService_ServiceDesc is the grpc.ServiceDesc for ZoneService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ZoneService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "beacondns.v1.ZoneService",
+	HandlerType: (*ZoneServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateZone",
+			Handler:    _ZoneService_CreateZone_Handler,
+		},
+		{
+			MethodName: "CreateReverseZones",
+			Handler:    _ZoneService_CreateReverseZones_Handler,
+		},
+		{
+			MethodName: "DeleteZone",
+			Handler:    _ZoneService_DeleteZone_Handler,
+		},
+		{
+			MethodName: "ListDeletedZones",
+			Handler:    _ZoneService_ListDeletedZones_Handler,
+		},
+		{
+			MethodName: "RestoreZone",
+			Handler:    _ZoneService_RestoreZone_Handler,
+		},
+		{
+			MethodName: "GetZone",
+			Handler:    _ZoneService_GetZone_Handler,
+		},
+		{
+			MethodName: "ListZones",
+			Handler:    _ZoneService_ListZones_Handler,
+		},
+		{
+			MethodName: "UpdateZoneTags",
+			Handler:    _ZoneService_UpdateZoneTags_Handler,
+		},
+		{
+			MethodName: "ListResourceRecordSets",
+			Handler:    _ZoneService_ListResourceRecordSets_Handler,
+		},
+		{
+			MethodName: "GetResourceRecordSet",
+			Handler:    _ZoneService_GetResourceRecordSet_Handler,
+		},
+		{
+			MethodName: "UpsertResourceRecordSet",
+			Handler:    _ZoneService_UpsertResourceRecordSet_Handler,
+		},
+		{
+			MethodName: "DeleteResourceRecordSet",
+			Handler:    _ZoneService_DeleteResourceRecordSet_Handler,
+		},
+		{
+			MethodName: "ImportZone",
+			Handler:    _ZoneService_ImportZone_Handler,
+		},
+		{
+			MethodName: "ExportZone",
+			Handler:    _ZoneService_ExportZone_Handler,
+		},
+		{
+			MethodName: "LintZone",
+			Handler:    _ZoneService_LintZone_Handler,
+		},
+		{
+			MethodName: "ListZoneVersions",
+			Handler:    _ZoneService_ListZoneVersions_Handler,
+		},
+		{
+			MethodName: "DiffZoneVersions",
+			Handler:    _ZoneService_DiffZoneVersions_Handler,
+		},
+		{
+			MethodName: "RollbackZone",
+			Handler:    _ZoneService_RollbackZone_Handler,
+		},
+		{
+			MethodName: "CreateZoneTemplate",
+			Handler:    _ZoneService_CreateZoneTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateZoneTemplate",
+			Handler:    _ZoneService_UpdateZoneTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteZoneTemplate",
+			Handler:    _ZoneService_DeleteZoneTemplate_Handler,
+		},
+		{
+			MethodName: "GetZoneTemplate",
+			Handler:    _ZoneService_GetZoneTemplate_Handler,
+		},
+		{
+			MethodName: "ListZoneTemplates",
+			Handler:    _ZoneService_ListZoneTemplates_Handler,
+		},
+		{
+			MethodName: "ApplyZoneTemplate",
+			Handler:    _ZoneService_ApplyZoneTemplate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "beacondns/v1/zone.proto",
+}
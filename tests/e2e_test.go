@@ -23,6 +23,8 @@ const (
 	postgresDBPassword = "beacondns"
 
 	controllerPort = "8080"
+
+	bootstrapAPIKey = "beacon_e2e-bootstrap-key-0123456789abcdef"
 )
 
 func TestE2E(t *testing.T) {
@@ -103,14 +105,28 @@ func TestE2E(t *testing.T) {
 
 	beaconHost := fmt.Sprintf("http://%s", net.JoinHostPort(controllerHost, controllerPortStr))
 
-	beaconClient := client.New(beaconHost)
+	beaconClient := client.New(beaconHost, client.WithAPIKey(bootstrapAPIKey))
 
 	t.Logf("beaconHost: %s", beaconHost)
 
+	_, err = client.New(beaconHost).GetZone(ctx, "test.com")
+	var unauthorized *client.UnauthorizedError
+	require.ErrorAs(t, err, &unauthorized)
+
 	_, err = beaconClient.GetZone(ctx, "test.com")
 	var nze *client.NoSuchZoneError
 	require.ErrorAs(t, err, &nze)
 	t.Logf("%s\n", err.Error())
+
+	viewerKey, err := beaconClient.CreateAPIKey(ctx, client.CreateAPIKeyRequest{
+		Name:  "e2e-viewer",
+		Roles: []string{"viewer"},
+	})
+	require.NoError(t, err)
+
+	_, err = client.New(beaconHost, client.WithAPIKey(viewerKey.Key)).CreateZone(ctx, "test.com")
+	var accessDenied *client.AccessDeniedError
+	require.ErrorAs(t, err, &accessDenied)
 }
 
 type controllerEnv struct {
@@ -135,12 +151,13 @@ func runController(
 				KeepImage:  true,
 			},
 			Env: map[string]string{
-				"BEACON_DB_HOST":         env.PostgresURL,
-				"BEACON_DB_NAME":         postgresDBName,
-				"BEACON_DB_USER":         postgresDBUser,
-				"BEACON_DB_PASSWORD":     postgresDBPassword,
-				"BEACON_ETCD_ENDPOINTS":  env.EtcdURL,
-				"BEACON_CONTROLLER_PORT": controllerPort,
+				"BEACON_DB_HOST":           env.PostgresURL,
+				"BEACON_DB_NAME":           postgresDBName,
+				"BEACON_DB_USER":           postgresDBUser,
+				"BEACON_DB_PASSWORD":       postgresDBPassword,
+				"BEACON_ETCD_ENDPOINTS":    env.EtcdURL,
+				"BEACON_CONTROLLER_PORT":   controllerPort,
+				"BEACON_BOOTSTRAP_API_KEY": bootstrapAPIKey,
 			},
 			ExposedPorts: []string{"8080/tcp"},
 			WaitingFor:   wait.ForHTTP("/health").WithPort(controllerPort),